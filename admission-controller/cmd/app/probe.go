@@ -51,9 +51,15 @@ func startProbing() {
 			},
 		})
 	} else if probeType == PROBE_TYPE_READINESS {
+		datahubDialOpts, err := config.Datahub.DialOptions()
+		if err != nil {
+			scope.Errorf("Readiness probe: build datahub credentials failed due to %s", err.Error())
+			os.Exit(1)
+		}
 		probe.ReadinessProbe(&probe.ReadinessProbeConfig{
-			DatahubAddr:   config.Datahub.Address,
-			AdmCtrSrvPort: config.Port,
+			DatahubAddr:        config.Datahub.Address,
+			DatahubDialOptions: datahubDialOpts,
+			AdmCtrSrvPort:      config.Port,
 		})
 	} else {
 		scope.Errorf("Probe type does not supports %s, please try %s or %s.", probeType, PROBE_TYPE_LIVENESS, PROBE_TYPE_READINESS)
@@ -7,17 +7,21 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	admission_controller "github.com/containers-ai/alameda/admission-controller"
 	admission_controller_kubernetes "github.com/containers-ai/alameda/admission-controller/pkg/kubernetes"
 	"github.com/containers-ai/alameda/admission-controller/pkg/server"
 	admission_controller_utils "github.com/containers-ai/alameda/admission-controller/pkg/utils"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+	"github.com/containers-ai/alameda/pkg/instrumentation"
 	utils "github.com/containers-ai/alameda/pkg/utils"
 	k8s_utils "github.com/containers-ai/alameda/pkg/utils/kubernetes"
 	"github.com/containers-ai/alameda/pkg/utils/kubernetes/metadata"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	openshiftappsv1 "github.com/openshift/api/apps/v1"
 	"github.com/pkg/errors"
@@ -86,10 +90,12 @@ var (
 				panic(err)
 			}
 
+			serverConfig, err := newServerConfig()
+			if err != nil {
+				panic(err.Error())
+			}
 			admissionController, err := server.NewAdmissionControllerWithConfig(
-				server.Config{
-					Enable: config.Enable,
-				},
+				*serverConfig,
 				sigsK8SClient,
 				datahubServiceClient,
 				getJSONPatchValidationFunction(),
@@ -98,6 +104,8 @@ var (
 				panic(err.Error())
 			}
 
+			config.Metrics.Start()
+
 			mux := http.NewServeMux()
 			registerHandlerFunc(mux, admissionController)
 
@@ -118,6 +126,13 @@ func initConfig() {
 	initViperSetting()
 	mergeConfigFileValueWithDefaultConfigValue()
 
+	if err := config.Webhook.Validate(); err != nil {
+		panic(errors.Wrap(err, "initialization failed: validate webhook configuration failed"))
+	}
+	if err := config.Metrics.Validate(); err != nil {
+		panic(errors.Wrap(err, "initialization failed: validate metrics configuration failed"))
+	}
+
 	k8sCfg, err := sigs_k8s_client_config.GetConfig()
 	if err != nil {
 		panic(errors.Errorf("initialization failed: get k8s rest configuration failed: %s", err.Error()))
@@ -172,7 +187,16 @@ func initLog() {
 func initThirdPartyClient() error {
 
 	var err error
-	conn, err := grpc.Dial(config.Datahub.Address, grpc.WithInsecure(), grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(grpc_retry.WithMax(config.GRPC.Retry))))
+	dialOpts, err := config.Datahub.DialOptions()
+	if err != nil {
+		return err
+	}
+	dialOpts = append(dialOpts,
+		grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(
+			instrumentation.UnaryClientInterceptor(),
+			grpc_retry.UnaryClientInterceptor(grpc_retry.WithMax(config.GRPC.Retry)))),
+		grpc.WithStreamInterceptor(instrumentation.StreamClientInterceptor()))
+	conn, err := grpc.Dial(config.Datahub.Address, dialOpts...)
 	if err != nil {
 		return err
 	}
@@ -300,13 +324,33 @@ func prepareMutatingWebhookConfigurationInstance() error {
 		},
 	}
 
+	config.Webhook.SetupWebhook(&mutatingWebhookConfigurationInstance.Webhooks[0])
+
 	return nil
 }
 
+func newServerConfig() (*server.Config, error) {
+
+	serverConfig := &server.Config{
+		Enable:         config.Enable,
+		Timeout:        time.Duration(config.Webhook.TimeoutSeconds) * time.Second,
+		Cache:          *config.Cache,
+		CircuitBreaker: *config.CircuitBreaker,
+	}
+	if config.Webhook.ObjectSelector != nil {
+		objectSelector, err := meta_v1.LabelSelectorAsSelector(config.Webhook.ObjectSelector)
+		if err != nil {
+			return nil, errors.Wrap(err, "new server configuration failed")
+		}
+		serverConfig.ObjectSelector = objectSelector
+	}
+	return serverConfig, nil
+}
+
 func prepareClusterID() error {
 
 	var err error
-	clusterID, err = k8s_utils.GetClusterUID(sigsK8SClient)
+	clusterID, err = DatahubCluster.ResolveClusterID(config.Datahub.ClusterID, sigsK8SClient)
 	if err != nil {
 		return errors.Wrap(err, "get cluster uid failed")
 	}
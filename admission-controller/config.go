@@ -3,9 +3,12 @@ package admission_controller
 import (
 	"crypto/tls"
 
+	"github.com/containers-ai/alameda/admission-controller/pkg/server"
 	"github.com/containers-ai/alameda/admission-controller/pkg/service"
+	"github.com/containers-ai/alameda/admission-controller/pkg/webhook"
 	"github.com/containers-ai/alameda/pkg/framework/datahub"
 	"github.com/containers-ai/alameda/pkg/grpc"
+	"github.com/containers-ai/alameda/pkg/instrumentation"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 	"github.com/pkg/errors"
 )
@@ -18,17 +21,21 @@ const (
 
 // Config contains the server (the webhook) cert and key.
 type Config struct {
-	CACertFile              string                      `mapstructure:"caCertFile"`
-	CertFile                string                      `mapstructure:"tlsCertFile"`
-	KeyFile                 string                      `mapstructure:"tlsPrivateKeyFile"`
-	Enable                  bool                        `mapstructure:"enable"`
-	JsonPatchValidationFunc JsonPatchValidationFuncName `mapstructure:"jsonPatchValidationFunc"`
-	DeployedNamespace       string                      `mapstructure:"deployedNamespace"`
-	Log                     *log.Config                 `mapstructure:"log"`
-	Datahub                 *datahub.Config             `mapstructure:"datahub"`
-	Port                    int32                       `mapstructure:"port"`
-	Service                 *service.Config             `mapstructure:"service"`
-	GRPC                    *grpc.Config                `mapstructure:"gRPC"`
+	CACertFile              string                       `mapstructure:"caCertFile"`
+	CertFile                string                       `mapstructure:"tlsCertFile"`
+	KeyFile                 string                       `mapstructure:"tlsPrivateKeyFile"`
+	Enable                  bool                         `mapstructure:"enable"`
+	JsonPatchValidationFunc JsonPatchValidationFuncName  `mapstructure:"jsonPatchValidationFunc"`
+	DeployedNamespace       string                       `mapstructure:"deployedNamespace"`
+	Log                     *log.Config                  `mapstructure:"log"`
+	Datahub                 *datahub.Config              `mapstructure:"datahub"`
+	Port                    int32                        `mapstructure:"port"`
+	Service                 *service.Config              `mapstructure:"service"`
+	GRPC                    *grpc.Config                 `mapstructure:"gRPC"`
+	Webhook                 *webhook.Config              `mapstructure:"webhook"`
+	Cache                   *server.CacheConfig          `mapstructure:"cache"`
+	CircuitBreaker          *server.CircuitBreakerConfig `mapstructure:"circuitBreaker"`
+	Metrics                 *instrumentation.Config      `mapstructure:"metrics"`
 }
 
 func NewDefaultConfig() Config {
@@ -47,6 +54,10 @@ func NewDefaultConfig() Config {
 		Datahub:                 &defaultDatahubConfig,
 		Port:                    8000,
 		Service:                 defaultSvcConfig,
+		Webhook:                 webhook.NewDefaultConfig(),
+		Cache:                   server.NewDefaultCacheConfig(),
+		CircuitBreaker:          server.NewDefaultCircuitBreakerConfig(),
+		Metrics:                 instrumentation.NewDefaultConfig(),
 	}
 }
 
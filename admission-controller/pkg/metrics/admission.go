@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "alameda_admission_controller"
+)
+
+var (
+	patchesApplied = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "patches_applied_total",
+		Help:      "Total number of pods patched with resource recommendations on admission",
+	}, []string{"namespace"})
+)
+
+func init() {
+	prometheus.MustRegister(patchesApplied)
+}
+
+// AddPatchApplied Count the pod in the namespace patched with resource recommendations
+func AddPatchApplied(podNamespace string) {
+	patchesApplied.WithLabelValues(podNamespace).Inc()
+}
@@ -10,12 +10,16 @@ import (
 )
 
 type ReadinessProbeConfig struct {
-	DatahubAddr   string
-	AdmCtrSrvPort int32
+	DatahubAddr        string
+	AdmCtrSrvPort      int32
+	DatahubDialOptions []grpc.DialOption
 }
 
-func queryDatahub(datahubAddr string) error {
-	conn, err := grpc.Dial(datahubAddr, grpc.WithInsecure())
+func queryDatahub(datahubAddr string, opts []grpc.DialOption) error {
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithInsecure()}
+	}
+	conn, err := grpc.Dial(datahubAddr, opts...)
 	if err != nil {
 		return err
 	}
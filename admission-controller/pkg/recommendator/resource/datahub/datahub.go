@@ -7,6 +7,7 @@ import (
 
 	"github.com/containers-ai/alameda/admission-controller/pkg/recommendator/resource"
 	"github.com/containers-ai/alameda/pkg/framework/datahub"
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/ptypes"
@@ -23,12 +24,20 @@ var (
 		"Deployment":       datahub_v1alpha1.Kind_DEPLOYMENT,
 		"DeploymentConfig": datahub_v1alpha1.Kind_DEPLOYMENTCONFIG,
 		"StatefulSet":      datahub_v1alpha1.Kind_STATEFULSET,
+		"DaemonSet":        DatahubKind.Kind_DAEMONSET,
+		"ReplicaSet":       DatahubKind.Kind_REPLICASET,
+		"Job":              DatahubKind.Kind_JOB,
+		"CronJob":          DatahubKind.Kind_CRONJOB,
 	}
 	datahubKind_K8SKind = map[datahub_v1alpha1.Kind]string{
 		datahub_v1alpha1.Kind_POD:              "Pod",
 		datahub_v1alpha1.Kind_DEPLOYMENT:       "Deployment",
 		datahub_v1alpha1.Kind_DEPLOYMENTCONFIG: "DeploymentConfig",
 		datahub_v1alpha1.Kind_STATEFULSET:      "StatefulSet",
+		DatahubKind.Kind_DAEMONSET:             "DaemonSet",
+		DatahubKind.Kind_REPLICASET:            "ReplicaSet",
+		DatahubKind.Kind_JOB:                   "Job",
+		DatahubKind.Kind_CRONJOB:               "CronJob",
 	}
 	datahubMetricType_K8SResourceName = map[datahub_v1alpha1.MetricType]core_v1.ResourceName{
 		datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE: core_v1.ResourceCPU,
@@ -0,0 +1,52 @@
+package datahub
+
+import (
+	"testing"
+
+	"github.com/containers-ai/alameda/admission-controller/pkg/recommendator/resource"
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestControllerKindConversion(t *testing.T) {
+
+	type testCase struct {
+		k8sKind     string
+		datahubKind datahub_v1alpha1.Kind
+	}
+
+	testCases := []testCase{
+		testCase{k8sKind: "Deployment", datahubKind: datahub_v1alpha1.Kind_DEPLOYMENT},
+		testCase{k8sKind: "DeploymentConfig", datahubKind: datahub_v1alpha1.Kind_DEPLOYMENTCONFIG},
+		testCase{k8sKind: "StatefulSet", datahubKind: datahub_v1alpha1.Kind_STATEFULSET},
+		testCase{k8sKind: "DaemonSet", datahubKind: DatahubKind.Kind_DAEMONSET},
+		testCase{k8sKind: "ReplicaSet", datahubKind: DatahubKind.Kind_REPLICASET},
+		testCase{k8sKind: "Job", datahubKind: DatahubKind.Kind_JOB},
+		testCase{k8sKind: "CronJob", datahubKind: DatahubKind.Kind_CRONJOB},
+	}
+
+	assert := assert.New(t)
+	for _, testCase := range testCases {
+		request, err := buildListAvailablePodRecommendationsRequest(resource.ListControllerPodResourceRecommendationsRequest{
+			Namespace: "default",
+			Name:      "app",
+			Kind:      testCase.k8sKind,
+		})
+		assert.Nil(err, testCase.k8sKind)
+		assert.Equal(testCase.datahubKind, request.GetKind(), testCase.k8sKind)
+
+		podRecommendation := buildPodResourceRecommendationFromDatahubPodRecommendation(&datahub_v1alpha1.PodRecommendation{
+			NamespacedName: &datahub_v1alpha1.NamespacedName{Namespace: "default", Name: "app-a"},
+			TopController: &datahub_v1alpha1.TopController{
+				NamespacedName: &datahub_v1alpha1.NamespacedName{Namespace: "default", Name: "app"},
+				Kind:           testCase.datahubKind,
+			},
+		})
+		assert.Equal(testCase.k8sKind, podRecommendation.TopControllerKind, testCase.k8sKind)
+		assert.Equal("app", podRecommendation.TopControllerName, testCase.k8sKind)
+	}
+
+	_, err := buildListAvailablePodRecommendationsRequest(resource.ListControllerPodResourceRecommendationsRequest{Kind: "ReplicationController"})
+	assert.NotNil(err)
+}
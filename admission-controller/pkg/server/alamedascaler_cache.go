@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	autoscalingv1alpha1 "github.com/containers-ai/alameda/operator/pkg/apis/autoscaling/v1alpha1"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// alamedaScalerCache caches AlamedaScalers of namespaces listed from kubernetes,
+// so pods not managed by any AlamedaScaler are answered without calling datahub
+type alamedaScalerCache struct {
+	client client.Client
+	ttl    time.Duration
+
+	lock                    *sync.Mutex
+	namespaceToCacheItemMap map[string]alamedaScalerCacheItem
+}
+
+type alamedaScalerCacheItem struct {
+	alamedaScalers []autoscalingv1alpha1.AlamedaScaler
+	expiredAt      time.Time
+}
+
+func newAlamedaScalerCache(client client.Client, cfg CacheConfig) *alamedaScalerCache {
+	return &alamedaScalerCache{
+		client: client,
+		ttl:    time.Duration(cfg.TTLSeconds) * time.Second,
+
+		lock:                    &sync.Mutex{},
+		namespaceToCacheItemMap: make(map[string]alamedaScalerCacheItem),
+	}
+}
+
+func (c *alamedaScalerCache) listAlamedaScalers(namespace string) ([]autoscalingv1alpha1.AlamedaScaler, error) {
+
+	now := time.Now()
+	c.lock.Lock()
+	item, exist := c.namespaceToCacheItemMap[namespace]
+	c.lock.Unlock()
+	if exist && now.Before(item.expiredAt) {
+		return item.alamedaScalers, nil
+	}
+
+	alamedaScalerList := &autoscalingv1alpha1.AlamedaScalerList{}
+	if err := c.client.List(context.TODO(), &client.ListOptions{Namespace: namespace}, alamedaScalerList); err != nil {
+		return nil, errors.Wrapf(err, "list AlamedaScalers in namespace %s failed", namespace)
+	}
+
+	c.lock.Lock()
+	c.namespaceToCacheItemMap[namespace] = alamedaScalerCacheItem{
+		alamedaScalers: alamedaScalerList.Items,
+		expiredAt:      now.Add(c.ttl),
+	}
+	c.lock.Unlock()
+	return alamedaScalerList.Items, nil
+}
+
+// hasAlamedaScaler returns true if there is any AlamedaScaler in the namespace
+func (c *alamedaScalerCache) hasAlamedaScaler(namespace string) (bool, error) {
+
+	alamedaScalers, err := c.listAlamedaScalers(namespace)
+	if err != nil {
+		return false, err
+	}
+	return len(alamedaScalers) > 0, nil
+}
+
+// isControllerManaged returns true if the controller is monitored by any AlamedaScaler in its namespace
+func (c *alamedaScalerCache) isControllerManaged(controllerID namespaceKindName) (bool, error) {
+
+	alamedaScalers, err := c.listAlamedaScalers(controllerID.getNamespace())
+	if err != nil {
+		return false, err
+	}
+	for _, alamedaScaler := range alamedaScalers {
+		if alamedaScaler.HasAlamedaController(controllerID.getNamespace(), controllerID.getName(), controllerID.getKind()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
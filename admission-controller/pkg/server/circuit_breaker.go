@@ -0,0 +1,84 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	errCircuitOpen           = errors.New("circuit breaker is open")
+	errLatencyBudgetExceeded = errors.New("latency budget exceeded")
+)
+
+// circuitBreaker fails calls open when calls fail or exceed latency budget consecutively
+type circuitBreaker struct {
+	latencyBudget    time.Duration
+	failureThreshold int
+	cooldown         time.Duration
+
+	lock                *sync.Mutex
+	consecutiveFailures int
+	openUntil           time.Time
+}
+
+func newCircuitBreaker(cfg CircuitBreakerConfig) *circuitBreaker {
+	return &circuitBreaker{
+		latencyBudget:    time.Duration(cfg.LatencyBudgetMilliseconds) * time.Millisecond,
+		failureThreshold: cfg.FailureThreshold,
+		cooldown:         time.Duration(cfg.CooldownSeconds) * time.Second,
+
+		lock: &sync.Mutex{},
+	}
+}
+
+// call runs f within latency budget, returns errCircuitOpen without running f if circuit is open,
+// and errLatencyBudgetExceeded without waiting f to return if f does not return within latency budget.
+// Both errors returned by f and latency budget overruns are counted as failures.
+func (cb *circuitBreaker) call(f func() error) error {
+
+	if cb.isOpen(time.Now()) {
+		return errCircuitOpen
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- f()
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			cb.recordFailure(time.Now())
+		} else {
+			cb.recordSuccess()
+		}
+		return err
+	case <-time.After(cb.latencyBudget):
+		cb.recordFailure(time.Now())
+		return errLatencyBudgetExceeded
+	}
+}
+
+func (cb *circuitBreaker) isOpen(now time.Time) bool {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	return now.Before(cb.openUntil)
+}
+
+func (cb *circuitBreaker) recordSuccess() {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	cb.consecutiveFailures = 0
+}
+
+// recordFailure opens circuit for cooldown if failures reach threshold, a failure after cooldown opens circuit again
+func (cb *circuitBreaker) recordFailure(now time.Time) {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	cb.consecutiveFailures++
+	if cb.consecutiveFailures >= cb.failureThreshold {
+		cb.openUntil = now.Add(cb.cooldown)
+	}
+}
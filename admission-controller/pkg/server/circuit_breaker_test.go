@@ -0,0 +1,54 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreakerCall(t *testing.T) {
+
+	cb := newCircuitBreaker(CircuitBreakerConfig{
+		LatencyBudgetMilliseconds: 10,
+		FailureThreshold:          2,
+		CooldownSeconds:           60,
+	})
+	slow := func() error {
+		time.Sleep(100 * time.Millisecond)
+		return nil
+	}
+	errFailed := errors.New("failed")
+	failed := func() error {
+		return errFailed
+	}
+
+	// Errors returned in latency budget open circuit
+	assert.Equal(t, errFailed, cb.call(failed))
+	assert.False(t, cb.isOpen(time.Now()))
+	assert.Equal(t, errFailed, cb.call(failed))
+	assert.True(t, cb.isOpen(time.Now()))
+	assert.Equal(t, errCircuitOpen, cb.call(failed))
+
+	// Calls exceeding latency budget open circuit, a success resets consecutive failures
+	cb = newCircuitBreaker(CircuitBreakerConfig{
+		LatencyBudgetMilliseconds: 10,
+		FailureThreshold:          2,
+		CooldownSeconds:           60,
+	})
+	assert.Equal(t, errLatencyBudgetExceeded, cb.call(slow))
+	assert.Nil(t, cb.call(func() error { return nil }))
+	assert.Equal(t, errLatencyBudgetExceeded, cb.call(slow))
+	assert.False(t, cb.isOpen(time.Now()))
+	assert.Equal(t, errFailed, cb.call(failed))
+	assert.True(t, cb.isOpen(time.Now()))
+
+	called := false
+	assert.Equal(t, errCircuitOpen, cb.call(func() error {
+		called = true
+		return nil
+	}))
+	assert.False(t, called)
+	assert.False(t, cb.isOpen(time.Now().Add(time.Minute)))
+}
@@ -1,5 +1,48 @@
 package server
 
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
 type Config struct {
 	Enable bool
+	// Timeout is the time to answer AdmissionReview in, pod is admitted without mutating after timeout
+	Timeout time.Duration
+	// ObjectSelector selects pods to mutate
+	ObjectSelector labels.Selector
+	Cache          CacheConfig
+	CircuitBreaker CircuitBreakerConfig
+}
+
+// CacheConfig is the configuration of cache of AlamedaScalers to answer pods not managed by any AlamedaScaler
+type CacheConfig struct {
+	TTLSeconds int64 `mapstructure:"ttlSeconds"`
+}
+
+// NewDefaultCacheConfig returns CacheConfig with default value
+func NewDefaultCacheConfig() *CacheConfig {
+	return &CacheConfig{
+		TTLSeconds: 30,
+	}
+}
+
+// CircuitBreakerConfig is the configuration of circuit breaker of calls to datahub
+type CircuitBreakerConfig struct {
+	// LatencyBudgetMilliseconds is the max latency of a call to datahub, pod is admitted without mutating if it is exceeded
+	LatencyBudgetMilliseconds int64 `mapstructure:"latencyBudgetMilliseconds"`
+	// FailureThreshold is the number of consecutive calls failing or exceeding latency budget to open circuit
+	FailureThreshold int `mapstructure:"failureThreshold"`
+	// CooldownSeconds is the time circuit stays open, pods are admitted without calling datahub while circuit is open
+	CooldownSeconds int64 `mapstructure:"cooldownSeconds"`
+}
+
+// NewDefaultCircuitBreakerConfig returns CircuitBreakerConfig with default value
+func NewDefaultCircuitBreakerConfig() *CircuitBreakerConfig {
+	return &CircuitBreakerConfig{
+		LatencyBudgetMilliseconds: 2000,
+		FailureThreshold:          3,
+		CooldownSeconds:           30,
+	}
 }
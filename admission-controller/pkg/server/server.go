@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 
 	admission_controller_kubernetes "github.com/containers-ai/alameda/admission-controller/pkg/kubernetes"
+	"github.com/containers-ai/alameda/admission-controller/pkg/metrics"
 	"github.com/containers-ai/alameda/admission-controller/pkg/recommendator/resource"
 	datahub_resource_recommendator "github.com/containers-ai/alameda/admission-controller/pkg/recommendator/resource/datahub"
 	admission_controller_utils "github.com/containers-ai/alameda/admission-controller/pkg/utils"
@@ -30,6 +31,7 @@ import (
 	admission_v1beta1 "k8s.io/api/admission/v1beta1"
 	core_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -79,6 +81,9 @@ type admissionController struct {
 
 	podMutatePatchValdationFunction admission_controller_utils.ValidatePatchFunc
 
+	alamedaScalerCache    *alamedaScalerCache
+	datahubCircuitBreaker *circuitBreaker
+
 	clusterID string
 }
 
@@ -116,6 +121,9 @@ func NewAdmissionControllerWithConfig(cfg Config, sigsK8SClient client.Client, d
 
 		podMutatePatchValdationFunction: podMutatePatchValdationFunction,
 
+		alamedaScalerCache:    newAlamedaScalerCache(sigsK8SClient, cfg.Cache),
+		datahubCircuitBreaker: newCircuitBreaker(cfg.CircuitBreaker),
+
 		clusterID: clusterID,
 	}
 
@@ -148,7 +156,7 @@ func (ac *admissionController) serve(w http.ResponseWriter, r *http.Request, adm
 		ac.writeDefaultAdmissionReview(w)
 		return
 	}
-	admissionResponse, events, err := admit(admissionReview)
+	admissionResponse, events, err := ac.admitWithTimeout(admit, admissionReview)
 	if err != nil {
 		scope.Warnf("admit with error: %s, skip serving AdmissionReview: %+v", err.Error(), admissionReview)
 		ac.writeDefaultAdmissionReview(w)
@@ -159,10 +167,40 @@ func (ac *admissionController) serve(w http.ResponseWriter, r *http.Request, adm
 	err = ac.writeAdmissionReview(w, admissionResponse)
 	if err != nil {
 		scope.Warnf("")
-	} else {
-		if err := ac.sendEvents(events); err != nil {
-			scope.Warnf("Send events to datahub failed: %s\n", err.Error())
-		}
+	} else if dryRun := admissionReview.Request.DryRun; dryRun == nil || !*dryRun {
+		// Answer apiserver without waiting datahub
+		go func() {
+			if err := ac.sendEvents(events); err != nil {
+				scope.Warnf("Send events to datahub failed: %s\n", err.Error())
+			}
+		}()
+	}
+}
+
+// admitWithTimeout returns error if admit does not return within timeout, so pod is admitted without mutating
+func (ac *admissionController) admitWithTimeout(admit admitFunc, ar *admission_v1beta1.AdmissionReview) (admission_v1beta1.AdmissionResponse, []*datahub_v1alpha1.Event, error) {
+
+	type admitResult struct {
+		admissionResponse admission_v1beta1.AdmissionResponse
+		events            []*datahub_v1alpha1.Event
+		err               error
+	}
+
+	done := make(chan admitResult, 1)
+	go func() {
+		admissionResponse, events, err := admit(ar)
+		done <- admitResult{admissionResponse: admissionResponse, events: events, err: err}
+	}()
+
+	var timeout <-chan time.Time
+	if ac.config.Timeout > 0 {
+		timeout = time.After(ac.config.Timeout)
+	}
+	select {
+	case result := <-done:
+		return result.admissionResponse, result.events, result.err
+	case <-timeout:
+		return defaultAdmissionResponse, nil, errors.Errorf("admit timeout after %f seconds", ac.config.Timeout.Seconds())
 	}
 }
 
@@ -211,6 +249,19 @@ func (ac *admissionController) mutatePod(ar *admission_v1beta1.AdmissionReview)
 	pod.SetNamespace(ar.Request.Namespace)
 	podID := newNamespaceKindName(pod.Namespace, pod.Kind, pod.Name)
 
+	if ac.config.ObjectSelector != nil && !ac.config.ObjectSelector.Matches(labels.Set(pod.Labels)) {
+		scope.Debugf("pod is not selected by objectSelector, skip mutating pod: Pod: %+v", pod.ObjectMeta)
+		return admissionResponse, nil, nil
+	}
+
+	// Answer pods not managed by any AlamedaScaler without calling datahub
+	if hasAlamedaScaler, err := ac.alamedaScalerCache.hasAlamedaScaler(pod.Namespace); err != nil {
+		scope.Warnf("check if pod is managed by AlamedaScaler from cache failed: %s", err.Error())
+	} else if !hasAlamedaScaler {
+		scope.Debugf("no AlamedaScaler in namespace, skip mutating pod: Pod: %+v", pod.ObjectMeta)
+		return admissionResponse, nil, nil
+	}
+
 	scope.Infof("Mutating pod: %+v", pod.ObjectMeta)
 
 	ownerRef, err := ac.getTopSupportedOwnerReference(&pod)
@@ -219,21 +270,43 @@ func (ac *admissionController) mutatePod(ar *admission_v1beta1.AdmissionReview)
 	}
 	controllerID := ac.getControllerIDFromOwnerReference(pod.Namespace, ownerRef)
 
-	executionEnabeld, err := ac.isControllerExecutionEnabled(controllerID)
+	if managed, err := ac.alamedaScalerCache.isControllerManaged(controllerID); err != nil {
+		scope.Warnf("check if pod is managed by AlamedaScaler from cache failed: %s", err.Error())
+	} else if !managed {
+		scope.Debugf("controller is not managed by any AlamedaScaler, skip mutating pod: controllerID: %s, Pod: %+v", controllerID.String(), pod.ObjectMeta)
+		return admissionResponse, nil, nil
+	}
+
+	var alamedaScaler *autoscalingv1alpha1.AlamedaScaler
+	err = ac.datahubCircuitBreaker.call(func() error {
+		var err error
+		alamedaScaler, err = ac.getControllerAlamedaScaler(controllerID)
+		return err
+	})
 	if err != nil {
 		return admissionResponse, events, errors.Wrapf(err, "check if pod needs mutating faield, skip mutating pod: Pod: %+v", pod.ObjectMeta)
-	} else if !executionEnabeld {
+	} else if !alamedaScaler.IsEnableExecution() {
 		return admissionResponse, events, errors.Errorf("execution of AlamedaScaler monitoring this pod is not enabled, skip mutating pod: Pod: %+v", pod.ObjectMeta)
 	}
-	recommendation, err := ac.getPodResourceRecommendationByPodNamespaceNameOrByControllerID(podID, controllerID)
+	var recommendation *resource.PodResourceRecommendation
+	err = ac.datahubCircuitBreaker.call(func() error {
+		var err error
+		recommendation, err = ac.getPodResourceRecommendationByPodNamespaceNameOrByControllerID(podID, controllerID)
+		return err
+	})
 	if err != nil {
 		return admissionResponse, events, errors.Errorf("get pod resource recommendations failed, controllerID: %s, skip mutating pod: Pod: %+v, errMsg: %s", controllerID.String(), pod.ObjectMeta, err.Error())
 	} else if recommendation == nil {
 		return admissionResponse, events, errors.Errorf("fetch empty recommendations of controller, controllerID: %s, skip mutating pod: Pod: %+v", controllerID.String(), pod.ObjectMeta)
 	}
 
+	limitRanges, err := ac.listLimitRanges(pod.Namespace)
+	if err != nil {
+		scope.Warnf("ignore LimitRanges of namespace %s: %s", pod.Namespace, err.Error())
+	}
+
 	scope.Debugf("Mutate pod with recommendation: %+v\n", recommendation)
-	patches, err := admission_controller_utils.GetPatchesFromPodResourceRecommendation(&pod, recommendation)
+	patches, err := admission_controller_utils.GetPatchesFromPodResourceRecommendation(&pod, recommendation, alamedaScaler.GetLimitsPolicy(), limitRanges)
 	if err != nil {
 		return admissionResponse, events, errors.Wrapf(err, "get patches to mutate pod resource failed, skip mutating pod: Pod: %+v", pod.ObjectMeta)
 	}
@@ -246,6 +319,7 @@ func (ac *admissionController) mutatePod(ar *admission_v1beta1.AdmissionReview)
 
 	admissionResponse.Patch = []byte(patchString)
 	admissionResponse.PatchType = &patchType
+	metrics.AddPatchApplied(pod.Namespace)
 
 	event := newPodPatchEvent(pod.Namespace, ac.clusterID, pod.OwnerReferences[0])
 	events[0] = &event
@@ -359,6 +433,26 @@ func (ac *admissionController) listPodByController(controllerID namespaceKindNam
 		if err != nil {
 			return pods, errors.Wrapf(err, "list pods controlled by controllerID: %s failed", controllerID.String())
 		}
+	case "DaemonSet":
+		podsInCluster, err = listResource.ListPodsByDaemonSet(controllerID.getNamespace(), controllerID.getName())
+		if err != nil {
+			return pods, errors.Wrapf(err, "list pods controlled by controllerID: %s failed", controllerID.String())
+		}
+	case "ReplicaSet":
+		podsInCluster, err = listResource.ListPodsByReplicaSet(controllerID.getNamespace(), controllerID.getName())
+		if err != nil {
+			return pods, errors.Wrapf(err, "list pods controlled by controllerID: %s failed", controllerID.String())
+		}
+	case "Job":
+		podsInCluster, err = listResource.ListPodsByJob(controllerID.getNamespace(), controllerID.getName())
+		if err != nil {
+			return pods, errors.Wrapf(err, "list pods controlled by controllerID: %s failed", controllerID.String())
+		}
+	case "CronJob":
+		podsInCluster, err = listResource.ListPodsByCronJob(controllerID.getNamespace(), controllerID.getName())
+		if err != nil {
+			return pods, errors.Wrapf(err, "list pods controlled by controllerID: %s failed", controllerID.String())
+		}
 	default:
 		return pods, errors.Errorf("no matching resource lister for controller kind: %s", controllerID.getKind())
 	}
@@ -399,28 +493,28 @@ func (ac *admissionController) fetchNewPodRecommendations(controllerID namespace
 	return recommendations, err
 }
 
-func (ac *admissionController) isControllerExecutionEnabled(controllerID namespaceKindName) (bool, error) {
+func (ac *admissionController) listLimitRanges(namespace string) ([]core_v1.LimitRange, error) {
 
-	return ac.controllerValidator.IsControllerEnabledExecution(controllerID.namespace, controllerID.name, controllerID.kind)
+	limitRangeList := &core_v1.LimitRangeList{}
+	if err := ac.sigsK8SClient.List(context.TODO(), &client.ListOptions{Namespace: namespace}, limitRangeList); err != nil {
+		return nil, errors.Wrap(err, "list LimitRanges failed")
+	}
+	return limitRangeList.Items, nil
 }
 
-func (ac *admissionController) getTopSupportedOwnerReference(pod *core_v1.Pod) (meta_v1.OwnerReference, error) {
+func (ac *admissionController) getControllerAlamedaScaler(controllerID namespaceKindName) (*autoscalingv1alpha1.AlamedaScaler, error) {
 
-	var ownerRef = meta_v1.OwnerReference{}
+	return ac.controllerValidator.GetControllerAlamedaScaler(controllerID.namespace, controllerID.name, controllerID.kind)
+}
 
-	link, err := ac.ownerReferenceTracer.GetControllerOwnerReferenceLink(pod)
-	if err != nil {
-		return ownerRef, err
-	}
+func (ac *admissionController) getTopSupportedOwnerReference(pod *core_v1.Pod) (meta_v1.OwnerReference, error) {
 
-	for i := len(link) - 1; i >= 0; i-- {
-		if _, exist := autoscalingv1alpha1.K8SKindToAlamedaControllerType[link[i].Kind]; exist {
-			ownerRef = link[i]
-			break
-		}
+	supportedKinds := make(map[string]bool, len(autoscalingv1alpha1.K8SKindToAlamedaControllerType))
+	for kind := range autoscalingv1alpha1.K8SKindToAlamedaControllerType {
+		supportedKinds[kind] = true
 	}
 
-	return ownerRef, nil
+	return ac.ownerReferenceTracer.GetTopOwnerReferenceOfKinds(pod, supportedKinds)
 }
 
 func (ac *admissionController) getControllerIDFromOwnerReference(namespace string, ownerRef meta_v1.OwnerReference) namespaceKindName {
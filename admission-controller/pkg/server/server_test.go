@@ -0,0 +1,66 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestOwnedObjectMeta(name, ownerKind, ownerName string) meta_v1.ObjectMeta {
+	isController := true
+	return meta_v1.ObjectMeta{
+		Namespace: "default",
+		Name:      name,
+		OwnerReferences: []meta_v1.OwnerReference{
+			meta_v1.OwnerReference{Kind: ownerKind, Name: ownerName, Controller: &isController},
+		},
+	}
+}
+
+func TestListPodByController(t *testing.T) {
+
+	objects := []runtime.Object{
+		&core_v1.Pod{ObjectMeta: newTestOwnedObjectMeta("daemonset-a", "DaemonSet", "app")},
+		&core_v1.Pod{ObjectMeta: newTestOwnedObjectMeta("replicaset-a", "ReplicaSet", "app")},
+		&core_v1.Pod{ObjectMeta: newTestOwnedObjectMeta("replicaset-b", "ReplicaSet", "app")},
+		&core_v1.Pod{ObjectMeta: newTestOwnedObjectMeta("job-a", "Job", "app")},
+		&batchv1.Job{ObjectMeta: newTestOwnedObjectMeta("app-1560000000", "CronJob", "app")},
+		&core_v1.Pod{ObjectMeta: newTestOwnedObjectMeta("cronjob-a", "Job", "app-1560000000")},
+		&core_v1.Pod{ObjectMeta: newTestOwnedObjectMeta("other-a", "DaemonSet", "other")},
+	}
+
+	type testCase struct {
+		kind    string
+		want    []string
+		wantErr bool
+	}
+
+	testCases := []testCase{
+		testCase{kind: "DaemonSet", want: []string{"daemonset-a"}},
+		testCase{kind: "ReplicaSet", want: []string{"replicaset-a", "replicaset-b"}},
+		testCase{kind: "Job", want: []string{"job-a"}},
+		testCase{kind: "CronJob", want: []string{"cronjob-a"}},
+		testCase{kind: "ReplicationController", wantErr: true},
+	}
+
+	ac := &admissionController{sigsK8SClient: fake.NewFakeClient(objects...)}
+	assert := assert.New(t)
+	for _, testCase := range testCases {
+		pods, err := ac.listPodByController(newNamespaceKindName("default", testCase.kind, "app"))
+		if testCase.wantErr {
+			assert.NotNil(err, testCase.kind)
+			continue
+		}
+		assert.Nil(err, testCase.kind)
+		names := make([]string, 0, len(pods))
+		for _, pod := range pods {
+			names = append(names, pod.GetName())
+		}
+		assert.ElementsMatch(testCase.want, names, testCase.kind)
+	}
+}
@@ -5,33 +5,50 @@ import (
 	"strings"
 
 	"github.com/containers-ai/alameda/admission-controller/pkg/recommendator/resource"
+	autoscalingv1alpha1 "github.com/containers-ai/alameda/operator/pkg/apis/autoscaling/v1alpha1"
+	requirements_utils "github.com/containers-ai/alameda/pkg/utils/kubernetes/requirements"
 	"github.com/mattbaird/jsonpatch"
 	"github.com/pkg/errors"
 	core_v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func GetPatchesFromPodResourceRecommendation(pod *core_v1.Pod, recommendation *resource.PodResourceRecommendation) ([]jsonpatch.JsonPatchOperation, error) {
+// GetPatchesFromPodResourceRecommendation returns patches merging recommended resources into containers and
+// init containers of the pod, resources without recommendation are kept as they are and merged resources are
+// clamped into LimitRanges of the pod's namespace
+func GetPatchesFromPodResourceRecommendation(pod *core_v1.Pod, recommendation *resource.PodResourceRecommendation, limitsPolicy autoscalingv1alpha1.LimitsPolicy, limitRanges []core_v1.LimitRange) ([]jsonpatch.JsonPatchOperation, error) {
 
 	patches := make([]jsonpatch.JsonPatchOperation, 0)
 
 	originPod := pod.DeepCopy()
 	mutatedPod := pod.DeepCopy()
 
-	containerNameMap := make(map[string]int)
+	containerNameMap := make(map[string]*core_v1.Container)
+	for i, container := range mutatedPod.Spec.InitContainers {
+		containerNameMap[container.Name] = &mutatedPod.Spec.InitContainers[i]
+	}
 	for i, container := range mutatedPod.Spec.Containers {
-		containerNameMap[container.Name] = i
+		containerNameMap[container.Name] = &mutatedPod.Spec.Containers[i]
 	}
 
 	for _, containerResourceRecommendation := range recommendation.ContainerResourceRecommendations {
 
 		containerName := containerResourceRecommendation.Name
-		containerIndex, exist := containerNameMap[containerName]
+		container, exist := containerNameMap[containerName]
 		if !exist {
 			continue
 		}
 
-		mutatedPod.Spec.Containers[containerIndex].Resources.Limits = containerResourceRecommendation.Limits
-		mutatedPod.Spec.Containers[containerIndex].Resources.Requests = containerResourceRecommendation.Requests
+		requirements_utils.MergeRecommendation(&container.Resources, containerResourceRecommendation.Requests, containerResourceRecommendation.Limits, limitsPolicy)
+		requirements_utils.ClampByLimitRanges(&container.Resources, limitRanges)
+	}
+
+	// Record the applied recommendation, it is moved into AlamedaScaler monitoring the pod by operator
+	if !apiequality.Semantic.DeepEqual(originPod.Spec, mutatedPod.Spec) {
+		if err := setAppliedRecommendationAnnotation(originPod, mutatedPod); err != nil {
+			return patches, errors.Wrap(err, "set applied recommendation annotation failed")
+		}
 	}
 
 	originPodbytes, err := json.Marshal(originPod)
@@ -73,3 +90,30 @@ func GetK8SPatchesString(patches []jsonpatch.JsonPatchOperation) string {
 	patch = "[" + patch + "]"
 	return patch
 }
+
+func setAppliedRecommendationAnnotation(originPod, mutatedPod *core_v1.Pod) error {
+
+	// Name of pod created by controller is not generated yet
+	podName := originPod.GetName()
+	if podName == "" {
+		podName = originPod.GetGenerateName()
+	}
+	history := autoscalingv1alpha1.AlamedaRecommendationHistory{
+		Time:    meta_v1.Now(),
+		PodName: podName,
+		Applier: autoscalingv1alpha1.RecommendationApplierAdmissionController,
+		Applied: true,
+		Before:  autoscalingv1alpha1.NewAlamedaContainers(originPod.Spec),
+		After:   autoscalingv1alpha1.NewAlamedaContainers(mutatedPod.Spec),
+	}
+	historyBytes, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+
+	if mutatedPod.Annotations == nil {
+		mutatedPod.Annotations = make(map[string]string)
+	}
+	mutatedPod.Annotations[autoscalingv1alpha1.AnnotationKeyAppliedRecommendation] = string(historyBytes)
+	return nil
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/containers-ai/alameda/admission-controller/pkg/recommendator/resource"
+	autoscalingv1alpha1 "github.com/containers-ai/alameda/operator/pkg/apis/autoscaling/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	k8s_resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestGetPatchesFromPodResourceRecommendationWithInitContainers(t *testing.T) {
+
+	pod := &core_v1.Pod{
+		Spec: core_v1.PodSpec{
+			InitContainers: []core_v1.Container{{Name: "init"}},
+			Containers:     []core_v1.Container{{Name: "app"}},
+		},
+	}
+	recommendation := &resource.PodResourceRecommendation{
+		ContainerResourceRecommendations: []*resource.ContainerResourceRecommendation{
+			{
+				Name:     "init",
+				Requests: core_v1.ResourceList{core_v1.ResourceCPU: k8s_resource.MustParse("100m")},
+			},
+		},
+	}
+
+	patches, err := GetPatchesFromPodResourceRecommendation(pod, recommendation, autoscalingv1alpha1.LimitsPolicyRecommended, nil)
+	assert.NoError(t, err)
+	paths := make([]string, len(patches))
+	for i, patch := range patches {
+		paths[i] = patch.Path
+	}
+	assert.ElementsMatch(t, []string{"/spec/initContainers/0/resources/requests", "/metadata/annotations"}, paths)
+	assert.Empty(t, pod.Spec.InitContainers[0].Resources.Requests)
+}
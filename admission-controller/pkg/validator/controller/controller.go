@@ -1,6 +1,11 @@
 package controller
 
+import (
+	autoscaling_v1alpha1 "github.com/containers-ai/alameda/operator/pkg/apis/autoscaling/v1alpha1"
+)
+
 // Validator is an interface defining controller validation functions
 type Validator interface {
 	IsControllerEnabledExecution(namespace, name, kind string) (bool, error)
+	GetControllerAlamedaScaler(namespace, name, kind string) (*autoscaling_v1alpha1.AlamedaScaler, error)
 }
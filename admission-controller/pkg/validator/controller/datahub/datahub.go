@@ -5,6 +5,8 @@ import (
 
 	"github.com/containers-ai/alameda/admission-controller/pkg/validator/controller"
 	autoscaling_v1alpha1 "github.com/containers-ai/alameda/operator/pkg/apis/autoscaling/v1alpha1"
+	// register datahub kinds which are not defined in containers-ai/api yet
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/pkg/errors"
@@ -32,9 +34,19 @@ func NewControllerValidator(datahubServiceClient datahub_v1alpha1.DatahubService
 
 func (v *validator) IsControllerEnabledExecution(namespace, name, kind string) (bool, error) {
 
-	datahubKind, exist := datahub_v1alpha1.Kind_value[strings.ToUpper(kind)]
+	alamedaScaler, err := v.GetControllerAlamedaScaler(namespace, name, kind)
+	if err != nil {
+		return false, err
+	}
+	return alamedaScaler.IsEnableExecution(), nil
+}
+
+// GetControllerAlamedaScaler returns the AlamedaScaler monitoring the controller
+func (v *validator) GetControllerAlamedaScaler(namespace, name, kind string) (*autoscaling_v1alpha1.AlamedaScaler, error) {
+
+	datahubKind, exist := DatahubKind.Value(strings.ToUpper(kind))
 	if !exist {
-		return false, errors.Errorf("no matched datahub kind for kind: %s", kind)
+		return nil, errors.Errorf("no matched datahub kind for kind: %s", kind)
 	}
 
 	ctx := buildDefaultRequestContext()
@@ -48,31 +60,31 @@ func (v *validator) IsControllerEnabledExecution(namespace, name, kind string) (
 	resp, err := v.datahubServiceClient.ListControllers(ctx, req)
 	scope.Debugf("query ListControllers to datahub, received response: %+v", resp)
 	if err != nil {
-		return false, errors.Errorf("query ListControllers to datahub failed: errMsg: %s", err.Error())
+		return nil, errors.Errorf("query ListControllers to datahub failed: errMsg: %s", err.Error())
 	}
 	if resp.Status == nil {
-		return false, errors.New("receive nil status from datahub")
+		return nil, errors.New("receive nil status from datahub")
 	} else if resp.Status.Code != int32(code.Code_OK) {
-		return false, errors.Errorf("status code not 0: receive status code: %d,message: %s", resp.Status.Code, resp.Status.Message)
+		return nil, errors.Errorf("status code not 0: receive status code: %d,message: %s", resp.Status.Code, resp.Status.Message)
 	}
 
 	controllers := resp.Controllers
 	indices := getMatchedControllerIndices(controllers, namespace, name, datahub_v1alpha1.Kind(datahubKind))
 	if len(indices) == 0 {
-		return false, errors.Errorf("cannot find matched controller (%s/%s ,kind: %s) from datahub", namespace, name, kind)
+		return nil, errors.Errorf("cannot find matched controller (%s/%s ,kind: %s) from datahub", namespace, name, kind)
 	}
 	controller := controllers[0]
 
 	alamedaScalerIndices := getMatchedResourceIndicesWithKind(controller.OwnerInfo, datahub_v1alpha1.Kind_ALAMEDASCALER)
 	if len(alamedaScalerIndices) == 0 {
-		return false, errors.Errorf("cannot find matched AlamedaScaler to controller (%s/%s ,kind: %s) from datahub", namespace, name, kind)
+		return nil, errors.Errorf("cannot find matched AlamedaScaler to controller (%s/%s ,kind: %s) from datahub", namespace, name, kind)
 	}
 	alamedaScalerInfo := controller.OwnerInfo[alamedaScalerIndices[0]]
 	alamedaScalerNamespacedName := alamedaScalerInfo.NamespacedName
 	if alamedaScalerNamespacedName == nil {
-		return false, errors.Errorf("getting AlamedaScaler with empty NamespacedName controller (%s/%s ,kind: %s) from datahub", namespace, name, kind)
+		return nil, errors.Errorf("getting AlamedaScaler with empty NamespacedName controller (%s/%s ,kind: %s) from datahub", namespace, name, kind)
 	} else if alamedaScalerNamespacedName.Namespace == "" || alamedaScalerNamespacedName.Name == "" {
-		return false, errors.Errorf("getting AlamedaScaler with empty NamespacedName controller (%s/%s ,kind: %s) from datahub", namespace, name, kind)
+		return nil, errors.Errorf("getting AlamedaScaler with empty NamespacedName controller (%s/%s ,kind: %s) from datahub", namespace, name, kind)
 	}
 
 	alamedaScaler := autoscaling_v1alpha1.AlamedaScaler{}
@@ -84,7 +96,7 @@ func (v *validator) IsControllerEnabledExecution(namespace, name, kind string) (
 		},
 		&alamedaScaler)
 	if err != nil {
-		return false, errors.Errorf("get AlamedaScaler from k8s failed: %s", err.Error())
+		return nil, errors.Errorf("get AlamedaScaler from k8s failed: %s", err.Error())
 	}
 	scope.Debugf(`get monitoring AlamedaScaler for controller, controller:{
 		namespace: %s,
@@ -94,7 +106,7 @@ func (v *validator) IsControllerEnabledExecution(namespace, name, kind string) (
 		namespace: %s,
 		name: %s
 	}`, namespace, name, kind, alamedaScaler.Namespace, alamedaScaler.Name)
-	return alamedaScaler.IsEnableExecution(), nil
+	return &alamedaScaler, nil
 }
 
 func getMatchedControllerIndices(controllers []*datahub_v1alpha1.Controller, namespace string, name string, kind datahub_v1alpha1.Kind) []int {
@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"github.com/pkg/errors"
+	admissionregistration_v1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Config is the configuration of the webhook registered in MutatingWebhookConfiguration
+type Config struct {
+	// FailurePolicy is how apiserver handles pod creation when admission-controller is unavailable, Ignore or Fail
+	FailurePolicy string `mapstructure:"failurePolicy"`
+	// SideEffects is side effects class of admission-controller, None, NoneOnDryRun, Some or Unknown
+	SideEffects string `mapstructure:"sideEffects"`
+	// NamespaceSelector selects namespaces of pods sent to admission-controller
+	NamespaceSelector *meta_v1.LabelSelector `mapstructure:"namespaceSelector"`
+	// ObjectSelector selects pods to mutate, it is evaluated by admission-controller since
+	// MutatingWebhookConfiguration of the supported kubernetes version does not support it
+	ObjectSelector *meta_v1.LabelSelector `mapstructure:"objectSelector"`
+	// TimeoutSeconds is the time admission-controller answers in, pods are admitted without mutating after timeout
+	TimeoutSeconds int32 `mapstructure:"timeoutSeconds"`
+}
+
+// NewDefaultConfig returns Config with default value
+func NewDefaultConfig() *Config {
+
+	c := &Config{
+		FailurePolicy:  string(admissionregistration_v1beta1.Ignore),
+		SideEffects:    string(admissionregistration_v1beta1.SideEffectClassNoneOnDryRun),
+		TimeoutSeconds: 5,
+	}
+	return c
+}
+
+// Validate validates Config
+func (c *Config) Validate() error {
+
+	switch admissionregistration_v1beta1.FailurePolicyType(c.FailurePolicy) {
+	case admissionregistration_v1beta1.Ignore, admissionregistration_v1beta1.Fail:
+	default:
+		return errors.Errorf("invalid failurePolicy: %s", c.FailurePolicy)
+	}
+
+	switch admissionregistration_v1beta1.SideEffectClass(c.SideEffects) {
+	case admissionregistration_v1beta1.SideEffectClassNone, admissionregistration_v1beta1.SideEffectClassNoneOnDryRun,
+		admissionregistration_v1beta1.SideEffectClassSome, admissionregistration_v1beta1.SideEffectClassUnknown:
+	default:
+		return errors.Errorf("invalid sideEffects: %s", c.SideEffects)
+	}
+
+	for _, selector := range []*meta_v1.LabelSelector{c.NamespaceSelector, c.ObjectSelector} {
+		if _, err := meta_v1.LabelSelectorAsSelector(selector); err != nil {
+			return errors.Wrap(err, "invalid selector")
+		}
+	}
+
+	if c.TimeoutSeconds < 1 || c.TimeoutSeconds > 30 {
+		return errors.Errorf("timeoutSeconds must be between 1 and 30: %d", c.TimeoutSeconds)
+	}
+
+	return nil
+}
+
+// SetupWebhook sets failure policy, side effects and namespace selector into the webhook
+func (c *Config) SetupWebhook(webhook *admissionregistration_v1beta1.Webhook) {
+
+	failurePolicy := admissionregistration_v1beta1.FailurePolicyType(c.FailurePolicy)
+	sideEffects := admissionregistration_v1beta1.SideEffectClass(c.SideEffects)
+	webhook.FailurePolicy = &failurePolicy
+	webhook.SideEffects = &sideEffects
+	webhook.NamespaceSelector = c.NamespaceSelector.DeepCopy()
+}
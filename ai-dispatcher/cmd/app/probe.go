@@ -43,9 +43,15 @@ func startProbing() {
 	if probeType == PROBE_TYPE_LIVENESS {
 		probe.LivenessProbe(&probe.LivenessProbeConfig{})
 	} else if probeType == PROBE_TYPE_READINESS {
+		datahubDialOpts, err := datahubCredentials().DialOptions()
+		if err != nil {
+			scope.Errorf("Readiness probe: build datahub credentials failed due to %s", err.Error())
+			os.Exit(1)
+		}
 		probe.ReadinessProbe(&probe.ReadinessProbeConfig{
-			DatahubAddr: datahubAddr,
-			QueueURL:    viper.GetString("queue.url"),
+			DatahubAddr:        datahubAddr,
+			DatahubDialOptions: datahubDialOpts,
+			QueueURL:           viper.GetString("queue.url"),
 		})
 	} else {
 		scope.Errorf("Probe type does not supports %s, please try %s or %s.",
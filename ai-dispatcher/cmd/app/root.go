@@ -10,7 +10,11 @@ import (
 	"github.com/containers-ai/alameda/ai-dispatcher/pkg/dispatcher"
 	"github.com/containers-ai/alameda/ai-dispatcher/pkg/queue"
 	alameda_app "github.com/containers-ai/alameda/cmd/app"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+	DatahubCredentials "github.com/containers-ai/alameda/pkg/framework/datahub/credentials"
+	Instrumentation "github.com/containers-ai/alameda/pkg/instrumentation"
 	"github.com/containers-ai/alameda/pkg/utils/log"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -56,9 +60,22 @@ var rootCmd = &cobra.Command{
 			return
 		}
 		datahubConnRetry := viper.GetInt("datahub.connRetry")
-		conn, err := grpc.Dial(datahubAddr, grpc.WithInsecure(),
-			grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(
-				grpc_retry.WithMax(uint(datahubConnRetry)))))
+		// ai-dispatcher has no access to kubernetes, calls are scoped to the cluster datahub runs in if cluster id is not configured
+		datahubClusterID, _ := DatahubCluster.ResolveClusterID(viper.GetString("datahub."+DatahubCluster.ConfigKeyClusterID), nil)
+		dialOpts, err := datahubCredentials().DialOptions()
+		if err != nil {
+			scope.Errorf("Datahub credentials build failed. %s", err.Error())
+			return
+		}
+		dialOpts = append(dialOpts,
+			grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(
+				Instrumentation.UnaryClientInterceptor(),
+				DatahubCluster.UnaryClientInterceptor(datahubClusterID),
+				grpc_retry.UnaryClientInterceptor(grpc_retry.WithMax(uint(datahubConnRetry))))),
+			grpc.WithStreamInterceptor(grpc_middleware.ChainStreamClient(
+				Instrumentation.StreamClientInterceptor(),
+				DatahubCluster.StreamClientInterceptor(datahubClusterID))))
+		conn, err := grpc.Dial(datahubAddr, dialOpts...)
 		if err != nil {
 			scope.Errorf("Datahub connection constructs failed. %s", err.Error())
 			return
@@ -77,11 +94,23 @@ var rootCmd = &cobra.Command{
 		if viper.GetBool("queue.enabled") {
 			go modelCompleteNotification(modelMapper)
 		}
+		if viper.GetBool("metrics.enabled") {
+			go serveMetrics(viper.GetString("metrics.address"))
+		}
 		dp := dispatcher.NewDispatcher(conn, granularities, predictUnits, modelMapper)
 		dp.Start()
 	},
 }
 
+// datahubCredentials Return credentials presented to datahub configured in section datahub
+func datahubCredentials() *DatahubCredentials.Config {
+	config := DatahubCredentials.NewDefaultConfig()
+	if err := viper.UnmarshalKey("datahub", config); err != nil {
+		scope.Errorf("Unmarshal datahub credentials configuration failed. %s", err.Error())
+	}
+	return config
+}
+
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
 		scope.Errorf("%s", err.Error())
@@ -149,10 +178,17 @@ func setLoggerScopesWithConfig() {
 func modelCompleteNotification(modelMapper *dispatcher.ModelMapper) {
 	reconnectInterval := viper.GetInt64("queue.consumer.reconnectInterval")
 	queueConnRetryItvMS := viper.GetInt64("queue.retry.connectIntervalMs")
+	if queueConnRetryItvMS == 0 {
+		queueConnRetryItvMS = queue.DEFAULT_CONNECT_RETRY_INTERVAL_MS
+	}
+	queueConnMaxRetryItvMS := viper.GetInt64("queue.retry.connectMaxIntervalMs")
+	if queueConnMaxRetryItvMS == 0 {
+		queueConnMaxRetryItvMS = queue.DEFAULT_CONNECT_MAX_RETRY_INTERVAL_MS
+	}
 	modelCompleteQueue := "model_complete"
 	queueURL := viper.GetString("queue.url")
 	for {
-		queueConn := queue.GetQueueConn(queueURL, queueConnRetryItvMS)
+		queueConn := queue.GetQueueConn(queueURL, queueConnRetryItvMS, queueConnMaxRetryItvMS)
 		queueConsumer := queue.NewRabbitMQConsumer(queueConn)
 		for {
 			msg, ok, err := queueConsumer.ReceiveJsonString(modelCompleteQueue)
@@ -190,3 +226,9 @@ func modelCompleteNotification(modelMapper *dispatcher.ModelMapper) {
 		}
 	}
 }
+
+func serveMetrics(address string) {
+	if err := Instrumentation.ServeMetrics(address); err != nil {
+		scope.Error(err.Error())
+	}
+}
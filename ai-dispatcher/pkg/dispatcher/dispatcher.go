@@ -7,7 +7,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/containers-ai/alameda/ai-dispatcher/pkg/metrics"
 	"github.com/containers-ai/alameda/ai-dispatcher/pkg/queue"
+	DatahubAccuracy "github.com/containers-ai/alameda/pkg/framework/datahub/accuracy"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/spf13/viper"
@@ -91,10 +93,14 @@ func (dispatcher *Dispatcher) dispatch(granularity int64, predictionStep int64)
 	queueURL := viper.GetString("queue.url")
 	queueConnRetryItvMS := viper.GetInt64("queue.retry.connectIntervalMs")
 	if queueConnRetryItvMS == 0 {
-		queueConnRetryItvMS = 3000
+		queueConnRetryItvMS = queue.DEFAULT_CONNECT_RETRY_INTERVAL_MS
+	}
+	queueConnMaxRetryItvMS := viper.GetInt64("queue.retry.connectMaxIntervalMs")
+	if queueConnMaxRetryItvMS == 0 {
+		queueConnMaxRetryItvMS = queue.DEFAULT_CONNECT_MAX_RETRY_INTERVAL_MS
 	}
 	for {
-		queueConn := queue.GetQueueConn(queueURL, queueConnRetryItvMS)
+		queueConn := queue.GetQueueConn(queueURL, queueConnRetryItvMS, queueConnMaxRetryItvMS)
 		queueSender := queue.NewRabbitMQSender(queueConn)
 		for _, pdUnit := range dispatcher.svcPredictUnits {
 
@@ -113,6 +119,31 @@ func (dispatcher *Dispatcher) dispatch(granularity int64, predictionStep int64)
 	}
 }
 
+// isQueueFull Return true if jobs waiting in the queue exceed the max depth, so the
+// dispatch cycle is skipped rather than piling up jobs while consumers of the queue are down
+func (dispatcher *Dispatcher) isQueueFull(queueSender queue.QueueSender, queueName string, granularity int64) bool {
+	maxDepth := viper.GetInt("queue.backpressure.maxDepth")
+	if maxDepth == 0 {
+		maxDepth = queue.DEFAULT_MAX_QUEUE_DEPTH
+	}
+	if maxDepth < 0 {
+		return false
+	}
+
+	depth, err := queueSender.QueueDepth(queueName)
+	if err != nil {
+		scope.Warnf("Get depth of queue %s failed, dispatch jobs anyway. %s", queueName, err.Error())
+		return false
+	}
+	if depth <= maxDepth {
+		return false
+	}
+	scope.Warnf("Queue %s has %d jobs waiting more than %d, skip dispatching jobs with granularity %v seconds.",
+		queueName, depth, maxDepth, granularity)
+	metrics.AddDispatchCycleSkipped(queueName, queue.GetGranularityStr(granularity))
+	return true
+}
+
 func (dispatcher *Dispatcher) getAndPushJobs(queueSender queue.QueueSender,
 	pdUnit string, granularity int64, predictionStep int64) {
 
@@ -127,11 +158,18 @@ func (dispatcher *Dispatcher) getAndPushJobs(queueSender queue.QueueSender,
 			return
 		}
 		nodes := res.GetNodes()
+		nodeNames := make([]string, 0, len(nodes))
+		for _, node := range nodes {
+			nodeNames = append(nodeNames, node.GetName())
+		}
+		metrics.RetainPredictionAccuracies(DatahubAccuracy.UnitTypeNode, nodeNames)
 		// send predict jobs
 		scope.Infof("Start sending %v node jobs to queue with granularity %v seconds.",
 			len(nodes), granularity)
-		dispatcher.predictJobSender.sendNodePredictJobs(nodes, queueSender, pdUnit, granularity)
-		if viper.GetBool("queue.enabled") {
+		if !dispatcher.isQueueFull(queueSender, queueName, granularity) {
+			dispatcher.predictJobSender.sendNodePredictJobs(nodes, queueSender, pdUnit, granularity)
+		}
+		if viper.GetBool("queue.enabled") && !dispatcher.isQueueFull(queueSender, modelQueueName, granularity) {
 			dispatcher.modelJobSender.sendNodeModelJobs(nodes, queueSender, pdUnit, granularity,
 				predictionStep)
 		}
@@ -147,11 +185,18 @@ func (dispatcher *Dispatcher) getAndPushJobs(queueSender queue.QueueSender,
 			return
 		}
 		pods := res.GetPods()
+		podNames := make([]string, 0, len(pods))
+		for _, pod := range pods {
+			podNames = append(podNames, pod.GetNamespacedName().GetNamespace()+"/"+pod.GetNamespacedName().GetName())
+		}
+		metrics.RetainPredictionAccuracies(DatahubAccuracy.UnitTypePod, podNames)
 		// send predict jobs
 		scope.Infof("Start sending %v pod jobs to queue with granularity %v seconds.",
 			len(pods), granularity)
-		dispatcher.predictJobSender.sendPodPredictJobs(pods, queueSender, pdUnit, granularity)
-		if viper.GetBool("queue.enabled") {
+		if !dispatcher.isQueueFull(queueSender, queueName, granularity) {
+			dispatcher.predictJobSender.sendPodPredictJobs(pods, queueSender, pdUnit, granularity)
+		}
+		if viper.GetBool("queue.enabled") && !dispatcher.isQueueFull(queueSender, modelQueueName, granularity) {
 			dispatcher.modelJobSender.sendPodModelJobs(pods, queueSender, pdUnit, granularity,
 				predictionStep)
 		}
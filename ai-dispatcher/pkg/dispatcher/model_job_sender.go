@@ -2,15 +2,21 @@ package dispatcher
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/containers-ai/alameda/ai-dispatcher/pkg/metrics"
 	"github.com/containers-ai/alameda/ai-dispatcher/pkg/queue"
 	"github.com/containers-ai/alameda/ai-dispatcher/pkg/stats"
+	DatahubAccuracy "github.com/containers-ai/alameda/pkg/framework/datahub/accuracy"
+	DatahubStream "github.com/containers-ai/alameda/pkg/framework/datahub/stream"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/spf13/viper"
+	"google.golang.org/genproto/googleapis/rpc/code"
 	"google.golang.org/grpc"
 )
 
@@ -47,18 +53,21 @@ func (dispatcher *modelJobSender) sendNodeModelJobs(nodes []*datahub_v1alpha1.No
 	}
 	for _, node := range nodes {
 		nodeName := node.GetName()
-		nodePredictRes, err := datahubServiceClnt.ListNodePredictions(context.Background(),
+		nodePredictions := []*datahub_v1alpha1.NodePrediction{}
+		err := DatahubStream.ForEachNodePrediction(context.Background(), dispatcher.datahubGrpcCn,
 			&datahub_v1alpha1.ListNodePredictionsRequest{
 				NodeNames:      []string{node.GetName()},
 				Granularity:    granularity,
 				QueryCondition: queryCondition,
+			}, func(nodePrediction *datahub_v1alpha1.NodePrediction) error {
+				nodePredictions = append(nodePredictions, nodePrediction)
+				return nil
 			})
 		if err != nil {
 			scope.Errorf("Get node %s Prediction with granularity %v for sending model job failed: %s",
 				nodeName, granularity, err.Error())
 			continue
 		}
-		nodePredictions := nodePredictRes.GetNodePredictions()
 		if len(nodePredictions) == 0 {
 			scope.Infof("No predict found for node %s with granularity %v, send model job to queue.",
 				nodeName, granularity)
@@ -82,14 +91,11 @@ func (dispatcher *modelJobSender) sendNodeModelJobs(nodes []*datahub_v1alpha1.No
 				continue
 			}
 			if len(nodeInfo.ModelMetrics) > 0 && nodeStr != "" {
-				jb := queue.NewJobBuilder(pdUnit, granularity, nodeStr)
-				jobJSONStr, err := jb.GetJobJSONString()
-				if err != nil {
-					scope.Errorf("Prepare model job payload failed for node %s with granularity seconds %v. %s",
+				jb := queue.NewJobBuilder(pdUnit, nodeName, granularity, nodeStr)
+				if err := queueSender.SendJob(modelQueueName, jb); err != nil {
+					scope.Errorf("Send model job payload failed for node %s with granularity seconds %v. %s",
 						nodeName, granularity, err.Error())
-					continue
 				}
-				err = queueSender.SendJsonString(modelQueueName, jobJSONStr)
 			}
 			continue
 		}
@@ -116,6 +122,7 @@ func (dispatcher *modelJobSender) sendNodeModelJobs(nodes []*datahub_v1alpha1.No
 
 			nodeMetrics := nodeMetricsRes.GetNodeMetrics()
 			predictRawData := nodePrediction.GetPredictedRawData()
+			accuracies := []*DatahubAccuracy.PredictionAccuracy{}
 
 			for _, predictRawDatum := range predictRawData {
 				pData := predictRawDatum.GetData()
@@ -128,7 +135,16 @@ func (dispatcher *modelJobSender) sendNodeModelJobs(nodes []*datahub_v1alpha1.No
 							scope.Infof("start MAPE calculation for node %s metric %v with granularity %v",
 								nodeName, metricType, granularity)
 							measurementDataSet := stats.NewMeasurementDataSet(mData, pData, granularity)
-							mape, err := stats.MAPE(measurementDataSet)
+							accuracy := &DatahubAccuracy.PredictionAccuracy{
+								UnitType:    DatahubAccuracy.UnitTypeNode,
+								NodeName:    nodeName,
+								MetricType:  metricType,
+								Granularity: granularity,
+							}
+							mape, measured, err := measureAccuracy(measurementDataSet, accuracy)
+							if measured {
+								accuracies = append(accuracies, accuracy)
+							}
 							if err != nil {
 								nodeInfo.ModelMetrics = append(nodeInfo.ModelMetrics, metricType)
 								scope.Infof(
@@ -146,6 +162,7 @@ func (dispatcher *modelJobSender) sendNodeModelJobs(nodes []*datahub_v1alpha1.No
 					}
 				}
 			}
+			dispatcher.sendPredictionAccuracies(accuracies)
 			isModeling := dispatcher.modelMapper.IsModeling(pdUnit, dataGranularity, nodeInfo)
 			if !isModeling || (isModeling && dispatcher.modelMapper.IsModelTimeout(
 				pdUnit, dataGranularity, nodeInfo)) {
@@ -157,15 +174,8 @@ func (dispatcher *modelJobSender) sendNodeModelJobs(nodes []*datahub_v1alpha1.No
 					continue
 				}
 				if len(nodeInfo.ModelMetrics) > 0 && nodeStr != "" {
-					jb := queue.NewJobBuilder(pdUnit, granularity, nodeStr)
-					jobJSONStr, err := jb.GetJobJSONString()
-					if err != nil {
-						scope.Errorf(
-							"Prepare model job payload failed for node %s with granularity seconds %v. %s",
-							nodeName, granularity, err.Error())
-						continue
-					}
-					err = queueSender.SendJsonString(modelQueueName, jobJSONStr)
+					jb := queue.NewJobBuilder(pdUnit, nodeName, granularity, nodeStr)
+					err = queueSender.SendJob(modelQueueName, jb)
 					if err == nil {
 						dispatcher.modelMapper.AddModelInfo(pdUnit, dataGranularity, nodeInfo)
 					} else {
@@ -184,7 +194,6 @@ func (dispatcher *modelJobSender) sendPodModelJobs(pods []*datahub_v1alpha1.Pod,
 
 	marshaler := jsonpb.Marshaler{}
 	dataGranularity := queue.GetGranularityStr(granularity)
-	datahubServiceClnt := datahub_v1alpha1.NewDatahubServiceClient(dispatcher.datahubGrpcCn)
 	queryCondition := &datahub_v1alpha1.QueryCondition{
 		Order: datahub_v1alpha1.QueryCondition_DESC,
 		TimeRange: &datahub_v1alpha1.TimeRange{
@@ -200,17 +209,21 @@ func (dispatcher *modelJobSender) sendPodModelJobs(pods []*datahub_v1alpha1.Pod,
 		podNS := pod.GetNamespacedName().GetNamespace()
 		podName := pod.GetNamespacedName().GetName()
 		// send model jobs
-		podPredictRes, err := datahubServiceClnt.ListPodPredictions(context.Background(),
+		podPredictions := []*datahub_v1alpha1.PodPrediction{}
+		err := DatahubStream.ForEachPodPrediction(context.Background(), dispatcher.datahubGrpcCn,
 			&datahub_v1alpha1.ListPodPredictionsRequest{
+				NamespacedName: pod.GetNamespacedName(),
 				Granularity:    granularity,
 				QueryCondition: queryCondition,
+			}, func(podPrediction *datahub_v1alpha1.PodPrediction) error {
+				podPredictions = append(podPredictions, podPrediction)
+				return nil
 			})
 		if err != nil {
 			scope.Errorf("Get pod (%s/%s) Prediction with granularity %v for sending model job failed: %s",
 				podNS, podName, granularity, err.Error())
 			continue
 		}
-		podPredictions := podPredictRes.GetPodPredictions()
 		if len(podPredictions) == 0 {
 			scope.Infof("No predict found for pod (%s/%s), send model job to queue.",
 				podNS, podName)
@@ -243,14 +256,11 @@ func (dispatcher *modelJobSender) sendPodModelJobs(pods []*datahub_v1alpha1.Pod,
 				continue
 			}
 			if len(podInfo.Containers) > 0 && podStr != "" {
-				jb := queue.NewJobBuilder(pdUnit, granularity, podStr)
-				jobJSONStr, err := jb.GetJobJSONString()
-				if err != nil {
-					scope.Errorf("Prepare model job payload for pod (%s/%s) with granularity %v failed: %s",
+				jb := queue.NewJobBuilder(pdUnit, fmt.Sprintf("%s/%s", podNS, podName), granularity, podStr)
+				if err := queueSender.SendJob(modelQueueName, jb); err != nil {
+					scope.Errorf("Send model job payload failed for pod %s/%s with granularity %v seconds. %s",
 						podNS, podName, granularity, err.Error())
-					continue
 				}
-				err = queueSender.SendJsonString(modelQueueName, jobJSONStr)
 			}
 			continue
 		}
@@ -268,18 +278,22 @@ func (dispatcher *modelJobSender) sendPodModelJobs(pods []*datahub_v1alpha1.Pod,
 				time.Now().Unix(),
 			}
 
-			podMetricsRes, err := datahubServiceClnt.ListPodMetrics(context.Background(),
+			podMetrics := []*datahub_v1alpha1.PodMetric{}
+			err := DatahubStream.ForEachPodMetric(context.Background(), dispatcher.datahubGrpcCn,
 				&datahub_v1alpha1.ListPodMetricsRequest{
 					QueryCondition: queryCondition,
 					NamespacedName: podPrediction.GetNamespacedName(),
+				}, func(podMetric *datahub_v1alpha1.PodMetric) error {
+					podMetrics = append(podMetrics, podMetric)
+					return nil
 				})
 			if err != nil {
-				scope.Errorf("List nodes metric with granularity %v for sending model job failed: %s",
+				scope.Errorf("List pods metric with granularity %v for sending model job failed: %s",
 					granularity, err.Error())
 				continue
 			}
-			podMetrics := podMetricsRes.GetPodMetrics()
 			containerPredictions := podPrediction.GetContainerPredictions()
+			accuracies := []*DatahubAccuracy.PredictionAccuracy{}
 			for _, containerPrediction := range containerPredictions {
 				predictRawData := containerPrediction.GetPredictedRawData()
 				for _, predictRawDatum := range predictRawData {
@@ -297,7 +311,20 @@ func (dispatcher *modelJobSender) sendPodModelJobs(pods []*datahub_v1alpha1.Pod,
 									scope.Infof("start MAPE calculation for pod %s/%s container %s metric %v with granularity %v",
 										podNS, podName, containerName, metricType, granularity)
 									measurementDataSet := stats.NewMeasurementDataSet(mData, pData, granularity)
-									mape, err := stats.MAPE(measurementDataSet)
+									accuracy := &DatahubAccuracy.PredictionAccuracy{
+										UnitType: DatahubAccuracy.UnitTypePod,
+										NamespacedName: &datahub_v1alpha1.NamespacedName{
+											Namespace: podNS,
+											Name:      podName,
+										},
+										ContainerName: containerName,
+										MetricType:    metricType,
+										Granularity:   granularity,
+									}
+									mape, measured, err := measureAccuracy(measurementDataSet, accuracy)
+									if measured {
+										accuracies = append(accuracies, accuracy)
+									}
 									if err != nil {
 										modelMetrics = append(modelMetrics, metricType)
 										scope.Infof(
@@ -324,6 +351,7 @@ func (dispatcher *modelJobSender) sendPodModelJobs(pods []*datahub_v1alpha1.Pod,
 				}
 			}
 
+			dispatcher.sendPredictionAccuracies(accuracies)
 			isModeling := dispatcher.modelMapper.IsModeling(pdUnit, dataGranularity, podInfo)
 			if !isModeling || (isModeling && dispatcher.modelMapper.IsModelTimeout(pdUnit, dataGranularity, podInfo)) {
 				podStr, err := marshaler.MarshalToString(pod)
@@ -334,13 +362,8 @@ func (dispatcher *modelJobSender) sendPodModelJobs(pods []*datahub_v1alpha1.Pod,
 					continue
 				}
 				if len(podInfo.Containers) > 0 && podStr != "" {
-					jb := queue.NewJobBuilder(pdUnit, granularity, podStr)
-					jobJSONStr, err := jb.GetJobJSONString()
-					if err != nil {
-						scope.Errorf("Prepare model job payload failed for pod %s/%s with granularity %v seconds. %s",
-							podNS, podName, granularity, err.Error())
-					}
-					err = queueSender.SendJsonString(modelQueueName, jobJSONStr)
+					jb := queue.NewJobBuilder(pdUnit, fmt.Sprintf("%s/%s", podNS, podName), granularity, podStr)
+					err = queueSender.SendJob(modelQueueName, jb)
 					if err == nil {
 						dispatcher.modelMapper.AddModelInfo(pdUnit, dataGranularity, podInfo)
 					} else {
@@ -352,3 +375,51 @@ func (dispatcher *modelJobSender) sendPodModelJobs(pods []*datahub_v1alpha1.Pod,
 		}
 	}
 }
+
+// measureAccuracy Measure MAPE, RMSE and sMAPE of predictions into accuracy and export each of them
+// which is measured, the MAPE is returned and measured is true only if all of them are measured
+func measureAccuracy(measurementDataSet map[int64]*stats.MeasurementData,
+	accuracy *DatahubAccuracy.PredictionAccuracy) (mape float64, measured bool, err error) {
+	accuracy.Time = ptypes.TimestampNow()
+	accuracy.SampleCount = int32(len(measurementDataSet))
+	measured = true
+
+	if rmse, rmseErr := stats.RMSE(measurementDataSet); rmseErr != nil {
+		scope.Warnf("RMSE calculation failed: %s", rmseErr.Error())
+		measured = false
+	} else {
+		accuracy.Rmse = rmse
+		metrics.SetPredictionRMSE(accuracy)
+	}
+	if smape, smapeErr := stats.SMAPE(measurementDataSet); smapeErr != nil {
+		scope.Warnf("sMAPE calculation failed: %s", smapeErr.Error())
+		measured = false
+	} else {
+		accuracy.Smape = smape
+		metrics.SetPredictionSMAPE(accuracy)
+	}
+	if mape, err = stats.MAPE(measurementDataSet); err != nil {
+		return mape, false, err
+	}
+	accuracy.Mape = mape
+	metrics.SetPredictionMAPE(accuracy)
+
+	return mape, measured, nil
+}
+
+func (dispatcher *modelJobSender) sendPredictionAccuracies(accuracies []*DatahubAccuracy.PredictionAccuracy) {
+	if len(accuracies) == 0 {
+		return
+	}
+
+	accuracyServiceClnt := DatahubAccuracy.NewDatahubPredictionAccuracyServiceClient(dispatcher.datahubGrpcCn)
+	res, err := accuracyServiceClnt.CreatePredictionAccuracies(context.Background(),
+		&DatahubAccuracy.CreatePredictionAccuraciesRequest{
+			PredictionAccuracies: accuracies,
+		})
+	if err != nil {
+		scope.Errorf("Send %d prediction accuracies to datahub failed: %s", len(accuracies), err.Error())
+	} else if res.GetCode() != int32(code.Code_OK) {
+		scope.Errorf("Send %d prediction accuracies to datahub failed: %s", len(accuracies), res.GetMessage())
+	}
+}
@@ -1,6 +1,8 @@
 package dispatcher
 
 import (
+	"fmt"
+
 	"github.com/containers-ai/alameda/ai-dispatcher/pkg/queue"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/jsonpb"
@@ -27,14 +29,8 @@ func (dispatcher *predictJobSender) sendNodePredictJobs(nodes []*datahub_v1alpha
 				node.GetName(), granularity, err.Error())
 			continue
 		}
-		jb := queue.NewJobBuilder(pdUnit, granularity, nodeStr)
-		jobJSONStr, err := jb.GetJobJSONString()
-		if err != nil {
-			scope.Errorf("Prepare job payload failed for node %s with granularity seconds %v. %s",
-				node.GetName(), granularity, err.Error())
-			continue
-		}
-		err = queueSender.SendJsonString(queueName, jobJSONStr)
+		jb := queue.NewJobBuilder(pdUnit, node.GetName(), granularity, nodeStr)
+		err = queueSender.SendJob(queueName, jb)
 		if err != nil {
 			scope.Errorf("Send job for node %s failed with granularity %v seconds. %s",
 				node.GetName(), granularity, err.Error())
@@ -53,14 +49,9 @@ func (dispatcher *predictJobSender) sendPodPredictJobs(pods []*datahub_v1alpha1.
 				podNSN.GetNamespace(), podNSN.GetName(), granularity, err.Error())
 			continue
 		}
-		jb := queue.NewJobBuilder(pdUnit, granularity, podStr)
-		jobJSONStr, err := jb.GetJobJSONString()
-		if err != nil {
-			scope.Errorf("Prepare job payload failed for pod %s/%s with granularity %v seconds. %s",
-				podNSN.GetNamespace(), podNSN.GetName(), granularity, err.Error())
-			continue
-		}
-		err = queueSender.SendJsonString(queueName, jobJSONStr)
+		jb := queue.NewJobBuilder(pdUnit, fmt.Sprintf("%s/%s", podNSN.GetNamespace(), podNSN.GetName()),
+			granularity, podStr)
+		err = queueSender.SendJob(queueName, jb)
 		if err != nil {
 			scope.Errorf("Send job for pod %s/%s failed with granularity %v seconds. %s",
 				podNSN.GetNamespace(), podNSN.GetName(), granularity, err.Error())
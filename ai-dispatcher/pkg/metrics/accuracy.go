@@ -0,0 +1,112 @@
+package metrics
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	DatahubAccuracy "github.com/containers-ai/alameda/pkg/framework/datahub/accuracy"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "alameda_ai_dispatcher"
+)
+
+var (
+	accuracyLabels = []string{"unit_type", "namespace", "name", "container", "metric", "granularity"}
+
+	predictionMAPE = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "prediction_mape",
+		Help:      "Mean absolute percentage error of predictions against actual metrics",
+	}, accuracyLabels)
+	predictionRMSE = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "prediction_rmse",
+		Help:      "Root mean square error of predictions against actual metrics",
+	}, accuracyLabels)
+	predictionSMAPE = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "prediction_smape",
+		Help:      "Symmetric mean absolute percentage error of predictions against actual metrics",
+	}, accuracyLabels)
+
+	// accuracyUnits Label values of accuracy series exported by unit type and unit, series of units
+	// no longer dispatched are deleted by RetainPredictionAccuracies
+	accuracyUnits     = map[string]map[string]map[string][]string{}
+	accuracyUnitsLock sync.Mutex
+)
+
+func init() {
+	prometheus.MustRegister(predictionMAPE, predictionRMSE, predictionSMAPE)
+}
+
+// SetPredictionMAPE Export MAPE of predictions of a container or a node
+func SetPredictionMAPE(accuracy *DatahubAccuracy.PredictionAccuracy) {
+	predictionMAPE.WithLabelValues(accuracyLabelValues(accuracy)...).Set(accuracy.GetMape())
+}
+
+// SetPredictionRMSE Export RMSE of predictions of a container or a node
+func SetPredictionRMSE(accuracy *DatahubAccuracy.PredictionAccuracy) {
+	predictionRMSE.WithLabelValues(accuracyLabelValues(accuracy)...).Set(accuracy.GetRmse())
+}
+
+// SetPredictionSMAPE Export sMAPE of predictions of a container or a node
+func SetPredictionSMAPE(accuracy *DatahubAccuracy.PredictionAccuracy) {
+	predictionSMAPE.WithLabelValues(accuracyLabelValues(accuracy)...).Set(accuracy.GetSmape())
+}
+
+// RetainPredictionAccuracies Delete accuracy series of units of the type which are not in units,
+// units are names of nodes or namespace/name of pods
+func RetainPredictionAccuracies(unitType string, units []string) {
+	retained := make(map[string]bool, len(units))
+	for _, unit := range units {
+		retained[unit] = true
+	}
+
+	accuracyUnitsLock.Lock()
+	defer accuracyUnitsLock.Unlock()
+	for unit, labelValuesSet := range accuracyUnits[unitType] {
+		if retained[unit] {
+			continue
+		}
+		for _, labelValues := range labelValuesSet {
+			predictionMAPE.DeleteLabelValues(labelValues...)
+			predictionRMSE.DeleteLabelValues(labelValues...)
+			predictionSMAPE.DeleteLabelValues(labelValues...)
+		}
+		delete(accuracyUnits[unitType], unit)
+	}
+}
+
+// accuracyLabelValues Return label values of accuracy series of the container or the node
+// and remember them so that they are deleted once the unit is gone
+func accuracyLabelValues(accuracy *DatahubAccuracy.PredictionAccuracy) []string {
+	unitType := accuracy.GetUnitType()
+	name := accuracy.GetNamespacedName().GetName()
+	unit := accuracy.GetNamespacedName().GetNamespace() + "/" + name
+	if unitType == DatahubAccuracy.UnitTypeNode {
+		name = accuracy.GetNodeName()
+		unit = name
+	}
+	labelValues := []string{
+		unitType,
+		accuracy.GetNamespacedName().GetNamespace(),
+		name,
+		accuracy.GetContainerName(),
+		accuracy.GetMetricType().String(),
+		strconv.FormatInt(accuracy.GetGranularity(), 10),
+	}
+
+	accuracyUnitsLock.Lock()
+	defer accuracyUnitsLock.Unlock()
+	if accuracyUnits[unitType] == nil {
+		accuracyUnits[unitType] = map[string]map[string][]string{}
+	}
+	if accuracyUnits[unitType][unit] == nil {
+		accuracyUnits[unitType][unit] = map[string][]string{}
+	}
+	accuracyUnits[unitType][unit][strings.Join(labelValues, "\x00")] = labelValues
+	return labelValues
+}
@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"testing"
+
+	DatahubAccuracy "github.com/containers-ai/alameda/pkg/framework/datahub/accuracy"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func countSeries(collector prometheus.Collector) int {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		collector.Collect(ch)
+		close(ch)
+	}()
+	count := 0
+	for range ch {
+		count++
+	}
+	return count
+}
+
+func TestRetainPredictionAccuracies(t *testing.T) {
+	newPodAccuracy := func(name string) *DatahubAccuracy.PredictionAccuracy {
+		return &DatahubAccuracy.PredictionAccuracy{
+			UnitType:       DatahubAccuracy.UnitTypePod,
+			NamespacedName: &datahub_v1alpha1.NamespacedName{Namespace: "default", Name: name},
+			ContainerName:  "app",
+			MetricType:     datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES,
+			Granularity:    30,
+		}
+	}
+	node := &DatahubAccuracy.PredictionAccuracy{
+		UnitType:    DatahubAccuracy.UnitTypeNode,
+		NodeName:    "node1",
+		MetricType:  datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES,
+		Granularity: 30,
+	}
+	for _, accuracy := range []*DatahubAccuracy.PredictionAccuracy{newPodAccuracy("pod1"), newPodAccuracy("pod2"), node} {
+		SetPredictionMAPE(accuracy)
+		SetPredictionRMSE(accuracy)
+	}
+	if got := countSeries(predictionMAPE); got != 3 {
+		t.Fatalf("expect 3 MAPE series, got %d", got)
+	}
+
+	RetainPredictionAccuracies(DatahubAccuracy.UnitTypePod, []string{"default/pod2"})
+	if got := countSeries(predictionMAPE); got != 2 {
+		t.Errorf("expect MAPE series of pod1 deleted, got %d series", got)
+	}
+	if got := countSeries(predictionRMSE); got != 2 {
+		t.Errorf("expect RMSE series of pod1 deleted, got %d series", got)
+	}
+
+	RetainPredictionAccuracies(DatahubAccuracy.UnitTypeNode, nil)
+	if got := countSeries(predictionMAPE); got != 1 {
+		t.Errorf("expect MAPE series of node1 deleted, got %d series", got)
+	}
+}
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	jobsDispatched = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "jobs_dispatched_total",
+		Help:      "Total number of jobs sent to queues by result",
+	}, []string{"queue", "result"})
+	dispatchCyclesSkipped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "dispatch_cycles_skipped_total",
+		Help:      "Total number of dispatch cycles skipped since queues are too deep",
+	}, []string{"queue", "granularity"})
+)
+
+func init() {
+	prometheus.MustRegister(jobsDispatched, dispatchCyclesSkipped)
+}
+
+// AddJobDispatched Count the job sent to the queue, err is the result of sending
+func AddJobDispatched(queueName string, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	jobsDispatched.WithLabelValues(queueName, result).Inc()
+}
+
+// AddJobDropped Count the job dropped since a job with the same id is sent to the queue
+func AddJobDropped(queueName string) {
+	jobsDispatched.WithLabelValues(queueName, "duplicate").Inc()
+}
+
+// AddDispatchCycleSkipped Count the dispatch cycle of the granularity skipped due to depth of the queue
+func AddDispatchCycleSkipped(queueName, granularity string) {
+	dispatchCyclesSkipped.WithLabelValues(queueName, granularity).Inc()
+}
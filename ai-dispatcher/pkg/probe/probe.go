@@ -15,7 +15,7 @@ func LivenessProbe(cfg *LivenessProbeConfig) {
 func ReadinessProbe(cfg *ReadinessProbeConfig) {
 	// query datahub
 	datahubAddr := cfg.DatahubAddr
-	err := queryDatahub(datahubAddr)
+	err := queryDatahub(datahubAddr, cfg.DatahubDialOptions)
 	if err != nil {
 		scope.Errorf("Readiness probe: query datahub with address (%s) failed due to %s", datahubAddr, err.Error())
 		os.Exit(1)
@@ -0,0 +1,52 @@
+package queue
+
+import (
+	"sync"
+	"time"
+)
+
+// sentJobs records ids of jobs sent by the process, it is shared by senders of all granularities
+var sentJobs = newJobIDCache()
+
+// jobIDCache Cache of job ids expiring after their ttl
+type jobIDCache struct {
+	lock      sync.Mutex
+	expires   map[string]time.Time
+	lastPrune time.Time
+}
+
+// jobIDCachePruneInterval Interval of removing expired ids from cache
+const jobIDCachePruneInterval = time.Minute
+
+func newJobIDCache() *jobIDCache {
+	return &jobIDCache{
+		expires: make(map[string]time.Time),
+	}
+}
+
+// add Add the id to the cache, false is returned if the id is already cached and not expired
+func (c *jobIDCache) add(id string, ttl time.Duration, now time.Time) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if now.Sub(c.lastPrune) >= jobIDCachePruneInterval {
+		for cachedID, expire := range c.expires {
+			if !now.Before(expire) {
+				delete(c.expires, cachedID)
+			}
+		}
+		c.lastPrune = now
+	}
+	if expire, exist := c.expires[id]; exist && now.Before(expire) {
+		return false
+	}
+	c.expires[id] = now.Add(ttl)
+	return true
+}
+
+// remove Remove the id from the cache
+func (c *jobIDCache) remove(id string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.expires, id)
+}
@@ -2,12 +2,15 @@ package queue
 
 import (
 	"encoding/json"
+	"fmt"
+	"math/rand"
 	"time"
 
 	"github.com/streadway/amqp"
 )
 
 type job struct {
+	JobID             string `json:"jobId"`
 	UnitType          string `json:"unitType"`
 	Granularity       string `json:"granularity"`
 	GranularitySec    int64  `json:"granularitySec"`
@@ -18,9 +21,12 @@ type jobBuilder struct {
 	job *job
 }
 
-func NewJobBuilder(unitType string, granularitySec int64, payloadJSONString string) *jobBuilder {
+// NewJobBuilder Build job of the unit, unitName identifies the unit among units of the type
+// and is used with the granularity and current time window to derive the job id
+func NewJobBuilder(unitType, unitName string, granularitySec int64, payloadJSONString string) *jobBuilder {
 	granularity := GetGranularityStr(granularitySec)
 	job := &job{
+		JobID:             NewJobID(unitType, unitName, granularitySec, time.Now()),
 		UnitType:          unitType,
 		GranularitySec:    granularitySec,
 		Granularity:       granularity,
@@ -29,6 +35,11 @@ func NewJobBuilder(unitType string, granularitySec int64, payloadJSONString stri
 	return &jobBuilder{job: job}
 }
 
+// GetJobID Return id of the job
+func (jobBuilder *jobBuilder) GetJobID() string {
+	return jobBuilder.job.JobID
+}
+
 func (jobBuilder *jobBuilder) GetJobJSONString() (string, error) {
 	jobJSONBin, err := json.Marshal(jobBuilder.job)
 	if err != nil {
@@ -50,14 +61,31 @@ func GetGranularityStr(granularitySec int64) string {
 	return "30s"
 }
 
-func GetQueueConn(queueURL string, retryItvMS int64) *amqp.Connection {
+// NewJobID Return id of the job of the unit with the granularity in the time window containing t,
+// windows are aligned to multiples of the granularity so jobs of a unit sent in the same window share the id
+func NewJobID(unitType, unitName string, granularitySec int64, t time.Time) string {
+	window := t.Unix()
+	if granularitySec > 0 {
+		window -= window % granularitySec
+	}
+	return fmt.Sprintf("%s/%s/%s/%d", unitType, unitName, GetGranularityStr(granularitySec), window)
+}
+
+// GetQueueConn Connect to queue, connecting is retried with interval starting from retryItvMS
+// and doubling up to maxRetryItvMS until connected
+func GetQueueConn(queueURL string, retryItvMS, maxRetryItvMS int64) *amqp.Connection {
+	interval := retryItvMS
 	for {
 		queueConn, err := amqp.Dial(queueURL)
-		if err != nil {
-			scope.Errorf("Queue connection constructs failed and will retry after %v milliseconds. %s", retryItvMS, err.Error())
-			time.Sleep(time.Duration(retryItvMS) * time.Millisecond)
-			continue
+		if err == nil {
+			return queueConn
+		}
+		// jitter avoids dispatchers of all granularities reconnecting at the same time
+		wait := interval/2 + rand.Int63n(interval/2+1)
+		scope.Errorf("Queue connection constructs failed and will retry after %v milliseconds. %s", wait, err.Error())
+		time.Sleep(time.Duration(wait) * time.Millisecond)
+		if interval *= 2; interval > maxRetryItvMS {
+			interval = maxRetryItvMS
 		}
-		return queueConn
 	}
 }
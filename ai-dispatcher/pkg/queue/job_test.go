@@ -0,0 +1,44 @@
+package queue
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewJobID(t *testing.T) {
+	windowStart := time.Unix(3600*100, 0)
+
+	id := NewJobID("POD", "default/pod1", 3600, windowStart)
+	if id != "POD/default/pod1/1h/360000" {
+		t.Errorf("unexpected job id %s", id)
+	}
+	if sameWindow := NewJobID("POD", "default/pod1", 3600, windowStart.Add(59*time.Minute)); sameWindow != id {
+		t.Errorf("expect the same id in the window, got %s and %s", id, sameWindow)
+	}
+	if nextWindow := NewJobID("POD", "default/pod1", 3600, windowStart.Add(time.Hour)); nextWindow == id {
+		t.Errorf("expect different ids in different windows, got %s", nextWindow)
+	}
+	if otherGranularity := NewJobID("POD", "default/pod1", 30, windowStart); otherGranularity == id {
+		t.Errorf("expect different ids of different granularities, got %s", otherGranularity)
+	}
+}
+
+func TestJobIDCache(t *testing.T) {
+	cache := newJobIDCache()
+	now := time.Now()
+
+	if !cache.add("job1", time.Minute, now) {
+		t.Fatal("expect new id added")
+	}
+	if cache.add("job1", time.Minute, now.Add(30*time.Second)) {
+		t.Error("expect duplicated id dropped before expired")
+	}
+	if !cache.add("job1", time.Minute, now.Add(time.Minute)) {
+		t.Error("expect id added again after expired")
+	}
+
+	cache.remove("job1")
+	if !cache.add("job1", time.Minute, now.Add(time.Minute)) {
+		t.Error("expect removed id added again")
+	}
+}
@@ -4,14 +4,24 @@ import "github.com/containers-ai/alameda/pkg/utils/log"
 
 type QueueSender interface {
 	SendJsonString(queueName, jsonStr string) error
+	// SendJob Send the job to the queue, job is dropped without error if a job with the same id is sent
+	SendJob(queueName string, jb *jobBuilder) error
+	// QueueDepth Return number of jobs waiting in the queue
+	QueueDepth(queueName string) (int, error)
 	getRetry() *retry
 }
 
 var scope = log.RegisterScope("queue", "job queue", 0)
 
 const (
-	DEFAULT_PUBLISH_RETRY_TIME              = 3
-	DEFAULT_PUBLISH_RETRY_INTERVAL_MS int64 = 500
-	DEFAULT_CONSUME_RETRY_TIME              = 3
-	DEFAULT_CONSUME_RETRY_INTERVAL_MS int64 = 500
+	DEFAULT_PUBLISH_RETRY_TIME                  = 3
+	DEFAULT_PUBLISH_RETRY_INTERVAL_MS     int64 = 500
+	DEFAULT_CONFIRM_TIMEOUT_MS            int64 = 5000
+	DEFAULT_CONSUME_RETRY_TIME                  = 3
+	DEFAULT_CONSUME_RETRY_INTERVAL_MS     int64 = 500
+	DEFAULT_CONNECT_RETRY_INTERVAL_MS     int64 = 3000
+	DEFAULT_CONNECT_MAX_RETRY_INTERVAL_MS int64 = 60000
+	DEFAULT_DEAD_LETTER_EXCHANGE                = "alameda.dlx"
+	DEFAULT_DEAD_LETTER_MAX_LENGTH              = 10000
+	DEFAULT_MAX_QUEUE_DEPTH                     = 10000
 )
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/containers-ai/alameda/ai-dispatcher/pkg/metrics"
+	InternalRabbitMQ "github.com/containers-ai/alameda/internal/pkg/message-queue/rabbitmq"
+	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"github.com/streadway/amqp"
 )
@@ -12,6 +15,7 @@ import (
 type retry struct {
 	publishRetryTime       int
 	publishRetryIntervalMS int64
+	confirmTimeoutMS       int64
 	consumeRetryTime       int
 	consumeRetryIntervalMS int64
 }
@@ -20,61 +24,166 @@ type RabbitMQSender struct {
 	conn *amqp.Connection
 }
 
+// SendJob Send the job to the queue, the job is dropped if a job with the same id is sent
+// by the process or is still waiting in the queue
+func (sender *RabbitMQSender) SendJob(queueName string, jb *jobBuilder) (err error) {
+	jobID := jb.GetJobID()
+	if !sentJobs.add(queueName+"/"+jobID, time.Duration(jb.job.GranularitySec)*time.Second, time.Now()) {
+		scope.Debugf("Job %s is sent to queue %s already, drop it.", jobID, queueName)
+		metrics.AddJobDropped(queueName)
+		return nil
+	}
+	defer func() {
+		if err != nil {
+			// job is sent again next cycle
+			sentJobs.remove(queueName + "/" + jobID)
+		}
+	}()
+
+	jobJSONStr, err := jb.GetJobJSONString()
+	if err != nil {
+		return errors.Wrapf(err, "encode job %s failed", jobID)
+	}
+	return sender.send(queueName, jobJSONStr, jobID)
+}
+
 func (sender *RabbitMQSender) SendJsonString(queueName, jsonStr string) error {
-	publishRetryTime := sender.getRetry().publishRetryTime
-	publishRetryIntervalMS := sender.getRetry().publishRetryIntervalMS
-	for retry := 0; retry < publishRetryTime; retry++ {
-		queueCH, err := sender.conn.Channel()
+	return sender.send(queueName, jsonStr, sender.getMessageHash(jsonStr))
+}
 
-		if err != nil {
-			if retry == (publishRetryTime - 1) {
-				return err
-			}
-			continue
+// send Publish the message to the queue with publisher confirms, the message is retried
+// until broker confirms it, dedupID is sent as message id identifying duplicated messages
+func (sender *RabbitMQSender) send(queueName, jsonStr, dedupID string) (err error) {
+	defer func() {
+		metrics.AddJobDispatched(queueName, err)
+	}()
+
+	retry := sender.getRetry()
+	for i := 0; i < retry.publishRetryTime; i++ {
+		if i > 0 {
+			time.Sleep(time.Duration(retry.publishRetryIntervalMS) * time.Millisecond)
 		}
-		defer queueCH.Close()
-		q, err := queueCH.QueueDeclare(
-			queueName, // name
-			true,      // durable
-			false,     // delete when unused
-			false,     // exclusive
-			false,     // no-wait
-			amqp.Table{
-				"x-message-deduplication": true,
-			}, // arguments
-		)
-		if err != nil {
-			if retry == (publishRetryTime - 1) {
-				return err
-			}
-			continue
+		err = sender.publish(queueName, jsonStr, dedupID, retry)
+		if err == nil {
+			return nil
 		}
+		scope.Warnf("Send message to queue %s failed at attempt %d. %s", queueName, i+1, err.Error())
+	}
+	return errors.Wrapf(err, "send message to queue %s failed after %d retries", queueName, retry.publishRetryTime)
+}
+
+func (sender *RabbitMQSender) publish(queueName, jsonStr, dedupID string, retry *retry) error {
+	queueCH, err := sender.conn.Channel()
+	if err != nil {
+		return errors.Wrap(err, "open channel failed")
+	}
+	defer func() {
+		queueCH.Close()
+	}()
+
+	queueCH, err = sender.declareQueue(queueCH, queueName)
+	if err != nil {
+		return err
+	}
 
-		err = queueCH.Publish(
-			"",     // exchange
-			q.Name, // routing key
-			false,  // mandatory
-			false,  // immediate
-			amqp.Publishing{
-				ContentType:  "text/plain",
-				Body:         []byte(jsonStr),
-				DeliveryMode: 2, // 2 means persistent
-				Headers: amqp.Table{
-					"x-deduplication-header": sender.getMessageHash(jsonStr),
-				},
-			})
+	return InternalRabbitMQ.PublishWithConfirm(queueCH,
+		"",        // exchange
+		queueName, // routing key
+		amqp.Publishing{
+			ContentType:  "text/plain",
+			Body:         []byte(jsonStr),
+			DeliveryMode: 2, // 2 means persistent
+			MessageId:    dedupID,
+		}, time.Duration(retry.confirmTimeoutMS)*time.Millisecond)
+}
 
+// declareQueue Declare the queue without arguments like AI workers consuming it, and
+// queue "<queueName>.dead" bound to the dead letter exchange with the name of the queue.
+// Jobs rejected by workers without requeueing are dead lettered to it if the queue has
+// policy "dead-letter-exchange" set to the exchange, the policy keeps the routing key of jobs.
+// A channel replacing queueCH is returned since broker closes the channel if declaring fails.
+func (sender *RabbitMQSender) declareQueue(queueCH *amqp.Channel, queueName string) (*amqp.Channel, error) {
+	deadLetterExchange := viper.GetString("queue.deadLetter.exchange")
+	if deadLetterExchange == "" {
+		deadLetterExchange = DEFAULT_DEAD_LETTER_EXCHANGE
+	}
+	deadLetterMaxLength := viper.GetInt("queue.deadLetter.maxLength")
+	if deadLetterMaxLength == 0 {
+		deadLetterMaxLength = DEFAULT_DEAD_LETTER_MAX_LENGTH
+	}
+	deadLetterQueueName := queueName + ".dead"
+
+	err := queueCH.ExchangeDeclare(
+		deadLetterExchange, // name
+		"direct",           // kind
+		true,               // durable
+		false,              // delete when unused
+		false,              // internal
+		false,              // no-wait
+		nil,                // arguments
+	)
+	if err != nil {
+		return queueCH, errors.Wrapf(err, "declare dead letter exchange %s failed", deadLetterExchange)
+	}
+	_, err = queueCH.QueueDeclare(
+		deadLetterQueueName, // name
+		true,                // durable
+		false,               // delete when unused
+		false,               // exclusive
+		false,               // no-wait
+		amqp.Table{
+			"x-max-length": int32(deadLetterMaxLength),
+			"x-overflow":   "drop-head",
+		}, // arguments
+	)
+	if err != nil {
+		return queueCH, errors.Wrapf(err, "declare dead letter queue %s failed", deadLetterQueueName)
+	}
+	err = queueCH.QueueBind(deadLetterQueueName, queueName, deadLetterExchange, false, nil)
+	if err != nil {
+		return queueCH, errors.Wrapf(err, "bind dead letter queue %s failed", deadLetterQueueName)
+	}
+
+	_, err = queueCH.QueueDeclare(
+		queueName, // name
+		true,      // durable
+		false,     // delete when unused
+		false,     // exclusive
+		false,     // no-wait
+		nil,       // arguments
+	)
+	if amqpErr, ok := err.(*amqp.Error); ok && amqpErr.Code == amqp.PreconditionFailed {
+		// queue declared with arguments by others, keep using it as is
+		scope.Warnf("Queue %s exists with different arguments, keep using it. %s", queueName, err.Error())
+		newCH, err := sender.conn.Channel()
 		if err != nil {
-			if retry == (publishRetryTime - 1) {
-				return err
-			}
-			continue
-		} else {
-			return nil
+			return queueCH, errors.Wrap(err, "open channel failed")
 		}
-		time.Sleep(time.Duration(publishRetryIntervalMS) * time.Millisecond)
+		queueCH = newCH
+		_, err = queueCH.QueueDeclarePassive(queueName, true, false, false, false, nil)
 	}
-	return fmt.Errorf("unknown error to send message to queue %s", queueName)
+	if err != nil {
+		return queueCH, errors.Wrapf(err, "declare queue %s failed", queueName)
+	}
+	return queueCH, nil
+}
+
+// QueueDepth Return number of jobs waiting in the queue, 0 is returned if the queue is not declared yet
+func (sender *RabbitMQSender) QueueDepth(queueName string) (int, error) {
+	queueCH, err := sender.conn.Channel()
+	if err != nil {
+		return 0, errors.Wrap(err, "open channel failed")
+	}
+	defer queueCH.Close()
+
+	q, err := queueCH.QueueDeclarePassive(queueName, true, false, false, false, nil)
+	if amqpErr, ok := err.(*amqp.Error); ok && amqpErr.Code == amqp.NotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrapf(err, "inspect queue %s failed", queueName)
+	}
+	return q.Messages, nil
 }
 
 func NewRabbitMQSender(conn *amqp.Connection) *RabbitMQSender {
@@ -94,9 +203,15 @@ func (sender *RabbitMQSender) getRetry() *retry {
 	if publishRetryIntervalMS == 0 {
 		publishRetryIntervalMS = DEFAULT_PUBLISH_RETRY_INTERVAL_MS
 	}
+
+	confirmTimeoutMS := viper.GetInt64("queue.retry.confirmTimeoutMs")
+	if confirmTimeoutMS == 0 {
+		confirmTimeoutMS = DEFAULT_CONFIRM_TIMEOUT_MS
+	}
 	return &retry{
 		publishRetryTime:       publishRetryTime,
 		publishRetryIntervalMS: publishRetryIntervalMS,
+		confirmTimeoutMS:       confirmTimeoutMS,
 	}
 }
 
@@ -82,6 +82,43 @@ func MAPE(measurementDataSet map[int64]*MeasurementData) (float64, error) {
 	return 100 * (result / nPts), nil
 }
 
+// RMSE Root mean square error between predicted and actual values
+func RMSE(measurementDataSet map[int64]*MeasurementData) (float64, error) {
+	nPts := 0.0
+	result := 0.0
+	for _, data := range measurementDataSet {
+		diff := data.GetPredictData() - data.GetMetricData()
+		nPts = nPts + 1
+		result = result + diff*diff
+	}
+	if nPts == 0 {
+		return 0, fmt.Errorf("no points in calculation of RMSE")
+	}
+	return math.Sqrt(result / nPts), nil
+}
+
+// SMAPE Symmetric mean absolute percentage error between predicted and actual
+// values, which is bounded to [0, 200] and defined when actual value is 0
+func SMAPE(measurementDataSet map[int64]*MeasurementData) (float64, error) {
+	nPts := 0.0
+	result := 0.0
+	for _, data := range measurementDataSet {
+		metricValue := data.GetMetricData()
+		predictValue := data.GetPredictData()
+		nPts = nPts + 1
+		denominator := math.Abs(metricValue) + math.Abs(predictValue)
+		if denominator == 0 {
+			// Both are 0, the prediction is exact
+			continue
+		}
+		result = result + 2*math.Abs(predictValue-metricValue)/denominator
+	}
+	if nPts == 0 {
+		return 0, fmt.Errorf("no points in calculation of sMAPE")
+	}
+	return 100 * (result / nPts), nil
+}
+
 func (mData MeasurementData) GetMetricData() float64 {
 	return mData.metricData.value
 }
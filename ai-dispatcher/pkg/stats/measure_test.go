@@ -1,6 +1,9 @@
 package stats
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestMAPE(t *testing.T) {
 	type args struct {
@@ -73,3 +76,46 @@ func TestMAPE(t *testing.T) {
 		})
 	}
 }
+
+func TestRMSEAndSMAPE(t *testing.T) {
+	measurementDataSet := map[int64]*MeasurementData{
+		1: &MeasurementData{
+			predictData: data{value: 90},
+			metricData:  data{value: 100},
+		},
+		2: &MeasurementData{
+			predictData: data{value: 0},
+			metricData:  data{value: 0},
+		},
+		3: &MeasurementData{
+			predictData: data{value: 120},
+			metricData:  data{value: 80},
+		},
+		4: &MeasurementData{
+			predictData: data{value: 10},
+			metricData:  data{value: 0},
+		},
+	}
+	tests := []struct {
+		name    string
+		measure func(map[int64]*MeasurementData) (float64, error)
+		want    float64
+	}{
+		{name: "RMSE", measure: RMSE, want: 21.213203435596427},
+		{name: "sMAPE", measure: SMAPE, want: 62.63157894736842},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.measure(measurementDataSet)
+			if err != nil {
+				t.Fatalf("%s() error = %v", tt.name, err)
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+			if _, err := tt.measure(map[int64]*MeasurementData{}); err == nil {
+				t.Errorf("%s() expect error without points", tt.name)
+			}
+		})
+	}
+}
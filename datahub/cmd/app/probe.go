@@ -39,12 +39,16 @@ func startProbing() {
 	if probeType == ProbeTypeLiveness {
 		probe.LivenessProbe(&probe.LivenessProbeConfig{
 			BindAddr: config.BindAddress,
+			TLS:      config.TLS,
 		})
 	} else if probeType == ProbeTypeReadiness {
 		probe.ReadinessProbe(&probe.ReadinessProbeConfig{
-			InfluxdbCfg:   config.InfluxDB,
-			PrometheusCfg: config.Prometheus,
-			RabbitMQCfg:   config.RabbitMQ,
+			InfluxdbCfg:       config.InfluxDB,
+			StorageEngine:     config.Storage.Engine,
+			PrometheusCfg:     config.Prometheus,
+			MetricSource:      config.Metric.Source,
+			MetricInfluxdbCfg: config.Metric.InfluxDB,
+			RabbitMQCfg:       config.RabbitMQ,
 		})
 	} else {
 		scope.Errorf("Probe type %s is not supported, please try %s or %s.", probeType, ProbeTypeLiveness, ProbeTypeReadiness)
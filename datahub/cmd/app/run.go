@@ -40,7 +40,7 @@ var (
 				panic(err)
 			}
 
-			server.InitInfluxdbDatabase()
+			server.InitStorageDatabases()
 
 			if err = server.Run(); err != nil {
 				server.Stop()
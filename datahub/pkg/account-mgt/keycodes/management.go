@@ -2,10 +2,11 @@ package keycodes
 
 import (
 	"encoding/json"
-	"fmt"
+	RepoInflux "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb"
 	EntityInflux "github.com/containers-ai/alameda/internal/pkg/database/entity/influxdb"
 	EntityInfluxKeycode "github.com/containers-ai/alameda/internal/pkg/database/entity/influxdb/cluster_status"
-	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
 	//DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	InfluxClient "github.com/influxdata/influxdb/client/v2"
 	"strings"
@@ -210,7 +211,7 @@ func (c *KeycodeMgt) refresh(force bool) error { // NOTE: DO GET KeycodeMutex lo
 
 func (c *KeycodeMgt) writeInfluxEntry(keycode, status string) error {
 	points := make([]*InfluxClient.Point, 0)
-	client := InternalInflux.NewClient(InfluxConfig)
+	engine := RepoInflux.NewStorageEngine(InfluxConfig)
 
 	tags := map[string]string{
 		EntityInfluxKeycode.Keycode: keycode,
@@ -232,10 +233,13 @@ func (c *KeycodeMgt) writeInfluxEntry(keycode, status string) error {
 	}
 	points = append(points, pt)
 
-	err = client.WritePoints(points, InfluxClient.BatchPointsConfig{
-		Database: string(EntityInflux.ClusterStatus),
-	})
+	storagePoints, err := StorageInflux.NewPoints(points)
+	if err != nil {
+		scope.Error(err.Error())
+		return err
+	}
 
+	err = engine.WritePoints(string(EntityInflux.ClusterStatus), storagePoints)
 	if err != nil {
 		scope.Error(err.Error())
 		return err
@@ -246,11 +250,10 @@ func (c *KeycodeMgt) writeInfluxEntry(keycode, status string) error {
 
 func (c *KeycodeMgt) deleteInfluxEntry(keycode string) error {
 	if keycode != "" {
-		client := InternalInflux.NewClient(InfluxConfig)
+		engine := RepoInflux.NewStorageEngine(InfluxConfig)
 
-		cmd := fmt.Sprintf("DROP SERIES FROM %s WHERE \"%s\"='%s'", EntityInfluxKeycode.KeycodeMeasurement, EntityInfluxKeycode.Keycode, keycode)
-		scope.Debugf("delete keycode in influxdb command: %s", cmd)
-		_, err := client.QueryDB(cmd, string(EntityInflux.ClusterStatus))
+		condition := storage.Compare(EntityInfluxKeycode.Keycode, storage.Equal, keycode)
+		err := engine.DropSeries(string(EntityInflux.ClusterStatus), string(EntityInfluxKeycode.KeycodeMeasurement), condition)
 		if err != nil {
 			scope.Errorf(err.Error())
 			return nil
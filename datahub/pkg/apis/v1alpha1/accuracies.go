@@ -0,0 +1,56 @@
+package v1alpha1
+
+import (
+	DaoPredictionImpl "github.com/containers-ai/alameda/datahub/pkg/dao/prediction/impl"
+	DatahubAccuracy "github.com/containers-ai/alameda/pkg/framework/datahub/accuracy"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+	AlamedaUtils "github.com/containers-ai/alameda/pkg/utils"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/status"
+)
+
+// CreatePredictionAccuracies add accuracy scores of predictions to database
+func (s *ServiceV1alpha1) CreatePredictionAccuracies(ctx context.Context, in *DatahubAccuracy.CreatePredictionAccuraciesRequest) (*status.Status, error) {
+	scope.Debug("Request received from CreatePredictionAccuracies grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	predictionDAO := DaoPredictionImpl.NewInfluxDBWithConfig(*s.Config.InfluxDB, DatahubCluster.FromContext(ctx))
+	err := predictionDAO.CreatePredictionAccuracies(in.GetPredictionAccuracies())
+	if err != nil {
+		scope.Errorf("create prediction accuracies failed: %+v", err.Error())
+		return &status.Status{
+			Code:    int32(code.Code_INTERNAL),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &status.Status{
+		Code: int32(code.Code_OK),
+	}, nil
+}
+
+// ListPredictionAccuracies list accuracy scores of predictions
+func (s *ServiceV1alpha1) ListPredictionAccuracies(ctx context.Context, in *DatahubAccuracy.ListPredictionAccuraciesRequest) (*DatahubAccuracy.ListPredictionAccuraciesResponse, error) {
+	scope.Debug("Request received from ListPredictionAccuracies grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	predictionDAO := DaoPredictionImpl.NewInfluxDBWithConfig(*s.Config.InfluxDB, DatahubCluster.FromContext(ctx))
+	accuracies, err := predictionDAO.ListPredictionAccuracies(in)
+	if err != nil {
+		scope.Errorf("list prediction accuracies failed: %+v", err.Error())
+		return &DatahubAccuracy.ListPredictionAccuraciesResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := &DatahubAccuracy.ListPredictionAccuraciesResponse{
+		Status: &status.Status{
+			Code: int32(code.Code_OK),
+		},
+		PredictionAccuracies: accuracies,
+	}
+	scope.Debug("Response sent from ListPredictionAccuracies grpc function: " + AlamedaUtils.InterfaceToString(response))
+	return response, nil
+}
@@ -0,0 +1,100 @@
+package v1alpha1
+
+import (
+	DaoClusterStatusImpl "github.com/containers-ai/alameda/datahub/pkg/dao/cluster_status/impl"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+	AlamedaUtils "github.com/containers-ai/alameda/pkg/utils"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/status"
+)
+
+// RegisterCluster add the cluster to cluster registry
+func (s *ServiceV1alpha1) RegisterCluster(ctx context.Context, in *DatahubCluster.RegisterClusterRequest) (*DatahubCluster.RegisterClusterResponse, error) {
+	scope.Debug("Request received from RegisterCluster grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	if in.GetCluster().GetId() == "" {
+		return &DatahubCluster.RegisterClusterResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INVALID_ARGUMENT),
+				Message: "cluster id is required",
+			},
+		}, nil
+	}
+
+	clusterDAO := &DaoClusterStatusImpl.Cluster{
+		InfluxDBConfig: *s.Config.InfluxDB,
+	}
+	cluster, err := clusterDAO.RegisterCluster(in.GetCluster())
+	if err != nil {
+		scope.Errorf("register cluster failed: %+v", err.Error())
+		return &DatahubCluster.RegisterClusterResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &DatahubCluster.RegisterClusterResponse{
+		Status: &status.Status{
+			Code: int32(code.Code_OK),
+		},
+		Cluster: cluster,
+	}, nil
+}
+
+// ListClusters list clusters in cluster registry
+func (s *ServiceV1alpha1) ListClusters(ctx context.Context, in *DatahubCluster.ListClustersRequest) (*DatahubCluster.ListClustersResponse, error) {
+	scope.Debug("Request received from ListClusters grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	clusterDAO := &DaoClusterStatusImpl.Cluster{
+		InfluxDBConfig: *s.Config.InfluxDB,
+	}
+	clusters, err := clusterDAO.ListClusters(in.GetIds())
+	if err != nil {
+		scope.Errorf("list clusters failed: %+v", err.Error())
+		return &DatahubCluster.ListClustersResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := &DatahubCluster.ListClustersResponse{
+		Status: &status.Status{
+			Code: int32(code.Code_OK),
+		},
+		Clusters: clusters,
+	}
+	scope.Debug("Response sent from ListClusters grpc function: " + AlamedaUtils.InterfaceToString(response))
+	return response, nil
+}
+
+// DeregisterCluster remove the cluster from cluster registry
+func (s *ServiceV1alpha1) DeregisterCluster(ctx context.Context, in *DatahubCluster.DeregisterClusterRequest) (*status.Status, error) {
+	scope.Debug("Request received from DeregisterCluster grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	if in.GetId() == "" {
+		return &status.Status{
+			Code:    int32(code.Code_INVALID_ARGUMENT),
+			Message: "cluster id is required",
+		}, nil
+	}
+
+	clusterDAO := &DaoClusterStatusImpl.Cluster{
+		InfluxDBConfig: *s.Config.InfluxDB,
+	}
+	if err := clusterDAO.DeregisterCluster(in.GetId()); err != nil {
+		scope.Errorf("deregister cluster failed: %+v", err.Error())
+		return &status.Status{
+			Code:    int32(code.Code_INTERNAL),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &status.Status{
+		Code: int32(code.Code_OK),
+	}, nil
+}
@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	EventMgt "github.com/containers-ai/alameda/internal/pkg/event-mgt"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
 	DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"golang.org/x/net/context"
 	"google.golang.org/genproto/googleapis/rpc/code"
@@ -11,6 +12,14 @@ import (
 func (s *ServiceV1alpha1) CreateEvents(ctx context.Context, in *DatahubV1alpha1.CreateEventsRequest) (*status.Status, error) {
 	scope.Debug("Request received from CreateEvents grpc function")
 
+	// Events without cluster id belong to the cluster of the caller
+	clusterID := DatahubCluster.FromContext(ctx)
+	for _, event := range in.GetEvents() {
+		if event.GetClusterId() == "" {
+			event.ClusterId = clusterID
+		}
+	}
+
 	err := EventMgt.PostEvents(in)
 	if err != nil {
 		scope.Error(err.Error())
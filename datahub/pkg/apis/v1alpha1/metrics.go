@@ -2,9 +2,11 @@ package v1alpha1
 
 import (
 	DaoMetric "github.com/containers-ai/alameda/datahub/pkg/dao/metric"
-	DaoMetricPromth "github.com/containers-ai/alameda/datahub/pkg/dao/metric/prometheus"
+	_ "github.com/containers-ai/alameda/datahub/pkg/dao/metric/influxdb"
+	_ "github.com/containers-ai/alameda/datahub/pkg/dao/metric/prometheus"
 	DatahubUtils "github.com/containers-ai/alameda/datahub/pkg/utils"
 	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
 	AlamedaUtils "github.com/containers-ai/alameda/pkg/utils"
 	DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/ptypes/timestamp"
@@ -42,11 +44,21 @@ func (s *ServiceV1alpha1) ListNodeMetrics(ctx context.Context, in *DatahubV1alph
 		}, nil
 	}
 
-	metricDAO = DaoMetricPromth.NewWithConfig(*s.Config.Prometheus)
+	metricDAO, err = DaoMetric.NewWithConfig(*s.Config.Metric)
+	if err != nil {
+		scope.Errorf("ListNodeMetrics failed: %+v", err)
+		return &DatahubV1alpha1.ListNodeMetricsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	}
 
 	nodeNames = in.GetNodeNames()
 	queryCondition = datahubQueryConditionExtend{queryCondition: in.GetQueryCondition()}.daoQueryCondition()
 	listNodeMetricsRequest := DaoMetric.ListNodeMetricsRequest{
+		ClusterID:      DatahubCluster.FromContext(ctx),
 		NodeNames:      nodeNames,
 		QueryCondition: queryCondition,
 	}
@@ -109,7 +121,16 @@ func (s *ServiceV1alpha1) ListPodMetrics(ctx context.Context, in *DatahubV1alpha
 		}, nil
 	}
 
-	metricDAO = DaoMetricPromth.NewWithConfig(*s.Config.Prometheus)
+	metricDAO, err = DaoMetric.NewWithConfig(*s.Config.Metric)
+	if err != nil {
+		scope.Errorf("ListPodMetrics failed: %+v", err)
+		return &DatahubV1alpha1.ListPodMetricsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	}
 
 	if in.GetNamespacedName() != nil {
 		namespace = in.GetNamespacedName().GetNamespace()
@@ -117,6 +138,7 @@ func (s *ServiceV1alpha1) ListPodMetrics(ctx context.Context, in *DatahubV1alpha
 	}
 	queryCondition = datahubQueryConditionExtend{queryCondition: in.GetQueryCondition()}.daoQueryCondition()
 	listPodMetricsRequest := DaoMetric.ListPodMetricsRequest{
+		ClusterID:      DatahubCluster.FromContext(ctx),
 		Namespace:      namespace,
 		PodName:        podName,
 		QueryCondition: queryCondition,
@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	DaoPlanning "github.com/containers-ai/alameda/datahub/pkg/dao/planning"
 	DaoPlanningImpl "github.com/containers-ai/alameda/datahub/pkg/dao/planning/impl"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
 	AlamedaUtils "github.com/containers-ai/alameda/pkg/utils"
 	DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"golang.org/x/net/context"
@@ -16,6 +17,7 @@ func (s *ServiceV1alpha1) CreatePodPlannings(ctx context.Context, in *DatahubV1a
 
 	var containerDAO DaoPlanning.ContainerOperation = &DaoPlanningImpl.Container{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	if err := containerDAO.AddPodPlannings(in); err != nil {
@@ -37,6 +39,7 @@ func (s *ServiceV1alpha1) CreateControllerPlannings(ctx context.Context, in *Dat
 
 	controllerDAO := DaoPlanningImpl.Controller{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	controllerPlanningList := in.GetControllerPlannings()
@@ -61,6 +64,7 @@ func (s *ServiceV1alpha1) ListPodPlannings(ctx context.Context, in *DatahubV1alp
 
 	var containerDAO DaoPlanning.ContainerOperation = &DaoPlanningImpl.Container{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	podPlannings, err := containerDAO.ListPodPlannings(in)
@@ -90,6 +94,7 @@ func (s *ServiceV1alpha1) ListControllerPlannings(ctx context.Context, in *Datah
 
 	controllerDAO := &DaoPlanningImpl.Controller{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	controllerPlannings, err := controllerDAO.ListControllerPlannings(in)
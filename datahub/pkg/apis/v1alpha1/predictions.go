@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	DaoPredictionImpl "github.com/containers-ai/alameda/datahub/pkg/dao/prediction/impl"
 	DatahubUtils "github.com/containers-ai/alameda/datahub/pkg/utils"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
 	AlamedaUtils "github.com/containers-ai/alameda/pkg/utils"
 	DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/ptypes/timestamp"
@@ -17,7 +18,7 @@ import (
 func (s *ServiceV1alpha1) CreateNodePredictions(ctx context.Context, in *DatahubV1alpha1.CreateNodePredictionsRequest) (*status.Status, error) {
 	scope.Debug("Request received from CreateNodePredictions grpc function: " + AlamedaUtils.InterfaceToString(in))
 
-	predictionDAO := DaoPredictionImpl.NewInfluxDBWithConfig(*s.Config.InfluxDB)
+	predictionDAO := DaoPredictionImpl.NewInfluxDBWithConfig(*s.Config.InfluxDB, DatahubCluster.FromContext(ctx))
 	err := predictionDAO.CreateNodePredictions(in)
 	if err != nil {
 		scope.Errorf("create node predictions failed: %+v", err.Error())
@@ -36,7 +37,7 @@ func (s *ServiceV1alpha1) CreateNodePredictions(ctx context.Context, in *Datahub
 func (s *ServiceV1alpha1) CreatePodPredictions(ctx context.Context, in *DatahubV1alpha1.CreatePodPredictionsRequest) (*status.Status, error) {
 	scope.Debug("Request received from CreatePodPredictions grpc function: " + AlamedaUtils.InterfaceToString(in))
 
-	predictionDAO := DaoPredictionImpl.NewInfluxDBWithConfig(*s.Config.InfluxDB)
+	predictionDAO := DaoPredictionImpl.NewInfluxDBWithConfig(*s.Config.InfluxDB, DatahubCluster.FromContext(ctx))
 	err := predictionDAO.CreateContainerPredictions(in)
 	if err != nil {
 		scope.Errorf("create pod predictions failed: %+v", err.Error())
@@ -55,7 +56,7 @@ func (s *ServiceV1alpha1) CreatePodPredictions(ctx context.Context, in *DatahubV
 func (s *ServiceV1alpha1) ListNodePredictions(ctx context.Context, in *DatahubV1alpha1.ListNodePredictionsRequest) (*DatahubV1alpha1.ListNodePredictionsResponse, error) {
 	scope.Debug("Request received from ListNodePredictions grpc function: " + AlamedaUtils.InterfaceToString(in))
 
-	predictionDAO := DaoPredictionImpl.NewInfluxDBWithConfig(*s.Config.InfluxDB)
+	predictionDAO := DaoPredictionImpl.NewInfluxDBWithConfig(*s.Config.InfluxDB, DatahubCluster.FromContext(ctx))
 
 	datahubListNodePredictionsRequestExtended := datahubListNodePredictionsRequestExtended{in}
 	listNodePredictionRequest := datahubListNodePredictionsRequestExtended.daoListNodePredictionsRequest()
@@ -89,7 +90,7 @@ func (s *ServiceV1alpha1) ListPodPredictions(ctx context.Context, in *DatahubV1a
 	}
 
 	//--------------------------------------------------------
-	predictionDAO := DaoPredictionImpl.NewInfluxDBWithConfig(*s.Config.InfluxDB)
+	predictionDAO := DaoPredictionImpl.NewInfluxDBWithConfig(*s.Config.InfluxDB, DatahubCluster.FromContext(ctx))
 
 	datahubListPodPredictionsRequestExtended := datahubListPodPredictionsRequestExtended{in}
 	listPodPredictionsRequest := datahubListPodPredictionsRequestExtended.daoListPodPredictionsRequest()
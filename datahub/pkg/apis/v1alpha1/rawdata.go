@@ -2,8 +2,11 @@ package v1alpha1
 
 import (
 	"fmt"
+	Rawdata "github.com/containers-ai/alameda/datahub/pkg/rawdata"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
 	InternalPromth "github.com/containers-ai/alameda/internal/pkg/database/prometheus"
+	DatahubRawdata "github.com/containers-ai/alameda/pkg/framework/datahub/rawdata"
+	AlamedaUtils "github.com/containers-ai/alameda/pkg/utils"
 	DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	Common "github.com/containers-ai/api/common"
 	"github.com/pkg/errors"
@@ -23,7 +26,14 @@ func (s *ServiceV1alpha1) ReadRawdata(ctx context.Context, in *DatahubV1alpha1.R
 
 	switch in.GetDatabaseType() {
 	case Common.DatabaseType_INFLUXDB:
-		rawdata, err = InternalInflux.ReadRawdata(s.Config.InfluxDB, in.GetQueries())
+		for _, query := range in.GetQueries() {
+			if err = s.Config.Rawdata.CheckRead(query.GetDatabase(), query.GetTable()); err != nil {
+				break
+			}
+		}
+		if err == nil {
+			rawdata, err = InternalInflux.ReadRawdata(s.Config.InfluxDB, in.GetQueries())
+		}
 	case Common.DatabaseType_PROMETHEUS:
 		rawdata, err = InternalPromth.ReadRawdata(s.Config.Prometheus, in.GetQueries())
 	default:
@@ -34,7 +44,7 @@ func (s *ServiceV1alpha1) ReadRawdata(ctx context.Context, in *DatahubV1alpha1.R
 		scope.Errorf("api ReadRawdata failed: %v", err)
 		response := &DatahubV1alpha1.ReadRawdataResponse{
 			Status: &status.Status{
-				Code:    int32(code.Code_INTERNAL),
+				Code:    int32(rawdataErrorCode(err)),
 				Message: err.Error(),
 			},
 			Rawdata: rawdata,
@@ -62,7 +72,7 @@ func (s *ServiceV1alpha1) WriteRawdata(ctx context.Context, in *DatahubV1alpha1.
 
 	switch in.GetDatabaseType() {
 	case Common.DatabaseType_INFLUXDB:
-		err = InternalInflux.WriteRawdata(s.Config.InfluxDB, in.GetRawdata())
+		err = s.writeInfluxRawdata(ctx, in.GetRawdata())
 	case Common.DatabaseType_PROMETHEUS:
 		err = errors.New(fmt.Sprintf("database type(%s) is not supported yet", Common.DatabaseType_name[int32(in.GetDatabaseType())]))
 	default:
@@ -72,10 +82,131 @@ func (s *ServiceV1alpha1) WriteRawdata(ctx context.Context, in *DatahubV1alpha1.
 	if err != nil {
 		scope.Errorf("api WriteRawdata failed: %v", err)
 		return &status.Status{
-			Code:    int32(code.Code_INTERNAL),
+			Code:    int32(rawdataErrorCode(err)),
 			Message: err.Error(),
 		}, err
 	}
 
 	return &status.Status{Code: int32(code.Code_OK)}, nil
 }
+
+// writeInfluxRawdata Write rawdata to InfluxDB only if all measurements are writable,
+// every write including the denied ones is audited
+func (s *ServiceV1alpha1) writeInfluxRawdata(ctx context.Context, writeRawdata []*Common.WriteRawdata) error {
+	for _, rawdata := range writeRawdata {
+		if err := s.Config.Rawdata.CheckWrite(rawdata.GetDatabase(), rawdata.GetTable()); err != nil {
+			for _, denied := range writeRawdata {
+				Rawdata.AuditWrite(ctx, denied.GetDatabase(), denied.GetTable(), len(denied.GetRows()), err)
+			}
+			return err
+		}
+	}
+
+	var err error
+	for _, rawdata := range writeRawdata {
+		result := InternalInflux.WriteRawdata(s.Config.InfluxDB, []*Common.WriteRawdata{rawdata})
+		Rawdata.AuditWrite(ctx, rawdata.GetDatabase(), rawdata.GetTable(), len(rawdata.GetRows()), result)
+		if result != nil {
+			err = result
+		}
+	}
+	return err
+}
+
+// QueryRawdata query the whitelisted measurement with conditions bound as parameters
+func (s *ServiceV1alpha1) QueryRawdata(ctx context.Context, in *DatahubRawdata.QueryRawdataRequest) (*DatahubRawdata.QueryRawdataResponse, error) {
+	scope.Debug("Request received from QueryRawdata grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	if err := validateQueryRawdataRequest(in); err != nil {
+		return &DatahubRawdata.QueryRawdataResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INVALID_ARGUMENT),
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	if err := s.Config.Rawdata.CheckRead(in.GetDatabase(), in.GetMeasurement()); err != nil {
+		return &DatahubRawdata.QueryRawdataResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_PERMISSION_DENIED),
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	order := Common.QueryCondition_ASC
+	if in.GetDescending() {
+		order = Common.QueryCondition_DESC
+	}
+	query := &Common.Query{
+		Database: in.GetDatabase(),
+		Table:    in.GetMeasurement(),
+		Condition: &Common.QueryCondition{
+			TimeRange: &Common.TimeRange{
+				StartTime: in.GetStartTime(),
+				EndTime:   in.GetEndTime(),
+			},
+			Order:   order,
+			Selects: in.GetSelects(),
+			Groups:  in.GetGroups(),
+			Limit:   in.GetLimit(),
+		},
+	}
+
+	statement := InternalInflux.NewStatement(query)
+	for _, condition := range in.GetConditions() {
+		value := InternalInflux.ChangeFormat(condition.GetValue(), condition.GetDataType())
+		statement.AppendWhereClauseWithParam(condition.GetKey(), condition.GetOperator(), value)
+	}
+	statement.AppendWhereClauseFromTimeCondition()
+	statement.SetOrderClauseFromQueryCondition()
+	statement.SetLimitClauseFromQueryCondition()
+
+	rawdata, err := InternalInflux.ReadRawdataWithStatement(s.Config.InfluxDB, statement, query)
+	if err != nil {
+		scope.Errorf("query rawdata failed: %+v", err.Error())
+		return &DatahubRawdata.QueryRawdataResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return &DatahubRawdata.QueryRawdataResponse{
+		Status: &status.Status{
+			Code: int32(code.Code_OK),
+		},
+		Rawdata: rawdata,
+	}, nil
+}
+
+func validateQueryRawdataRequest(in *DatahubRawdata.QueryRawdataRequest) error {
+	if in.GetDatabase() == "" || in.GetMeasurement() == "" {
+		return errors.New("database and measurement are required")
+	}
+	for _, condition := range in.GetConditions() {
+		if condition.GetKey() == "" {
+			return errors.New("key of condition is required")
+		}
+		supported := false
+		for _, operator := range DatahubRawdata.Operators {
+			if condition.GetOperator() == operator {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return errors.Errorf("operator %q of condition is not supported", condition.GetOperator())
+		}
+	}
+	return nil
+}
+
+func rawdataErrorCode(err error) code.Code {
+	if errors.Cause(err) == Rawdata.ErrNotAllowed {
+		return code.Code_PERMISSION_DENIED
+	}
+	return code.Code_INTERNAL
+}
@@ -0,0 +1,311 @@
+package v1alpha1
+
+import (
+	"time"
+
+	DaoRecommendationImpl "github.com/containers-ai/alameda/datahub/pkg/dao/recommendation/impl"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+	DatahubHistory "github.com/containers-ai/alameda/pkg/framework/datahub/history"
+	AlamedaUtils "github.com/containers-ai/alameda/pkg/utils"
+	DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/status"
+)
+
+// History of recommendations. A generation of pod recommendations is the set
+// of containers' recommendations sharing the same start time, a generation of
+// controller recommendations is the recommendation created at a time.
+
+// DiffPodRecommendations compares two generations of recommendations of a pod
+func (s *ServiceV1alpha1) DiffPodRecommendations(ctx context.Context, in *DatahubHistory.DiffPodRecommendationsRequest) (*DatahubHistory.DiffPodRecommendationsResponse, error) {
+	scope.Debug("Request received from DiffPodRecommendations grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	if in.GetNamespacedName().GetNamespace() == "" || in.GetNamespacedName().GetName() == "" {
+		return &DatahubHistory.DiffPodRecommendationsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INVALID_ARGUMENT),
+				Message: "namespace and name of pod are required",
+			},
+		}, nil
+	}
+
+	containerDAO := &DaoRecommendationImpl.Container{
+		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
+	}
+
+	to, err := containerDAO.GetPodRecommendationGeneration(in.GetNamespacedName(), in.GetGranularity(), generationUntil(in.GetToTime()))
+	if err != nil {
+		scope.Errorf("api DiffPodRecommendations failed: %v", err)
+		return &DatahubHistory.DiffPodRecommendationsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	} else if to == nil {
+		return &DatahubHistory.DiffPodRecommendationsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_NOT_FOUND),
+				Message: "no recommendation generation to compare",
+			},
+		}, nil
+	}
+
+	fromUntil := time.Unix(to.GetStartTime().GetSeconds()-1, 0)
+	if in.GetFromTime() != nil {
+		fromUntil = generationUntil(in.GetFromTime())
+	}
+	from, err := containerDAO.GetPodRecommendationGeneration(in.GetNamespacedName(), in.GetGranularity(), fromUntil)
+	if err != nil {
+		scope.Errorf("api DiffPodRecommendations failed: %v", err)
+		return &DatahubHistory.DiffPodRecommendationsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := &DatahubHistory.DiffPodRecommendationsResponse{
+		Status: &status.Status{
+			Code: int32(code.Code_OK),
+		},
+		From:           from,
+		To:             to,
+		ContainerDiffs: DatahubHistory.DiffPodRecommendations(from, to),
+	}
+	scope.Debug("Response sent from DiffPodRecommendations grpc function: " + AlamedaUtils.InterfaceToString(response))
+	return response, nil
+}
+
+// DiffControllerRecommendations compares two generations of recommendations of a controller
+func (s *ServiceV1alpha1) DiffControllerRecommendations(ctx context.Context, in *DatahubHistory.DiffControllerRecommendationsRequest) (*DatahubHistory.DiffControllerRecommendationsResponse, error) {
+	scope.Debug("Request received from DiffControllerRecommendations grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	if in.GetNamespacedName().GetNamespace() == "" || in.GetNamespacedName().GetName() == "" {
+		return &DatahubHistory.DiffControllerRecommendationsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INVALID_ARGUMENT),
+				Message: "namespace and name of controller are required",
+			},
+		}, nil
+	}
+
+	controllerDAO := &DaoRecommendationImpl.Controller{
+		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
+	}
+
+	to, err := controllerDAO.GetControllerRecommendationGeneration(in.GetNamespacedName(), in.GetRecommendedType(), generationUntil(in.GetToTime()))
+	if err != nil {
+		scope.Errorf("api DiffControllerRecommendations failed: %v", err)
+		return &DatahubHistory.DiffControllerRecommendationsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	} else if to == nil {
+		return &DatahubHistory.DiffControllerRecommendationsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_NOT_FOUND),
+				Message: "no recommendation generation to compare",
+			},
+		}, nil
+	}
+
+	// Compare generations of the same recommended type
+	fromUntil := time.Unix(DatahubHistory.ControllerRecommendationTime(to).GetSeconds()-1, 0)
+	if in.GetFromTime() != nil {
+		fromUntil = generationUntil(in.GetFromTime())
+	}
+	from, err := controllerDAO.GetControllerRecommendationGeneration(in.GetNamespacedName(), to.GetRecommendedType(), fromUntil)
+	if err != nil {
+		scope.Errorf("api DiffControllerRecommendations failed: %v", err)
+		return &DatahubHistory.DiffControllerRecommendationsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := &DatahubHistory.DiffControllerRecommendationsResponse{
+		Status: &status.Status{
+			Code: int32(code.Code_OK),
+		},
+		From:       from,
+		To:         to,
+		FieldDiffs: DatahubHistory.DiffControllerRecommendations(from, to),
+	}
+	scope.Debug("Response sent from DiffControllerRecommendations grpc function: " + AlamedaUtils.InterfaceToString(response))
+	return response, nil
+}
+
+// RollbackPodRecommendations re-publishes the generation of pod recommendations starting at
+// the generation time as the one applicable from now, so evictioner and admission controller pick it up
+func (s *ServiceV1alpha1) RollbackPodRecommendations(ctx context.Context, in *DatahubHistory.RollbackPodRecommendationsRequest) (*DatahubHistory.RollbackPodRecommendationsResponse, error) {
+	scope.Debug("Request received from RollbackPodRecommendations grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	if in.GetNamespacedName().GetNamespace() == "" || in.GetNamespacedName().GetName() == "" || in.GetGenerationTime().GetSeconds() == 0 {
+		return &DatahubHistory.RollbackPodRecommendationsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INVALID_ARGUMENT),
+				Message: "namespace and name of pod and generation time are required",
+			},
+		}, nil
+	}
+
+	containerDAO := &DaoRecommendationImpl.Container{
+		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
+	}
+
+	generation, err := containerDAO.GetPodRecommendationGeneration(in.GetNamespacedName(), in.GetGranularity(), generationUntil(in.GetGenerationTime()))
+	if err != nil {
+		scope.Errorf("api RollbackPodRecommendations failed: %v", err)
+		return &DatahubHistory.RollbackPodRecommendationsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	} else if generation == nil || generation.GetStartTime().GetSeconds() != in.GetGenerationTime().GetSeconds() {
+		return &DatahubHistory.RollbackPodRecommendationsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_NOT_FOUND),
+				Message: errors.Errorf("no recommendation generation starts at %d", in.GetGenerationTime().GetSeconds()).Error(),
+			},
+		}, nil
+	}
+
+	podRecommendation := DatahubHistory.RepublishPodRecommendation(generation, time.Now())
+	createStatus, err := s.CreatePodRecommendations(ctx, &DatahubV1alpha1.CreatePodRecommendationsRequest{
+		PodRecommendations: []*DatahubV1alpha1.PodRecommendation{podRecommendation},
+		Granularity:        in.GetGranularity(),
+	})
+	if err != nil {
+		scope.Errorf("api RollbackPodRecommendations failed: %v", err)
+		return &DatahubHistory.RollbackPodRecommendationsResponse{
+			Status: createStatus,
+		}, nil
+	}
+
+	response := &DatahubHistory.RollbackPodRecommendationsResponse{
+		Status: &status.Status{
+			Code: int32(code.Code_OK),
+		},
+		PodRecommendation: podRecommendation,
+	}
+	scope.Debug("Response sent from RollbackPodRecommendations grpc function: " + AlamedaUtils.InterfaceToString(response))
+	return response, nil
+}
+
+// RollbackControllerRecommendations re-publishes the generation of controller recommendations
+// created at the generation time as the latest one
+func (s *ServiceV1alpha1) RollbackControllerRecommendations(ctx context.Context, in *DatahubHistory.RollbackControllerRecommendationsRequest) (*DatahubHistory.RollbackControllerRecommendationsResponse, error) {
+	scope.Debug("Request received from RollbackControllerRecommendations grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	if in.GetNamespacedName().GetNamespace() == "" || in.GetNamespacedName().GetName() == "" || in.GetGenerationTime().GetSeconds() == 0 {
+		return &DatahubHistory.RollbackControllerRecommendationsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INVALID_ARGUMENT),
+				Message: "namespace and name of controller and generation time are required",
+			},
+		}, nil
+	}
+
+	controllerDAO := &DaoRecommendationImpl.Controller{
+		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
+	}
+
+	generation, err := controllerDAO.GetControllerRecommendationGeneration(in.GetNamespacedName(), in.GetRecommendedType(), generationUntil(in.GetGenerationTime()))
+	if err != nil {
+		scope.Errorf("api RollbackControllerRecommendations failed: %v", err)
+		return &DatahubHistory.RollbackControllerRecommendationsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	} else if generation == nil || DatahubHistory.ControllerRecommendationTime(generation).GetSeconds() != in.GetGenerationTime().GetSeconds() {
+		return &DatahubHistory.RollbackControllerRecommendationsResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_NOT_FOUND),
+				Message: errors.Errorf("no recommendation generation created at %d", in.GetGenerationTime().GetSeconds()).Error(),
+			},
+		}, nil
+	}
+
+	controllerRecommendation := DatahubHistory.RepublishControllerRecommendation(generation, time.Now())
+	createStatus, err := s.CreateControllerRecommendations(ctx, &DatahubV1alpha1.CreateControllerRecommendationsRequest{
+		ControllerRecommendations: []*DatahubV1alpha1.ControllerRecommendation{controllerRecommendation},
+	})
+	if err != nil {
+		scope.Errorf("api RollbackControllerRecommendations failed: %v", err)
+		return &DatahubHistory.RollbackControllerRecommendationsResponse{
+			Status: createStatus,
+		}, nil
+	}
+
+	response := &DatahubHistory.RollbackControllerRecommendationsResponse{
+		Status: &status.Status{
+			Code: int32(code.Code_OK),
+		},
+		ControllerRecommendation: controllerRecommendation,
+	}
+	scope.Debug("Response sent from RollbackControllerRecommendations grpc function: " + AlamedaUtils.InterfaceToString(response))
+	return response, nil
+}
+
+// GetLatestControllerRecommendation returns the latest generation of recommendations of the controller of the kind
+func (s *ServiceV1alpha1) GetLatestControllerRecommendation(ctx context.Context, in *DatahubHistory.GetLatestControllerRecommendationRequest) (*DatahubHistory.GetLatestControllerRecommendationResponse, error) {
+	scope.Debug("Request received from GetLatestControllerRecommendation grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	if in.GetNamespacedName().GetNamespace() == "" || in.GetNamespacedName().GetName() == "" {
+		return &DatahubHistory.GetLatestControllerRecommendationResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INVALID_ARGUMENT),
+				Message: "namespace and name of controller are required",
+			},
+		}, nil
+	}
+
+	controllerDAO := &DaoRecommendationImpl.Controller{
+		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
+	}
+
+	controllerRecommendation, err := controllerDAO.GetLatestControllerRecommendationOfKind(in.GetNamespacedName(), in.GetKind(), in.GetRecommendedType(), generationUntil(in.GetTime()))
+	if err != nil {
+		scope.Errorf("api GetLatestControllerRecommendation failed: %v", err)
+		return &DatahubHistory.GetLatestControllerRecommendationResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := &DatahubHistory.GetLatestControllerRecommendationResponse{
+		Status: &status.Status{
+			Code: int32(code.Code_OK),
+		},
+		ControllerRecommendation: controllerRecommendation,
+	}
+	scope.Debug("Response sent from GetLatestControllerRecommendation grpc function: " + AlamedaUtils.InterfaceToString(response))
+	return response, nil
+}
+
+// generationUntil Return the upper bound of generation time, now if the timestamp is not set
+func generationUntil(t *timestamp.Timestamp) time.Time {
+	if t == nil || t.GetSeconds() == 0 {
+		return time.Now()
+	}
+	return time.Unix(t.GetSeconds(), 0)
+}
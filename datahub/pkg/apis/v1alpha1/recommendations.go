@@ -5,6 +5,7 @@ import (
 	DaoRecommendationImpl "github.com/containers-ai/alameda/datahub/pkg/dao/recommendation/impl"
 	AutoScalingV1alpha1 "github.com/containers-ai/alameda/operator/pkg/apis/autoscaling/v1alpha1"
 	ReconcilerAlamedaRecommendation "github.com/containers-ai/alameda/operator/pkg/reconciler/alamedarecommendation"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
 	AlamedaUtils "github.com/containers-ai/alameda/pkg/utils"
 	DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"golang.org/x/net/context"
@@ -20,6 +21,7 @@ func (s *ServiceV1alpha1) CreatePodRecommendations(ctx context.Context, in *Data
 
 	var containerDAO DaoRecommendation.ContainerOperation = &DaoRecommendationImpl.Container{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	podRecommendations := in.GetPodRecommendations()
@@ -62,6 +64,7 @@ func (s *ServiceV1alpha1) CreateControllerRecommendations(ctx context.Context, i
 
 	controllerDAO := DaoRecommendationImpl.Controller{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	controllerRecommendationList := in.GetControllerRecommendations()
@@ -86,6 +89,7 @@ func (s *ServiceV1alpha1) ListPodRecommendations(ctx context.Context, in *Datahu
 
 	var containerDAO DaoRecommendation.ContainerOperation = &DaoRecommendationImpl.Container{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	podRecommendations, err := containerDAO.ListPodRecommendations(in)
@@ -115,6 +119,7 @@ func (s *ServiceV1alpha1) ListAvailablePodRecommendations(ctx context.Context, i
 
 	containerDAO := &DaoRecommendationImpl.Container{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	podRecommendations, err := containerDAO.ListAvailablePodRecommendations(in)
@@ -144,6 +149,7 @@ func (s *ServiceV1alpha1) ListControllerRecommendations(ctx context.Context, in
 
 	controllerDAO := &DaoRecommendationImpl.Controller{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	controllerRecommendations, err := controllerDAO.ListControllerRecommendations(in)
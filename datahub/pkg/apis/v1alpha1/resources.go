@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	DaoClusterStatus "github.com/containers-ai/alameda/datahub/pkg/dao/cluster_status"
 	DaoClusterStatusImpl "github.com/containers-ai/alameda/datahub/pkg/dao/cluster_status/impl"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
 	AlamedaUtils "github.com/containers-ai/alameda/pkg/utils"
 	DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"golang.org/x/net/context"
@@ -16,6 +17,7 @@ func (s *ServiceV1alpha1) CreateAlamedaNodes(ctx context.Context, in *DatahubV1a
 
 	var nodeDAO DaoClusterStatus.NodeOperation = &DaoClusterStatusImpl.Node{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 	if err := nodeDAO.RegisterAlamedaNodes(in.GetAlamedaNodes()); err != nil {
 		scope.Error(err.Error())
@@ -36,6 +38,7 @@ func (s *ServiceV1alpha1) CreatePods(ctx context.Context, in *DatahubV1alpha1.Cr
 
 	var containerDAO DaoClusterStatus.ContainerOperation = &DaoClusterStatusImpl.Container{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	if err := containerDAO.AddPods(in.GetPods()); err != nil {
@@ -55,6 +58,7 @@ func (s *ServiceV1alpha1) CreateControllers(ctx context.Context, in *DatahubV1al
 
 	controllerDAO := &DaoClusterStatusImpl.Controller{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	err := controllerDAO.CreateControllers(in.GetControllers())
@@ -77,6 +81,7 @@ func (s *ServiceV1alpha1) ListAlamedaPods(ctx context.Context, in *DatahubV1alph
 
 	var containerDAO DaoClusterStatus.ContainerOperation = &DaoClusterStatusImpl.Container{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	namespace, name := "", ""
@@ -114,6 +119,7 @@ func (s *ServiceV1alpha1) ListAlamedaNodes(ctx context.Context, in *DatahubV1alp
 
 	var nodeDAO DaoClusterStatus.NodeOperation = &DaoClusterStatusImpl.Node{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	timeRange := in.GetTimeRange()
@@ -141,6 +147,7 @@ func (s *ServiceV1alpha1) ListNodes(ctx context.Context, in *DatahubV1alpha1.Lis
 
 	var nodeDAO DaoClusterStatus.NodeOperation = &DaoClusterStatusImpl.Node{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	req := DaoClusterStatus.ListNodesRequest{
@@ -170,6 +177,7 @@ func (s *ServiceV1alpha1) ListControllers(ctx context.Context, in *DatahubV1alph
 
 	controllerDAO := &DaoClusterStatusImpl.Controller{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	controllers, err := controllerDAO.ListControllers(in)
@@ -210,6 +218,7 @@ func (s *ServiceV1alpha1) DeleteAlamedaNodes(ctx context.Context, in *DatahubV1a
 
 	var nodeDAO DaoClusterStatus.NodeOperation = &DaoClusterStatusImpl.Node{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 	alamedaNodeList := []*DatahubV1alpha1.Node{}
 	for _, alamedaNode := range in.GetAlamedaNodes() {
@@ -235,6 +244,7 @@ func (s *ServiceV1alpha1) DeleteControllers(ctx context.Context, in *DatahubV1al
 
 	controllerDAO := &DaoClusterStatusImpl.Controller{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 
 	err := controllerDAO.DeleteControllers(in)
@@ -257,6 +267,7 @@ func (s *ServiceV1alpha1) DeletePods(ctx context.Context, in *DatahubV1alpha1.De
 
 	var containerDAO DaoClusterStatus.ContainerOperation = &DaoClusterStatusImpl.Container{
 		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
 	}
 	if err := containerDAO.DeletePods(in.GetPods()); err != nil {
 		scope.Errorf("DeletePods failed: %+v", err)
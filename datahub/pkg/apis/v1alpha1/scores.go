@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	DaoScore "github.com/containers-ai/alameda/datahub/pkg/dao/score"
 	DaoScoreImplInflux "github.com/containers-ai/alameda/datahub/pkg/dao/score/impl/influxdb"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
 	AlamedaUtils "github.com/containers-ai/alameda/pkg/utils"
 	DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/ptypes"
@@ -22,7 +23,7 @@ func (s *ServiceV1alpha1) CreateSimulatedSchedulingScores(ctx context.Context, i
 		daoSimulatedSchedulingScoreEntities = make([]*DaoScore.SimulatedSchedulingScore, 0)
 	)
 
-	scoreDAO = DaoScoreImplInflux.NewWithConfig(*s.Config.InfluxDB)
+	scoreDAO = DaoScoreImplInflux.NewWithConfig(*s.Config.InfluxDB, DatahubCluster.FromContext(ctx))
 
 	for _, scoreEntity := range in.GetScores() {
 
@@ -67,7 +68,7 @@ func (s *ServiceV1alpha1) ListSimulatedSchedulingScores(ctx context.Context, in
 		datahubScores = make([]*DatahubV1alpha1.SimulatedSchedulingScore, 0)
 	)
 
-	scoreDAO = DaoScoreImplInflux.NewWithConfig(*s.Config.InfluxDB)
+	scoreDAO = DaoScoreImplInflux.NewWithConfig(*s.Config.InfluxDB, DatahubCluster.FromContext(ctx))
 
 	datahubListSimulatedSchedulingScoresRequestExtended := datahubListSimulatedSchedulingScoresRequestExtended{in}
 	scoreDAOListRequest = datahubListSimulatedSchedulingScoresRequestExtended.daoLisRequest()
@@ -0,0 +1,452 @@
+package v1alpha1
+
+import (
+	"encoding/base64"
+	"sort"
+	"strconv"
+
+	DaoClusterStatus "github.com/containers-ai/alameda/datahub/pkg/dao/cluster_status"
+	DaoClusterStatusImpl "github.com/containers-ai/alameda/datahub/pkg/dao/cluster_status/impl"
+	DaoRecommendation "github.com/containers-ai/alameda/datahub/pkg/dao/recommendation"
+	DaoRecommendationImpl "github.com/containers-ai/alameda/datahub/pkg/dao/recommendation/impl"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+	DatahubStream "github.com/containers-ai/alameda/pkg/framework/datahub/stream"
+	AlamedaUtils "github.com/containers-ai/alameda/pkg/utils"
+	DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	GrpcStatus "google.golang.org/grpc/status"
+)
+
+// Streaming and paged variants of the List APIs. Instead of building the
+// result of every pod or node in one response, they query and send the
+// result of one pod or node at a time.
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// StreamPodMetrics sends pods' metrics one pod per message
+func (s *ServiceV1alpha1) StreamPodMetrics(in *DatahubV1alpha1.ListPodMetricsRequest, stream DatahubStream.DatahubStreamService_StreamPodMetricsServer) error {
+	scope.Debug("Request received from StreamPodMetrics grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	podNames, err := s.listStreamPodNames(stream.Context(), in.GetNamespacedName())
+	if err != nil {
+		scope.Errorf("StreamPodMetrics failed: %+v", err)
+		return GrpcStatus.Error(codes.Internal, err.Error())
+	}
+
+	for _, podName := range podNames {
+		podMetrics, err := s.listPodMetricsOfPod(stream.Context(), in, podName)
+		if err != nil {
+			scope.Errorf("StreamPodMetrics failed: %+v", err)
+			return err
+		}
+		for _, podMetric := range podMetrics {
+			if err := stream.Send(podMetric); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// StreamPodPredictions sends pods' predictions one pod per message
+func (s *ServiceV1alpha1) StreamPodPredictions(in *DatahubV1alpha1.ListPodPredictionsRequest, stream DatahubStream.DatahubStreamService_StreamPodPredictionsServer) error {
+	scope.Debug("Request received from StreamPodPredictions grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	podNames, err := s.listStreamPodNames(stream.Context(), in.GetNamespacedName())
+	if err != nil {
+		scope.Errorf("StreamPodPredictions failed: %+v", err)
+		return GrpcStatus.Error(codes.Internal, err.Error())
+	}
+
+	for _, podName := range podNames {
+		podPredictions, err := s.listPodPredictionsOfPod(stream.Context(), in, podName)
+		if err != nil {
+			scope.Errorf("StreamPodPredictions failed: %+v", err)
+			return err
+		}
+		for _, podPrediction := range podPredictions {
+			if err := stream.Send(podPrediction); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// StreamNodePredictions sends nodes' predictions one node per message
+func (s *ServiceV1alpha1) StreamNodePredictions(in *DatahubV1alpha1.ListNodePredictionsRequest, stream DatahubStream.DatahubStreamService_StreamNodePredictionsServer) error {
+	scope.Debug("Request received from StreamNodePredictions grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	nodeNames, err := s.listStreamNodeNames(stream.Context(), in.GetNodeNames())
+	if err != nil {
+		scope.Errorf("StreamNodePredictions failed: %+v", err)
+		return GrpcStatus.Error(codes.Internal, err.Error())
+	}
+
+	for _, nodeName := range nodeNames {
+		nodePredictions, err := s.listNodePredictionsOfNode(stream.Context(), in, nodeName)
+		if err != nil {
+			scope.Errorf("StreamNodePredictions failed: %+v", err)
+			return err
+		}
+		for _, nodePrediction := range nodePredictions {
+			if err := stream.Send(nodePrediction); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// StreamAvailablePodRecommendations sends applicable pod recommendations one per message
+func (s *ServiceV1alpha1) StreamAvailablePodRecommendations(in *DatahubV1alpha1.ListPodRecommendationsRequest, stream DatahubStream.DatahubStreamService_StreamAvailablePodRecommendationsServer) error {
+	scope.Debug("Request received from StreamAvailablePodRecommendations grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	var containerDAO DaoRecommendation.ContainerOperation = &DaoRecommendationImpl.Container{
+		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(stream.Context()),
+	}
+	err := containerDAO.ListAvailablePodRecommendationsInBatches(in, defaultPageSize, func(podRecommendations []*DatahubV1alpha1.PodRecommendation) error {
+		for _, podRecommendation := range podRecommendations {
+			if err := stream.Send(podRecommendation); err != nil {
+				return GrpcStatus.Error(codes.Unavailable, err.Error())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		scope.Errorf("StreamAvailablePodRecommendations failed: %+v", err)
+		if _, ok := GrpcStatus.FromError(err); ok {
+			return err
+		}
+		return GrpcStatus.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+// ListPodMetricsPage lists pods' metrics of at most page size pods
+func (s *ServiceV1alpha1) ListPodMetricsPage(ctx context.Context, in *DatahubStream.ListPodMetricsPageRequest) (*DatahubStream.ListPodMetricsPageResponse, error) {
+	scope.Debug("Request received from ListPodMetricsPage grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	podNames, err := s.listStreamPodNames(ctx, in.GetRequest().GetNamespacedName())
+	if err != nil {
+		scope.Errorf("ListPodMetricsPage failed: %+v", err)
+		return &DatahubStream.ListPodMetricsPageResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	start, end, nextPageToken, err := pageRange(len(podNames), in.GetPageSize(), in.GetPageToken())
+	if err != nil {
+		return &DatahubStream.ListPodMetricsPageResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INVALID_ARGUMENT),
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	podMetrics := make([]*DatahubV1alpha1.PodMetric, 0)
+	for _, podName := range podNames[start:end] {
+		metrics, err := s.listPodMetricsOfPod(ctx, in.GetRequest(), podName)
+		if err != nil {
+			scope.Errorf("ListPodMetricsPage failed: %+v", err)
+			return &DatahubStream.ListPodMetricsPageResponse{
+				Status: grpcStatusToStatus(err),
+			}, nil
+		}
+		podMetrics = append(podMetrics, metrics...)
+	}
+
+	return &DatahubStream.ListPodMetricsPageResponse{
+		Status: &status.Status{
+			Code: int32(code.Code_OK),
+		},
+		PodMetrics:    podMetrics,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// ListPodPredictionsPage lists pods' predictions of at most page size pods
+func (s *ServiceV1alpha1) ListPodPredictionsPage(ctx context.Context, in *DatahubStream.ListPodPredictionsPageRequest) (*DatahubStream.ListPodPredictionsPageResponse, error) {
+	scope.Debug("Request received from ListPodPredictionsPage grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	podNames, err := s.listStreamPodNames(ctx, in.GetRequest().GetNamespacedName())
+	if err != nil {
+		scope.Errorf("ListPodPredictionsPage failed: %+v", err)
+		return &DatahubStream.ListPodPredictionsPageResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	start, end, nextPageToken, err := pageRange(len(podNames), in.GetPageSize(), in.GetPageToken())
+	if err != nil {
+		return &DatahubStream.ListPodPredictionsPageResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INVALID_ARGUMENT),
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	podPredictions := make([]*DatahubV1alpha1.PodPrediction, 0)
+	for _, podName := range podNames[start:end] {
+		predictions, err := s.listPodPredictionsOfPod(ctx, in.GetRequest(), podName)
+		if err != nil {
+			scope.Errorf("ListPodPredictionsPage failed: %+v", err)
+			return &DatahubStream.ListPodPredictionsPageResponse{
+				Status: grpcStatusToStatus(err),
+			}, nil
+		}
+		podPredictions = append(podPredictions, predictions...)
+	}
+
+	return &DatahubStream.ListPodPredictionsPageResponse{
+		Status: &status.Status{
+			Code: int32(code.Code_OK),
+		},
+		PodPredictions: podPredictions,
+		NextPageToken:  nextPageToken,
+	}, nil
+}
+
+// ListNodePredictionsPage lists nodes' predictions of at most page size nodes
+func (s *ServiceV1alpha1) ListNodePredictionsPage(ctx context.Context, in *DatahubStream.ListNodePredictionsPageRequest) (*DatahubStream.ListNodePredictionsPageResponse, error) {
+	scope.Debug("Request received from ListNodePredictionsPage grpc function: " + AlamedaUtils.InterfaceToString(in))
+
+	nodeNames, err := s.listStreamNodeNames(ctx, in.GetRequest().GetNodeNames())
+	if err != nil {
+		scope.Errorf("ListNodePredictionsPage failed: %+v", err)
+		return &DatahubStream.ListNodePredictionsPageResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INTERNAL),
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	start, end, nextPageToken, err := pageRange(len(nodeNames), in.GetPageSize(), in.GetPageToken())
+	if err != nil {
+		return &DatahubStream.ListNodePredictionsPageResponse{
+			Status: &status.Status{
+				Code:    int32(code.Code_INVALID_ARGUMENT),
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	nodePredictions := make([]*DatahubV1alpha1.NodePrediction, 0)
+	for _, nodeName := range nodeNames[start:end] {
+		predictions, err := s.listNodePredictionsOfNode(ctx, in.GetRequest(), nodeName)
+		if err != nil {
+			scope.Errorf("ListNodePredictionsPage failed: %+v", err)
+			return &DatahubStream.ListNodePredictionsPageResponse{
+				Status: grpcStatusToStatus(err),
+			}, nil
+		}
+		nodePredictions = append(nodePredictions, predictions...)
+	}
+
+	return &DatahubStream.ListNodePredictionsPageResponse{
+		Status: &status.Status{
+			Code: int32(code.Code_OK),
+		},
+		NodePredictions: nodePredictions,
+		NextPageToken:   nextPageToken,
+	}, nil
+}
+
+// listStreamPodNames Return the pods to iterate, the requested pod or all alameda pods sorted by namespace and name
+func (s *ServiceV1alpha1) listStreamPodNames(ctx context.Context, namespacedName *DatahubV1alpha1.NamespacedName) ([]*DatahubV1alpha1.NamespacedName, error) {
+
+	if namespacedName != nil && namespacedName.GetName() != "" {
+		return []*DatahubV1alpha1.NamespacedName{namespacedName}, nil
+	}
+
+	var containerDAO DaoClusterStatus.ContainerOperation = &DaoClusterStatusImpl.Container{
+		InfluxDBConfig: *s.Config.InfluxDB,
+		ClusterID:      DatahubCluster.FromContext(ctx),
+	}
+	pods, err := containerDAO.ListAlamedaPods("", "", DatahubV1alpha1.Kind_POD, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "list alameda pods failed")
+	}
+
+	podNames := make([]*DatahubV1alpha1.NamespacedName, 0, len(pods))
+	seen := make(map[string]bool)
+	for _, pod := range pods {
+		podName := pod.GetNamespacedName()
+		if podName == nil {
+			continue
+		} else if namespace := namespacedName.GetNamespace(); namespace != "" && namespace != podName.GetNamespace() {
+			continue
+		}
+		key := podName.GetNamespace() + "/" + podName.GetName()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		podNames = append(podNames, podName)
+	}
+	sort.Slice(podNames, func(i, j int) bool {
+		if podNames[i].GetNamespace() != podNames[j].GetNamespace() {
+			return podNames[i].GetNamespace() < podNames[j].GetNamespace()
+		}
+		return podNames[i].GetName() < podNames[j].GetName()
+	})
+
+	return podNames, nil
+}
+
+// listStreamNodeNames Return the nodes to iterate, the requested nodes or all alameda nodes sorted by name
+func (s *ServiceV1alpha1) listStreamNodeNames(ctx context.Context, requestNodeNames []string) ([]string, error) {
+
+	nodeNames := make([]string, 0)
+	if len(requestNodeNames) > 0 {
+		nodeNames = append(nodeNames, requestNodeNames...)
+	} else {
+		var nodeDAO DaoClusterStatus.NodeOperation = &DaoClusterStatusImpl.Node{
+			InfluxDBConfig: *s.Config.InfluxDB,
+			ClusterID:      DatahubCluster.FromContext(ctx),
+		}
+		nodes, err := nodeDAO.ListAlamedaNodes(nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "list alameda nodes failed")
+		}
+		seen := make(map[string]bool)
+		for _, node := range nodes {
+			if node.GetName() == "" || seen[node.GetName()] {
+				continue
+			}
+			seen[node.GetName()] = true
+			nodeNames = append(nodeNames, node.GetName())
+		}
+	}
+	sort.Strings(nodeNames)
+
+	return nodeNames, nil
+}
+
+func (s *ServiceV1alpha1) listPodMetricsOfPod(ctx context.Context, in *DatahubV1alpha1.ListPodMetricsRequest, podName *DatahubV1alpha1.NamespacedName) ([]*DatahubV1alpha1.PodMetric, error) {
+
+	req := &DatahubV1alpha1.ListPodMetricsRequest{}
+	if in != nil {
+		req = proto.Clone(in).(*DatahubV1alpha1.ListPodMetricsRequest)
+	}
+	req.NamespacedName = podName
+
+	resp, err := s.ListPodMetrics(ctx, req)
+	if err != nil {
+		return nil, err
+	} else if err := statusError(resp.GetStatus()); err != nil {
+		return nil, err
+	}
+
+	return resp.GetPodMetrics(), nil
+}
+
+func (s *ServiceV1alpha1) listPodPredictionsOfPod(ctx context.Context, in *DatahubV1alpha1.ListPodPredictionsRequest, podName *DatahubV1alpha1.NamespacedName) ([]*DatahubV1alpha1.PodPrediction, error) {
+
+	req := &DatahubV1alpha1.ListPodPredictionsRequest{}
+	if in != nil {
+		req = proto.Clone(in).(*DatahubV1alpha1.ListPodPredictionsRequest)
+	}
+	req.NamespacedName = podName
+
+	resp, err := s.ListPodPredictions(ctx, req)
+	if err != nil {
+		return nil, err
+	} else if err := statusError(resp.GetStatus()); err != nil {
+		return nil, err
+	}
+
+	return resp.GetPodPredictions(), nil
+}
+
+func (s *ServiceV1alpha1) listNodePredictionsOfNode(ctx context.Context, in *DatahubV1alpha1.ListNodePredictionsRequest, nodeName string) ([]*DatahubV1alpha1.NodePrediction, error) {
+
+	req := &DatahubV1alpha1.ListNodePredictionsRequest{}
+	if in != nil {
+		req = proto.Clone(in).(*DatahubV1alpha1.ListNodePredictionsRequest)
+	}
+	req.NodeNames = []string{nodeName}
+
+	resp, err := s.ListNodePredictions(ctx, req)
+	if err != nil {
+		return nil, err
+	} else if err := statusError(resp.GetStatus()); err != nil {
+		return nil, err
+	}
+
+	return resp.GetNodePredictions(), nil
+}
+
+// statusError Convert non-OK response status to gRPC error
+func statusError(s *status.Status) error {
+	if s == nil {
+		return GrpcStatus.Error(codes.Internal, "receive nil status")
+	} else if s.GetCode() != int32(code.Code_OK) {
+		return GrpcStatus.Error(codes.Code(s.GetCode()), s.GetMessage())
+	}
+	return nil
+}
+
+// grpcStatusToStatus Convert gRPC error to response status
+func grpcStatusToStatus(err error) *status.Status {
+	grpcStatus, _ := GrpcStatus.FromError(err)
+	return &status.Status{
+		Code:    int32(grpcStatus.Code()),
+		Message: grpcStatus.Message(),
+	}
+}
+
+// pageRange Return the range of items to list and the token of the next page
+func pageRange(total int, pageSize int32, pageToken string) (int, int, string, error) {
+
+	size := int(pageSize)
+	if size <= 0 {
+		size = defaultPageSize
+	} else if size > maxPageSize {
+		size = maxPageSize
+	}
+
+	start := 0
+	if pageToken != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return 0, 0, "", errors.Errorf("invalid page token %s", pageToken)
+		}
+		start, err = strconv.Atoi(string(decoded))
+		if err != nil || start < 0 {
+			return 0, 0, "", errors.Errorf("invalid page token %s", pageToken)
+		}
+	}
+	if start > total {
+		start = total
+	}
+
+	end := start + size
+	if end >= total {
+		return start, total, "", nil
+	}
+
+	return start, end, base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(end))), nil
+}
@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"reflect"
+	"testing"
+
+	DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	AuthenticationV1 "k8s.io/api/authentication/v1"
+	AuthorizationV1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestAttributesOfMethod(t *testing.T) {
+	testCases := []struct {
+		method   string
+		expected Attributes
+	}{
+		{method: "/containers_ai.alameda.v1alpha1.datahub.DatahubService/DeletePods", expected: Attributes{Verb: "delete", Resource: "pods"}},
+		{method: "/containers_ai.alameda.v1alpha1.datahub.DatahubService/ListPodPredictions", expected: Attributes{Verb: "list", Resource: "podpredictions"}},
+		{method: "/containers_ai.alameda.v1alpha1.datahub.DatahubService/WriteRawdata", expected: Attributes{Verb: "create", Resource: "rawdata"}},
+		{method: "/containers_ai.alameda.v1alpha1.datahub.DatahubService/DeregisterCluster", expected: Attributes{Verb: "delete", Resource: "cluster"}},
+		{method: "/containers_ai.alameda.v1alpha1.datahub.DatahubService/ListPodMetricsPage", expected: Attributes{Verb: "list", Resource: "podmetrics"}},
+		{method: "/containers_ai.alameda.v1alpha1.datahub.DatahubService/ListNodePredictionsPage", expected: Attributes{Verb: "list", Resource: "nodepredictions"}},
+		{method: "/containers_ai.alameda.v1alpha1.datahub.DatahubStreamService/StreamPodMetrics", expected: Attributes{Verb: "list", Resource: "podmetrics"}},
+		{method: "/containers_ai.alameda.v1alpha1.datahub.DatahubStreamService/StreamPodPredictions", expected: Attributes{Verb: "list", Resource: "podpredictions"}},
+		{method: "/containers_ai.alameda.v1alpha1.datahub.DatahubStreamService/StreamAvailablePodRecommendations", expected: Attributes{Verb: "list", Resource: "availablepodrecommendations"}},
+		{method: "/containers_ai.alameda.v1alpha1.datahub.DatahubRecommendationHistoryService/DiffPodRecommendations", expected: Attributes{Verb: "list", Resource: "podrecommendations"}},
+		{method: "/containers_ai.alameda.v1alpha1.datahub.DatahubRecommendationHistoryService/RollbackPodRecommendations", expected: Attributes{Verb: "update", Resource: "podrecommendations"}},
+		{method: "/datahub.keycodes.KeycodesService/ActivateRegistrationData", expected: Attributes{Verb: "update", Resource: "activateregistrationdata"}},
+	}
+	for _, testCase := range testCases {
+		if actual := AttributesOfMethod(testCase.method); actual != testCase.expected {
+			t.Errorf("%s: expect %+v, got %+v", testCase.method, testCase.expected, actual)
+		}
+	}
+}
+
+func TestNamespacesOfRequest(t *testing.T) {
+	req := &DatahubV1alpha1.DeletePodsRequest{
+		Pods: []*DatahubV1alpha1.Pod{
+			{NamespacedName: &DatahubV1alpha1.NamespacedName{Namespace: "ns2", Name: "pod1"}},
+			{NamespacedName: &DatahubV1alpha1.NamespacedName{Namespace: "ns1", Name: "pod2"}},
+			{NamespacedName: &DatahubV1alpha1.NamespacedName{Namespace: "ns2", Name: "pod3"}},
+		},
+	}
+	if actual := NamespacesOfRequest(req); !reflect.DeepEqual(actual, []string{"ns1", "ns2"}) {
+		t.Errorf("expect namespaces [ns1 ns2], got %v", actual)
+	}
+	if actual := NamespacesOfRequest(&DatahubV1alpha1.ListAlamedaNodesRequest{}); len(actual) != 0 {
+		t.Errorf("expect no namespace, got %v", actual)
+	}
+}
+
+// newFakeClientset Return clientset authenticating token "valid" as user
+// "alice" who is allowed to access namespace "ns1" only, and token "remote"
+// as user "bob" of group "remote-agents"
+func newFakeClientset() *fake.Clientset {
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*AuthenticationV1.TokenReview)
+		switch review.Spec.Token {
+		case "valid":
+			review.Status.Authenticated = true
+			review.Status.User = AuthenticationV1.UserInfo{Username: "alice"}
+		case "remote":
+			review.Status.Authenticated = true
+			review.Status.User = AuthenticationV1.UserInfo{Username: "bob", Groups: []string{"remote-agents"}}
+		}
+		return true, review, nil
+	})
+	clientset.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*AuthorizationV1.SubjectAccessReview)
+		review.Status.Allowed = review.Spec.User == "alice" && review.Spec.ResourceAttributes.Namespace == "ns1"
+		return true, review, nil
+	})
+	return clientset
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	clientset := newFakeClientset()
+	config := NewDefaultConfig()
+	guard := NewGuard(
+		NewTokenReviewAuthenticator(clientset, *config.Authentication),
+		NewSubjectAccessReviewAuthorizer(clientset, *config.Authorization),
+		nil,
+		config.PublicMethods,
+	)
+	interceptor := guard.UnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return UserFromContext(ctx), nil
+	}
+	deletePods := func(namespace string) *DatahubV1alpha1.DeletePodsRequest {
+		return &DatahubV1alpha1.DeletePodsRequest{
+			Pods: []*DatahubV1alpha1.Pod{{NamespacedName: &DatahubV1alpha1.NamespacedName{Namespace: namespace, Name: "pod"}}},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		method   string
+		token    string
+		req      interface{}
+		expected codes.Code
+	}{
+		{name: "public method", method: "/containers_ai.alameda.v1alpha1.datahub.DatahubService/Ping", expected: codes.OK},
+		{name: "no token", method: "/containers_ai.alameda.v1alpha1.datahub.DatahubService/DeletePods", req: deletePods("ns1"), expected: codes.Unauthenticated},
+		{name: "invalid token", method: "/containers_ai.alameda.v1alpha1.datahub.DatahubService/DeletePods", token: "invalid", req: deletePods("ns1"), expected: codes.Unauthenticated},
+		{name: "allowed namespace", method: "/containers_ai.alameda.v1alpha1.datahub.DatahubService/DeletePods", token: "valid", req: deletePods("ns1"), expected: codes.OK},
+		{name: "denied namespace", method: "/containers_ai.alameda.v1alpha1.datahub.DatahubService/DeletePods", token: "valid", req: deletePods("ns2"), expected: codes.PermissionDenied},
+		{name: "denied cluster wide", method: "/containers_ai.alameda.v1alpha1.datahub.DatahubService/ListAlamedaNodes", token: "valid", req: &DatahubV1alpha1.ListAlamedaNodesRequest{}, expected: codes.PermissionDenied},
+	}
+	for _, testCase := range testCases {
+		ctx := context.Background()
+		if testCase.token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+testCase.token))
+		}
+		_, err := interceptor(ctx, testCase.req, &grpc.UnaryServerInfo{FullMethod: testCase.method}, handler)
+		if actual := status.Code(err); actual != testCase.expected {
+			t.Errorf("%s: expect code %s, got %s (%v)", testCase.name, testCase.expected, actual, err)
+		}
+	}
+}
+
+func TestClusterBinding(t *testing.T) {
+	clientset := newFakeClientset()
+	config := NewDefaultConfig()
+	bindings := []ClusterBinding{{ClusterID: "remote", Groups: []string{"remote-agents"}}}
+	guard := NewGuard(
+		NewTokenReviewAuthenticator(clientset, *config.Authentication),
+		nil,
+		NewClusterBinder("local", bindings),
+		config.PublicMethods,
+	)
+	interceptor := guard.UnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+
+	testCases := []struct {
+		name      string
+		token     string
+		clusterID string
+		expected  codes.Code
+	}{
+		{name: "unbound user without cluster id", token: "valid", expected: codes.OK},
+		{name: "unbound user to local cluster", token: "valid", clusterID: "local", expected: codes.OK},
+		{name: "unbound user to remote cluster", token: "valid", clusterID: "remote", expected: codes.PermissionDenied},
+		{name: "bound group to bound cluster", token: "remote", clusterID: "remote", expected: codes.OK},
+		{name: "bound group without cluster id", token: "remote", expected: codes.PermissionDenied},
+		{name: "bound group to other cluster", token: "remote", clusterID: "other", expected: codes.PermissionDenied},
+	}
+	for _, testCase := range testCases {
+		md := metadata.Pairs("authorization", "Bearer "+testCase.token)
+		if testCase.clusterID != "" {
+			md.Set("x-alameda-cluster-id", testCase.clusterID)
+		}
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		_, err := interceptor(ctx, &DatahubV1alpha1.ListAlamedaNodesRequest{}, &grpc.UnaryServerInfo{FullMethod: "/containers_ai.alameda.v1alpha1.datahub.DatahubService/ListAlamedaNodes"}, handler)
+		if actual := status.Code(err); actual != testCase.expected {
+			t.Errorf("%s: expect code %s, got %s (%v)", testCase.name, testCase.expected, actual, err)
+		}
+	}
+}
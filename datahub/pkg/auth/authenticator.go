@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	AuthenticationV1 "k8s.io/api/authentication/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Authenticator Authenticates bearer tokens of callers
+type Authenticator interface {
+	Authenticate(token string) (*AuthenticationV1.UserInfo, error)
+}
+
+// tokenReviewAuthenticator Authenticates Kubernetes ServiceAccount tokens by TokenReview
+type tokenReviewAuthenticator struct {
+	clientset kubernetes.Interface
+	audiences []string
+	cache     *ttlCache
+}
+
+// NewTokenReviewAuthenticator Return authenticator reviewing tokens with Kubernetes API server
+func NewTokenReviewAuthenticator(clientset kubernetes.Interface, config AuthenticationConfig) Authenticator {
+	return &tokenReviewAuthenticator{
+		clientset: clientset,
+		audiences: config.Audiences,
+		cache:     newTTLCache(config.CacheTTL),
+	}
+}
+
+func (a *tokenReviewAuthenticator) Authenticate(token string) (*AuthenticationV1.UserInfo, error) {
+	sum := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(sum[:])
+	if value, ok := a.cache.get(key); ok {
+		return value.(*AuthenticationV1.UserInfo), nil
+	}
+
+	review, err := a.clientset.AuthenticationV1().TokenReviews().Create(&AuthenticationV1.TokenReview{
+		Spec: AuthenticationV1.TokenReviewSpec{
+			Token:     token,
+			Audiences: a.audiences,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "create token review failed")
+	}
+	if !review.Status.Authenticated {
+		return nil, errors.Errorf("token is not authenticated: %s", review.Status.Error)
+	}
+
+	user := review.Status.User
+	a.cache.set(key, &user)
+	return &user, nil
+}
@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	AuthenticationV1 "k8s.io/api/authentication/v1"
+	AuthorizationV1 "k8s.io/api/authorization/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// verbPrefixes Map prefixes of RPC names to verbs of Kubernetes RBAC, RPCs
+// not matching any prefix are authorized as "update". Streaming and diffing
+// RPCs read the same resources as their List equivalents.
+var verbPrefixes = []struct {
+	prefix string
+	verb   string
+}{
+	{prefix: "List", verb: "list"},
+	{prefix: "Read", verb: "list"},
+	{prefix: "Query", verb: "list"},
+	{prefix: "Stream", verb: "list"},
+	{prefix: "Diff", verb: "list"},
+	{prefix: "Get", verb: "get"},
+	{prefix: "Watch", verb: "watch"},
+	{prefix: "Create", verb: "create"},
+	{prefix: "Write", verb: "create"},
+	{prefix: "Add", verb: "create"},
+	{prefix: "Register", verb: "create"},
+	{prefix: "Update", verb: "update"},
+	{prefix: "Rollback", verb: "update"},
+	{prefix: "Delete", verb: "delete"},
+	{prefix: "Deregister", verb: "delete"},
+	{prefix: "Remove", verb: "delete"},
+}
+
+// Attributes Resource attributes a call is authorized against
+type Attributes struct {
+	Verb      string
+	Resource  string
+	Namespace string
+}
+
+// AttributesOfMethod Return verb and resource of the full gRPC method name,
+// e.g. "/pkg.Service/DeletePods" is mapped to verb "delete" and resource "pods".
+// Suffix "Page" of paged RPCs is dropped, so "ListPodMetricsPage" and
+// "StreamPodMetrics" are both mapped to verb "list" and resource "podmetrics".
+func AttributesOfMethod(fullMethod string) Attributes {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, verbPrefix := range verbPrefixes {
+		if strings.HasPrefix(name, verbPrefix.prefix) && len(name) > len(verbPrefix.prefix) {
+			resource := strings.TrimPrefix(name, verbPrefix.prefix)
+			if trimmed := strings.TrimSuffix(resource, "Page"); trimmed != "" {
+				resource = trimmed
+			}
+			return Attributes{
+				Verb:     verbPrefix.verb,
+				Resource: strings.ToLower(resource),
+			}
+		}
+	}
+	return Attributes{
+		Verb:     "update",
+		Resource: strings.ToLower(name),
+	}
+}
+
+// NamespacesOfRequest Return sorted namespaces of objects in the request message,
+// empty if the request is not scoped to namespaces
+func NamespacesOfRequest(req interface{}) []string {
+	set := map[string]bool{}
+	collectNamespaces(reflect.ValueOf(req), set)
+	namespaces := make([]string, 0, len(set))
+	for namespace := range set {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+func collectNamespaces(value reflect.Value, set map[string]bool) {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !value.IsNil() {
+			collectNamespaces(value.Elem(), set)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			collectNamespaces(value.Index(i), set)
+		}
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			collectNamespaces(value.MapIndex(key), set)
+		}
+	case reflect.Struct:
+		valueType := value.Type()
+		for i := 0; i < value.NumField(); i++ {
+			field := valueType.Field(i)
+			if field.PkgPath != "" || strings.HasPrefix(field.Name, "XXX_") {
+				continue
+			}
+			if field.Name == "Namespace" && field.Type.Kind() == reflect.String {
+				if namespace := value.Field(i).String(); namespace != "" {
+					set[namespace] = true
+				}
+				continue
+			}
+			collectNamespaces(value.Field(i), set)
+		}
+	}
+}
+
+// Authorizer Authorizes users to access resources
+type Authorizer interface {
+	Authorize(user *AuthenticationV1.UserInfo, attributes Attributes) error
+}
+
+// subjectAccessReviewAuthorizer Authorizes users by SubjectAccessReview against
+// resources of the api group, resources are not required to exist so
+// administrators grant access to datahub with RBAC rules of the api group
+type subjectAccessReviewAuthorizer struct {
+	clientset kubernetes.Interface
+	apiGroup  string
+	cache     *ttlCache
+}
+
+// NewSubjectAccessReviewAuthorizer Return authorizer reviewing access with Kubernetes API server
+func NewSubjectAccessReviewAuthorizer(clientset kubernetes.Interface, config AuthorizationConfig) Authorizer {
+	return &subjectAccessReviewAuthorizer{
+		clientset: clientset,
+		apiGroup:  config.APIGroup,
+		cache:     newTTLCache(config.CacheTTL),
+	}
+}
+
+func (a *subjectAccessReviewAuthorizer) Authorize(user *AuthenticationV1.UserInfo, attributes Attributes) error {
+	key := fmt.Sprintf("%s/%s/%s/%s", user.Username, attributes.Verb, attributes.Resource, attributes.Namespace)
+	if value, ok := a.cache.get(key); ok {
+		if allowed := value.(bool); !allowed {
+			return errors.Errorf("user %s is not allowed to %s %s in namespace %q", user.Username, attributes.Verb, attributes.Resource, attributes.Namespace)
+		}
+		return nil
+	}
+
+	extra := make(map[string]AuthorizationV1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = AuthorizationV1.ExtraValue(v)
+	}
+	review, err := a.clientset.AuthorizationV1().SubjectAccessReviews().Create(&AuthorizationV1.SubjectAccessReview{
+		Spec: AuthorizationV1.SubjectAccessReviewSpec{
+			ResourceAttributes: &AuthorizationV1.ResourceAttributes{
+				Namespace: attributes.Namespace,
+				Verb:      attributes.Verb,
+				Group:     a.apiGroup,
+				Resource:  attributes.Resource,
+			},
+			User:   user.Username,
+			Groups: user.Groups,
+			Extra:  extra,
+			UID:    user.UID,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "create subject access review failed")
+	}
+
+	a.cache.set(key, review.Status.Allowed)
+	if !review.Status.Allowed {
+		return errors.Errorf("user %s is not allowed to %s %s in namespace %q: %s", user.Username, attributes.Verb, attributes.Resource, attributes.Namespace, review.Status.Reason)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// ttlCache Cache of results of reviews expiring after ttl
+type ttlCache struct {
+	ttl   time.Duration
+	lock  sync.Mutex
+	items map[string]ttlCacheItem
+}
+
+type ttlCacheItem struct {
+	value    interface{}
+	expireAt time.Time
+}
+
+func newTTLCache(ttlSeconds int64) *ttlCache {
+	return &ttlCache{
+		ttl:   time.Duration(ttlSeconds) * time.Second,
+		items: make(map[string]ttlCacheItem),
+	}
+}
+
+func (c *ttlCache) get(key string) (interface{}, bool) {
+	if c.ttl <= 0 {
+		return nil, false
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	item, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(item.expireAt) {
+		delete(c.items, key)
+		return nil, false
+	}
+	return item.value, true
+}
+
+func (c *ttlCache) set(key string, value interface{}) {
+	if c.ttl <= 0 {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := time.Now()
+	for k, item := range c.items {
+		if now.After(item.expireAt) {
+			delete(c.items, k)
+		}
+	}
+	c.items[key] = ttlCacheItem{value: value, expireAt: now.Add(c.ttl)}
+}
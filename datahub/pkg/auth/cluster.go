@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"github.com/pkg/errors"
+	AuthenticationV1 "k8s.io/api/authentication/v1"
+)
+
+// ClusterBinder Checks authenticated users are bound to the cluster their calls
+// are scoped to. Users and groups bound to no cluster are components of the
+// cluster datahub runs in and are allowed to access the local cluster only.
+type ClusterBinder struct {
+	localClusterID string
+	users          map[string]map[string]bool
+	groups         map[string]map[string]bool
+}
+
+// NewClusterBinder Return binder of users and groups to clusters, calls without
+// cluster id are scoped to localClusterID
+func NewClusterBinder(localClusterID string, bindings []ClusterBinding) *ClusterBinder {
+	binder := &ClusterBinder{
+		localClusterID: localClusterID,
+		users:          make(map[string]map[string]bool),
+		groups:         make(map[string]map[string]bool),
+	}
+	for _, binding := range bindings {
+		for _, user := range binding.Users {
+			addBinding(binder.users, user, binding.ClusterID)
+		}
+		for _, group := range binding.Groups {
+			addBinding(binder.groups, group, binding.ClusterID)
+		}
+	}
+	return binder
+}
+
+func addBinding(bindings map[string]map[string]bool, subject, clusterID string) {
+	if bindings[subject] == nil {
+		bindings[subject] = make(map[string]bool)
+	}
+	bindings[subject][clusterID] = true
+}
+
+// Bind Check the user is allowed to scope calls to the cluster
+func (b *ClusterBinder) Bind(user *AuthenticationV1.UserInfo, clusterID string) error {
+	if clusterID == "" {
+		clusterID = b.localClusterID
+	}
+
+	bound := false
+	for _, clusters := range b.clustersOf(user) {
+		if clusters[clusterID] {
+			return nil
+		}
+		bound = true
+	}
+	if !bound && clusterID == b.localClusterID {
+		return nil
+	}
+	return errors.Errorf("user %s is not bound to cluster %q", user.Username, clusterID)
+}
+
+func (b *ClusterBinder) clustersOf(user *AuthenticationV1.UserInfo) []map[string]bool {
+	clusters := make([]map[string]bool, 0, len(user.Groups)+1)
+	if userClusters, ok := b.users[user.Username]; ok {
+		clusters = append(clusters, userClusters)
+	}
+	for _, group := range user.Groups {
+		if groupClusters, ok := b.groups[group]; ok {
+			clusters = append(clusters, groupClusters)
+		}
+	}
+	return clusters
+}
@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultCacheTTL = 60
+	defaultAPIGroup = "datahub.containers.ai"
+)
+
+var (
+	defaultPublicMethods = []string{
+		"/containers_ai.alameda.v1alpha1.datahub.DatahubService/Ping",
+		"/grpc.health.v1.Health/Check",
+		"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo",
+	}
+)
+
+// Config Configuration of authentication and authorization of datahub gRPC server
+type Config struct {
+	Authentication *AuthenticationConfig `mapstructure:"authentication"`
+	Authorization  *AuthorizationConfig  `mapstructure:"authorization"`
+	// PublicMethods are full gRPC method names callable without credentials
+	PublicMethods []string `mapstructure:"publicMethods"`
+	// ClusterBindings bind authenticated users and groups to clusters they are allowed to scope calls to
+	ClusterBindings []ClusterBinding `mapstructure:"clusterBindings"`
+}
+
+// AuthenticationConfig Configuration of authenticating bearer tokens by TokenReview
+type AuthenticationConfig struct {
+	Enabled   bool     `mapstructure:"enabled"`
+	Audiences []string `mapstructure:"audiences"`
+	// CacheTTL is seconds results of TokenReview are cached
+	CacheTTL int64 `mapstructure:"cacheTTL"`
+}
+
+// AuthorizationConfig Configuration of authorizing calls by SubjectAccessReview
+type AuthorizationConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// APIGroup is the group of resources calls are authorized against
+	APIGroup string `mapstructure:"apiGroup"`
+	// CacheTTL is seconds results of SubjectAccessReview are cached
+	CacheTTL int64 `mapstructure:"cacheTTL"`
+}
+
+// ClusterBinding Binds users and groups to the cluster they are allowed to send in
+// x-alameda-cluster-id metadata, e.g. ServiceAccount of datahub clients of a remote cluster
+type ClusterBinding struct {
+	ClusterID string   `mapstructure:"clusterID"`
+	Users     []string `mapstructure:"users"`
+	Groups    []string `mapstructure:"groups"`
+}
+
+// NewDefaultConfig Provide default configuration with authentication and authorization disabled
+func NewDefaultConfig() *Config {
+	return &Config{
+		Authentication: &AuthenticationConfig{
+			CacheTTL: defaultCacheTTL,
+		},
+		Authorization: &AuthorizationConfig{
+			APIGroup: defaultAPIGroup,
+			CacheTTL: defaultCacheTTL,
+		},
+		PublicMethods: append([]string{}, defaultPublicMethods...),
+	}
+}
+
+// Validate Confirm the auth configuration is validated
+func (c *Config) Validate() error {
+	if c.Authorization.Enabled && !c.Authentication.Enabled {
+		return errors.New("authorization requires authentication enabled")
+	}
+	if c.Authorization.APIGroup == "" {
+		return errors.New("apiGroup of authorization is required")
+	}
+	if len(c.ClusterBindings) > 0 && !c.Authentication.Enabled {
+		return errors.New("clusterBindings requires authentication enabled")
+	}
+	for _, binding := range c.ClusterBindings {
+		if binding.ClusterID == "" {
+			return errors.New("clusterID of clusterBindings is required")
+		}
+	}
+	return nil
+}
+
+// TLSConfig Configuration of tls of datahub gRPC server
+type TLSConfig struct {
+	Enabled  bool   `mapstructure:"enabled"`
+	CertFile string `mapstructure:"certFile"`
+	KeyFile  string `mapstructure:"keyFile"`
+	// ClientCAFile verifies certificates of clients, clients are required to present certificates (mTLS) if it is set
+	ClientCAFile string `mapstructure:"clientCAFile"`
+}
+
+// NewDefaultTLSConfig Provide default configuration with tls disabled
+func NewDefaultTLSConfig() *TLSConfig {
+	return &TLSConfig{
+		CertFile: "/etc/alameda/datahub/tls/tls.crt",
+		KeyFile:  "/etc/alameda/datahub/tls/tls.key",
+	}
+}
+
+// Validate Confirm the tls configuration is validated
+func (c *TLSConfig) Validate() error {
+	if c.Enabled && (c.CertFile == "" || c.KeyFile == "") {
+		return errors.New("certFile and keyFile are required when tls is enabled")
+	}
+	return nil
+}
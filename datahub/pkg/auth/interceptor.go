@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"strings"
+
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+	"github.com/containers-ai/alameda/pkg/framework/datahub/credentials"
+	Log "github.com/containers-ai/alameda/pkg/utils/log"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	AuthenticationV1 "k8s.io/api/authentication/v1"
+)
+
+var (
+	scope = Log.RegisterScope("auth", "datahub authentication and authorization", 0)
+)
+
+type userContextKey struct{}
+
+// UserFromContext Return the authenticated user of the call, nil if authentication is disabled
+func UserFromContext(ctx context.Context) *AuthenticationV1.UserInfo {
+	user, _ := ctx.Value(userContextKey{}).(*AuthenticationV1.UserInfo)
+	return user
+}
+
+// Guard Authenticates and authorizes calls to datahub, nil authenticator,
+// authorizer or cluster binder disables the check
+type Guard struct {
+	authenticator Authenticator
+	authorizer    Authorizer
+	clusterBinder *ClusterBinder
+	publicMethods map[string]bool
+}
+
+// NewGuard Return guard of gRPC calls, cluster ids sent by authenticated users are checked by clusterBinder
+func NewGuard(authenticator Authenticator, authorizer Authorizer, clusterBinder *ClusterBinder, publicMethods []string) *Guard {
+	guard := &Guard{
+		authenticator: authenticator,
+		authorizer:    authorizer,
+		clusterBinder: clusterBinder,
+		publicMethods: make(map[string]bool, len(publicMethods)),
+	}
+	for _, method := range publicMethods {
+		guard.publicMethods[method] = true
+	}
+	return guard
+}
+
+// UnaryServerInterceptor Return interceptor authenticating and authorizing every unary call
+func (g *Guard) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if g.publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		ctx, err := g.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := g.authorize(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor Return interceptor authenticating every streaming call
+// and authorizing every message received from the stream
+func (g *Guard) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if g.publicMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		ctx, err := g.authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &guardedServerStream{
+			ServerStream: ss,
+			ctx:          ctx,
+			guard:        g,
+			fullMethod:   info.FullMethod,
+		})
+	}
+}
+
+func (g *Guard) authenticate(ctx context.Context) (context.Context, error) {
+	if g.authenticator == nil {
+		return ctx, nil
+	}
+	token := bearerToken(ctx)
+	if token == "" {
+		return ctx, status.Error(codes.Unauthenticated, "bearer token is required")
+	}
+	user, err := g.authenticator.Authenticate(token)
+	if err != nil {
+		scope.Warnf("authenticate call failed: %s", err.Error())
+		return ctx, status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+	if g.clusterBinder != nil {
+		if err := g.clusterBinder.Bind(user, DatahubCluster.FromIncomingContext(ctx)); err != nil {
+			scope.Warnf("bind call to cluster failed: %s", err.Error())
+			return ctx, status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+	return context.WithValue(ctx, userContextKey{}, user), nil
+}
+
+// authorize Check the user is allowed to call the method in every namespace of
+// the request, requests without namespace are checked cluster wide
+func (g *Guard) authorize(ctx context.Context, fullMethod string, req interface{}) error {
+	if g.authorizer == nil {
+		return nil
+	}
+	user := UserFromContext(ctx)
+	if user == nil {
+		return status.Error(codes.Unauthenticated, "authorization requires authenticated user")
+	}
+
+	attributes := AttributesOfMethod(fullMethod)
+	namespaces := NamespacesOfRequest(req)
+	if len(namespaces) == 0 {
+		namespaces = []string{""}
+	}
+	for _, namespace := range namespaces {
+		attributes.Namespace = namespace
+		if err := g.authorizer.Authorize(user, attributes); err != nil {
+			scope.Warnf("authorize call %s failed: %s", fullMethod, err.Error())
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+	return nil
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, value := range md.Get(credentials.MetadataKeyAuthorization) {
+		if strings.HasPrefix(value, "Bearer ") {
+			return strings.TrimSpace(strings.TrimPrefix(value, "Bearer "))
+		}
+	}
+	return ""
+}
+
+type guardedServerStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	guard      *Guard
+	fullMethod string
+}
+
+func (s *guardedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *guardedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.guard.authorize(s.ctx, s.fullMethod, m)
+}
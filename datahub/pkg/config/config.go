@@ -3,12 +3,17 @@ package config
 import (
 	"errors"
 	Keycodes "github.com/containers-ai/alameda/datahub/pkg/account-mgt/keycodes"
+	Auth "github.com/containers-ai/alameda/datahub/pkg/auth"
+	DaoMetric "github.com/containers-ai/alameda/datahub/pkg/dao/metric"
 	Notifier "github.com/containers-ai/alameda/datahub/pkg/notifier"
+	Rawdata "github.com/containers-ai/alameda/datahub/pkg/rawdata"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
 	InternalLdap "github.com/containers-ai/alameda/internal/pkg/database/ldap"
 	InternalPromth "github.com/containers-ai/alameda/internal/pkg/database/prometheus"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
 	InternalRabbitMQ "github.com/containers-ai/alameda/internal/pkg/message-queue/rabbitmq"
 	InternalWeaveScope "github.com/containers-ai/alameda/internal/pkg/weavescope"
+	Instrumentation "github.com/containers-ai/alameda/pkg/instrumentation"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 )
 
@@ -18,39 +23,60 @@ const (
 
 type Config struct {
 	BindAddress string                     `mapstructure:"bindAddress"`
+	ClusterID   string                     `mapstructure:"clusterID"`
+	TLS         *Auth.TLSConfig            `mapstructure:"tls"`
+	Auth        *Auth.Config               `mapstructure:"auth"`
 	Prometheus  *InternalPromth.Config     `mapstructure:"prometheus"`
+	Metric      *DaoMetric.Config          `mapstructure:"metric"`
 	InfluxDB    *InternalInflux.Config     `mapstructure:"influxdb"`
+	Storage     *storage.Config            `mapstructure:"storage"`
+	Rawdata     *Rawdata.Config            `mapstructure:"rawdata"`
 	Ldap        *InternalLdap.Config       `mapstructure:"ldap"`
 	Keycode     *Keycodes.Config           `mapstructure:"keycode"`
 	Notifier    *Notifier.Config           `mapstructure:"notifier"`
 	WeaveScope  *InternalWeaveScope.Config `mapstructure:"weavescope"`
 	RabbitMQ    *InternalRabbitMQ.Config   `mapstructure:"rabbitmq"`
 	Log         *log.Config                `mapstructure:"log"`
+	Metrics     *Instrumentation.Config    `mapstructure:"metrics"`
 }
 
 func NewDefaultConfig() Config {
 	var (
 		defaultLogConfig        = log.NewDefaultConfig()
+		defaultTLSConfig        = Auth.NewDefaultTLSConfig()
+		defaultAuthConfig       = Auth.NewDefaultConfig()
 		defaultPrometheusConfig = InternalPromth.NewDefaultConfig()
+		defaultMetricConfig     = DaoMetric.NewDefaultConfig()
 		defaultInfluxDBConfig   = InternalInflux.NewDefaultConfig()
+		defaultStorageConfig    = storage.NewDefaultConfig()
+		defaultRawdataConfig    = Rawdata.NewDefaultConfig()
 		defaultLdapConfig       = InternalLdap.NewDefaultConfig()
 		defaultKeycodeConfig    = Keycodes.NewDefaultConfig()
 		defaultNotifierConfig   = Notifier.NewDefaultConfig()
 		defaultWeaveScopeConfig = InternalWeaveScope.NewDefaultConfig()
 		defaultRabbitMQConfig   = InternalRabbitMQ.NewDefaultConfig()
+		defaultMetricsConfig    = Instrumentation.NewDefaultConfig()
 		config                  = Config{
 			BindAddress: defaultBindAddress,
+			TLS:         defaultTLSConfig,
+			Auth:        defaultAuthConfig,
 			Prometheus:  defaultPrometheusConfig,
+			Metric:      defaultMetricConfig,
 			InfluxDB:    defaultInfluxDBConfig,
+			Storage:     defaultStorageConfig,
+			Rawdata:     defaultRawdataConfig,
 			Ldap:        defaultLdapConfig,
 			Keycode:     defaultKeycodeConfig,
 			Notifier:    defaultNotifierConfig,
 			WeaveScope:  defaultWeaveScopeConfig,
 			RabbitMQ:    defaultRabbitMQConfig,
 			Log:         &defaultLogConfig,
+			Metrics:     defaultMetricsConfig,
 		}
 	)
 
+	defaultMetricConfig.Prometheus = defaultPrometheusConfig
+	defaultStorageConfig.InfluxDB = defaultInfluxDBConfig
 	defaultKeycodeConfig.InfluxDB = defaultInfluxDBConfig
 	defaultKeycodeConfig.Ldap = nil // TODO: defaultLdapConfig
 
@@ -65,5 +91,40 @@ func (c *Config) Validate() error {
 		return errors.New("failed to validate gRPC config: " + err.Error())
 	}
 
+	err = c.Metric.Validate()
+	if err != nil {
+		return errors.New("failed to validate metric config: " + err.Error())
+	}
+
+	err = c.Storage.Validate()
+	if err != nil {
+		return errors.New("failed to validate storage config: " + err.Error())
+	}
+
+	err = c.Rawdata.Validate()
+	if err != nil {
+		return errors.New("failed to validate rawdata config: " + err.Error())
+	}
+
+	err = c.RabbitMQ.Validate()
+	if err != nil {
+		return errors.New("failed to validate rabbitmq config: " + err.Error())
+	}
+
+	err = c.Metrics.Validate()
+	if err != nil {
+		return errors.New("failed to validate metrics config: " + err.Error())
+	}
+
+	err = c.TLS.Validate()
+	if err != nil {
+		return errors.New("failed to validate tls config: " + err.Error())
+	}
+
+	err = c.Auth.Validate()
+	if err != nil {
+		return errors.New("failed to validate auth config: " + err.Error())
+	}
+
 	return nil
 }
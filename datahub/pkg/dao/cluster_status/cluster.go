@@ -0,0 +1,12 @@
+package clusterstatus
+
+import (
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+)
+
+// ClusterOperation provides cluster registry operations
+type ClusterOperation interface {
+	RegisterCluster(*DatahubCluster.Cluster) (*DatahubCluster.Cluster, error)
+	ListClusters(ids []string) ([]*DatahubCluster.Cluster, error)
+	DeregisterCluster(id string) error
+}
@@ -0,0 +1,61 @@
+package impl
+
+import (
+	"time"
+
+	EntityInfluxClusterStatus "github.com/containers-ai/alameda/datahub/pkg/entity/influxdb/cluster_status"
+	RepoInfluxClusterStatus "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb/cluster_status"
+	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+	"github.com/pkg/errors"
+)
+
+// Implement Cluster interface
+type Cluster struct {
+	InfluxDBConfig InternalInflux.Config
+}
+
+// RegisterCluster Add the cluster to registry or refresh its name and update time if it is registered
+func (cluster *Cluster) RegisterCluster(datahubCluster *DatahubCluster.Cluster) (*DatahubCluster.Cluster, error) {
+	clusterRepository := RepoInfluxClusterStatus.NewClusterRepository(&cluster.InfluxDBConfig)
+
+	now := time.Now().Unix()
+	entity := &EntityInfluxClusterStatus.ClusterEntity{
+		ID:         datahubCluster.GetId(),
+		Name:       datahubCluster.GetName(),
+		CreateTime: now,
+		UpdateTime: now,
+	}
+	entities, err := clusterRepository.ListClusters([]string{entity.ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "register cluster failed")
+	}
+	if len(entities) > 0 && entities[0].CreateTime != 0 {
+		entity.CreateTime = entities[0].CreateTime
+	}
+
+	if err := clusterRepository.AddClusters([]*EntityInfluxClusterStatus.ClusterEntity{entity}); err != nil {
+		return nil, errors.Wrap(err, "register cluster failed")
+	}
+	return entity.BuildDatahubCluster(), nil
+}
+
+// ListClusters List registered clusters of the ids, all clusters are listed if ids is empty
+func (cluster *Cluster) ListClusters(ids []string) ([]*DatahubCluster.Cluster, error) {
+	clusters := []*DatahubCluster.Cluster{}
+	clusterRepository := RepoInfluxClusterStatus.NewClusterRepository(&cluster.InfluxDBConfig)
+	entities, err := clusterRepository.ListClusters(ids)
+	if err != nil {
+		return clusters, errors.Wrap(err, "list clusters failed")
+	}
+	for _, entity := range entities {
+		clusters = append(clusters, entity.BuildDatahubCluster())
+	}
+	return clusters, nil
+}
+
+// DeregisterCluster Remove the cluster from registry, data of the cluster is kept
+func (cluster *Cluster) DeregisterCluster(id string) error {
+	clusterRepository := RepoInfluxClusterStatus.NewClusterRepository(&cluster.InfluxDBConfig)
+	return clusterRepository.RemoveCluster(id)
+}
@@ -14,19 +14,21 @@ var (
 // Implement ContainerOperation interface
 type Container struct {
 	InfluxDBConfig InternalInflux.Config
+	// ClusterID is the cluster data is scoped to, data of all clusters is accessed if it is empty
+	ClusterID string
 }
 
 func (container *Container) AddPods(pods []*datahub_v1alpha1.Pod) error {
-	containerRepository := RepoInfluxClusterStatus.NewContainerRepository(&container.InfluxDBConfig)
+	containerRepository := RepoInfluxClusterStatus.NewContainerRepository(&container.InfluxDBConfig, container.ClusterID)
 	return containerRepository.CreateContainers(pods)
 }
 
 func (container *Container) DeletePods(pods []*datahub_v1alpha1.Pod) error {
-	containerRepository := RepoInfluxClusterStatus.NewContainerRepository(&container.InfluxDBConfig)
+	containerRepository := RepoInfluxClusterStatus.NewContainerRepository(&container.InfluxDBConfig, container.ClusterID)
 	return containerRepository.DeleteContainers(pods)
 }
 
 func (container *Container) ListAlamedaPods(ns, name string, kind datahub_v1alpha1.Kind, timeRange *datahub_v1alpha1.TimeRange) ([]*datahub_v1alpha1.Pod, error) {
-	containerRepository := RepoInfluxClusterStatus.NewContainerRepository(&container.InfluxDBConfig)
+	containerRepository := RepoInfluxClusterStatus.NewContainerRepository(&container.InfluxDBConfig, container.ClusterID)
 	return containerRepository.ListAlamedaContainers(ns, name, kind, timeRange)
 }
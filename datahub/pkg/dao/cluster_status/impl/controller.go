@@ -8,19 +8,21 @@ import (
 
 type Controller struct {
 	InfluxDBConfig InternalInflux.Config
+	// ClusterID is the cluster data is scoped to, data of all clusters is accessed if it is empty
+	ClusterID string
 }
 
 func (c *Controller) CreateControllers(controllers []*datahub_v1alpha1.Controller) error {
-	controllerRepository := RepoInfluxClusterStatus.NewControllerRepository(&c.InfluxDBConfig)
+	controllerRepository := RepoInfluxClusterStatus.NewControllerRepository(&c.InfluxDBConfig, c.ClusterID)
 	return controllerRepository.CreateControllers(controllers)
 }
 
 func (c *Controller) ListControllers(in *datahub_v1alpha1.ListControllersRequest) ([]*datahub_v1alpha1.Controller, error) {
-	controllerRepository := RepoInfluxClusterStatus.NewControllerRepository(&c.InfluxDBConfig)
+	controllerRepository := RepoInfluxClusterStatus.NewControllerRepository(&c.InfluxDBConfig, c.ClusterID)
 	return controllerRepository.ListControllers(in)
 }
 
 func (c *Controller) DeleteControllers(in *datahub_v1alpha1.DeleteControllersRequest) error {
-	controllerRepository := RepoInfluxClusterStatus.NewControllerRepository(&c.InfluxDBConfig)
+	controllerRepository := RepoInfluxClusterStatus.NewControllerRepository(&c.InfluxDBConfig, c.ClusterID)
 	return controllerRepository.DeleteControllers(in)
 }
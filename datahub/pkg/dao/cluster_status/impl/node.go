@@ -11,21 +11,23 @@ import (
 // Implement Node interface
 type Node struct {
 	InfluxDBConfig InternalInflux.Config
+	// ClusterID is the cluster data is scoped to, data of all clusters is accessed if it is empty
+	ClusterID string
 }
 
 func (node *Node) RegisterAlamedaNodes(alamedaNodes []*datahub_v1alpha1.Node) error {
-	nodeRepository := RepoInfluxClusterStatus.NewNodeRepository(&node.InfluxDBConfig)
+	nodeRepository := RepoInfluxClusterStatus.NewNodeRepository(&node.InfluxDBConfig, node.ClusterID)
 	return nodeRepository.AddAlamedaNodes(alamedaNodes)
 }
 
 func (node *Node) DeregisterAlamedaNodes(alamedaNodes []*datahub_v1alpha1.Node) error {
-	nodeRepository := RepoInfluxClusterStatus.NewNodeRepository(&node.InfluxDBConfig)
+	nodeRepository := RepoInfluxClusterStatus.NewNodeRepository(&node.InfluxDBConfig, node.ClusterID)
 	return nodeRepository.RemoveAlamedaNodes(alamedaNodes)
 }
 
 func (node *Node) ListAlamedaNodes(timeRange *datahub_v1alpha1.TimeRange) ([]*datahub_v1alpha1.Node, error) {
 	alamedaNodes := []*datahub_v1alpha1.Node{}
-	nodeRepository := RepoInfluxClusterStatus.NewNodeRepository(&node.InfluxDBConfig)
+	nodeRepository := RepoInfluxClusterStatus.NewNodeRepository(&node.InfluxDBConfig, node.ClusterID)
 	entities, err := nodeRepository.ListAlamedaNodes(timeRange)
 	if err != nil {
 		return alamedaNodes, errors.Wrap(err, "list alameda nodes failed")
@@ -38,7 +40,7 @@ func (node *Node) ListAlamedaNodes(timeRange *datahub_v1alpha1.TimeRange) ([]*da
 
 func (node *Node) ListNodes(request DaoClusterStatus.ListNodesRequest) ([]*datahub_v1alpha1.Node, error) {
 	nodes := []*datahub_v1alpha1.Node{}
-	nodeRepository := RepoInfluxClusterStatus.NewNodeRepository(&node.InfluxDBConfig)
+	nodeRepository := RepoInfluxClusterStatus.NewNodeRepository(&node.InfluxDBConfig, node.ClusterID)
 	entities, err := nodeRepository.ListNodes(request)
 	if err != nil {
 		return nodes, errors.Wrap(err, "list nodes failed")
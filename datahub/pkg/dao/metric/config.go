@@ -0,0 +1,75 @@
+package metric
+
+import (
+	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	InternalPromth "github.com/containers-ai/alameda/internal/pkg/database/prometheus"
+	"github.com/pkg/errors"
+)
+
+// Source Type alias
+type Source = string
+
+const (
+	// SourcePrometheus Metrics are read from prometheus recording rules
+	SourcePrometheus Source = "prometheus"
+	// SourceInfluxDB Metrics are read from measurements written by telegraf's kubernetes input plugin
+	SourceInfluxDB Source = "influxdb"
+	// SourceFake Metrics are served from memory, used by tests
+	SourceFake Source = "fake"
+)
+
+const (
+	defaultSource           = SourcePrometheus
+	defaultInfluxDBDatabase = "telegraf"
+)
+
+// Config Configuration of the metrics backend
+type Config struct {
+	Source     Source                 `mapstructure:"source"`
+	Prometheus *InternalPromth.Config `mapstructure:"-"`
+	InfluxDB   *InfluxDBConfig        `mapstructure:"influxdb"`
+}
+
+// InfluxDBConfig Configuration of the InfluxDB metrics backend
+type InfluxDBConfig struct {
+	InternalInflux.Config `mapstructure:",squash"`
+	Database              string `mapstructure:"database"`
+}
+
+// NewDefaultConfig Provide default configuration for metrics backend
+func NewDefaultConfig() *Config {
+	var config = Config{
+		Source:     defaultSource,
+		Prometheus: InternalPromth.NewDefaultConfig(),
+		InfluxDB: &InfluxDBConfig{
+			Config:   *InternalInflux.NewDefaultConfig(),
+			Database: defaultInfluxDBDatabase,
+		},
+	}
+	return &config
+}
+
+// Validate Confirm the metrics backend configuration is validated
+func (c *Config) Validate() error {
+	if !isRegistered(c.Source) {
+		return errors.Errorf("metrics source \"%s\" is not registered", c.Source)
+	}
+
+	switch c.Source {
+	case SourcePrometheus:
+		if c.Prometheus == nil {
+			return errors.New("prometheus configuration is required")
+		}
+		return c.Prometheus.Validate()
+	case SourceInfluxDB:
+		if c.InfluxDB == nil {
+			return errors.New("influxdb configuration is required")
+		}
+		if c.InfluxDB.Database == "" {
+			return errors.New("influxdb database is required")
+		}
+		return c.InfluxDB.Validate()
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package fake
+
+import (
+	"sync"
+	"time"
+
+	"github.com/containers-ai/alameda/datahub/pkg/dao/metric"
+	Metric "github.com/containers-ai/alameda/datahub/pkg/metric"
+)
+
+var (
+	defaultDAO = New()
+)
+
+func init() {
+	metric.RegisterFactory(metric.SourceFake, func(config metric.Config) (metric.MetricsDAO, error) {
+		return defaultDAO, nil
+	})
+}
+
+// DAO In-process implementation of MetricsDAO serving metrics added by callers
+type DAO struct {
+	lock             sync.RWMutex
+	containerMetrics []metric.ContainerMetric
+	nodeMetrics      []metric.NodeMetric
+}
+
+// New Constructor of an empty fake metric dao
+func New() *DAO {
+	return &DAO{}
+}
+
+// Default Return the fake metric dao served for source "fake"
+func Default() *DAO {
+	return defaultDAO
+}
+
+// AddContainerMetrics Add container metrics served by ListPodMetrics
+func (d *DAO) AddContainerMetrics(containerMetrics ...metric.ContainerMetric) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.containerMetrics = append(d.containerMetrics, containerMetrics...)
+}
+
+// AddNodeMetrics Add node metrics served by ListNodesMetric
+func (d *DAO) AddNodeMetrics(nodeMetrics ...metric.NodeMetric) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.nodeMetrics = append(d.nodeMetrics, nodeMetrics...)
+}
+
+// Reset Remove all metrics
+func (d *DAO) Reset() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.containerMetrics = nil
+	d.nodeMetrics = nil
+}
+
+// ListPodMetrics Method implementation of MetricsDAO
+func (d *DAO) ListPodMetrics(req metric.ListPodMetricsRequest) (metric.PodsMetricMap, error) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	var (
+		podsMetricMap    = metric.PodsMetricMap{}
+		ptrPodsMetricMap = &podsMetricMap
+	)
+
+	for _, containerMetric := range d.containerMetrics {
+		if req.Namespace != "" && req.Namespace != containerMetric.Namespace {
+			continue
+		}
+		if req.PodName != "" && req.PodName != containerMetric.PodName {
+			continue
+		}
+
+		copied := metric.ContainerMetric{
+			Namespace:     containerMetric.Namespace,
+			PodName:       containerMetric.PodName,
+			ContainerName: containerMetric.ContainerName,
+			Metrics:       map[Metric.ContainerMetricType][]Metric.Sample{},
+		}
+		for metricType, samples := range containerMetric.Metrics {
+			copied.Metrics[metricType] = filterSamples(samples, req.StartTime, req.EndTime)
+		}
+		ptrPodsMetricMap.AddContainerMetric(&copied)
+	}
+
+	ptrPodsMetricMap.SortByTimestamp(req.QueryCondition.TimestampOrder)
+	ptrPodsMetricMap.Limit(req.QueryCondition.Limit)
+
+	return *ptrPodsMetricMap, nil
+}
+
+// ListNodesMetric Method implementation of MetricsDAO
+func (d *DAO) ListNodesMetric(req metric.ListNodeMetricsRequest) (metric.NodesMetricMap, error) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	var (
+		nodesMetricMap    = metric.NodesMetricMap{}
+		ptrNodesMetricMap = &nodesMetricMap
+		nodeNames         = map[string]bool{}
+	)
+
+	for _, nodeName := range req.GetNodeNames() {
+		nodeNames[nodeName] = true
+	}
+
+	for _, nodeMetric := range d.nodeMetrics {
+		if len(nodeNames) > 0 && !nodeNames[nodeMetric.NodeName] {
+			continue
+		}
+
+		copied := metric.NodeMetric{
+			NodeName: nodeMetric.NodeName,
+			Metrics:  map[Metric.NodeMetricType][]Metric.Sample{},
+		}
+		for metricType, samples := range nodeMetric.Metrics {
+			copied.Metrics[metricType] = filterSamples(samples, req.StartTime, req.EndTime)
+		}
+		ptrNodesMetricMap.AddNodeMetric(&copied)
+	}
+
+	ptrNodesMetricMap.SortByTimestamp(req.QueryCondition.TimestampOrder)
+	ptrNodesMetricMap.Limit(req.QueryCondition.Limit)
+
+	return *ptrNodesMetricMap, nil
+}
+
+func filterSamples(samples []Metric.Sample, startTime, endTime *time.Time) []Metric.Sample {
+	filtered := make([]Metric.Sample, 0, len(samples))
+	for _, sample := range samples {
+		if startTime != nil && sample.Timestamp.Before(*startTime) {
+			continue
+		}
+		if endTime != nil && sample.Timestamp.After(*endTime) {
+			continue
+		}
+		filtered = append(filtered, sample)
+	}
+	return filtered
+}
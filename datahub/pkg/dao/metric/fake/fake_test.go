@@ -0,0 +1,148 @@
+package fake
+
+import (
+	"testing"
+	"time"
+
+	"github.com/containers-ai/alameda/datahub/pkg/dao/metric"
+	Metric "github.com/containers-ai/alameda/datahub/pkg/metric"
+	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
+)
+
+func TestNewWithConfig(t *testing.T) {
+	config := metric.NewDefaultConfig()
+	config.Source = metric.SourceFake
+	if err := config.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	metricsDAO, err := metric.NewWithConfig(*config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metricsDAO != Default() {
+		t.Errorf("expect fake source to serve the default fake dao")
+	}
+
+	config.Source = "unknown"
+	if _, err := metric.NewWithConfig(*config); err == nil {
+		t.Errorf("expect error for unregistered source")
+	}
+}
+
+func TestListPodMetrics(t *testing.T) {
+	now := time.Now()
+	dao := New()
+	dao.AddContainerMetrics(
+		metric.ContainerMetric{
+			Namespace:     "ns1",
+			PodName:       "pod1",
+			ContainerName: "c1",
+			Metrics: map[Metric.ContainerMetricType][]Metric.Sample{
+				Metric.TypeContainerCPUUsageSecondsPercentage: {
+					{Timestamp: now.Add(-2 * time.Minute), Value: "1"},
+					{Timestamp: now.Add(-1 * time.Minute), Value: "2"},
+				},
+			},
+		},
+		metric.ContainerMetric{
+			Namespace:     "ns1",
+			PodName:       "pod1",
+			ContainerName: "c2",
+			Metrics: map[Metric.ContainerMetricType][]Metric.Sample{
+				Metric.TypeContainerMemoryUsageBytes: {
+					{Timestamp: now.Add(-1 * time.Minute), Value: "1024"},
+				},
+			},
+		},
+		metric.ContainerMetric{
+			Namespace:     "ns2",
+			PodName:       "pod2",
+			ContainerName: "c1",
+			Metrics: map[Metric.ContainerMetricType][]Metric.Sample{
+				Metric.TypeContainerMemoryUsageBytes: {
+					{Timestamp: now, Value: "2048"},
+				},
+			},
+		},
+	)
+
+	startTime := now.Add(-90 * time.Second)
+	podsMetricMap, err := dao.ListPodMetrics(metric.ListPodMetricsRequest{
+		Namespace: "ns1",
+		PodName:   "pod1",
+		QueryCondition: DBCommon.QueryCondition{
+			StartTime:      &startTime,
+			TimestampOrder: DBCommon.Desc,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(podsMetricMap) != 1 {
+		t.Fatalf("expect 1 pod, got %d", len(podsMetricMap))
+	}
+	podMetric, exist := podsMetricMap["ns1/pod1"]
+	if !exist {
+		t.Fatalf("expect pod ns1/pod1 in result")
+	}
+	if len(*podMetric.ContainersMetricMap) != 2 {
+		t.Fatalf("expect 2 containers, got %d", len(*podMetric.ContainersMetricMap))
+	}
+	samples := (*podMetric.ContainersMetricMap)["ns1/pod1/c1"].Metrics[Metric.TypeContainerCPUUsageSecondsPercentage]
+	if len(samples) != 1 || samples[0].Value != "2" {
+		t.Errorf("expect samples filtered by start time, got %v", samples)
+	}
+}
+
+func TestListNodesMetric(t *testing.T) {
+	now := time.Now()
+	dao := New()
+	dao.AddNodeMetrics(
+		metric.NodeMetric{
+			NodeName: "node1",
+			Metrics: map[Metric.NodeMetricType][]Metric.Sample{
+				Metric.TypeNodeCPUUsageSecondsPercentage: {
+					{Timestamp: now.Add(-1 * time.Minute), Value: "100"},
+					{Timestamp: now, Value: "200"},
+				},
+			},
+		},
+		metric.NodeMetric{
+			NodeName: "node2",
+			Metrics: map[Metric.NodeMetricType][]Metric.Sample{
+				Metric.TypeNodeMemoryUsageBytes: {
+					{Timestamp: now, Value: "4096"},
+				},
+			},
+		},
+	)
+
+	nodesMetricMap, err := dao.ListNodesMetric(metric.ListNodeMetricsRequest{
+		QueryCondition: DBCommon.QueryCondition{
+			TimestampOrder: DBCommon.Desc,
+			Limit:          1,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodesMetricMap) != 2 {
+		t.Fatalf("expect 2 nodes, got %d", len(nodesMetricMap))
+	}
+	samples := nodesMetricMap["node1"].Metrics[Metric.TypeNodeCPUUsageSecondsPercentage]
+	if len(samples) != 1 || samples[0].Value != "200" {
+		t.Errorf("expect latest sample only, got %v", samples)
+	}
+
+	nodesMetricMap, err = dao.ListNodesMetric(metric.ListNodeMetricsRequest{
+		NodeNames: []string{"node2"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, exist := nodesMetricMap["node2"]; !exist || len(nodesMetricMap) != 1 {
+		t.Errorf("expect only node2, got %v", nodesMetricMap)
+	}
+}
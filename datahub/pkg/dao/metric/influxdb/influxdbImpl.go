@@ -0,0 +1,99 @@
+package influxdb
+
+import (
+	"github.com/containers-ai/alameda/datahub/pkg/dao/metric"
+	RepoInfluxMetric "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb/metric"
+	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
+	"github.com/containers-ai/alameda/pkg/utils/log"
+	"github.com/pkg/errors"
+)
+
+var (
+	scope = log.RegisterScope("metric_dao_implement", "metric dao implement", 0)
+)
+
+func init() {
+	metric.RegisterFactory(metric.SourceInfluxDB, func(config metric.Config) (metric.MetricsDAO, error) {
+		if config.InfluxDB == nil {
+			return nil, errors.New("influxdb configuration is required")
+		}
+		return NewWithConfig(*config.InfluxDB), nil
+	})
+}
+
+type influxdbMetricDAOImpl struct {
+	influxDBConfig metric.InfluxDBConfig
+}
+
+// NewWithConfig Constructor of influxdb metric dao
+func NewWithConfig(config metric.InfluxDBConfig) metric.MetricsDAO {
+	return &influxdbMetricDAOImpl{influxDBConfig: config}
+}
+
+// ListPodMetrics Method implementation of MetricsDAO
+func (i *influxdbMetricDAOImpl) ListPodMetrics(req metric.ListPodMetricsRequest) (metric.PodsMetricMap, error) {
+
+	scope.Debugf("influxdb-metric-dao-ListPodMetrics input %v", req)
+	var (
+		podsMetricMap    = metric.PodsMetricMap{}
+		ptrPodsMetricMap = &podsMetricMap
+	)
+
+	options := []DBCommon.Option{
+		DBCommon.StartTime(req.StartTime),
+		DBCommon.EndTime(req.EndTime),
+		DBCommon.StepTime(req.StepTime),
+		DBCommon.AggregateOverTimeFunc(req.AggregateOverTimeFunction),
+		DBCommon.ClusterID(req.ClusterID),
+	}
+
+	containerRepo := RepoInfluxMetric.NewContainerRepositoryWithConfig(i.influxDBConfig.Config, i.influxDBConfig.Database)
+	entities, err := containerRepo.ListMetricsByPodNamespacedName(req.Namespace, req.PodName, options...)
+	if err != nil {
+		return podsMetricMap, errors.Wrap(err, "list pod metrics failed")
+	}
+
+	for _, entity := range entities {
+		containerMetric := entity.ContainerMetric()
+		ptrPodsMetricMap.AddContainerMetric(&containerMetric)
+	}
+
+	ptrPodsMetricMap.SortByTimestamp(req.QueryCondition.TimestampOrder)
+	ptrPodsMetricMap.Limit(req.QueryCondition.Limit)
+
+	return *ptrPodsMetricMap, nil
+}
+
+// ListNodesMetric Method implementation of MetricsDAO
+func (i *influxdbMetricDAOImpl) ListNodesMetric(req metric.ListNodeMetricsRequest) (metric.NodesMetricMap, error) {
+
+	scope.Debugf("influxdb-metric-dao-ListNodesMetric input %v", req)
+	var (
+		nodesMetricMap    = metric.NodesMetricMap{}
+		ptrNodesMetricMap = &nodesMetricMap
+	)
+
+	options := []DBCommon.Option{
+		DBCommon.StartTime(req.StartTime),
+		DBCommon.EndTime(req.EndTime),
+		DBCommon.StepTime(req.StepTime),
+		DBCommon.AggregateOverTimeFunc(req.AggregateOverTimeFunction),
+		DBCommon.ClusterID(req.ClusterID),
+	}
+
+	nodeRepo := RepoInfluxMetric.NewNodeRepositoryWithConfig(i.influxDBConfig.Config, i.influxDBConfig.Database)
+	entities, err := nodeRepo.ListMetricsByNodeNames(req.GetNodeNames(), options...)
+	if err != nil {
+		return nodesMetricMap, errors.Wrap(err, "list nodes metrics failed")
+	}
+
+	for _, entity := range entities {
+		nodeMetric := entity.NodeMetric()
+		ptrNodesMetricMap.AddNodeMetric(&nodeMetric)
+	}
+
+	ptrNodesMetricMap.SortByTimestamp(req.QueryCondition.TimestampOrder)
+	ptrNodesMetricMap.Limit(req.QueryCondition.Limit)
+
+	return *ptrNodesMetricMap, nil
+}
@@ -14,15 +14,19 @@ type MetricsDAO interface {
 	ListNodesMetric(ListNodeMetricsRequest) (NodesMetricMap, error)
 }
 
-// ListPodMetricsRequest Argument of method ListPodMetrics
+// ListPodMetricsRequest Argument of method ListPodMetrics. Metrics of all
+// clusters are listed if ClusterID is empty.
 type ListPodMetricsRequest struct {
+	ClusterID string
 	Namespace metadata.NamespaceName
 	PodName   metadata.PodName
 	DBCommon.QueryCondition
 }
 
-// ListNodeMetricsRequest Argument of method ListNodeMetrics
+// ListNodeMetricsRequest Argument of method ListNodeMetrics. Metrics of all
+// clusters are listed if ClusterID is empty.
 type ListNodeMetricsRequest struct {
+	ClusterID string
 	NodeNames []metadata.NodeName
 	DBCommon.QueryCondition
 }
@@ -83,7 +87,9 @@ func (c *ContainerMetric) Limit(limit int) {
 	}
 
 	for metricType, samples := range c.Metrics {
-		c.Metrics[metricType] = samples[:limit]
+		if limit < len(samples) {
+			c.Metrics[metricType] = samples[:limit]
+		}
 	}
 }
 
@@ -217,7 +223,9 @@ func (n *NodeMetric) Limit(limit int) {
 	}
 
 	for metricType, samples := range n.Metrics {
-		n.Metrics[metricType] = samples[:limit]
+		if limit < len(samples) {
+			n.Metrics[metricType] = samples[:limit]
+		}
 	}
 }
 
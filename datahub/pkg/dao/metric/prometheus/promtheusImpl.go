@@ -18,6 +18,17 @@ var (
 	scope = log.RegisterScope("metric_dao_implement", "metric dao implement", 0)
 )
 
+func init() {
+	metric.RegisterFactory(metric.SourcePrometheus, func(config metric.Config) (metric.MetricsDAO, error) {
+		if config.Prometheus == nil {
+			return nil, errors.New("prometheus configuration is required")
+		}
+		return NewWithConfig(*config.Prometheus), nil
+	})
+}
+
+// prometheusMetricDAOImpl Prometheus only holds metrics of the cluster it scrapes,
+// ClusterID of requests is ignored
 type prometheusMetricDAOImpl struct {
 	prometheusConfig InternalPromth.Config
 }
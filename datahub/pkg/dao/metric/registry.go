@@ -0,0 +1,57 @@
+package metric
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Factory Constructor of MetricsDAO for one metrics source
+type Factory func(config Config) (MetricsDAO, error)
+
+var (
+	factoriesLock = sync.RWMutex{}
+	factories     = map[Source]Factory{}
+)
+
+// RegisterFactory Register the constructor of MetricsDAO serving the source,
+// implementations call it from their init function.
+func RegisterFactory(source Source, factory Factory) {
+	factoriesLock.Lock()
+	defer factoriesLock.Unlock()
+
+	factories[source] = factory
+}
+
+// RegisteredSources Return sources with registered constructor
+func RegisteredSources() []Source {
+	factoriesLock.RLock()
+	defer factoriesLock.RUnlock()
+
+	sources := make([]Source, 0, len(factories))
+	for source := range factories {
+		sources = append(sources, source)
+	}
+	return sources
+}
+
+func isRegistered(source Source) bool {
+	factoriesLock.RLock()
+	defer factoriesLock.RUnlock()
+
+	_, exist := factories[source]
+	return exist
+}
+
+// NewWithConfig Build MetricsDAO of the source selected in configuration
+func NewWithConfig(config Config) (MetricsDAO, error) {
+	factoriesLock.RLock()
+	factory, exist := factories[config.Source]
+	factoriesLock.RUnlock()
+
+	if !exist {
+		return nil, errors.Errorf("metrics source \"%s\" is not registered", config.Source)
+	}
+
+	return factory(config)
+}
@@ -14,16 +14,18 @@ var (
 // Container Implements ContainerOperation interface
 type Container struct {
 	InfluxDBConfig InternalInflux.Config
+	// ClusterID is the cluster data is scoped to, data of all clusters is accessed if it is empty
+	ClusterID string
 }
 
 // AddPodPlannings add pod plannings to database
 func (container *Container) AddPodPlannings(in *DatahubV1alpha1.CreatePodPlanningsRequest) error {
-	containerRepository := RepoInfluxPlanning.NewContainerRepository(&container.InfluxDBConfig)
+	containerRepository := RepoInfluxPlanning.NewContainerRepository(&container.InfluxDBConfig, container.ClusterID)
 	return containerRepository.CreateContainerPlannings(in)
 }
 
 // ListPodPlannings list pod plannings
 func (container *Container) ListPodPlannings(in *DatahubV1alpha1.ListPodPlanningsRequest) ([]*DatahubV1alpha1.PodPlanning, error) {
-	containerRepository := RepoInfluxPlanning.NewContainerRepository(&container.InfluxDBConfig)
+	containerRepository := RepoInfluxPlanning.NewContainerRepository(&container.InfluxDBConfig, container.ClusterID)
 	return containerRepository.ListContainerPlannings(in)
 }
@@ -8,14 +8,16 @@ import (
 
 type Controller struct {
 	InfluxDBConfig InternalInflux.Config
+	// ClusterID is the cluster data is scoped to, data of all clusters is accessed if it is empty
+	ClusterID string
 }
 
 func (c *Controller) AddControllerPlannings(controllerPlannings []*DatahubV1alpha1.ControllerPlanning) error {
-	controllerRepository := RepoInfluxPlanning.NewControllerRepository(&c.InfluxDBConfig)
+	controllerRepository := RepoInfluxPlanning.NewControllerRepository(&c.InfluxDBConfig, c.ClusterID)
 	return controllerRepository.CreateControllerPlannings(controllerPlannings)
 }
 
 func (c *Controller) ListControllerPlannings(in *DatahubV1alpha1.ListControllerPlanningsRequest) ([]*DatahubV1alpha1.ControllerPlanning, error) {
-	controllerRepository := RepoInfluxPlanning.NewControllerRepository(&c.InfluxDBConfig)
+	controllerRepository := RepoInfluxPlanning.NewControllerRepository(&c.InfluxDBConfig, c.ClusterID)
 	return controllerRepository.ListControllerPlannings(in)
 }
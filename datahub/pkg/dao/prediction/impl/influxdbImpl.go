@@ -4,6 +4,7 @@ import (
 	"github.com/containers-ai/alameda/datahub/pkg/dao/prediction"
 	RepoInfluxPrediction "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb/prediction"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	DatahubAccuracy "github.com/containers-ai/alameda/pkg/framework/datahub/accuracy"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/pkg/errors"
@@ -11,12 +12,15 @@ import (
 
 type influxDB struct {
 	influxDBConfig InternalInflux.Config
+	clusterID      string
 }
 
-// NewInfluxDBWithConfig Constructor of influxdb prediction dao
-func NewInfluxDBWithConfig(config InternalInflux.Config) prediction.DAO {
+// NewInfluxDBWithConfig Constructor of influxdb prediction dao scoped to the cluster,
+// predictions of all clusters are accessed if clusterID is empty
+func NewInfluxDBWithConfig(config InternalInflux.Config, clusterID string) prediction.DAO {
 	return influxDB{
 		influxDBConfig: config,
+		clusterID:      clusterID,
 	}
 }
 
@@ -27,7 +31,7 @@ func (i influxDB) CreateContainerPredictions(in *datahub_v1alpha1.CreatePodPredi
 		predictionRepo *RepoInfluxPrediction.ContainerRepository
 	)
 
-	predictionRepo = RepoInfluxPrediction.NewContainerRepositoryWithConfig(i.influxDBConfig)
+	predictionRepo = RepoInfluxPrediction.NewContainerRepositoryWithConfig(i.influxDBConfig, i.clusterID)
 
 	err = predictionRepo.CreateContainerPrediction(in)
 	if err != nil {
@@ -39,7 +43,7 @@ func (i influxDB) CreateContainerPredictions(in *datahub_v1alpha1.CreatePodPredi
 
 // ListPodPredictions Implementation of prediction dao interface
 func (i influxDB) ListPodPredictions(request prediction.ListPodPredictionsRequest) ([]*datahub_v1alpha1.PodPrediction, error) {
-	predictionRepo := RepoInfluxPrediction.NewContainerRepositoryWithConfig(i.influxDBConfig)
+	predictionRepo := RepoInfluxPrediction.NewContainerRepositoryWithConfig(i.influxDBConfig, i.clusterID)
 	return predictionRepo.ListContainerPredictionsByRequest(request)
 }
 
@@ -83,7 +87,7 @@ func (i influxDB) FillPodPredictions(predictions []*datahub_v1alpha1.PodPredicti
 
 // CreateNodePredictions Implementation of prediction dao interface
 func (i influxDB) CreateNodePredictions(in *datahub_v1alpha1.CreateNodePredictionsRequest) error {
-	predictionRepo := RepoInfluxPrediction.NewNodeRepositoryWithConfig(i.influxDBConfig)
+	predictionRepo := RepoInfluxPrediction.NewNodeRepositoryWithConfig(i.influxDBConfig, i.clusterID)
 
 	err := predictionRepo.CreateNodePrediction(in)
 	if err != nil {
@@ -95,6 +99,24 @@ func (i influxDB) CreateNodePredictions(in *datahub_v1alpha1.CreateNodePredictio
 
 // ListNodePredictions Implementation of prediction dao interface
 func (i influxDB) ListNodePredictions(request prediction.ListNodePredictionsRequest) ([]*datahub_v1alpha1.NodePrediction, error) {
-	predictionRepo := RepoInfluxPrediction.NewNodeRepositoryWithConfig(i.influxDBConfig)
+	predictionRepo := RepoInfluxPrediction.NewNodeRepositoryWithConfig(i.influxDBConfig, i.clusterID)
 	return predictionRepo.ListNodePredictionsByRequest(request)
 }
+
+// CreatePredictionAccuracies Implementation of prediction dao interface
+func (i influxDB) CreatePredictionAccuracies(accuracies []*DatahubAccuracy.PredictionAccuracy) error {
+	accuracyRepo := RepoInfluxPrediction.NewAccuracyRepositoryWithConfig(i.influxDBConfig, i.clusterID)
+
+	err := accuracyRepo.CreatePredictionAccuracies(accuracies)
+	if err != nil {
+		return errors.Wrap(err, "create prediction accuracies failed")
+	}
+
+	return nil
+}
+
+// ListPredictionAccuracies Implementation of prediction dao interface
+func (i influxDB) ListPredictionAccuracies(in *DatahubAccuracy.ListPredictionAccuraciesRequest) ([]*DatahubAccuracy.PredictionAccuracy, error) {
+	accuracyRepo := RepoInfluxPrediction.NewAccuracyRepositoryWithConfig(i.influxDBConfig, i.clusterID)
+	return accuracyRepo.ListPredictionAccuracies(in)
+}
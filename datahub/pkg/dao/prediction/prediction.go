@@ -5,6 +5,7 @@ import (
 	"github.com/containers-ai/alameda/datahub/pkg/kubernetes/metadata"
 	"github.com/containers-ai/alameda/datahub/pkg/metric"
 	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
+	DatahubAccuracy "github.com/containers-ai/alameda/pkg/framework/datahub/accuracy"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 )
 
@@ -19,6 +20,9 @@ type DAO interface {
 
 	ListNodePredictions(ListNodePredictionsRequest) ([]*datahub_v1alpha1.NodePrediction, error)
 	CreateNodePredictions(in *datahub_v1alpha1.CreateNodePredictionsRequest) error
+
+	ListPredictionAccuracies(in *DatahubAccuracy.ListPredictionAccuraciesRequest) ([]*DatahubAccuracy.PredictionAccuracy, error)
+	CreatePredictionAccuracies(accuracies []*DatahubAccuracy.PredictionAccuracy) error
 }
 
 // ListPodPredictionsRequest ListPodPredictionsRequest
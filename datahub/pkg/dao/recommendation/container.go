@@ -1,6 +1,8 @@
 package recommendation
 
 import (
+	"time"
+
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 )
 
@@ -9,4 +11,6 @@ type ContainerOperation interface {
 	AddPodRecommendations(in *datahub_v1alpha1.CreatePodRecommendationsRequest) error
 	ListPodRecommendations(in *datahub_v1alpha1.ListPodRecommendationsRequest) ([]*datahub_v1alpha1.PodRecommendation, error)
 	ListAvailablePodRecommendations(*datahub_v1alpha1.ListPodRecommendationsRequest) ([]*datahub_v1alpha1.PodRecommendation, error)
+	ListAvailablePodRecommendationsInBatches(in *datahub_v1alpha1.ListPodRecommendationsRequest, batchSize int, handle func([]*datahub_v1alpha1.PodRecommendation) error) error
+	GetPodRecommendationGeneration(podNamespacedName *datahub_v1alpha1.NamespacedName, granularity int64, until time.Time) (*datahub_v1alpha1.PodRecommendation, error)
 }
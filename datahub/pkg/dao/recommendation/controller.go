@@ -1,6 +1,8 @@
 package recommendation
 
 import (
+	"time"
+
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 )
 
@@ -8,4 +10,6 @@ import (
 type ControllerOperation interface {
 	AddControllerRecommendations([]*datahub_v1alpha1.ControllerRecommendation) error
 	ListControllerRecommendations(controllerNamespacedName *datahub_v1alpha1.NamespacedName, queryCondition *datahub_v1alpha1.QueryCondition) ([]*datahub_v1alpha1.ControllerRecommendation, error)
+	GetControllerRecommendationGeneration(controllerNamespacedName *datahub_v1alpha1.NamespacedName, recommendationType datahub_v1alpha1.ControllerRecommendedType, until time.Time) (*datahub_v1alpha1.ControllerRecommendation, error)
+	GetLatestControllerRecommendationOfKind(controllerNamespacedName *datahub_v1alpha1.NamespacedName, kind datahub_v1alpha1.Kind, recommendationType datahub_v1alpha1.ControllerRecommendedType, until time.Time) (*datahub_v1alpha1.ControllerRecommendation, error)
 }
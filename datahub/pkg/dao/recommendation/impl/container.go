@@ -5,6 +5,7 @@ import (
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"time"
 )
 
 var (
@@ -14,21 +15,35 @@ var (
 // Container Implements ContainerOperation interface
 type Container struct {
 	InfluxDBConfig InternalInflux.Config
+	// ClusterID is the cluster data is scoped to, data of all clusters is accessed if it is empty
+	ClusterID string
 }
 
 // AddPodRecommendations add pod recommendations to database
 func (container *Container) AddPodRecommendations(in *datahub_v1alpha1.CreatePodRecommendationsRequest) error {
-	containerRepository := RepoInfluxRecommendation.NewContainerRepository(&container.InfluxDBConfig)
+	containerRepository := RepoInfluxRecommendation.NewContainerRepository(&container.InfluxDBConfig, container.ClusterID)
 	return containerRepository.CreateContainerRecommendations(in)
 }
 
 // ListPodRecommendations list pod recommendations
 func (container *Container) ListPodRecommendations(in *datahub_v1alpha1.ListPodRecommendationsRequest) ([]*datahub_v1alpha1.PodRecommendation, error) {
-	containerRepository := RepoInfluxRecommendation.NewContainerRepository(&container.InfluxDBConfig)
+	containerRepository := RepoInfluxRecommendation.NewContainerRepository(&container.InfluxDBConfig, container.ClusterID)
 	return containerRepository.ListContainerRecommendations(in)
 }
 
 func (container *Container) ListAvailablePodRecommendations(in *datahub_v1alpha1.ListPodRecommendationsRequest) ([]*datahub_v1alpha1.PodRecommendation, error) {
-	containerRepository := RepoInfluxRecommendation.NewContainerRepository(&container.InfluxDBConfig)
+	containerRepository := RepoInfluxRecommendation.NewContainerRepository(&container.InfluxDBConfig, container.ClusterID)
 	return containerRepository.ListAvailablePodRecommendations(in)
 }
+
+// ListAvailablePodRecommendationsInBatches list available pod recommendations in batches of at most batchSize containers
+func (container *Container) ListAvailablePodRecommendationsInBatches(in *datahub_v1alpha1.ListPodRecommendationsRequest, batchSize int, handle func([]*datahub_v1alpha1.PodRecommendation) error) error {
+	containerRepository := RepoInfluxRecommendation.NewContainerRepository(&container.InfluxDBConfig, container.ClusterID)
+	return containerRepository.ListAvailablePodRecommendationsInBatches(in, batchSize, handle)
+}
+
+// GetPodRecommendationGeneration get the latest generation of pod recommendations starting at or before the time
+func (container *Container) GetPodRecommendationGeneration(podNamespacedName *datahub_v1alpha1.NamespacedName, granularity int64, until time.Time) (*datahub_v1alpha1.PodRecommendation, error) {
+	containerRepository := RepoInfluxRecommendation.NewContainerRepository(&container.InfluxDBConfig, container.ClusterID)
+	return containerRepository.GetPodRecommendationGeneration(podNamespacedName, granularity, until)
+}
@@ -4,18 +4,32 @@ import (
 	RepoInfluxRecommendation "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb/recommendation"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"time"
 )
 
 type Controller struct {
 	InfluxDBConfig InternalInflux.Config
+	// ClusterID is the cluster data is scoped to, data of all clusters is accessed if it is empty
+	ClusterID string
 }
 
 func (c *Controller) AddControllerRecommendations(controllerRecommendations []*datahub_v1alpha1.ControllerRecommendation) error {
-	controllerRepository := RepoInfluxRecommendation.NewControllerRepository(&c.InfluxDBConfig)
+	controllerRepository := RepoInfluxRecommendation.NewControllerRepository(&c.InfluxDBConfig, c.ClusterID)
 	return controllerRepository.CreateControllerRecommendations(controllerRecommendations)
 }
 
 func (c *Controller) ListControllerRecommendations(in *datahub_v1alpha1.ListControllerRecommendationsRequest) ([]*datahub_v1alpha1.ControllerRecommendation, error) {
-	controllerRepository := RepoInfluxRecommendation.NewControllerRepository(&c.InfluxDBConfig)
+	controllerRepository := RepoInfluxRecommendation.NewControllerRepository(&c.InfluxDBConfig, c.ClusterID)
 	return controllerRepository.ListControllerRecommendations(in)
 }
+
+func (c *Controller) GetControllerRecommendationGeneration(controllerNamespacedName *datahub_v1alpha1.NamespacedName, recommendationType datahub_v1alpha1.ControllerRecommendedType, until time.Time) (*datahub_v1alpha1.ControllerRecommendation, error) {
+	controllerRepository := RepoInfluxRecommendation.NewControllerRepository(&c.InfluxDBConfig, c.ClusterID)
+	return controllerRepository.GetControllerRecommendationGeneration(controllerNamespacedName, recommendationType, until)
+}
+
+// GetLatestControllerRecommendationOfKind get the latest generation of recommendations of the controller of the kind
+func (c *Controller) GetLatestControllerRecommendationOfKind(controllerNamespacedName *datahub_v1alpha1.NamespacedName, kind datahub_v1alpha1.Kind, recommendationType datahub_v1alpha1.ControllerRecommendedType, until time.Time) (*datahub_v1alpha1.ControllerRecommendation, error) {
+	controllerRepository := RepoInfluxRecommendation.NewControllerRepository(&c.InfluxDBConfig, c.ClusterID)
+	return controllerRepository.GetLatestControllerRecommendationOfKind(controllerNamespacedName, kind, recommendationType, until)
+}
@@ -9,13 +9,16 @@ import (
 )
 
 type influxdbDAO struct {
-	config InternalInflux.Config
+	config    InternalInflux.Config
+	clusterID string
 }
 
-// NewWithConfig New influxdb score dao implement
-func NewWithConfig(config InternalInflux.Config) DaoScore.DAO {
+// NewWithConfig New influxdb score dao implement scoped to the cluster,
+// scores of all clusters are accessed if clusterID is empty
+func NewWithConfig(config InternalInflux.Config, clusterID string) DaoScore.DAO {
 	return influxdbDAO{
-		config: config,
+		config:    config,
+		clusterID: clusterID,
 	}
 }
 
@@ -30,7 +33,7 @@ func (dao influxdbDAO) ListSimulatedScheduingScores(request DaoScore.ListRequest
 		scores                = make([]*DaoScore.SimulatedSchedulingScore, 0)
 	)
 
-	scoreRepository = RepoInfluxScore.NewRepositoryWithConfig(dao.config)
+	scoreRepository = RepoInfluxScore.NewRepositoryWithConfig(dao.config, dao.clusterID)
 	influxdbScoreEntities, err = scoreRepository.ListScoresByRequest(request)
 	if err != nil {
 		return scores, errors.Wrap(err, "list simulated scheduing scores failed")
@@ -65,7 +68,7 @@ func (dao influxdbDAO) CreateSimulatedScheduingScores(scores []*DaoScore.Simulat
 		scoreRepository RepoInfluxScore.SimulatedSchedulingScoreRepository
 	)
 
-	scoreRepository = RepoInfluxScore.NewRepositoryWithConfig(dao.config)
+	scoreRepository = RepoInfluxScore.NewRepositoryWithConfig(dao.config, dao.clusterID)
 	err = scoreRepository.CreateScores(scores)
 	if err != nil {
 		return errors.Wrap(err, "create simulated scheduing scores failed")
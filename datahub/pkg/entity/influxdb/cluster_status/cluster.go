@@ -0,0 +1,78 @@
+package clusterstatus
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/containers-ai/alameda/datahub/pkg/utils"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+type clusterTag = string
+type clusterField = string
+
+const (
+	ClusterTime clusterTag = "time"
+	ClusterID   clusterTag = storage.ClusterIDTag // ClusterID is the id of cluster, the same tag scoping data of other measurements
+
+	ClusterName       clusterField = "name"
+	ClusterCreateTime clusterField = "create_time" // ClusterCreateTime is the time cluster is registered first
+	ClusterUpdateTime clusterField = "update_time" // ClusterUpdateTime is the time cluster is registered last
+)
+
+var (
+	// ClusterTags list tags of cluster measurement
+	ClusterTags = []clusterTag{ClusterTime, ClusterID}
+	// ClusterFields list fields of cluster measurement
+	ClusterFields = []clusterField{ClusterName, ClusterCreateTime, ClusterUpdateTime}
+)
+
+// ClusterEntity is entity in database
+type ClusterEntity struct {
+	Time       time.Time
+	ID         string
+	Name       string
+	CreateTime int64
+	UpdateTime int64
+}
+
+// NewClusterEntityFromMap Build entity from map
+func NewClusterEntityFromMap(data map[string]string) ClusterEntity {
+
+	tempTimestamp, _ := utils.ParseTime(data[ClusterTime])
+
+	entity := ClusterEntity{
+		Time: tempTimestamp,
+		ID:   data[ClusterID],
+		Name: data[ClusterName],
+	}
+	if createTime, err := strconv.ParseInt(data[ClusterCreateTime], 10, 64); err == nil {
+		entity.CreateTime = createTime
+	}
+	if updateTime, err := strconv.ParseInt(data[ClusterUpdateTime], 10, 64); err == nil {
+		entity.UpdateTime = updateTime
+	}
+
+	return entity
+}
+
+// Fields Return fields of the entity written to database
+func (e ClusterEntity) Fields() map[string]interface{} {
+	return map[string]interface{}{
+		ClusterName:       e.Name,
+		ClusterCreateTime: e.CreateTime,
+		ClusterUpdateTime: e.UpdateTime,
+	}
+}
+
+// BuildDatahubCluster Build datahub cluster from entity
+func (e ClusterEntity) BuildDatahubCluster() *DatahubCluster.Cluster {
+	return &DatahubCluster.Cluster{
+		Id:         e.ID,
+		Name:       e.Name,
+		CreateTime: &timestamp.Timestamp{Seconds: e.CreateTime},
+		UpdateTime: &timestamp.Timestamp{Seconds: e.UpdateTime},
+	}
+}
@@ -0,0 +1,111 @@
+package metric
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	DaoMetric "github.com/containers-ai/alameda/datahub/pkg/dao/metric"
+	"github.com/containers-ai/alameda/datahub/pkg/metric"
+	"github.com/containers-ai/alameda/datahub/pkg/utils"
+	"github.com/containers-ai/alameda/pkg/utils/log"
+)
+
+type Field = string
+type Tag = string
+
+const (
+	ContainerTime          Tag = "time"
+	ContainerNamespace     Tag = "namespace"
+	ContainerPodName       Tag = "pod_name"
+	ContainerContainerName Tag = "container_name"
+	ContainerNodeName      Tag = "node_name"
+
+	ContainerCPUUsageNanoCores Field = "cpu_usage_nanocores"
+	ContainerMemoryUsageBytes  Field = "memory_usage_bytes"
+)
+
+var (
+	scope = log.RegisterScope("influxdb_metric_entity", "influxdb metric entity", 0)
+
+	// ContainerTags Tags' name written by telegraf for measurement kubernetes_pod_container
+	ContainerTags = []Tag{ContainerNamespace, ContainerPodName, ContainerContainerName, ContainerNodeName}
+	// ContainerFields Fields' name used by datahub in measurement kubernetes_pod_container
+	ContainerFields = []Field{ContainerCPUUsageNanoCores, ContainerMemoryUsageBytes}
+)
+
+// ContainerEntity Container usage entity written by telegraf
+type ContainerEntity struct {
+	Timestamp time.Time
+
+	Namespace     string
+	PodName       string
+	ContainerName string
+
+	CPUUsageNanoCores *string
+	MemoryUsageBytes  *string
+}
+
+// NewContainerEntityFromMap Build entity from map
+func NewContainerEntityFromMap(data map[string]string) ContainerEntity {
+
+	// TODO: log error
+	tempTimestamp, _ := utils.ParseTime(data[ContainerTime])
+
+	entity := ContainerEntity{
+		Timestamp:     tempTimestamp,
+		Namespace:     data[ContainerNamespace],
+		PodName:       data[ContainerPodName],
+		ContainerName: data[ContainerContainerName],
+	}
+
+	if value, exist := data[ContainerCPUUsageNanoCores]; exist && value != "" {
+		entity.CPUUsageNanoCores = &value
+	}
+
+	if value, exist := data[ContainerMemoryUsageBytes]; exist && value != "" {
+		entity.MemoryUsageBytes = &value
+	}
+
+	return entity
+}
+
+// ContainerMetric Build ContainerMetric base on entity properties
+func (e ContainerEntity) ContainerMetric() DaoMetric.ContainerMetric {
+
+	var (
+		containerMetric DaoMetric.ContainerMetric
+	)
+
+	containerMetric = DaoMetric.ContainerMetric{
+		Namespace:     e.Namespace,
+		PodName:       e.PodName,
+		ContainerName: e.ContainerName,
+		Metrics:       map[metric.ContainerMetricType][]metric.Sample{},
+	}
+
+	if e.CPUUsageNanoCores != nil {
+		containerMetric.Metrics[metric.TypeContainerCPUUsageSecondsPercentage] = []metric.Sample{
+			{Timestamp: e.Timestamp, Value: nanoCoresToMilliCores(*e.CPUUsageNanoCores)},
+		}
+	}
+
+	if e.MemoryUsageBytes != nil {
+		containerMetric.Metrics[metric.TypeContainerMemoryUsageBytes] = []metric.Sample{
+			{Timestamp: e.Timestamp, Value: *e.MemoryUsageBytes},
+		}
+	}
+
+	return containerMetric
+}
+
+// nanoCoresToMilliCores Convert telegraf's nanocores to the millicores datahub serves from prometheus
+func nanoCoresToMilliCores(nanoCores string) string {
+	v := "0"
+	if s, err := strconv.ParseFloat(nanoCores, 64); err == nil {
+		v = fmt.Sprintf("%f", s/1000000)
+	} else {
+		scope.Errorf("influxdb metric entity: parse nanocores failed: %s", err.Error())
+	}
+	return v
+}
@@ -0,0 +1,83 @@
+package metric
+
+import (
+	"time"
+
+	DaoMetric "github.com/containers-ai/alameda/datahub/pkg/dao/metric"
+	"github.com/containers-ai/alameda/datahub/pkg/metric"
+	"github.com/containers-ai/alameda/datahub/pkg/utils"
+)
+
+const (
+	NodeTime     Tag = "time"
+	NodeNodeName Tag = "node_name"
+
+	NodeCPUUsageNanoCores Field = "cpu_usage_nanocores"
+	NodeMemoryUsageBytes  Field = "memory_usage_bytes"
+)
+
+var (
+	// NodeTags Tags' name written by telegraf for measurement kubernetes_node
+	NodeTags = []Tag{NodeNodeName}
+	// NodeFields Fields' name used by datahub in measurement kubernetes_node
+	NodeFields = []Field{NodeCPUUsageNanoCores, NodeMemoryUsageBytes}
+)
+
+// NodeEntity Node usage entity written by telegraf
+type NodeEntity struct {
+	Timestamp time.Time
+
+	NodeName string
+
+	CPUUsageNanoCores *string
+	MemoryUsageBytes  *string
+}
+
+// NewNodeEntityFromMap Build entity from map
+func NewNodeEntityFromMap(data map[string]string) NodeEntity {
+
+	// TODO: log error
+	tempTimestamp, _ := utils.ParseTime(data[NodeTime])
+
+	entity := NodeEntity{
+		Timestamp: tempTimestamp,
+		NodeName:  data[NodeNodeName],
+	}
+
+	if value, exist := data[NodeCPUUsageNanoCores]; exist && value != "" {
+		entity.CPUUsageNanoCores = &value
+	}
+
+	if value, exist := data[NodeMemoryUsageBytes]; exist && value != "" {
+		entity.MemoryUsageBytes = &value
+	}
+
+	return entity
+}
+
+// NodeMetric Build NodeMetric base on entity properties
+func (e NodeEntity) NodeMetric() DaoMetric.NodeMetric {
+
+	var (
+		nodeMetric DaoMetric.NodeMetric
+	)
+
+	nodeMetric = DaoMetric.NodeMetric{
+		NodeName: e.NodeName,
+		Metrics:  map[metric.NodeMetricType][]metric.Sample{},
+	}
+
+	if e.CPUUsageNanoCores != nil {
+		nodeMetric.Metrics[metric.TypeNodeCPUUsageSecondsPercentage] = []metric.Sample{
+			{Timestamp: e.Timestamp, Value: nanoCoresToMilliCores(*e.CPUUsageNanoCores)},
+		}
+	}
+
+	if e.MemoryUsageBytes != nil {
+		nodeMetric.Metrics[metric.TypeNodeMemoryUsageBytes] = []metric.Sample{
+			{Timestamp: e.Timestamp, Value: *e.MemoryUsageBytes},
+		}
+	}
+
+	return nodeMetric
+}
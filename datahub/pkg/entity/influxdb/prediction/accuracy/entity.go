@@ -0,0 +1,27 @@
+package accuracy
+
+type Field = string
+type Tag = string
+
+const (
+	Time          Tag = "time"
+	UnitType      Tag = "unit_type"
+	Namespace     Tag = "namespace"
+	PodName       Tag = "pod_name"
+	ContainerName Tag = "container_name"
+	NodeName      Tag = "node_name"
+	Metric        Tag = "metric"
+	Granularity   Tag = "granularity"
+
+	MAPE        Field = "mape"
+	RMSE        Field = "rmse"
+	SMAPE       Field = "smape"
+	SampleCount Field = "sample_count"
+)
+
+var (
+	// Tags Tags' name in influxdb
+	Tags = []Tag{UnitType, Namespace, PodName, ContainerName, NodeName, Metric, Granularity}
+	// Fields Fields' name in influxdb
+	Fields = []Field{MAPE, RMSE, SMAPE, SampleCount}
+)
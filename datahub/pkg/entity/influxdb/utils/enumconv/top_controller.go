@@ -1,6 +1,7 @@
 package enumconv
 
 import (
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 )
 
@@ -10,6 +11,10 @@ const (
 	DeploymentConfig string = "DeploymentConfig"
 	AlamedaScaler    string = "AlamedaScaler"
 	StatefulSet      string = "StatefulSet"
+	DaemonSet        string = "DaemonSet"
+	ReplicaSet       string = "ReplicaSet"
+	Job              string = "Job"
+	CronJob          string = "CronJob"
 )
 
 var KindEnum map[string]datahub_v1alpha1.Kind = map[string]datahub_v1alpha1.Kind{
@@ -18,6 +23,10 @@ var KindEnum map[string]datahub_v1alpha1.Kind = map[string]datahub_v1alpha1.Kind
 	DeploymentConfig: datahub_v1alpha1.Kind_DEPLOYMENTCONFIG,
 	AlamedaScaler:    datahub_v1alpha1.Kind_ALAMEDASCALER,
 	StatefulSet:      datahub_v1alpha1.Kind_STATEFULSET,
+	DaemonSet:        DatahubKind.Kind_DAEMONSET,
+	ReplicaSet:       DatahubKind.Kind_REPLICASET,
+	Job:              DatahubKind.Kind_JOB,
+	CronJob:          DatahubKind.Kind_CRONJOB,
 }
 
 var KindDisp map[datahub_v1alpha1.Kind]string = map[datahub_v1alpha1.Kind]string{
@@ -26,4 +35,8 @@ var KindDisp map[datahub_v1alpha1.Kind]string = map[datahub_v1alpha1.Kind]string
 	datahub_v1alpha1.Kind_DEPLOYMENTCONFIG: DeploymentConfig,
 	datahub_v1alpha1.Kind_ALAMEDASCALER:    AlamedaScaler,
 	datahub_v1alpha1.Kind_STATEFULSET:      StatefulSet,
+	DatahubKind.Kind_DAEMONSET:             DaemonSet,
+	DatahubKind.Kind_REPLICASET:            ReplicaSet,
+	DatahubKind.Kind_JOB:                   Job,
+	DatahubKind.Kind_CRONJOB:               CronJob,
 }
@@ -2,20 +2,37 @@ package probe
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	Auth "github.com/containers-ai/alameda/datahub/pkg/auth"
 	DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
 	"google.golang.org/genproto/googleapis/rpc/code"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type LivenessProbeConfig struct {
 	BindAddr string
+	TLS      *Auth.TLSConfig
 }
 
-func queryDatahub(bindAddr string) error {
-	conn, err := grpc.Dial(fmt.Sprintf("localhost%s", bindAddr), grpc.WithInsecure())
+func queryDatahub(bindAddr string, tlsConfig *Auth.TLSConfig) error {
+	dialOpt := grpc.WithInsecure()
+	if tlsConfig != nil && tlsConfig.Enabled {
+		// Probe the local server presenting its own certificate in case mTLS is required
+		cert, err := tls.LoadX509KeyPair(tlsConfig.CertFile, tlsConfig.KeyFile)
+		if err != nil {
+			return errors.Wrap(err, "load server certificate failed")
+		}
+		dialOpt = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			Certificates:       []tls.Certificate{cert},
+			InsecureSkipVerify: true,
+		}))
+	}
+
+	conn, err := grpc.Dial(fmt.Sprintf("localhost%s", bindAddr), dialOpt)
 	if err != nil {
 		return err
 	}
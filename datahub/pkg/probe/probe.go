@@ -1,6 +1,8 @@
 package probe
 
 import (
+	DaoMetric "github.com/containers-ai/alameda/datahub/pkg/dao/metric"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 	"os"
 )
@@ -9,7 +11,7 @@ var scope = log.RegisterScope("probe", "datahub health probe", 0)
 
 func LivenessProbe(cfg *LivenessProbeConfig) {
 	bindAddr := cfg.BindAddr
-	err := queryDatahub(bindAddr)
+	err := queryDatahub(bindAddr, cfg.TLS)
 	if err != nil {
 		scope.Errorf("Liveness probe failed with address (%s) due to %s", bindAddr, err.Error())
 		os.Exit(1)
@@ -22,16 +24,29 @@ func ReadinessProbe(cfg *ReadinessProbeConfig) {
 	prometheusCfg := cfg.PrometheusCfg
 	queueCfg := cfg.RabbitMQCfg
 
-	err := queryInfluxdb(influxdbCfg)
-	if err != nil {
-		scope.Errorf("Readiness probe: failed to ping influxdb with address (%s) due to %s", influxdbCfg.Address, err.Error())
-		os.Exit(1)
+	var err error
+	if cfg.StorageEngine == storage.EngineInfluxDB {
+		err = queryInfluxdb(influxdbCfg)
+		if err != nil {
+			scope.Errorf("Readiness probe: failed to ping influxdb with address (%s) due to %s", influxdbCfg.Address, err.Error())
+			os.Exit(1)
+		}
 	}
 
-	err = queryPrometheus(prometheusCfg)
-	if err != nil {
-		scope.Errorf("Readiness probe: failed to query prometheus with url (%s) due to %s", prometheusCfg.URL, err.Error())
-		os.Exit(1)
+	switch cfg.MetricSource {
+	case DaoMetric.SourceInfluxDB:
+		metricInfluxdbCfg := cfg.MetricInfluxdbCfg
+		err = queryInfluxdb(&metricInfluxdbCfg.Config)
+		if err != nil {
+			scope.Errorf("Readiness probe: failed to ping metric influxdb with address (%s) due to %s", metricInfluxdbCfg.Address, err.Error())
+			os.Exit(1)
+		}
+	default:
+		err = queryPrometheus(prometheusCfg)
+		if err != nil {
+			scope.Errorf("Readiness probe: failed to query prometheus with url (%s) due to %s", prometheusCfg.URL, err.Error())
+			os.Exit(1)
+		}
 	}
 
 	err = queryQueue(queueCfg)
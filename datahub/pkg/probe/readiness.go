@@ -2,10 +2,12 @@ package probe
 
 import (
 	"fmt"
+	DaoMetric "github.com/containers-ai/alameda/datahub/pkg/dao/metric"
 	RepoPromthMetric "github.com/containers-ai/alameda/datahub/pkg/repository/prometheus/metric"
 	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
 	InternalPromth "github.com/containers-ai/alameda/internal/pkg/database/prometheus"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
 	InternalRabbitMQ "github.com/containers-ai/alameda/internal/pkg/message-queue/rabbitmq"
 	"github.com/pkg/errors"
 	"github.com/streadway/amqp"
@@ -14,9 +16,12 @@ import (
 )
 
 type ReadinessProbeConfig struct {
-	InfluxdbCfg   *InternalInflux.Config
-	PrometheusCfg *InternalPromth.Config
-	RabbitMQCfg   *InternalRabbitMQ.Config
+	InfluxdbCfg       *InternalInflux.Config
+	StorageEngine     storage.EngineName
+	PrometheusCfg     *InternalPromth.Config
+	MetricSource      DaoMetric.Source
+	MetricInfluxdbCfg *DaoMetric.InfluxDBConfig
+	RabbitMQCfg       *InternalRabbitMQ.Config
 }
 
 func queryInfluxdb(influxdbConfig *InternalInflux.Config) error {
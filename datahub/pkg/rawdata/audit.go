@@ -0,0 +1,34 @@
+package rawdata
+
+import (
+	"strings"
+
+	Auth "github.com/containers-ai/alameda/datahub/pkg/auth"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+	Log "github.com/containers-ai/alameda/pkg/utils/log"
+	"golang.org/x/net/context"
+)
+
+var (
+	auditScope = Log.RegisterScope("rawdata-audit", "audit log of raw writes to datahub databases", 0)
+)
+
+// AuditWrite Log the raw write of rows to the measurement of the database with
+// the caller, the cluster and the result of the write
+func AuditWrite(ctx context.Context, database, measurement string, rows int, err error) {
+	user := "anonymous"
+	if userInfo := Auth.UserFromContext(ctx); userInfo != nil {
+		user = userInfo.Username
+		if len(userInfo.Groups) > 0 {
+			user += " (" + strings.Join(userInfo.Groups, ",") + ")"
+		}
+	}
+
+	result := "succeeded"
+	if err != nil {
+		result = "failed: " + err.Error()
+	}
+
+	auditScope.Infof("raw write user=%q cluster=%q database=%q measurement=%q rows=%d result=%q",
+		user, DatahubCluster.FromContext(ctx), database, measurement, rows, result)
+}
@@ -0,0 +1,109 @@
+package rawdata
+
+import (
+	RepoInflux "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb"
+	RepoInfluxClusterStatus "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb/cluster_status"
+	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/pkg/errors"
+)
+
+const (
+	// AnyMeasurement matches all measurements of a database
+	AnyMeasurement = "*"
+
+	defaultMetricDatabase = "telegraf"
+)
+
+var (
+	// ErrNotAllowed is the cause of errors returned when raw access to a measurement is not allowed
+	ErrNotAllowed = errors.New("raw access is not allowed")
+)
+
+// Config Configuration of raw access to databases of datahub. Databases are
+// mapped to measurements which are accessible, measurements not listed are denied.
+type Config struct {
+	// ReadOnly denies all raw writes
+	ReadOnly bool                `mapstructure:"readOnly"`
+	Readable map[string][]string `mapstructure:"readable"`
+	Writable map[string][]string `mapstructure:"writable"`
+}
+
+// NewDefaultConfig Provide default configuration. Keycodes, recommendations and
+// cluster status are not writable and keycodes are not readable by default.
+func NewDefaultConfig() *Config {
+	return &Config{
+		Readable: map[string][]string{
+			defaultMetricDatabase:             {AnyMeasurement},
+			string(RepoInflux.Prediction):     {AnyMeasurement},
+			string(RepoInflux.Recommendation): {AnyMeasurement},
+			string(RepoInflux.Planning):       {AnyMeasurement},
+			string(RepoInflux.Score):          {AnyMeasurement},
+			string(RepoInflux.Event):          {AnyMeasurement},
+			string(RepoInflux.ClusterStatus): {
+				string(RepoInfluxClusterStatus.Node),
+				string(RepoInfluxClusterStatus.Container),
+				string(RepoInfluxClusterStatus.Controller),
+				string(RepoInfluxClusterStatus.Cluster),
+			},
+		},
+		Writable: map[string][]string{
+			string(RepoInflux.Prediction): {AnyMeasurement},
+			string(RepoInflux.Planning):   {AnyMeasurement},
+			string(RepoInflux.Score):      {AnyMeasurement},
+			string(RepoInflux.Event):      {AnyMeasurement},
+		},
+	}
+}
+
+// Validate Confirm the rawdata configuration is validated
+func (c *Config) Validate() error {
+	for _, whitelist := range []map[string][]string{c.Readable, c.Writable} {
+		for database, measurements := range whitelist {
+			if database == "" {
+				return errors.New("database name of rawdata whitelist is required")
+			}
+			for _, measurement := range measurements {
+				if measurement == "" {
+					return errors.Errorf("empty measurement in rawdata whitelist of database %s", database)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// CheckRead Return error caused by ErrNotAllowed if the measurement of the database is not readable,
+// the database qualifying the measurement e.g. "db.rp.measurement" is checked instead if it is set
+func (c *Config) CheckRead(database, measurement string) error {
+	qualifiedDatabase, _, name := InternalInflux.SplitMeasurement(measurement)
+	if qualifiedDatabase != "" {
+		database = qualifiedDatabase
+	}
+	if !allowed(c.Readable, database, name) {
+		return errors.Wrapf(ErrNotAllowed, "read measurement %q of database %q", measurement, database)
+	}
+	return nil
+}
+
+// CheckWrite Return error caused by ErrNotAllowed if the measurement of the database is not writable
+func (c *Config) CheckWrite(database, measurement string) error {
+	if c.ReadOnly {
+		return errors.Wrap(ErrNotAllowed, "datahub is in read-only mode")
+	}
+	if !allowed(c.Writable, database, measurement) {
+		return errors.Wrapf(ErrNotAllowed, "write measurement %q of database %q", measurement, database)
+	}
+	return nil
+}
+
+func allowed(whitelist map[string][]string, database, measurement string) bool {
+	if measurement == "" {
+		return false
+	}
+	for _, m := range whitelist[database] {
+		if m == AnyMeasurement || m == measurement {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package clusterstatus
+
+import (
+	EntityInfluxClusterStatus "github.com/containers-ai/alameda/datahub/pkg/entity/influxdb/cluster_status"
+	RepoInflux "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb"
+	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	"github.com/pkg/errors"
+)
+
+// ClusterRepository Repository of clusters registered to datahub, the registry is
+// shared by all clusters so its storage is not scoped to a cluster
+type ClusterRepository struct {
+	storage storage.Engine
+}
+
+// NewClusterRepository Return cluster repository
+func NewClusterRepository(influxDBCfg *InternalInflux.Config) *ClusterRepository {
+	scope.Infof("influxdb-NewClusterRepository input %v", influxDBCfg)
+	return &ClusterRepository{
+		storage: RepoInflux.NewStorageEngine(influxDBCfg),
+	}
+}
+
+// AddClusters add cluster information to database
+func (clusterRepository *ClusterRepository) AddClusters(clusters []*EntityInfluxClusterStatus.ClusterEntity) error {
+	scope.Infof("influxdb-AddClusters input %d %+v", len(clusters), clusters)
+	points := make([]*storage.Point, 0, len(clusters))
+	for _, cluster := range clusters {
+		points = append(points, &storage.Point{
+			Measurement: string(Cluster),
+			Tags:        map[string]string{EntityInfluxClusterStatus.ClusterID: cluster.ID},
+			Fields:      cluster.Fields(),
+			Time:        InternalInflux.ZeroTime,
+		})
+	}
+	if err := clusterRepository.storage.WritePoints(string(RepoInflux.ClusterStatus), points); err != nil {
+		return errors.Wrap(err, "add clusters failed")
+	}
+	return nil
+}
+
+// ListClusters list clusters of the ids, all clusters are listed if ids is empty
+func (clusterRepository *ClusterRepository) ListClusters(ids []string) ([]*EntityInfluxClusterStatus.ClusterEntity, error) {
+	scope.Infof("influxdb-ListClusters input %v", ids)
+	clusterEntities := []*EntityInfluxClusterStatus.ClusterEntity{}
+	query := storage.Query{
+		Database:    string(RepoInflux.ClusterStatus),
+		Measurement: string(Cluster),
+		Condition:   storage.EqualToAny(EntityInfluxClusterStatus.ClusterID, ids),
+	}
+	rows, err := clusterRepository.storage.Query(query)
+	if err != nil {
+		return clusterEntities, errors.Wrap(err, "list clusters from influxdb failed")
+	}
+	for _, row := range rows {
+		for _, data := range row.Data {
+			clusterEntity := EntityInfluxClusterStatus.NewClusterEntityFromMap(data)
+			clusterEntities = append(clusterEntities, &clusterEntity)
+		}
+	}
+	return clusterEntities, nil
+}
+
+// RemoveCluster remove cluster of the id from database
+func (clusterRepository *ClusterRepository) RemoveCluster(id string) error {
+	scope.Infof("influxdb-RemoveCluster input %s", id)
+	condition := storage.EqualTo(EntityInfluxClusterStatus.ClusterID, id)
+	if err := clusterRepository.storage.DropSeries(string(RepoInflux.ClusterStatus), string(Cluster), condition); err != nil {
+		return errors.Wrapf(err, "remove cluster %s failed", id)
+	}
+	return nil
+}
@@ -10,4 +10,6 @@ const (
 	// Container is container measurement
 	Container  influxdb.Measurement = "container"
 	Controller influxdb.Measurement = "controller"
+	// Cluster is cluster registry measurement
+	Cluster influxdb.Measurement = "cluster"
 )
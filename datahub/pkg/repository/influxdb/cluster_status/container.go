@@ -6,13 +6,15 @@ import (
 	"github.com/containers-ai/alameda/datahub/pkg/entity/influxdb/utils/enumconv"
 	RepoInflux "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	InfluxClient "github.com/influxdata/influxdb/client/v2"
 	"github.com/pkg/errors"
 	"strconv"
-	"strings"
 )
 
 var (
@@ -21,7 +23,7 @@ var (
 
 // ContainerRepository is used to operate node measurement of cluster_status database
 type ContainerRepository struct {
-	influxDB *InternalInflux.InfluxClient
+	storage storage.Engine
 }
 
 // IsTag checks the column is tag or not
@@ -35,14 +37,10 @@ func (containerRepository *ContainerRepository) IsTag(column string) bool {
 }
 
 // NewContainerRepository creates the ContainerRepository instance
-func NewContainerRepository(influxDBCfg *InternalInflux.Config) *ContainerRepository {
+func NewContainerRepository(influxDBCfg *InternalInflux.Config, clusterID string) *ContainerRepository {
 	scope.Infof("influxdb-NewContainerRepository input %v", influxDBCfg)
 	return &ContainerRepository{
-		influxDB: &InternalInflux.InfluxClient{
-			Address:  influxDBCfg.Address,
-			Username: influxDBCfg.Username,
-			Password: influxDBCfg.Password,
-		},
+		storage: RepoInflux.NewClusterStorageEngine(influxDBCfg, clusterID),
 	}
 }
 
@@ -50,62 +48,54 @@ func NewContainerRepository(influxDBCfg *InternalInflux.Config) *ContainerReposi
 func (containerRepository *ContainerRepository) ListAlamedaContainers(namespace, name string, kind datahub_v1alpha1.Kind, timeRange *datahub_v1alpha1.TimeRange) ([]*datahub_v1alpha1.Pod, error) {
 	scope.Infof("influxdb-ListAlamedaContainers input namespace %s; name %s; kind %+v; timereange %+v", namespace, name, kind, timeRange)
 	pods := []*datahub_v1alpha1.Pod{}
-	whereStr := ""
 
-	relationStatement := ""
+	var relationCondition storage.Condition
 	podCreatePeriodCondition := containerRepository.getPodCreatePeriodCondition(timeRange)
 
 	switch kind {
 	// bypass if Kind is Pod
 	case datahub_v1alpha1.Kind_POD:
-		// relationStatement = fmt.Sprintf(`("%s" = '%s' AND "%s" = '%s')`,
-		// 	cluster_status_entity.ContainerNamespace, namespace,
-		// 	cluster_status_entity.ContainerPodName, name)
-		if podCreatePeriodCondition != "" {
-			relationStatement = strings.Replace(podCreatePeriodCondition, "AND", "", 1)
-		}
-
+		relationCondition = podCreatePeriodCondition
 	case datahub_v1alpha1.Kind_DEPLOYMENT:
-		relationStatement = fmt.Sprintf(`("%s" = '%s' AND "%s" = '%s' AND "%s" = '%s' %s)`,
-			EntityInfluxClusterStatus.ContainerNamespace, namespace,
-			EntityInfluxClusterStatus.ContainerTopControllerName, name,
-			EntityInfluxClusterStatus.ContainerTopControllerKind, enumconv.KindDisp[datahub_v1alpha1.Kind_DEPLOYMENT],
-			podCreatePeriodCondition)
+		relationCondition = storage.AllOf(
+			storage.Compare(string(EntityInfluxClusterStatus.ContainerNamespace), storage.Equal, namespace),
+			storage.Compare(string(EntityInfluxClusterStatus.ContainerTopControllerName), storage.Equal, name),
+			storage.Compare(string(EntityInfluxClusterStatus.ContainerTopControllerKind), storage.Equal, enumconv.KindDisp[datahub_v1alpha1.Kind_DEPLOYMENT]),
+			podCreatePeriodCondition,
+		)
 	case datahub_v1alpha1.Kind_DEPLOYMENTCONFIG:
-		relationStatement = fmt.Sprintf(`("%s" = '%s' AND "%s" = '%s' AND "%s" = '%s' %s)`,
-			EntityInfluxClusterStatus.ContainerNamespace, namespace,
-			EntityInfluxClusterStatus.ContainerTopControllerName, name,
-			EntityInfluxClusterStatus.ContainerTopControllerKind, enumconv.KindDisp[datahub_v1alpha1.Kind_DEPLOYMENTCONFIG],
-			podCreatePeriodCondition)
+		relationCondition = storage.AllOf(
+			storage.Compare(string(EntityInfluxClusterStatus.ContainerNamespace), storage.Equal, namespace),
+			storage.Compare(string(EntityInfluxClusterStatus.ContainerTopControllerName), storage.Equal, name),
+			storage.Compare(string(EntityInfluxClusterStatus.ContainerTopControllerKind), storage.Equal, enumconv.KindDisp[datahub_v1alpha1.Kind_DEPLOYMENTCONFIG]),
+			podCreatePeriodCondition,
+		)
 	case datahub_v1alpha1.Kind_ALAMEDASCALER:
-		relationStatement = fmt.Sprintf(`("%s" = '%s' AND "%s" = '%s' %s)`,
-			EntityInfluxClusterStatus.ContainerAlamedaScalerNamespace, namespace,
-			EntityInfluxClusterStatus.ContainerAlamedaScalerName, name,
-			podCreatePeriodCondition)
+		relationCondition = storage.AllOf(
+			storage.Compare(string(EntityInfluxClusterStatus.ContainerAlamedaScalerNamespace), storage.Equal, namespace),
+			storage.Compare(string(EntityInfluxClusterStatus.ContainerAlamedaScalerName), storage.Equal, name),
+			podCreatePeriodCondition,
+		)
 	default:
-		err := errors.Errorf("no mapping filter statement with Datahub Kind: %s, skip building relation statement", datahub_v1alpha1.Kind_name[int32(kind)])
+		err := errors.Errorf("no mapping filter statement with Datahub Kind: %s, skip building relation statement", DatahubKind.Name(kind))
 		scope.Errorf("influxdb-ListAlamedaContainers error %+v", err)
 		return pods, err
 	}
-	if relationStatement != "" {
-		if whereStr != "" {
-			whereStr = fmt.Sprintf("%s AND %s", whereStr, relationStatement)
-		} else {
-			whereStr = fmt.Sprintf("WHERE %s", relationStatement)
-		}
-	}
 
-	cmd := fmt.Sprintf("SELECT * FROM %s %s GROUP BY \"%s\",\"%s\",\"%s\",\"%s\"",
-		string(Container), whereStr,
-		string(EntityInfluxClusterStatus.ContainerNamespace), string(EntityInfluxClusterStatus.ContainerPodName),
-		string(EntityInfluxClusterStatus.ContainerAlamedaScalerNamespace), string(EntityInfluxClusterStatus.ContainerAlamedaScalerName))
-	scope.Debugf("ListAlamedaContainers command: %s", cmd)
+	query := storage.Query{
+		Database:    string(RepoInflux.ClusterStatus),
+		Measurement: string(Container),
+		Condition:   relationCondition,
+		GroupByTags: []string{
+			string(EntityInfluxClusterStatus.ContainerNamespace), string(EntityInfluxClusterStatus.ContainerPodName),
+			string(EntityInfluxClusterStatus.ContainerAlamedaScalerNamespace), string(EntityInfluxClusterStatus.ContainerAlamedaScalerName),
+		},
+	}
 	var retErr error
-	if results, err := containerRepository.influxDB.QueryDB(cmd, string(RepoInflux.ClusterStatus)); err == nil {
+	if rows, err := containerRepository.storage.Query(query); err == nil {
 
 		containerEntities := make([]*EntityInfluxClusterStatus.ContainerEntity, 0)
 
-		rows := InternalInflux.PackMap(results)
 		for _, row := range rows {
 			for _, data := range row.Data {
 				entity := EntityInfluxClusterStatus.NewContainerEntityFromMap(data)
@@ -126,7 +116,7 @@ func (containerRepository *ContainerRepository) ListAlamedaContainers(namespace,
 
 // CreateContainers add containers information container measurement
 func (containerRepository *ContainerRepository) CreateContainers(pods []*datahub_v1alpha1.Pod) error {
-	scope.Infof("influxdb-CreateContainers input #pod=%d: %", len(pods))
+	scope.Infof("influxdb-CreateContainers input #pod=%d", len(pods))
 	points := []*InfluxClient.Point{}
 	for _, pod := range pods {
 		containerEntities, err := buildContainerEntitiesFromDatahubPod(pod)
@@ -141,9 +131,11 @@ func (containerRepository *ContainerRepository) CreateContainers(pods []*datahub
 			points = append(points, p)
 		}
 	}
-	err := containerRepository.influxDB.WritePoints(points, InfluxClient.BatchPointsConfig{
-		Database: string(RepoInflux.ClusterStatus),
-	})
+	storagePoints, err := StorageInflux.NewPoints(points)
+	if err != nil {
+		return errors.Wrap(err, "create containers failed")
+	}
+	err = containerRepository.storage.WritePoints(string(RepoInflux.ClusterStatus), storagePoints)
 	if err != nil {
 		scope.Errorf("influxdb-CreateContainers return error %+v", err)
 		return errors.Wrap(err, "create containers to influxdb failed")
@@ -162,11 +154,13 @@ func (containerRepository *ContainerRepository) DeleteContainers(pods []*datahub
 		podName := pod.GetNamespacedName().GetName()
 		alaScalerNS := pod.GetAlamedaScaler().GetNamespace()
 		alaScalerName := pod.GetAlamedaScaler().GetName()
-		cmd := fmt.Sprintf("DROP SERIES FROM %s WHERE \"%s\"='%s' AND \"%s\"='%s' AND \"%s\"='%s' AND \"%s\"='%s'", Container,
-			EntityInfluxClusterStatus.ContainerNamespace, podNS, EntityInfluxClusterStatus.ContainerPodName, podName,
-			EntityInfluxClusterStatus.ContainerAlamedaScalerNamespace, alaScalerNS, EntityInfluxClusterStatus.ContainerAlamedaScalerName, alaScalerName)
-		scope.Debugf("DeleteContainers command: %s", cmd)
-		_, err := containerRepository.influxDB.QueryDB(cmd, string(RepoInflux.ClusterStatus))
+		condition := storage.And{
+			storage.Compare(string(EntityInfluxClusterStatus.ContainerNamespace), storage.Equal, podNS),
+			storage.Compare(string(EntityInfluxClusterStatus.ContainerPodName), storage.Equal, podName),
+			storage.Compare(string(EntityInfluxClusterStatus.ContainerAlamedaScalerNamespace), storage.Equal, alaScalerNS),
+			storage.Compare(string(EntityInfluxClusterStatus.ContainerAlamedaScalerName), storage.Equal, alaScalerName),
+		}
+		err := containerRepository.storage.DropSeries(string(RepoInflux.ClusterStatus), string(Container), condition)
 		if err != nil {
 			scope.Errorf(err.Error())
 		}
@@ -178,17 +172,14 @@ func (containerRepository *ContainerRepository) DeleteContainers(pods []*datahub
 func (containerRepository *ContainerRepository) ListPodsContainers(pods []*datahub_v1alpha1.Pod) ([]*EntityInfluxClusterStatus.ContainerEntity, error) {
 	scope.Infof("influxdb-ListPodsContainers input #pod %d", len(pods))
 	var (
-		cmd                 = ""
-		cmdSelectString     = ""
-		cmdTagsFilterString = ""
-		containerEntities   = make([]*EntityInfluxClusterStatus.ContainerEntity, 0)
+		podConditions     = make([]storage.Condition, 0, len(pods))
+		containerEntities = make([]*EntityInfluxClusterStatus.ContainerEntity, 0)
 	)
 
 	if len(pods) == 0 {
 		return containerEntities, nil
 	}
 
-	cmdSelectString = fmt.Sprintf(`select * from "%s" `, Container)
 	for _, pod := range pods {
 
 		var (
@@ -201,21 +192,23 @@ func (containerRepository *ContainerRepository) ListPodsContainers(pods []*datah
 			podName = pod.GetNamespacedName().GetName()
 		}
 
-		cmdTagsFilterString += fmt.Sprintf(`("%s" = '%s' and "%s" = '%s') or `,
-			EntityInfluxClusterStatus.ContainerNamespace, namespace,
-			EntityInfluxClusterStatus.ContainerPodName, podName,
-		)
+		podConditions = append(podConditions, storage.And{
+			storage.Compare(string(EntityInfluxClusterStatus.ContainerNamespace), storage.Equal, namespace),
+			storage.Compare(string(EntityInfluxClusterStatus.ContainerPodName), storage.Equal, podName),
+		})
 	}
-	cmdTagsFilterString = strings.TrimSuffix(cmdTagsFilterString, "or ")
 
-	cmd = fmt.Sprintf("%s where %s", cmdSelectString, cmdTagsFilterString)
-	results, err := containerRepository.influxDB.QueryDB(cmd, string(RepoInflux.ClusterStatus))
+	query := storage.Query{
+		Database:    string(RepoInflux.ClusterStatus),
+		Measurement: string(Container),
+		Condition:   storage.AnyOf(podConditions...),
+	}
+	rows, err := containerRepository.storage.Query(query)
 	if err != nil {
 		scope.Errorf("influxdb-ListPodsContainers error %+v", err)
 		return containerEntities, errors.Wrap(err, "list pod containers failed")
 	}
 
-	rows := InternalInflux.PackMap(results)
 	for _, row := range rows {
 		for _, data := range row.Data {
 			entity := EntityInfluxClusterStatus.NewContainerEntityFromMap(data)
@@ -227,9 +220,9 @@ func (containerRepository *ContainerRepository) ListPodsContainers(pods []*datah
 	return containerEntities, nil
 }
 
-func (containerRepository *ContainerRepository) getPodCreatePeriodCondition(timeRange *datahub_v1alpha1.TimeRange) string {
+func (containerRepository *ContainerRepository) getPodCreatePeriodCondition(timeRange *datahub_v1alpha1.TimeRange) storage.Condition {
 	if timeRange == nil {
-		return ""
+		return nil
 	}
 
 	var start int64 = 0
@@ -243,20 +236,15 @@ func (containerRepository *ContainerRepository) getPodCreatePeriodCondition(time
 		end = timeRange.EndTime.Seconds
 	}
 
-	if start == 0 && end == 0 {
-		return ""
-	} else if start == 0 && end != 0 {
-		period := fmt.Sprintf(`AND "pod_create_time" < %d`, end)
-		return period
-	} else if start != 0 && end == 0 {
-		period := fmt.Sprintf(`AND "pod_create_time" >= %d`, start)
-		return period
-	} else if start != 0 && end != 0 {
-		period := fmt.Sprintf(`AND "pod_create_time" >= %d AND "pod_create_time" < %d`, start, end)
-		return period
+	var conditions []storage.Condition
+	if start != 0 {
+		conditions = append(conditions, storage.Compare(string(EntityInfluxClusterStatus.ContainerPodCreateTime), storage.GreaterEqual, start))
+	}
+	if end != 0 {
+		conditions = append(conditions, storage.Compare(string(EntityInfluxClusterStatus.ContainerPodCreateTime), storage.Less, end))
 	}
 
-	return ""
+	return storage.AllOf(conditions...)
 }
 
 func buildContainerEntitiesFromDatahubPod(pod *datahub_v1alpha1.Pod) ([]*EntityInfluxClusterStatus.ContainerEntity, error) {
@@ -1,29 +1,26 @@
 package clusterstatus
 
 import (
-	"fmt"
 	EntityInfluxClusterStatus "github.com/containers-ai/alameda/datahub/pkg/entity/influxdb/cluster_status"
 	RepoInflux "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	InfluxClient "github.com/influxdata/influxdb/client/v2"
 	"strconv"
-	"strings"
 	"time"
 )
 
 type ControllerRepository struct {
-	influxDB *InternalInflux.InfluxClient
+	storage storage.Engine
 }
 
-func NewControllerRepository(influxDBCfg *InternalInflux.Config) *ControllerRepository {
+func NewControllerRepository(influxDBCfg *InternalInflux.Config, clusterID string) *ControllerRepository {
 	scope.Infof("influxdb-NewControllerRepository input %v", influxDBCfg)
 	return &ControllerRepository{
-		influxDB: &InternalInflux.InfluxClient{
-			Address:  influxDBCfg.Address,
-			Username: influxDBCfg.Username,
-			Password: influxDBCfg.Password,
-		},
+		storage: RepoInflux.NewClusterStorageEngine(influxDBCfg, clusterID),
 	}
 }
 
@@ -33,7 +30,7 @@ func (c *ControllerRepository) CreateControllers(controllers []*datahub_v1alpha1
 	for _, controller := range controllers {
 		controllerNamespace := controller.GetControllerInfo().GetNamespacedName().GetNamespace()
 		controllerName := controller.GetControllerInfo().GetNamespacedName().GetName()
-		controllerKind := controller.GetControllerInfo().GetKind().String()
+		controllerKind := DatahubKind.Name(controller.GetControllerInfo().GetKind())
 		controllerExecution := controller.GetEnableRecommendationExecution()
 		controllerPolicy := controller.GetPolicy().String()
 
@@ -44,7 +41,7 @@ func (c *ControllerRepository) CreateControllers(controllers []*datahub_v1alpha1
 		if len(controller.GetOwnerInfo()) > 0 {
 			ownerNamespace = controller.GetOwnerInfo()[0].GetNamespacedName().GetNamespace()
 			ownerName = controller.GetOwnerInfo()[0].GetNamespacedName().GetName()
-			ownerKind = controller.GetOwnerInfo()[0].GetKind().String()
+			ownerKind = DatahubKind.Name(controller.GetOwnerInfo()[0].GetKind())
 		}
 
 		tags := map[string]string{
@@ -70,10 +67,13 @@ func (c *ControllerRepository) CreateControllers(controllers []*datahub_v1alpha1
 		points = append(points, pt)
 	}
 
-	err := c.influxDB.WritePoints(points, InfluxClient.BatchPointsConfig{
-		Database: string(RepoInflux.ClusterStatus),
-	})
+	storagePoints, err := StorageInflux.NewPoints(points)
+	if err != nil {
+		scope.Error(err.Error())
+		return nil
+	}
 
+	err = c.storage.WritePoints(string(RepoInflux.ClusterStatus), storagePoints)
 	if err != nil {
 		scope.Error(err.Error())
 	}
@@ -86,25 +86,23 @@ func (c *ControllerRepository) ListControllers(in *datahub_v1alpha1.ListControll
 	namespace := in.GetNamespacedName().GetNamespace()
 	name := in.GetNamespacedName().GetName()
 
-	whereStr := c.convertQueryCondition(namespace, name)
-
-	influxdbStatement := InternalInflux.Statement{
-		Measurement: Controller,
-		WhereClause: whereStr,
+	query := storage.Query{
+		Database:    string(RepoInflux.ClusterStatus),
+		Measurement: string(Controller),
+		Condition: storage.AllOf(
+			storage.EqualTo(EntityInfluxClusterStatus.ControllerNamespace, namespace),
+			storage.EqualTo(EntityInfluxClusterStatus.ControllerName, name),
+		),
 		GroupByTags: []string{EntityInfluxClusterStatus.ControllerNamespace, EntityInfluxClusterStatus.ControllerName},
 	}
 
-	cmd := influxdbStatement.BuildQueryCmd()
-
-	results, err := c.influxDB.QueryDB(cmd, string(RepoInflux.ClusterStatus))
+	rows, err := c.storage.Query(query)
 	if err != nil {
-		scope.Infof("influxdb-ListControllers cmd %s, error %v", cmd, err)
+		scope.Infof("influxdb-ListControllers error %v", err)
 		return make([]*datahub_v1alpha1.Controller, 0), err
 	}
 
-	influxdbRows := InternalInflux.PackMap(results)
-
-	controllerList := c.getControllersFromInfluxRows(influxdbRows)
+	controllerList := c.getControllersFromRows(rows)
 	scope.Infof("influxdb-ListControllers return %d %v", len(controllerList), controllerList)
 	return controllerList, nil
 }
@@ -112,49 +110,27 @@ func (c *ControllerRepository) ListControllers(in *datahub_v1alpha1.ListControll
 func (c *ControllerRepository) DeleteControllers(in *datahub_v1alpha1.DeleteControllersRequest) error {
 	scope.Infof("influxdb-DeleteControllers input %s %+v", in.String(), in)
 	controllers := in.GetControllers()
-	whereStr := ""
+	conditions := make([]storage.Condition, 0, len(controllers))
 
 	for _, controller := range controllers {
 		namespace := controller.GetControllerInfo().GetNamespacedName().GetNamespace()
 		name := controller.GetControllerInfo().GetNamespacedName().GetName()
-		whereStr += fmt.Sprintf(" (\"name\"='%s' AND \"namespace\"='%s') OR", name, namespace)
-	}
-
-	whereStr = strings.TrimSuffix(whereStr, "OR")
-
-	if whereStr != "" {
-		whereStr = "WHERE" + whereStr
+		conditions = append(conditions, storage.And{
+			storage.Compare(EntityInfluxClusterStatus.ControllerName, storage.Equal, name),
+			storage.Compare(EntityInfluxClusterStatus.ControllerNamespace, storage.Equal, namespace),
+		})
 	}
-	cmd := fmt.Sprintf("DROP SERIES FROM %s %s", string(Controller), whereStr)
 
-	_, err := c.influxDB.QueryDB(cmd, string(RepoInflux.ClusterStatus))
+	err := c.storage.DropSeries(string(RepoInflux.ClusterStatus), string(Controller), storage.AnyOf(conditions...))
 	if err != nil {
-		scope.Infof("influxdb-DeleteControllers cmd %s, error %v", cmd, err)
+		scope.Infof("influxdb-DeleteControllers error %v", err)
 		return err
 	}
 
 	return nil
 }
 
-func (c *ControllerRepository) convertQueryCondition(namespace string, name string) string {
-	ret := ""
-
-	if namespace != "" {
-		ret += fmt.Sprintf("\"namespace\"='%s' ", namespace)
-	}
-
-	if name != "" {
-		ret += fmt.Sprintf("AND \"name\"='%s' ", name)
-	}
-
-	ret = strings.TrimPrefix(ret, "AND")
-	if ret != "" {
-		ret = "WHERE " + ret
-	}
-	return ret
-}
-
-func (c *ControllerRepository) getControllersFromInfluxRows(rows []*InternalInflux.InfluxRow) []*datahub_v1alpha1.Controller {
+func (c *ControllerRepository) getControllersFromRows(rows []*storage.Row) []*datahub_v1alpha1.Controller {
 	controllerList := make([]*datahub_v1alpha1.Controller, 0)
 	for _, row := range rows {
 		namespace := row.Tags[EntityInfluxClusterStatus.ControllerNamespace]
@@ -176,8 +152,8 @@ func (c *ControllerRepository) getControllersFromInfluxRows(rows []*InternalInfl
 			tempOwnerKind := data[EntityInfluxClusterStatus.ControllerOwnerKind]
 			var ownerKind datahub_v1alpha1.Kind
 
-			if val, found := datahub_v1alpha1.Kind_value[tempOwnerKind]; found {
-				ownerKind = datahub_v1alpha1.Kind(val)
+			if val, found := DatahubKind.Value(tempOwnerKind); found {
+				ownerKind = val
 			}
 
 			tempOwner := &datahub_v1alpha1.ResourceInfo{
@@ -193,8 +169,8 @@ func (c *ControllerRepository) getControllersFromInfluxRows(rows []*InternalInfl
 			//------
 			tempKind := data[EntityInfluxClusterStatus.ControllerKind]
 			var kind datahub_v1alpha1.Kind
-			if val, found := datahub_v1alpha1.Kind_value[tempKind]; found {
-				kind = datahub_v1alpha1.Kind(val)
+			if val, found := DatahubKind.Value(tempKind); found {
+				kind = val
 			}
 			tempController.ControllerInfo.Kind = kind
 
@@ -6,14 +6,15 @@ import (
 	EntityInfluxClusterStatus "github.com/containers-ai/alameda/datahub/pkg/entity/influxdb/cluster_status"
 	RepoInflux "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	InfluxClient "github.com/influxdata/influxdb/client/v2"
 	"github.com/pkg/errors"
-	"strings"
 )
 
 type NodeRepository struct {
-	influxDB *InternalInflux.InfluxClient
+	storage storage.Engine
 }
 
 func (nodeRepository *NodeRepository) IsTag(column string) bool {
@@ -25,14 +26,10 @@ func (nodeRepository *NodeRepository) IsTag(column string) bool {
 	return false
 }
 
-func NewNodeRepository(influxDBCfg *InternalInflux.Config) *NodeRepository {
+func NewNodeRepository(influxDBCfg *InternalInflux.Config, clusterID string) *NodeRepository {
 	scope.Infof("influxdb-NewNodeRepository input %v", influxDBCfg)
 	return &NodeRepository{
-		influxDB: &InternalInflux.InfluxClient{
-			Address:  influxDBCfg.Address,
-			Username: influxDBCfg.Username,
-			Password: influxDBCfg.Password,
-		},
+		storage: RepoInflux.NewClusterStorageEngine(influxDBCfg, clusterID),
 	}
 }
 
@@ -69,9 +66,11 @@ func (nodeRepository *NodeRepository) AddAlamedaNodes(alamedaNodes []*datahub_v1
 			scope.Error(err.Error())
 		}
 	}
-	err := nodeRepository.influxDB.WritePoints(points, InfluxClient.BatchPointsConfig{
-		Database: string(RepoInflux.ClusterStatus),
-	})
+	storagePoints, err := StorageInflux.NewPoints(points)
+	if err != nil {
+		return errors.Wrapf(err, "add alameda nodes failed: %s", err.Error())
+	}
+	err = nodeRepository.storage.WritePoints(string(RepoInflux.ClusterStatus), storagePoints)
 	if err != nil {
 		return errors.Wrapf(err, "add alameda nodes failed: %s", err.Error())
 	}
@@ -83,9 +82,8 @@ func (nodeRepository *NodeRepository) RemoveAlamedaNodes(alamedaNodes []*datahub
 	hasErr := false
 	errMsg := ""
 	for _, alamedaNode := range alamedaNodes {
-		cmd := fmt.Sprintf("DROP SERIES FROM %s WHERE \"%s\"='%s'",
-			string(Node), string(EntityInfluxClusterStatus.NodeName), alamedaNode.Name)
-		_, err := nodeRepository.influxDB.QueryDB(cmd, string(RepoInflux.ClusterStatus))
+		condition := storage.Compare(string(EntityInfluxClusterStatus.NodeName), storage.Equal, alamedaNode.Name)
+		err := nodeRepository.storage.DropSeries(string(RepoInflux.ClusterStatus), string(Node), condition)
 		if err != nil {
 			hasErr = true
 			errMsg += errMsg + err.Error()
@@ -100,26 +98,25 @@ func (nodeRepository *NodeRepository) RemoveAlamedaNodes(alamedaNodes []*datahub
 func (nodeRepository *NodeRepository) ListAlamedaNodes(timeRange *datahub_v1alpha1.TimeRange) ([]*EntityInfluxClusterStatus.NodeEntity, error) {
 	scope.Infof("influxdb-ListAlamedaNodes input %+v", timeRange)
 	nodeEntities := []*EntityInfluxClusterStatus.NodeEntity{}
-	nodeCreatePeriodCondition := nodeRepository.getNodeCreatePeriodCondition(timeRange)
-
-	cmd := fmt.Sprintf("SELECT * FROM %s WHERE \"%s\"=%s %s",
-		string(Node), string(EntityInfluxClusterStatus.NodeInCluster), "true", nodeCreatePeriodCondition)
+	query := storage.Query{
+		Database:    string(RepoInflux.ClusterStatus),
+		Measurement: string(Node),
+		Condition: storage.AllOf(
+			storage.Compare(string(EntityInfluxClusterStatus.NodeInCluster), storage.Equal, true),
+			nodeRepository.getNodeCreatePeriodCondition(timeRange),
+		),
+	}
 
-	scope.Debug(fmt.Sprintf("Query nodes in cluster: %s", cmd))
-	results, err := nodeRepository.influxDB.QueryDB(cmd, string(RepoInflux.ClusterStatus))
+	rows, err := nodeRepository.storage.Query(query)
 	if err != nil {
 		scope.Errorf("influxdb-ListAlamedaNodes error %+v", err)
 		return nodeEntities, errors.Wrap(err, "list alameda nodes from influxdb failed")
 	}
 
-	if len(results) == 1 && len(results[0].Series) > 0 {
-
-		influxdbRows := InternalInflux.PackMap(results)
-		for _, influxdbRow := range influxdbRows {
-			for _, data := range influxdbRow.Data {
-				nodeEntity := EntityInfluxClusterStatus.NewNodeEntityFromMap(data)
-				nodeEntities = append(nodeEntities, &nodeEntity)
-			}
+	for _, row := range rows {
+		for _, data := range row.Data {
+			nodeEntity := EntityInfluxClusterStatus.NewNodeEntityFromMap(data)
+			nodeEntities = append(nodeEntities, &nodeEntity)
 		}
 	}
 	scope.Infof("influxdb-ListAlamedaNodes return %d %v", len(nodeEntities), nodeEntities)
@@ -131,18 +128,19 @@ func (nodeRepository *NodeRepository) ListNodes(request DaoClusterStatus.ListNod
 
 	nodeEntities := []*EntityInfluxClusterStatus.NodeEntity{}
 
-	whereClause := nodeRepository.buildInfluxQLWhereClauseFromRequest(request)
-	cmd := fmt.Sprintf("SELECT * FROM %s %s", string(Node), whereClause)
-	scope.Debug(fmt.Sprintf("Query nodes in cluster: %s", cmd))
-	results, err := nodeRepository.influxDB.QueryDB(cmd, string(RepoInflux.ClusterStatus))
+	query := storage.Query{
+		Database:    string(RepoInflux.ClusterStatus),
+		Measurement: string(Node),
+		Condition:   nodeRepository.buildConditionFromRequest(request),
+	}
+	rows, err := nodeRepository.storage.Query(query)
 	if err != nil {
 		scope.Errorf("influxdb-ListNodes error %+v", err)
 		return nodeEntities, errors.Wrap(err, "list nodes from influxdb failed")
 	}
 
-	influxdbRows := InternalInflux.PackMap(results)
-	for _, influxdbRow := range influxdbRows {
-		for _, data := range influxdbRow.Data {
+	for _, row := range rows {
+		for _, data := range row.Data {
 			nodeEntity := EntityInfluxClusterStatus.NewNodeEntityFromMap(data)
 			nodeEntities = append(nodeEntities, &nodeEntity)
 		}
@@ -152,32 +150,16 @@ func (nodeRepository *NodeRepository) ListNodes(request DaoClusterStatus.ListNod
 	return nodeEntities, nil
 }
 
-func (nodeRepository *NodeRepository) buildInfluxQLWhereClauseFromRequest(request DaoClusterStatus.ListNodesRequest) string {
-
-	var (
-		whereClause string
-		conditions  string
+func (nodeRepository *NodeRepository) buildConditionFromRequest(request DaoClusterStatus.ListNodesRequest) storage.Condition {
+	return storage.AllOf(
+		storage.Compare(string(EntityInfluxClusterStatus.NodeInCluster), storage.Equal, request.InCluster),
+		storage.EqualToAny(string(EntityInfluxClusterStatus.NodeName), request.NodeNames),
 	)
-
-	conditions += fmt.Sprintf("\"%s\" = %t", EntityInfluxClusterStatus.NodeInCluster, request.InCluster)
-
-	statementFilteringNodes := ""
-	for _, nodeName := range request.NodeNames {
-		statementFilteringNodes += fmt.Sprintf(`"%s" = '%s' OR `, EntityInfluxClusterStatus.NodeName, nodeName)
-	}
-	statementFilteringNodes = strings.TrimSuffix(statementFilteringNodes, "OR ")
-	if statementFilteringNodes != "" {
-		conditions = fmt.Sprintf("(%s) AND (%s)", conditions, statementFilteringNodes)
-	}
-
-	whereClause = fmt.Sprintf("WHERE %s", conditions)
-
-	return whereClause
 }
 
-func (nodeRepository *NodeRepository) getNodeCreatePeriodCondition(timeRange *datahub_v1alpha1.TimeRange) string {
+func (nodeRepository *NodeRepository) getNodeCreatePeriodCondition(timeRange *datahub_v1alpha1.TimeRange) storage.Condition {
 	if timeRange == nil {
-		return ""
+		return nil
 	}
 
 	var start int64 = 0
@@ -191,18 +173,13 @@ func (nodeRepository *NodeRepository) getNodeCreatePeriodCondition(timeRange *da
 		end = timeRange.EndTime.Seconds
 	}
 
-	if start == 0 && end == 0 {
-		return ""
-	} else if start == 0 && end != 0 {
-		period := fmt.Sprintf(`AND "create_time" < %d`, end)
-		return period
-	} else if start != 0 && end == 0 {
-		period := fmt.Sprintf(`AND "create_time" >= %d`, start)
-		return period
-	} else if start != 0 && end != 0 {
-		period := fmt.Sprintf(`AND "create_time" >= %d AND "create_time" < %d`, start, end)
-		return period
+	var conditions []storage.Condition
+	if start != 0 {
+		conditions = append(conditions, storage.Compare(string(EntityInfluxClusterStatus.NodeCreateTime), storage.GreaterEqual, start))
+	}
+	if end != 0 {
+		conditions = append(conditions, storage.Compare(string(EntityInfluxClusterStatus.NodeCreateTime), storage.Less, end))
 	}
 
-	return ""
+	return storage.AllOf(conditions...)
 }
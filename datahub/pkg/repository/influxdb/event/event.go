@@ -5,6 +5,9 @@ import (
 	RepoInflux "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb"
 	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
+	DatahubEvent "github.com/containers-ai/alameda/pkg/framework/datahub/event"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/ptypes"
@@ -17,17 +20,13 @@ var (
 )
 
 type EventRepository struct {
-	influxDB *InternalInflux.InfluxClient
+	storage storage.Engine
 }
 
 func NewEventRepository(influxDBCfg *InternalInflux.Config) *EventRepository {
 	scope.Infof("influxdb-NewEventRepository input %v", influxDBCfg)
 	return &EventRepository{
-		influxDB: &InternalInflux.InfluxClient{
-			Address:  influxDBCfg.Address,
-			Username: influxDBCfg.Username,
-			Password: influxDBCfg.Password,
-		},
+		storage: RepoInflux.NewStorageEngine(influxDBCfg),
 	}
 }
 
@@ -40,7 +39,7 @@ func (e *EventRepository) CreateEvents(in *datahub_v1alpha1.CreateEventsRequest)
 			EntityInfluxEvent.EventClusterId:         event.GetClusterId(),
 			EntityInfluxEvent.EventSourceHost:        event.GetSource().GetHost(),
 			EntityInfluxEvent.EventSourceComponent:   event.GetSource().GetComponent(),
-			EntityInfluxEvent.EventType:              event.GetType().String(),
+			EntityInfluxEvent.EventType:              DatahubEvent.Name(event.GetType()),
 			EntityInfluxEvent.EventVersion:           event.GetVersion().String(),
 			EntityInfluxEvent.EventLevel:             event.GetLevel().String(),
 			EntityInfluxEvent.EventSubjectKind:       event.GetSubject().GetKind(),
@@ -64,10 +63,13 @@ func (e *EventRepository) CreateEvents(in *datahub_v1alpha1.CreateEventsRequest)
 		points = append(points, pt)
 	}
 
-	err := e.influxDB.WritePoints(points, InfluxClient.BatchPointsConfig{
-		Database: string(RepoInflux.Event),
-	})
+	storagePoints, err := StorageInflux.NewPoints(points)
+	if err != nil {
+		scope.Error(err.Error())
+		return err
+	}
 
+	err = e.storage.WritePoints(string(RepoInflux.Event), storagePoints)
 	if err != nil {
 		scope.Error(err.Error())
 		return err
@@ -83,7 +85,7 @@ func (e *EventRepository) ListEvents(in *datahub_v1alpha1.ListEventsRequest) ([]
 
 	eventTypeList := make([]string, 0)
 	for _, eventType := range in.GetType() {
-		eventTypeList = append(eventTypeList, eventType.String())
+		eventTypeList = append(eventTypeList, DatahubEvent.Name(eventType))
 	}
 
 	eventVersionList := make([]string, 0)
@@ -96,41 +98,35 @@ func (e *EventRepository) ListEvents(in *datahub_v1alpha1.ListEventsRequest) ([]
 		eventLevelList = append(eventLevelList, eventLevel.String())
 	}
 
-	influxdbStatement := InternalInflux.Statement{
-		Measurement:    Event,
-		QueryCondition: DBCommon.BuildQueryConditionV1(in.GetQueryCondition()),
+	query := storage.Query{
+		Database:    string(RepoInflux.Event),
+		Measurement: string(Event),
 	}
 
-	influxdbStatement.AppendWhereClauseByList(EntityInfluxEvent.EventId, "=", "OR", idList)
-	influxdbStatement.AppendWhereClauseByList(EntityInfluxEvent.EventClusterId, "=", "OR", clusterIdList)
-	influxdbStatement.AppendWhereClauseByList(EntityInfluxEvent.EventType, "=", "OR", eventTypeList)
-	influxdbStatement.AppendWhereClauseByList(EntityInfluxEvent.EventVersion, "=", "OR", eventVersionList)
-	influxdbStatement.AppendWhereClauseByList(EntityInfluxEvent.EventLevel, "=", "OR", eventLevelList)
-
-	influxdbStatement.AppendWhereClauseFromTimeCondition()
-	influxdbStatement.SetOrderClauseFromQueryCondition()
-	influxdbStatement.SetLimitClauseFromQueryCondition()
-
-	cmd := influxdbStatement.BuildQueryCmd()
+	query.AppendCondition(storage.EqualToAny(EntityInfluxEvent.EventId, idList))
+	query.AppendCondition(storage.EqualToAny(EntityInfluxEvent.EventClusterId, clusterIdList))
+	query.AppendCondition(storage.EqualToAny(EntityInfluxEvent.EventType, eventTypeList))
+	query.AppendCondition(storage.EqualToAny(EntityInfluxEvent.EventVersion, eventVersionList))
+	query.AppendCondition(storage.EqualToAny(EntityInfluxEvent.EventLevel, eventLevelList))
+	query.ApplyQueryCondition(DBCommon.BuildQueryConditionV1(in.GetQueryCondition()))
 
-	results, err := e.influxDB.QueryDB(cmd, string(RepoInflux.Event))
+	rows, err := e.storage.Query(query)
 	if err != nil {
 		scope.Errorf("influxdb-ListEvents error %v", err)
 		return make([]*datahub_v1alpha1.Event, 0), err
 	}
 
-	influxdbRows := InternalInflux.PackMap(results)
-	events := e.getEventsFromInfluxRows(influxdbRows)
+	events := e.getEventsFromRows(rows)
 
 	scope.Infof("influxdb-ListEvents return %d %v", len(events), events)
 	return events, nil
 }
 
-func (e *EventRepository) getEventsFromInfluxRows(rows []*InternalInflux.InfluxRow) []*datahub_v1alpha1.Event {
+func (e *EventRepository) getEventsFromRows(rows []*storage.Row) []*datahub_v1alpha1.Event {
 	events := make([]*datahub_v1alpha1.Event, 0)
 
-	for _, influxdbRow := range rows {
-		for _, data := range influxdbRow.Data {
+	for _, row := range rows {
+		for _, data := range row.Data {
 			t, _ := time.Parse(time.RFC3339Nano, data[EntityInfluxEvent.EventTime])
 			tempTime, _ := ptypes.TimestampProto(t)
 
@@ -148,8 +144,8 @@ func (e *EventRepository) getEventsFromInfluxRows(rows []*InternalInflux.InfluxR
 
 			eventType := datahub_v1alpha1.EventType_EVENT_TYPE_UNDEFINED
 			if tempType, exist := data[EntityInfluxEvent.EventType]; exist {
-				if value, ok := datahub_v1alpha1.EventType_value[tempType]; ok {
-					eventType = datahub_v1alpha1.EventType(value)
+				if value, ok := DatahubEvent.Value(tempType); ok {
+					eventType = value
 				}
 			}
 
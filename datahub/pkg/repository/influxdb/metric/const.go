@@ -0,0 +1,12 @@
+package metric
+
+import (
+	"github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+)
+
+const (
+	// Container is container usage measurement written by telegraf's kubernetes input plugin
+	Container influxdb.Measurement = "kubernetes_pod_container"
+	// Node is node usage measurement written by telegraf's kubernetes input plugin
+	Node influxdb.Measurement = "kubernetes_node"
+)
@@ -0,0 +1,64 @@
+package metric
+
+import (
+	EntityInfluxMetric "github.com/containers-ai/alameda/datahub/pkg/entity/influxdb/metric"
+	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
+	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
+	"github.com/pkg/errors"
+)
+
+// ContainerRepository Repository to access container usage written by telegraf
+type ContainerRepository struct {
+	influxDB *InternalInflux.InfluxClient
+	database InternalInflux.Database
+}
+
+// NewContainerRepositoryWithConfig New container usage repository with influxdb configuration
+func NewContainerRepositoryWithConfig(influxDBCfg InternalInflux.Config, database string) *ContainerRepository {
+	return &ContainerRepository{
+		influxDB: InternalInflux.NewClient(&influxDBCfg),
+		database: InternalInflux.Database(database),
+	}
+}
+
+// ListMetricsByPodNamespacedName Provide container usage entities of pods matching namespace and pod name
+func (r *ContainerRepository) ListMetricsByPodNamespacedName(namespace string, podName string, options ...DBCommon.Option) ([]EntityInfluxMetric.ContainerEntity, error) {
+
+	scope.Debugf("influxdb-ListMetricsByPodNamespacedName input {%s, %s, %v}", namespace, podName, options)
+
+	entities := make([]EntityInfluxMetric.ContainerEntity, 0)
+
+	opt := DBCommon.NewDefaultOptions()
+	for _, option := range options {
+		option(&opt)
+	}
+
+	statement := newStatementWithOptions(opt)
+	statement.AppendWhereClauseDirectly(StorageInflux.BuildCondition(storage.ClusterCondition(opt.ClusterID)))
+	statement.AppendWhereClause(EntityInfluxMetric.ContainerNamespace, "=", namespace)
+	statement.AppendWhereClause(EntityInfluxMetric.ContainerPodName, "=", podName)
+
+	groupByTags := []string{
+		EntityInfluxMetric.ContainerNamespace,
+		EntityInfluxMetric.ContainerPodName,
+		EntityInfluxMetric.ContainerContainerName,
+	}
+	cmd := buildQueryCmd(Container, EntityInfluxMetric.ContainerFields, groupByTags, statement, opt)
+
+	results, err := r.influxDB.QueryDB(cmd, string(r.database))
+	if err != nil {
+		scope.Errorf("influxdb-ListMetricsByPodNamespacedName error %v", err)
+		return entities, errors.Wrap(err, "list container metrics by pod namespaced name failed")
+	}
+
+	rows := InternalInflux.PackMap(results)
+	for _, row := range rows {
+		for _, data := range row.Data {
+			entities = append(entities, EntityInfluxMetric.NewContainerEntityFromMap(data))
+		}
+	}
+
+	return entities, nil
+}
@@ -0,0 +1,76 @@
+package metric
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
+	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/pkg/utils/log"
+)
+
+var (
+	scope = log.RegisterScope("metric_db_measurements", "influxdb metric repository", 0)
+
+	// aggregateFunctions Map aggregate function over step to InfluxQL function
+	aggregateFunctions = map[DBCommon.AggregateFunction]string{
+		DBCommon.None:        "mean",
+		DBCommon.MaxOverTime: "max",
+	}
+)
+
+// buildQueryCmd Build InfluxQL selecting fields aggregated over step time and grouped by tags
+func buildQueryCmd(measurement InternalInflux.Measurement, fields []string, groupByTags []string, statement InternalInflux.Statement, opt DBCommon.Options) string {
+
+	var (
+		stepTime      = DBCommon.DefaultStepTime
+		aggregateFunc = aggregateFunctions[DBCommon.None]
+		selectedStr   = ""
+		groupByStr    = ""
+	)
+
+	if opt.StepTime != nil && *opt.StepTime > 0 {
+		stepTime = *opt.StepTime
+	}
+
+	if f, exist := aggregateFunctions[opt.AggregateOverTimeFunc]; exist {
+		aggregateFunc = f
+	}
+
+	for _, field := range fields {
+		selectedStr += fmt.Sprintf(`%s("%s") AS "%s",`, aggregateFunc, field, field)
+	}
+	selectedStr = strings.TrimSuffix(selectedStr, ",")
+
+	groupByStr = fmt.Sprintf("GROUP BY time(%ds)", int64(stepTime/time.Second))
+	for _, tag := range groupByTags {
+		groupByStr += fmt.Sprintf(`,"%s"`, tag)
+	}
+
+	return fmt.Sprintf(`SELECT %s FROM "%s" %s %s fill(none)`, selectedStr, measurement, statement.WhereClause, groupByStr)
+}
+
+// newStatementWithOptions Build statement with time condition, start time defaults to one hour before end time
+func newStatementWithOptions(opt DBCommon.Options) InternalInflux.Statement {
+
+	endTime := time.Now()
+	if opt.EndTime != nil {
+		endTime = *opt.EndTime
+	}
+
+	startTime := endTime.Add(-1 * time.Hour)
+	if opt.StartTime != nil {
+		startTime = *opt.StartTime
+	}
+
+	statement := InternalInflux.Statement{
+		QueryCondition: &DBCommon.QueryCondition{
+			StartTime: &startTime,
+			EndTime:   &endTime,
+		},
+	}
+	statement.AppendWhereClauseFromTimeCondition()
+
+	return statement
+}
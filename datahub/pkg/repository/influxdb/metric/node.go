@@ -0,0 +1,68 @@
+package metric
+
+import (
+	EntityInfluxMetric "github.com/containers-ai/alameda/datahub/pkg/entity/influxdb/metric"
+	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
+	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
+	"github.com/pkg/errors"
+)
+
+// NodeRepository Repository to access node usage written by telegraf
+type NodeRepository struct {
+	influxDB *InternalInflux.InfluxClient
+	database InternalInflux.Database
+}
+
+// NewNodeRepositoryWithConfig New node usage repository with influxdb configuration
+func NewNodeRepositoryWithConfig(influxDBCfg InternalInflux.Config, database string) *NodeRepository {
+	return &NodeRepository{
+		influxDB: InternalInflux.NewClient(&influxDBCfg),
+		database: InternalInflux.Database(database),
+	}
+}
+
+// ListMetricsByNodeNames Provide node usage entities of nodes, all nodes are listed if nodeNames is empty
+func (r *NodeRepository) ListMetricsByNodeNames(nodeNames []string, options ...DBCommon.Option) ([]EntityInfluxMetric.NodeEntity, error) {
+
+	scope.Debugf("influxdb-ListMetricsByNodeNames input {%v, %v}", nodeNames, options)
+
+	entities := make([]EntityInfluxMetric.NodeEntity, 0)
+
+	opt := DBCommon.NewDefaultOptions()
+	for _, option := range options {
+		option(&opt)
+	}
+
+	names := make([]string, 0, len(nodeNames))
+	for _, nodeName := range nodeNames {
+		if nodeName != "" {
+			names = append(names, nodeName)
+		}
+	}
+
+	statement := newStatementWithOptions(opt)
+	statement.AppendWhereClauseDirectly(StorageInflux.BuildCondition(storage.ClusterCondition(opt.ClusterID)))
+	statement.AppendWhereClauseByList(EntityInfluxMetric.NodeNodeName, "=", "OR", names)
+
+	groupByTags := []string{
+		EntityInfluxMetric.NodeNodeName,
+	}
+	cmd := buildQueryCmd(Node, EntityInfluxMetric.NodeFields, groupByTags, statement, opt)
+
+	results, err := r.influxDB.QueryDB(cmd, string(r.database))
+	if err != nil {
+		scope.Errorf("influxdb-ListMetricsByNodeNames error %v", err)
+		return entities, errors.Wrap(err, "list node metrics by node names failed")
+	}
+
+	rows := InternalInflux.PackMap(results)
+	for _, row := range rows {
+		for _, data := range row.Data {
+			entities = append(entities, EntityInfluxMetric.NewNodeEntityFromMap(data))
+		}
+	}
+
+	return entities, nil
+}
@@ -1,13 +1,15 @@
 package planning
 
 import (
-	"fmt"
 	EntityInfluxPlanning "github.com/containers-ai/alameda/datahub/pkg/entity/influxdb/planning"
 	EntityInfluxUtilsEnum "github.com/containers-ai/alameda/datahub/pkg/entity/influxdb/utils/enumconv"
 	RepoInflux "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb"
 	DatahubUtils "github.com/containers-ai/alameda/datahub/pkg/utils"
 	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
 	Log "github.com/containers-ai/alameda/pkg/utils/log"
 	DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/ptypes/timestamp"
@@ -24,18 +26,14 @@ var (
 
 // ContainerRepository is used to operate container measurement of planning database
 type ContainerRepository struct {
-	influxDB *InternalInflux.InfluxClient
+	storage storage.Engine
 }
 
 // NewContainerRepository creates the ContainerRepository instance
-func NewContainerRepository(influxDBCfg *InternalInflux.Config) *ContainerRepository {
+func NewContainerRepository(influxDBCfg *InternalInflux.Config, clusterID string) *ContainerRepository {
 	scope.Infof("influxdb-NewContainerRepository input %v", influxDBCfg)
 	return &ContainerRepository{
-		influxDB: &InternalInflux.InfluxClient{
-			Address:  influxDBCfg.Address,
-			Username: influxDBCfg.Username,
-			Password: influxDBCfg.Password,
-		},
+		storage: RepoInflux.NewClusterStorageEngine(influxDBCfg, clusterID),
 	}
 }
 
@@ -216,10 +214,13 @@ func (c *ContainerRepository) CreateContainerPlannings(in *DatahubV1alpha1.Creat
 			}
 		}
 	}
-	err := c.influxDB.WritePoints(points, InfluxClient.BatchPointsConfig{
-		Database: string(RepoInflux.Planning),
-	})
+	storagePoints, err := StorageInflux.NewPoints(points)
+	if err != nil {
+		scope.Errorf("influxdb-CreateContainerPlannings error %v", err)
+		return err
+	}
 
+	err = c.storage.WritePoints(string(RepoInflux.Planning), storagePoints)
 	if err != nil {
 		scope.Errorf("influxdb-CreateContainerPlannings error %v", err)
 		return err
@@ -235,10 +236,10 @@ func (c *ContainerRepository) ListContainerPlannings(in *DatahubV1alpha1.ListPod
 
 	podPlannings := make([]*DatahubV1alpha1.PodPlanning, 0)
 
-	influxdbStatement := InternalInflux.Statement{
-		Measurement:    Container,
-		QueryCondition: DBCommon.BuildQueryConditionV1(in.GetQueryCondition()),
-		GroupByTags:    []string{EntityInfluxPlanning.ContainerName, EntityInfluxPlanning.ContainerNamespace, EntityInfluxPlanning.ContainerPodName},
+	query := storage.Query{
+		Database:    string(RepoInflux.Planning),
+		Measurement: string(Container),
+		GroupByTags: []string{EntityInfluxPlanning.ContainerName, EntityInfluxPlanning.ContainerNamespace, EntityInfluxPlanning.ContainerPodName},
 	}
 
 	nameCol := ""
@@ -249,35 +250,27 @@ func (c *ContainerRepository) ListContainerPlannings(in *DatahubV1alpha1.ListPod
 		nameCol = string(EntityInfluxPlanning.ContainerTopControllerName)
 	case DatahubV1alpha1.Kind_DEPLOYMENTCONFIG:
 		nameCol = string(EntityInfluxPlanning.ContainerTopControllerName)
-	case DatahubV1alpha1.Kind_STATEFULSET:
+	case DatahubV1alpha1.Kind_STATEFULSET, DatahubKind.Kind_DAEMONSET, DatahubKind.Kind_REPLICASET, DatahubKind.Kind_JOB, DatahubKind.Kind_CRONJOB:
 		nameCol = string(EntityInfluxPlanning.ContainerTopControllerName)
 	default:
-		return podPlannings, errors.Errorf("no matching kind for Datahub Kind, received Kind: %s", DatahubV1alpha1.Kind_name[int32(kind)])
+		return podPlannings, errors.Errorf("no matching kind for Datahub Kind, received Kind: %s", DatahubKind.Name(kind))
 	}
-	influxdbStatement.AppendWhereClause(EntityInfluxPlanning.ContainerNamespace, "=", in.GetNamespacedName().GetNamespace())
-	influxdbStatement.AppendWhereClause(nameCol, "=", in.GetNamespacedName().GetName())
-
-	influxdbStatement.AppendWhereClauseFromTimeCondition()
+	query.AppendCondition(storage.EqualTo(EntityInfluxPlanning.ContainerNamespace, in.GetNamespacedName().GetNamespace()))
+	query.AppendCondition(storage.EqualTo(nameCol, in.GetNamespacedName().GetName()))
 
 	if kind != DatahubV1alpha1.Kind_POD {
-		kindConditionStr := fmt.Sprintf("\"%s\"='%s'", EntityInfluxPlanning.ContainerTopControllerKind, EntityInfluxUtilsEnum.KindDisp[kind])
-		influxdbStatement.AppendWhereClause(EntityInfluxPlanning.ContainerTopControllerKind, "=", kindConditionStr)
+		query.AppendCondition(storage.EqualTo(EntityInfluxPlanning.ContainerTopControllerKind, EntityInfluxUtilsEnum.KindDisp[kind]))
 	}
 
 	if granularity == 0 || granularity == 30 {
-		tempCondition := fmt.Sprintf("(\"%s\"='' OR \"%s\"='30')", EntityInfluxPlanning.ContainerGranularity, EntityInfluxPlanning.ContainerGranularity)
-		influxdbStatement.AppendWhereClauseDirectly(tempCondition)
+		query.AppendCondition(storage.EqualToAny(EntityInfluxPlanning.ContainerGranularity, []string{"", "30"}))
 	} else {
-		influxdbStatement.AppendWhereClause(EntityInfluxPlanning.ContainerGranularity, "=", strconv.FormatInt(granularity, 10))
+		query.AppendCondition(storage.EqualTo(EntityInfluxPlanning.ContainerGranularity, strconv.FormatInt(granularity, 10)))
 	}
 
-	influxdbStatement.SetOrderClauseFromQueryCondition()
-	influxdbStatement.SetLimitClauseFromQueryCondition()
-
-	cmd := influxdbStatement.BuildQueryCmd()
-	scope.Debugf(fmt.Sprintf("ListContainerPlannings: %s", cmd))
+	query.ApplyQueryCondition(DBCommon.BuildQueryConditionV1(in.GetQueryCondition()))
 
-	podPlannings, err := c.queryPlannings(cmd, granularity)
+	podPlannings, err := c.queryPlannings(query, granularity)
 	if err != nil {
 		scope.Errorf("influxdb-ListContainerPlannings error %v", err)
 		return podPlannings, err
@@ -287,16 +280,14 @@ func (c *ContainerRepository) ListContainerPlannings(in *DatahubV1alpha1.ListPod
 	return podPlannings, nil
 }
 
-func (c *ContainerRepository) queryPlannings(cmd string, granularity int64) ([]*DatahubV1alpha1.PodPlanning, error) {
+func (c *ContainerRepository) queryPlannings(query storage.Query, granularity int64) ([]*DatahubV1alpha1.PodPlanning, error) {
 	podPlannings := make([]*DatahubV1alpha1.PodPlanning, 0)
 
-	results, err := c.influxDB.QueryDB(cmd, string(RepoInflux.Planning))
+	rows, err := c.storage.Query(query)
 	if err != nil {
 		return podPlannings, err
 	}
 
-	rows := InternalInflux.PackMap(results)
-
 	for _, row := range rows {
 		for _, data := range row.Data {
 			podPlanning := &DatahubV1alpha1.PodPlanning{}
@@ -5,6 +5,9 @@ import (
 	RepoInflux "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb"
 	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
 	DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/timestamp"
@@ -14,17 +17,13 @@ import (
 )
 
 type ControllerRepository struct {
-	influxDB *InternalInflux.InfluxClient
+	storage storage.Engine
 }
 
-func NewControllerRepository(influxDBCfg *InternalInflux.Config) *ControllerRepository {
+func NewControllerRepository(influxDBCfg *InternalInflux.Config, clusterID string) *ControllerRepository {
 	scope.Infof("influxdb-NewControllerRepository input %v", influxDBCfg)
 	return &ControllerRepository{
-		influxDB: &InternalInflux.InfluxClient{
-			Address:  influxDBCfg.Address,
-			Username: influxDBCfg.Username,
-			Password: influxDBCfg.Password,
-		},
+		storage: RepoInflux.NewClusterStorageEngine(influxDBCfg, clusterID),
 	}
 }
 
@@ -49,7 +48,7 @@ func (c *ControllerRepository) CreateControllerPlannings(controllerPlannings []*
 				EntityInfluxPlanning.ControllerCurrentReplicas: planningSpec.GetCurrentReplicas(),
 				EntityInfluxPlanning.ControllerDesiredReplicas: planningSpec.GetDesiredReplicas(),
 				EntityInfluxPlanning.ControllerCreateTime:      planningSpec.GetCreateTime().GetSeconds(),
-				EntityInfluxPlanning.ControllerKind:            planningSpec.GetKind().String(),
+				EntityInfluxPlanning.ControllerKind:            DatahubKind.Name(planningSpec.GetKind()),
 
 				EntityInfluxPlanning.ControllerCurrentCPURequest: planningSpec.GetCurrentCpuRequests(),
 				EntityInfluxPlanning.ControllerCurrentMEMRequest: planningSpec.GetCurrentMemRequests(),
@@ -81,7 +80,7 @@ func (c *ControllerRepository) CreateControllerPlannings(controllerPlannings []*
 				EntityInfluxPlanning.ControllerCurrentReplicas: planningSpec.GetCurrentReplicas(),
 				EntityInfluxPlanning.ControllerDesiredReplicas: planningSpec.GetDesiredReplicas(),
 				EntityInfluxPlanning.ControllerCreateTime:      planningSpec.GetCreateTime().GetSeconds(),
-				EntityInfluxPlanning.ControllerKind:            planningSpec.GetKind().String(),
+				EntityInfluxPlanning.ControllerKind:            DatahubKind.Name(planningSpec.GetKind()),
 			}
 
 			pt, err := InfluxClient.NewPoint(string(Controller), tags, fields, time.Unix(planningSpec.GetTime().GetSeconds(), 0))
@@ -93,10 +92,13 @@ func (c *ControllerRepository) CreateControllerPlannings(controllerPlannings []*
 		}
 	}
 
-	err := c.influxDB.WritePoints(points, InfluxClient.BatchPointsConfig{
-		Database: string(RepoInflux.Planning),
-	})
+	storagePoints, err := StorageInflux.NewPoints(points)
+	if err != nil {
+		scope.Error(err.Error())
+		return err
+	}
 
+	err = c.storage.WritePoints(string(RepoInflux.Planning), storagePoints)
 	if err != nil {
 		scope.Error(err.Error())
 		return err
@@ -112,42 +114,37 @@ func (c *ControllerRepository) ListControllerPlannings(in *DatahubV1alpha1.ListC
 	ctlPlanningType := in.GetCtlPlanningType()
 
 	scope.Infof("influxdb-ListControllerPlannings input namespace %s, name %s, ctlplanningtype %d", namespace, name, ctlPlanningType)
-	influxdbStatement := InternalInflux.Statement{
-		Measurement:    Controller,
-		QueryCondition: DBCommon.BuildQueryConditionV1(in.GetQueryCondition()),
+	query := storage.Query{
+		Database:    string(RepoInflux.Planning),
+		Measurement: string(Controller),
 	}
 
-	influxdbStatement.AppendWhereClause(EntityInfluxPlanning.ControllerNamespace, "=", namespace)
-	influxdbStatement.AppendWhereClause(EntityInfluxPlanning.ControllerName, "=", name)
-	influxdbStatement.AppendWhereClauseFromTimeCondition()
-	influxdbStatement.SetOrderClauseFromQueryCondition()
-	influxdbStatement.SetLimitClauseFromQueryCondition()
+	query.AppendCondition(storage.EqualTo(EntityInfluxPlanning.ControllerNamespace, namespace))
+	query.AppendCondition(storage.EqualTo(EntityInfluxPlanning.ControllerName, name))
+	query.ApplyQueryCondition(DBCommon.BuildQueryConditionV1(in.GetQueryCondition()))
 
 	if ctlPlanningType != DatahubV1alpha1.ControllerPlanningType_CPT_UNDEFINED {
-		influxdbStatement.AppendWhereClause(EntityInfluxPlanning.ControllerType, "=", ctlPlanningType.String())
+		query.AppendCondition(storage.EqualTo(EntityInfluxPlanning.ControllerType, ctlPlanningType.String()))
 	}
 
-	cmd := influxdbStatement.BuildQueryCmd()
-
-	results, err := c.influxDB.QueryDB(cmd, string(RepoInflux.Planning))
+	rows, err := c.storage.Query(query)
 	if err != nil {
 		scope.Errorf("influxdb-ListControllerPlannings error %v", err)
 		return make([]*DatahubV1alpha1.ControllerPlanning, 0), err
 	}
 
-	influxdbRows := InternalInflux.PackMap(results)
-	recommendations := c.getControllersPlanningsFromInfluxRows(influxdbRows)
+	recommendations := c.getControllersPlanningsFromRows(rows)
 
 	scope.Infof("influxdb-ListControllerPlannings return %d %v", len(recommendations), recommendations)
 
 	return recommendations, nil
 }
 
-func (c *ControllerRepository) getControllersPlanningsFromInfluxRows(rows []*InternalInflux.InfluxRow) []*DatahubV1alpha1.ControllerPlanning {
+func (c *ControllerRepository) getControllersPlanningsFromRows(rows []*storage.Row) []*DatahubV1alpha1.ControllerPlanning {
 	plannings := make([]*DatahubV1alpha1.ControllerPlanning, 0)
 
-	for _, influxdbRow := range rows {
-		for _, data := range influxdbRow.Data {
+	for _, row := range rows {
+		for _, data := range row.Data {
 			currentReplicas, _ := strconv.ParseInt(data[EntityInfluxPlanning.ControllerCurrentReplicas], 10, 64)
 			desiredReplicas, _ := strconv.ParseInt(data[EntityInfluxPlanning.ControllerDesiredReplicas], 10, 64)
 			createTime, _ := strconv.ParseInt(data[EntityInfluxPlanning.ControllerCreateTime], 10, 64)
@@ -172,8 +169,8 @@ func (c *ControllerRepository) getControllersPlanningsFromInfluxRows(rows []*Int
 
 			var planningKind DatahubV1alpha1.Kind
 			if tempKind, exist := data[EntityInfluxPlanning.ControllerKind]; exist {
-				if value, ok := DatahubV1alpha1.Kind_value[tempKind]; ok {
-					planningKind = DatahubV1alpha1.Kind(value)
+				if value, ok := DatahubKind.Value(tempKind); ok {
+					planningKind = value
 				}
 			}
 
@@ -0,0 +1,191 @@
+package prediction
+
+import (
+	EntityInfluxPredictionAccuracy "github.com/containers-ai/alameda/datahub/pkg/entity/influxdb/prediction/accuracy"
+	Metric "github.com/containers-ai/alameda/datahub/pkg/metric"
+	RepoInflux "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb"
+	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
+	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
+	DatahubAccuracy "github.com/containers-ai/alameda/pkg/framework/datahub/accuracy"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/ptypes"
+	InfluxClient "github.com/influxdata/influxdb/client/v2"
+	"github.com/pkg/errors"
+	"strconv"
+	"time"
+)
+
+// AccuracyRepository Repository to access accuracy scores of predictions
+type AccuracyRepository struct {
+	storage storage.Engine
+}
+
+// NewAccuracyRepositoryWithConfig New accuracy repository with influxDB configuration
+func NewAccuracyRepositoryWithConfig(influxDBCfg InternalInflux.Config, clusterID string) *AccuracyRepository {
+	scope.Infof("influxdb-NewAccuracyRepositoryWithConfig input %v", influxDBCfg)
+	return &AccuracyRepository{
+		storage: RepoInflux.NewClusterStorageEngine(&influxDBCfg, clusterID),
+	}
+}
+
+// CreatePredictionAccuracies Write accuracy scores of predictions
+func (r *AccuracyRepository) CreatePredictionAccuracies(accuracies []*DatahubAccuracy.PredictionAccuracy) error {
+	scope.Infof("influxdb-CreatePredictionAccuracies input %d %v", len(accuracies), accuracies)
+
+	points := make([]*InfluxClient.Point, 0)
+	for _, accuracy := range accuracies {
+		metricType, ok := accuracyMetricTypes[accuracy.GetMetricType()]
+		if !ok {
+			return errors.Errorf("no corresponding metric type of %s", accuracy.GetMetricType())
+		}
+
+		granularity := accuracy.GetGranularity()
+		if granularity == 0 {
+			granularity = 30
+		}
+
+		tags := map[string]string{
+			EntityInfluxPredictionAccuracy.UnitType:    accuracy.GetUnitType(),
+			EntityInfluxPredictionAccuracy.Metric:      metricType,
+			EntityInfluxPredictionAccuracy.Granularity: strconv.FormatInt(granularity, 10),
+		}
+		switch accuracy.GetUnitType() {
+		case DatahubAccuracy.UnitTypePod:
+			tags[EntityInfluxPredictionAccuracy.Namespace] = accuracy.GetNamespacedName().GetNamespace()
+			tags[EntityInfluxPredictionAccuracy.PodName] = accuracy.GetNamespacedName().GetName()
+			tags[EntityInfluxPredictionAccuracy.ContainerName] = accuracy.GetContainerName()
+		case DatahubAccuracy.UnitTypeNode:
+			tags[EntityInfluxPredictionAccuracy.NodeName] = accuracy.GetNodeName()
+		default:
+			return errors.Errorf("unknown unit type %s of prediction accuracy", accuracy.GetUnitType())
+		}
+
+		fields := map[string]interface{}{
+			EntityInfluxPredictionAccuracy.MAPE:        accuracy.GetMape(),
+			EntityInfluxPredictionAccuracy.RMSE:        accuracy.GetRmse(),
+			EntityInfluxPredictionAccuracy.SMAPE:       accuracy.GetSmape(),
+			EntityInfluxPredictionAccuracy.SampleCount: int64(accuracy.GetSampleCount()),
+		}
+
+		t := time.Now()
+		if accuracy.GetTime() != nil {
+			t = time.Unix(accuracy.GetTime().GetSeconds(), 0)
+		}
+
+		point, err := InfluxClient.NewPoint(string(Accuracy), tags, fields, t)
+		if err != nil {
+			return errors.Wrap(err, "new influxdb data point failed")
+		}
+		points = append(points, point)
+	}
+
+	storagePoints, err := StorageInflux.NewPoints(points)
+	if err != nil {
+		scope.Errorf("influxdb-CreatePredictionAccuracies error %v", err)
+		return errors.Wrap(err, "create prediction accuracies failed")
+	}
+
+	err = r.storage.WritePoints(string(RepoInflux.Prediction), storagePoints)
+	if err != nil {
+		scope.Errorf("influxdb-CreatePredictionAccuracies error %v", err)
+		return errors.Wrap(err, "create prediction accuracies failed")
+	}
+
+	return nil
+}
+
+// ListPredictionAccuracies List accuracy scores of predictions
+func (r *AccuracyRepository) ListPredictionAccuracies(in *DatahubAccuracy.ListPredictionAccuraciesRequest) ([]*DatahubAccuracy.PredictionAccuracy, error) {
+	scope.Infof("influxdb-ListPredictionAccuracies input %v", in)
+
+	query := storage.Query{
+		Database:    string(RepoInflux.Prediction),
+		Measurement: string(Accuracy),
+		GroupByTags: EntityInfluxPredictionAccuracy.Tags,
+	}
+	query.AppendCondition(storage.EqualTo(EntityInfluxPredictionAccuracy.UnitType, in.GetUnitType()))
+	query.AppendCondition(storage.EqualTo(EntityInfluxPredictionAccuracy.Namespace, in.GetNamespacedName().GetNamespace()))
+	query.AppendCondition(storage.EqualTo(EntityInfluxPredictionAccuracy.PodName, in.GetNamespacedName().GetName()))
+	query.AppendCondition(storage.EqualToAny(EntityInfluxPredictionAccuracy.NodeName, in.GetNodeNames()))
+
+	metricTypes := make([]string, 0)
+	for _, metricType := range in.GetMetricTypes() {
+		if value, ok := accuracyMetricTypes[metricType]; ok {
+			metricTypes = append(metricTypes, value)
+		}
+	}
+	query.AppendCondition(storage.EqualToAny(EntityInfluxPredictionAccuracy.Metric, metricTypes))
+
+	if granularity := in.GetGranularity(); granularity == 0 || granularity == 30 {
+		query.AppendCondition(storage.EqualTo(EntityInfluxPredictionAccuracy.Granularity, "30"))
+	} else {
+		query.AppendCondition(storage.EqualTo(EntityInfluxPredictionAccuracy.Granularity, strconv.FormatInt(granularity, 10)))
+	}
+
+	query.ApplyQueryCondition(DBCommon.BuildQueryConditionV1(in.GetQueryCondition()))
+
+	rows, err := r.storage.Query(query)
+	if err != nil {
+		scope.Errorf("influxdb-ListPredictionAccuracies error %v", err)
+		return make([]*DatahubAccuracy.PredictionAccuracy, 0), errors.Wrap(err, "list prediction accuracies failed")
+	}
+
+	accuracies := r.getPredictionAccuraciesFromRows(rows)
+
+	scope.Infof("influxdb-ListPredictionAccuracies return %d %v", len(accuracies), accuracies)
+	return accuracies, nil
+}
+
+func (r *AccuracyRepository) getPredictionAccuraciesFromRows(rows []*storage.Row) []*DatahubAccuracy.PredictionAccuracy {
+	accuracies := make([]*DatahubAccuracy.PredictionAccuracy, 0)
+	for _, row := range rows {
+		for _, data := range row.Data {
+			t, _ := time.Parse(time.RFC3339Nano, data[EntityInfluxPredictionAccuracy.Time])
+			tempTime, _ := ptypes.TimestampProto(t)
+
+			granularity, _ := strconv.ParseInt(data[EntityInfluxPredictionAccuracy.Granularity], 10, 64)
+			mape, _ := strconv.ParseFloat(data[EntityInfluxPredictionAccuracy.MAPE], 64)
+			rmse, _ := strconv.ParseFloat(data[EntityInfluxPredictionAccuracy.RMSE], 64)
+			smape, _ := strconv.ParseFloat(data[EntityInfluxPredictionAccuracy.SMAPE], 64)
+			sampleCount, _ := strconv.ParseInt(data[EntityInfluxPredictionAccuracy.SampleCount], 10, 32)
+
+			var metricType datahub_v1alpha1.MetricType
+			for key, value := range accuracyMetricTypes {
+				if value == data[EntityInfluxPredictionAccuracy.Metric] {
+					metricType = key
+				}
+			}
+
+			accuracy := &DatahubAccuracy.PredictionAccuracy{
+				UnitType:    data[EntityInfluxPredictionAccuracy.UnitType],
+				MetricType:  metricType,
+				Granularity: granularity,
+				Time:        tempTime,
+				Mape:        mape,
+				Rmse:        rmse,
+				Smape:       smape,
+				SampleCount: int32(sampleCount),
+			}
+			if accuracy.UnitType == DatahubAccuracy.UnitTypePod {
+				accuracy.NamespacedName = &datahub_v1alpha1.NamespacedName{
+					Namespace: data[EntityInfluxPredictionAccuracy.Namespace],
+					Name:      data[EntityInfluxPredictionAccuracy.PodName],
+				}
+				accuracy.ContainerName = data[EntityInfluxPredictionAccuracy.ContainerName]
+			} else {
+				accuracy.NodeName = data[EntityInfluxPredictionAccuracy.NodeName]
+			}
+
+			accuracies = append(accuracies, accuracy)
+		}
+	}
+
+	return accuracies
+}
+
+var accuracyMetricTypes = map[datahub_v1alpha1.MetricType]string{
+	datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE: Metric.TypeContainerCPUUsageSecondsPercentage,
+	datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES:           Metric.TypeContainerMemoryUsageBytes,
+}
@@ -9,4 +9,6 @@ const (
 	Node influxdb.Measurement = "node"
 	// Container is container measurement
 	Container influxdb.Measurement = "container"
+	// Accuracy is measurement of accuracy scores of predictions
+	Accuracy influxdb.Measurement = "accuracy"
 )
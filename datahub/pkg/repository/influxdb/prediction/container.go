@@ -1,7 +1,6 @@
 package prediction
 
 import (
-	"fmt"
 	DaoPrediction "github.com/containers-ai/alameda/datahub/pkg/dao/prediction"
 	EntityInfluxPredictionContainer "github.com/containers-ai/alameda/datahub/pkg/entity/influxdb/prediction/container"
 	Metric "github.com/containers-ai/alameda/datahub/pkg/metric"
@@ -9,6 +8,8 @@ import (
 	Utils "github.com/containers-ai/alameda/datahub/pkg/utils"
 	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/ptypes"
 	InfluxClient "github.com/influxdata/influxdb/client/v2"
@@ -20,18 +21,14 @@ import (
 
 // ContainerRepository Repository to access containers' prediction data
 type ContainerRepository struct {
-	influxDB *InternalInflux.InfluxClient
+	storage storage.Engine
 }
 
 // NewContainerRepositoryWithConfig New container repository with influxDB configuration
-func NewContainerRepositoryWithConfig(influxDBCfg InternalInflux.Config) *ContainerRepository {
+func NewContainerRepositoryWithConfig(influxDBCfg InternalInflux.Config, clusterID string) *ContainerRepository {
 	scope.Infof("influxdb-NewContainerRepositoryWithConfig input %v", influxDBCfg)
 	return &ContainerRepository{
-		influxDB: &InternalInflux.InfluxClient{
-			Address:  influxDBCfg.Address,
-			Username: influxDBCfg.Username,
-			Password: influxDBCfg.Password,
-		},
+		storage: RepoInflux.NewClusterStorageEngine(&influxDBCfg, clusterID),
 	}
 }
 
@@ -52,9 +49,13 @@ func (r *ContainerRepository) CreateContainerPrediction(in *datahub_v1alpha1.Cre
 		}
 	}
 
-	err := r.influxDB.WritePoints(points, InfluxClient.BatchPointsConfig{
-		Database: string(RepoInflux.Prediction),
-	})
+	storagePoints, err := StorageInflux.NewPoints(points)
+	if err != nil {
+		scope.Errorf("influxdb-CreateContainerPrediction error %v", err)
+		return errors.Wrap(err, "create container prediction failed")
+	}
+
+	err = r.storage.WritePoints(string(RepoInflux.Prediction), storagePoints)
 	if err != nil {
 		scope.Errorf("influxdb-CreateContainerPrediction error %v", err)
 		return errors.Wrap(err, "create container prediction failed")
@@ -115,8 +116,6 @@ func (r *ContainerRepository) appendMetricDataToPoints(kind Metric.ContainerMetr
 // ListContainerPredictionsByRequest list containers' prediction from influxDB
 func (r *ContainerRepository) ListContainerPredictionsByRequest(request DaoPrediction.ListPodPredictionsRequest) ([]*datahub_v1alpha1.PodPrediction, error) {
 	scope.Infof("influxdb-ListContainerPredictionsByRequest input %v", request)
-	whereClause := r.buildInfluxQLWhereClauseFromRequest(request)
-
 	queryCondition := DBCommon.QueryCondition{
 		StartTime:      request.QueryCondition.StartTime,
 		EndTime:        request.QueryCondition.EndTime,
@@ -125,31 +124,26 @@ func (r *ContainerRepository) ListContainerPredictionsByRequest(request DaoPredi
 		Limit:          request.QueryCondition.Limit,
 	}
 
-	influxdbStatement := InternalInflux.Statement{
-		QueryCondition: &queryCondition,
-		Measurement:    Container,
-		WhereClause:    whereClause,
-		GroupByTags:    []string{EntityInfluxPredictionContainer.Namespace, EntityInfluxPredictionContainer.PodName, EntityInfluxPredictionContainer.Name, EntityInfluxPredictionContainer.Metric, EntityInfluxPredictionContainer.Kind, EntityInfluxPredictionContainer.Granularity},
+	query := storage.Query{
+		Database:    string(RepoInflux.Prediction),
+		Measurement: string(Container),
+		Condition:   r.buildConditionFromRequest(request),
+		GroupByTags: []string{EntityInfluxPredictionContainer.Namespace, EntityInfluxPredictionContainer.PodName, EntityInfluxPredictionContainer.Name, EntityInfluxPredictionContainer.Metric, EntityInfluxPredictionContainer.Kind, EntityInfluxPredictionContainer.Granularity},
 	}
+	query.ApplyQueryCondition(&queryCondition)
 
-	influxdbStatement.AppendWhereClauseFromTimeCondition()
-	influxdbStatement.SetLimitClauseFromQueryCondition()
-	influxdbStatement.SetOrderClauseFromQueryCondition()
-	cmd := influxdbStatement.BuildQueryCmd()
-
-	results, err := r.influxDB.QueryDB(cmd, string(RepoInflux.Prediction))
+	rows, err := r.storage.Query(query)
 	if err != nil {
 		scope.Errorf("influxdb-ListContainerPredictionsByRequest error %v", err)
 		return []*datahub_v1alpha1.PodPrediction{}, errors.Wrap(err, "list container prediction failed")
 	}
 
-	rows := InternalInflux.PackMap(results)
-	podPredictions := r.getPodPredictionsFromInfluxRows(rows)
+	podPredictions := r.getPodPredictionsFromRows(rows)
 	scope.Infof("influxdb-ListContainerPredictionsByRequest return %d %v", len(podPredictions), podPredictions)
 	return podPredictions, nil
 }
 
-func (r *ContainerRepository) getPodPredictionsFromInfluxRows(rows []*InternalInflux.InfluxRow) []*datahub_v1alpha1.PodPrediction {
+func (r *ContainerRepository) getPodPredictionsFromRows(rows []*storage.Row) []*datahub_v1alpha1.PodPrediction {
 	podMap := map[string]*datahub_v1alpha1.PodPrediction{}
 	podContainerMap := map[string]*datahub_v1alpha1.ContainerPrediction{}
 	podContainerKindMetricMap := map[string]*datahub_v1alpha1.MetricData{}
@@ -251,41 +245,17 @@ func (r *ContainerRepository) getPodPredictionsFromInfluxRows(rows []*InternalIn
 	return podList
 }
 
-func (r *ContainerRepository) buildInfluxQLWhereClauseFromRequest(request DaoPrediction.ListPodPredictionsRequest) string {
-
-	var (
-		whereClause string
-		conditions  string
-	)
+func (r *ContainerRepository) buildConditionFromRequest(request DaoPrediction.ListPodPredictionsRequest) storage.Condition {
 
-	if request.Namespace != "" {
-		conditions += fmt.Sprintf(`"%s"='%s'`, EntityInfluxPredictionContainer.Namespace, request.Namespace)
-	}
-	if request.PodName != "" {
-		if conditions != "" {
-			conditions += fmt.Sprintf(` AND "%s"='%s'`, EntityInfluxPredictionContainer.PodName, request.PodName)
-		} else {
-			conditions += fmt.Sprintf(`"%s"='%s'`, EntityInfluxPredictionContainer.PodName, request.PodName)
-		}
-	}
-
-	if conditions != "" {
-		if request.Granularity == 30 {
-			conditions += fmt.Sprintf(` AND ("%s"='' OR "%s"='%d')`, EntityInfluxPredictionContainer.Granularity, EntityInfluxPredictionContainer.Granularity, request.Granularity)
-		} else {
-			conditions += fmt.Sprintf(` AND "%s"='%d'`, EntityInfluxPredictionContainer.Granularity, request.Granularity)
-		}
-	} else {
-		if request.Granularity == 30 {
-			conditions += fmt.Sprintf(`("%s"='' OR "%s"='%d')`, EntityInfluxPredictionContainer.Granularity, EntityInfluxPredictionContainer.Granularity, request.Granularity)
-		} else {
-			conditions += fmt.Sprintf(`"%s"='%d'`, EntityInfluxPredictionContainer.Granularity, request.Granularity)
-		}
+	granularity := strconv.FormatInt(request.Granularity, 10)
+	granularityCondition := storage.EqualTo(EntityInfluxPredictionContainer.Granularity, granularity)
+	if request.Granularity == 30 {
+		granularityCondition = storage.EqualToAny(EntityInfluxPredictionContainer.Granularity, []string{"", granularity})
 	}
 
-	if conditions != "" {
-		whereClause = fmt.Sprintf("WHERE %s", conditions)
-	}
-
-	return whereClause
+	return storage.AllOf(
+		storage.EqualTo(EntityInfluxPredictionContainer.Namespace, request.Namespace),
+		storage.EqualTo(EntityInfluxPredictionContainer.PodName, request.PodName),
+		granularityCondition,
+	)
 }
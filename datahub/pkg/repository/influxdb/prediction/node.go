@@ -1,7 +1,6 @@
 package prediction
 
 import (
-	"fmt"
 	DaoPrediction "github.com/containers-ai/alameda/datahub/pkg/dao/prediction"
 	EntityInfluxPredictionNode "github.com/containers-ai/alameda/datahub/pkg/entity/influxdb/prediction/node"
 	Metric "github.com/containers-ai/alameda/datahub/pkg/metric"
@@ -9,6 +8,8 @@ import (
 	Utils "github.com/containers-ai/alameda/datahub/pkg/utils"
 	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/ptypes"
@@ -24,17 +25,13 @@ var (
 )
 
 type NodeRepository struct {
-	influxDB *InternalInflux.InfluxClient
+	storage storage.Engine
 }
 
-func NewNodeRepositoryWithConfig(influxDBCfg InternalInflux.Config) *NodeRepository {
+func NewNodeRepositoryWithConfig(influxDBCfg InternalInflux.Config, clusterID string) *NodeRepository {
 	scope.Infof("influxdb-NewNodeRepositoryWithConfig input %v", influxDBCfg)
 	return &NodeRepository{
-		influxDB: &InternalInflux.InfluxClient{
-			Address:  influxDBCfg.Address,
-			Username: influxDBCfg.Username,
-			Password: influxDBCfg.Password,
-		},
+		storage: RepoInflux.NewClusterStorageEngine(&influxDBCfg, clusterID),
 	}
 }
 
@@ -52,9 +49,13 @@ func (r *NodeRepository) CreateNodePrediction(in *datahub_v1alpha1.CreateNodePre
 		r.appendMetricDataToPoints(Metric.NodeMetricKindLowerbound, nodePrediction.GetPredictedLowerboundData(), &points, nodeName, isScheduled)
 	}
 
-	err := r.influxDB.WritePoints(points, InfluxClient.BatchPointsConfig{
-		Database: string(RepoInflux.Prediction),
-	})
+	storagePoints, err := StorageInflux.NewPoints(points)
+	if err != nil {
+		scope.Errorf("influxdb-CreateNodePrediction error %v", err)
+		return errors.Wrap(err, "create node prediction failed")
+	}
+
+	err = r.storage.WritePoints(string(RepoInflux.Prediction), storagePoints)
 	if err != nil {
 		scope.Errorf("influxdb-CreateNodePrediction error %v", err)
 		return errors.Wrap(err, "create node prediction failed")
@@ -115,8 +116,6 @@ func (r *NodeRepository) ListNodePredictionsByRequest(request DaoPrediction.List
 
 	scope.Infof("influxdb-ListNodePredictionsByRequest input %v", request)
 
-	whereClause := r.buildInfluxQLWhereClauseFromRequest(request)
-
 	queryCondition := DBCommon.QueryCondition{
 		StartTime:      request.QueryCondition.StartTime,
 		EndTime:        request.QueryCondition.EndTime,
@@ -125,33 +124,28 @@ func (r *NodeRepository) ListNodePredictionsByRequest(request DaoPrediction.List
 		Limit:          request.QueryCondition.Limit,
 	}
 
-	influxdbStatement := InternalInflux.Statement{
-		QueryCondition: &queryCondition,
-		Measurement:    Node,
-		WhereClause:    whereClause,
+	query := storage.Query{
+		Database:    string(RepoInflux.Prediction),
+		Measurement: string(Node),
+		Condition:   r.buildConditionFromRequest(request),
 		//GroupByTags: []string{node_entity.Name, node_entity.Metric, node_entity.IsScheduled, node_entity.Kind, node_entity.Granularity},
 		GroupByTags: []string{EntityInfluxPredictionNode.Name, EntityInfluxPredictionNode.Metric, EntityInfluxPredictionNode.IsScheduled, EntityInfluxPredictionNode.Kind},
 	}
+	query.ApplyQueryCondition(&queryCondition)
 
-	influxdbStatement.AppendWhereClauseFromTimeCondition()
-	influxdbStatement.SetLimitClauseFromQueryCondition()
-	influxdbStatement.SetOrderClauseFromQueryCondition()
-	cmd := influxdbStatement.BuildQueryCmd()
-
-	results, err := r.influxDB.QueryDB(cmd, string(RepoInflux.Prediction))
+	rows, err := r.storage.Query(query)
 	if err != nil {
 		scope.Errorf("influxdb-ListNodePredictionsByRequest error %v", err)
 		return []*datahub_v1alpha1.NodePrediction{}, errors.Wrap(err, "list node prediction failed")
 	}
 
-	rows := InternalInflux.PackMap(results)
-	nodePredictions := r.getNodePredictionsFromInfluxRows(rows)
+	nodePredictions := r.getNodePredictionsFromRows(rows)
 
 	scope.Infof("influxdb-ListNodePredictionsByRequest return %d %v", len(nodePredictions), nodePredictions)
 	return nodePredictions, nil
 }
 
-func (r *NodeRepository) getNodePredictionsFromInfluxRows(rows []*InternalInflux.InfluxRow) []*datahub_v1alpha1.NodePrediction {
+func (r *NodeRepository) getNodePredictionsFromRows(rows []*storage.Row) []*datahub_v1alpha1.NodePrediction {
 	nodeMap := map[string]*datahub_v1alpha1.NodePrediction{}
 	nodeMetricKindMap := map[string]*datahub_v1alpha1.MetricData{}
 	nodeMetricKindSampleMap := map[string][]*datahub_v1alpha1.Sample{}
@@ -235,36 +229,16 @@ func (r *NodeRepository) getNodePredictionsFromInfluxRows(rows []*InternalInflux
 	return nodeList
 }
 
-func (r *NodeRepository) buildInfluxQLWhereClauseFromRequest(request DaoPrediction.ListNodePredictionsRequest) string {
+func (r *NodeRepository) buildConditionFromRequest(request DaoPrediction.ListNodePredictionsRequest) storage.Condition {
 
-	var (
-		whereClause string
-		conditions  string
-	)
-
-	for _, nodeName := range request.NodeNames {
-		conditions += fmt.Sprintf(`"%s" = '%s' or `, EntityInfluxPredictionNode.Name, nodeName)
+	granularity := strconv.FormatInt(request.Granularity, 10)
+	granularityCondition := storage.EqualTo(EntityInfluxPredictionNode.Granularity, granularity)
+	if request.Granularity == 30 {
+		granularityCondition = storage.EqualToAny(EntityInfluxPredictionNode.Granularity, []string{"", granularity})
 	}
 
-	conditions = strings.TrimSuffix(conditions, "or ")
-
-	if conditions != "" {
-		if request.Granularity == 30 {
-			conditions += fmt.Sprintf(` AND ("%s"='' OR "%s"='%d')`, EntityInfluxPredictionNode.Granularity, EntityInfluxPredictionNode.Granularity, request.Granularity)
-		} else {
-			conditions += fmt.Sprintf(` AND "%s"='%d'`, EntityInfluxPredictionNode.Granularity, request.Granularity)
-		}
-	} else {
-		if request.Granularity == 30 {
-			conditions += fmt.Sprintf(`("%s"='' OR "%s"='%d')`, EntityInfluxPredictionNode.Granularity, EntityInfluxPredictionNode.Granularity, request.Granularity)
-		} else {
-			conditions += fmt.Sprintf(`"%s"='%d'`, EntityInfluxPredictionNode.Granularity, request.Granularity)
-		}
-	}
-
-	if conditions != "" {
-		whereClause = fmt.Sprintf("where %s", conditions)
-	}
-
-	return whereClause
+	return storage.AllOf(
+		storage.EqualToAny(EntityInfluxPredictionNode.Name, request.NodeNames),
+		granularityCondition,
+	)
 }
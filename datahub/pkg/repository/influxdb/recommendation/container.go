@@ -1,19 +1,22 @@
 package recommendation
 
 import (
-	"fmt"
 	EntityInfluxRecommend "github.com/containers-ai/alameda/datahub/pkg/entity/influxdb/recommendation"
 	"github.com/containers-ai/alameda/datahub/pkg/entity/influxdb/utils/enumconv"
 	RepoInflux "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb"
 	Utils "github.com/containers-ai/alameda/datahub/pkg/utils"
 	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	InfluxClient "github.com/influxdata/influxdb/client/v2"
 	"github.com/pkg/errors"
 	"math"
+	"sort"
 	"strconv"
 	"time"
 )
@@ -24,18 +27,14 @@ var (
 
 // ContainerRepository is used to operate node measurement of recommendation database
 type ContainerRepository struct {
-	influxDB *InternalInflux.InfluxClient
+	storage storage.Engine
 }
 
 // NewContainerRepository creates the ContainerRepository instance
-func NewContainerRepository(influxDBCfg *InternalInflux.Config) *ContainerRepository {
+func NewContainerRepository(influxDBCfg *InternalInflux.Config, clusterID string) *ContainerRepository {
 	scope.Infof("influxdb-NewContainerRepository input %v", influxDBCfg)
 	return &ContainerRepository{
-		influxDB: &InternalInflux.InfluxClient{
-			Address:  influxDBCfg.Address,
-			Username: influxDBCfg.Username,
-			Password: influxDBCfg.Password,
-		},
+		storage: RepoInflux.NewClusterStorageEngine(influxDBCfg, clusterID),
 	}
 }
 
@@ -215,10 +214,13 @@ func (c *ContainerRepository) CreateContainerRecommendations(in *datahub_v1alpha
 			}
 		}
 	}
-	err := c.influxDB.WritePoints(points, InfluxClient.BatchPointsConfig{
-		Database: string(RepoInflux.Recommendation),
-	})
+	storagePoints, err := StorageInflux.NewPoints(points)
+	if err != nil {
+		scope.Errorf("influxdb-CreateContainerRecommendations error %v", err)
+		return err
+	}
 
+	err = c.storage.WritePoints(string(RepoInflux.Recommendation), storagePoints)
 	if err != nil {
 		scope.Errorf("influxdb-CreateContainerRecommendations error %v", err)
 		return err
@@ -235,10 +237,10 @@ func (c *ContainerRepository) ListContainerRecommendations(in *datahub_v1alpha1.
 
 	podRecommendations := make([]*datahub_v1alpha1.PodRecommendation, 0)
 
-	influxdbStatement := InternalInflux.Statement{
-		Measurement:    Container,
-		QueryCondition: DBCommon.BuildQueryConditionV1(in.GetQueryCondition()),
-		GroupByTags:    []string{EntityInfluxRecommend.ContainerName, EntityInfluxRecommend.ContainerNamespace, EntityInfluxRecommend.ContainerPodName},
+	query := storage.Query{
+		Database:    string(RepoInflux.Recommendation),
+		Measurement: string(Container),
+		GroupByTags: []string{EntityInfluxRecommend.ContainerName, EntityInfluxRecommend.ContainerNamespace, EntityInfluxRecommend.ContainerPodName},
 	}
 
 	nameCol := ""
@@ -249,35 +251,22 @@ func (c *ContainerRepository) ListContainerRecommendations(in *datahub_v1alpha1.
 		nameCol = string(EntityInfluxRecommend.ContainerTopControllerName)
 	case datahub_v1alpha1.Kind_DEPLOYMENTCONFIG:
 		nameCol = string(EntityInfluxRecommend.ContainerTopControllerName)
-	case datahub_v1alpha1.Kind_STATEFULSET:
+	case datahub_v1alpha1.Kind_STATEFULSET, DatahubKind.Kind_DAEMONSET, DatahubKind.Kind_REPLICASET, DatahubKind.Kind_JOB, DatahubKind.Kind_CRONJOB:
 		nameCol = string(EntityInfluxRecommend.ContainerTopControllerName)
 	default:
-		return podRecommendations, errors.Errorf("no matching kind for Datahub Kind, received Kind: %s", datahub_v1alpha1.Kind_name[int32(kind)])
+		return podRecommendations, errors.Errorf("no matching kind for Datahub Kind, received Kind: %s", DatahubKind.Name(kind))
 	}
-	influxdbStatement.AppendWhereClause(EntityInfluxRecommend.ContainerNamespace, "=", in.GetNamespacedName().GetNamespace())
-	influxdbStatement.AppendWhereClause(nameCol, "=", in.GetNamespacedName().GetName())
-
-	influxdbStatement.AppendWhereClauseFromTimeCondition()
+	query.AppendCondition(storage.EqualTo(EntityInfluxRecommend.ContainerNamespace, in.GetNamespacedName().GetNamespace()))
+	query.AppendCondition(storage.EqualTo(nameCol, in.GetNamespacedName().GetName()))
 
 	if kind != datahub_v1alpha1.Kind_POD {
-		kindConditionStr := fmt.Sprintf("\"%s\"='%s'", EntityInfluxRecommend.ContainerTopControllerKind, enumconv.KindDisp[kind])
-		influxdbStatement.AppendWhereClause(EntityInfluxRecommend.ContainerTopControllerKind, "=", kindConditionStr)
-	}
-
-	if granularity == 0 || granularity == 30 {
-		tempCondition := fmt.Sprintf("(\"%s\"='' OR \"%s\"='30')", EntityInfluxRecommend.ContainerGranularity, EntityInfluxRecommend.ContainerGranularity)
-		influxdbStatement.AppendWhereClauseDirectly(tempCondition)
-	} else {
-		influxdbStatement.AppendWhereClause(EntityInfluxRecommend.ContainerGranularity, "=", strconv.FormatInt(granularity, 10))
+		query.AppendCondition(storage.EqualTo(EntityInfluxRecommend.ContainerTopControllerKind, enumconv.KindDisp[kind]))
 	}
 
-	influxdbStatement.SetOrderClauseFromQueryCondition()
-	influxdbStatement.SetLimitClauseFromQueryCondition()
-
-	cmd := influxdbStatement.BuildQueryCmd()
-	scope.Debugf(fmt.Sprintf("ListContainerRecommendations: %s", cmd))
+	query.AppendCondition(c.granularityCondition(granularity))
+	query.ApplyQueryCondition(DBCommon.BuildQueryConditionV1(in.GetQueryCondition()))
 
-	podRecommendations, err := c.queryRecommendation(cmd, granularity)
+	podRecommendations, err := c.queryRecommendation(query, granularity)
 	if err != nil {
 		scope.Errorf("influxdb-ListContainerRecommendations error %v", err)
 		return podRecommendations, err
@@ -294,10 +283,60 @@ func (c *ContainerRepository) ListAvailablePodRecommendations(in *datahub_v1alph
 	scope.Infof("influxdb-ListAvailablePodRecommendations input %v, kind %s, granularity %d ", in, kind, granularity)
 	podRecommendations := make([]*datahub_v1alpha1.PodRecommendation, 0)
 
-	influxdbStatement := InternalInflux.Statement{
-		Measurement:    Container,
-		QueryCondition: DBCommon.BuildQueryConditionV1(in.GetQueryCondition()),
-		GroupByTags:    []string{EntityInfluxRecommend.ContainerName, EntityInfluxRecommend.ContainerNamespace, EntityInfluxRecommend.ContainerPodName},
+	query, err := c.availablePodRecommendationsQuery(in)
+	if err != nil {
+		return podRecommendations, err
+	}
+
+	podRecommendations, err = c.queryRecommendation(query, granularity)
+	if err != nil {
+		scope.Errorf("influxdb-ListAvailablePodRecommendations error %v", err)
+		return podRecommendations, err
+	}
+
+	scope.Infof("influxdb-ListAvailablePodRecommendations return %d %v", len(podRecommendations), podRecommendations)
+	return podRecommendations, nil
+}
+
+// ListAvailablePodRecommendationsInBatches Query available pod recommendations of at most batchSize
+// containers at a time and pass each batch to the handler as it is read
+func (c *ContainerRepository) ListAvailablePodRecommendationsInBatches(in *datahub_v1alpha1.ListPodRecommendationsRequest, batchSize int, handle func([]*datahub_v1alpha1.PodRecommendation) error) error {
+	scope.Infof("influxdb-ListAvailablePodRecommendationsInBatches input %v, batch size %d", in, batchSize)
+
+	query, err := c.availablePodRecommendationsQuery(in)
+	if err != nil {
+		return err
+	}
+	query.SeriesLimit = batchSize
+
+	for {
+		rows, err := c.storage.Query(query)
+		if err != nil {
+			scope.Errorf("influxdb-ListAvailablePodRecommendationsInBatches error %v", err)
+			return err
+		}
+		if len(rows) > 0 {
+			if err := handle(c.rowsToPodRecommendations(rows, in.GetGranularity())); err != nil {
+				return err
+			}
+		}
+		if len(rows) < batchSize {
+			return nil
+		}
+		query.SeriesOffset += batchSize
+	}
+}
+
+// availablePodRecommendationsQuery Build query of recommendations applicable at the apply time of the request,
+// grouped by container
+func (c *ContainerRepository) availablePodRecommendationsQuery(in *datahub_v1alpha1.ListPodRecommendationsRequest) (storage.Query, error) {
+	kind := in.GetKind()
+	granularity := in.GetGranularity()
+
+	query := storage.Query{
+		Database:    string(RepoInflux.Recommendation),
+		Measurement: string(Container),
+		GroupByTags: []string{EntityInfluxRecommend.ContainerName, EntityInfluxRecommend.ContainerNamespace, EntityInfluxRecommend.ContainerPodName},
 	}
 
 	nameCol := ""
@@ -308,53 +347,95 @@ func (c *ContainerRepository) ListAvailablePodRecommendations(in *datahub_v1alph
 		nameCol = string(EntityInfluxRecommend.ContainerTopControllerName)
 	case datahub_v1alpha1.Kind_DEPLOYMENTCONFIG:
 		nameCol = string(EntityInfluxRecommend.ContainerTopControllerName)
-	case datahub_v1alpha1.Kind_STATEFULSET:
+	case datahub_v1alpha1.Kind_STATEFULSET, DatahubKind.Kind_DAEMONSET, DatahubKind.Kind_REPLICASET, DatahubKind.Kind_JOB, DatahubKind.Kind_CRONJOB:
 		nameCol = string(EntityInfluxRecommend.ContainerTopControllerName)
 	default:
-		return podRecommendations, errors.Errorf("no matching kind for Datahub Kind, received Kind: %s", datahub_v1alpha1.Kind_name[int32(kind)])
-	}
-	influxdbStatement.AppendWhereClause(EntityInfluxRecommend.ContainerNamespace, "=", in.GetNamespacedName().GetNamespace())
-	influxdbStatement.AppendWhereClause(nameCol, "=", in.GetNamespacedName().GetName())
-
-	if granularity == 0 || granularity == 30 {
-		tempCondition := fmt.Sprintf("(\"%s\"='' OR \"%s\"='30')", EntityInfluxRecommend.ContainerGranularity, EntityInfluxRecommend.ContainerGranularity)
-		influxdbStatement.AppendWhereClauseDirectly(tempCondition)
-	} else {
-		influxdbStatement.AppendWhereClause(EntityInfluxRecommend.ContainerGranularity, "=", strconv.FormatInt(granularity, 10))
+		return query, errors.Errorf("no matching kind for Datahub Kind, received Kind: %s", DatahubKind.Name(kind))
 	}
+	query.AppendCondition(storage.EqualTo(EntityInfluxRecommend.ContainerNamespace, in.GetNamespacedName().GetNamespace()))
+	query.AppendCondition(storage.EqualTo(nameCol, in.GetNamespacedName().GetName()))
+	query.AppendCondition(c.granularityCondition(granularity))
 
-	whereStrTime := ""
 	applyTime := in.GetQueryCondition().GetTimeRange().GetApplyTime().GetSeconds()
 	if applyTime > 0 {
-		whereStrTime = fmt.Sprintf(" \"end_time\">=%d AND \"start_time\"<=%d", applyTime, applyTime)
+		query.AppendCondition(storage.Compare(EntityInfluxRecommend.ContainerEndTime, storage.GreaterEqual, applyTime))
+		query.AppendCondition(storage.Compare(EntityInfluxRecommend.ContainerStartTime, storage.LessEqual, applyTime))
 	}
-	influxdbStatement.AppendWhereClauseDirectly(whereStrTime)
 
-	influxdbStatement.SetOrderClauseFromQueryCondition()
-	influxdbStatement.SetLimitClauseFromQueryCondition()
+	queryCondition := DBCommon.BuildQueryConditionV1(in.GetQueryCondition())
+	query.Order = queryCondition.TimestampOrder
+	query.Limit = queryCondition.Limit
+
+	return query, nil
+}
+
+// GetPodRecommendationGeneration Return the latest generation of the pod's recommendations which starts at
+// or before the time, nil if there is none. Containers' recommendations of a generation share the start time.
+func (c *ContainerRepository) GetPodRecommendationGeneration(podNamespacedName *datahub_v1alpha1.NamespacedName, granularity int64, until time.Time) (*datahub_v1alpha1.PodRecommendation, error) {
+	scope.Infof("influxdb-GetPodRecommendationGeneration input %v, granularity %d, until %v", podNamespacedName, granularity, until)
 
-	cmd := influxdbStatement.BuildQueryCmd()
-	scope.Debugf(fmt.Sprintf("ListContainerRecommendations: %s", cmd))
+	query := storage.Query{
+		Database:    string(RepoInflux.Recommendation),
+		Measurement: string(Container),
+		Order:       DBCommon.Desc,
+		Limit:       1,
+	}
+	query.AppendCondition(storage.EqualTo(EntityInfluxRecommend.ContainerNamespace, podNamespacedName.GetNamespace()))
+	query.AppendCondition(storage.EqualTo(EntityInfluxRecommend.ContainerPodName, podNamespacedName.GetName()))
+	query.AppendCondition(c.granularityCondition(granularity))
+	query.AppendTimeRange(nil, &until)
 
-	podRecommendations, err := c.queryRecommendation(cmd, granularity)
+	latestRecommendations, err := c.queryRecommendation(query, granularity)
 	if err != nil {
-		scope.Errorf("influxdb-ListAvailablePodRecommendations error %v", err)
-		return podRecommendations, err
+		return nil, errors.Wrap(err, "get latest pod recommendation failed")
+	}
+	if len(latestRecommendations) == 0 {
+		return nil, nil
 	}
 
-	scope.Infof("influxdb-ListAvailablePodRecommendations return %d %v", len(podRecommendations), podRecommendations)
-	return podRecommendations, nil
+	// Query recommendations of all containers in the generation
+	generationTime := time.Unix(latestRecommendations[0].GetStartTime().GetSeconds(), 0)
+	query.Order = DBCommon.Asc
+	query.Limit = 0
+	query.AppendTimeRange(&generationTime, &generationTime)
+	containersRecommendations, err := c.queryRecommendation(query, granularity)
+	if err != nil {
+		return nil, errors.Wrap(err, "get containers' recommendations of generation failed")
+	}
+
+	podRecommendation := latestRecommendations[0]
+	podRecommendation.ContainerRecommendations = make([]*datahub_v1alpha1.ContainerRecommendation, 0, len(containersRecommendations))
+	for _, containerRecommendations := range containersRecommendations {
+		podRecommendation.ContainerRecommendations = append(podRecommendation.ContainerRecommendations, containerRecommendations.GetContainerRecommendations()...)
+	}
+	sort.Slice(podRecommendation.ContainerRecommendations, func(i, j int) bool {
+		return podRecommendation.ContainerRecommendations[i].GetName() < podRecommendation.ContainerRecommendations[j].GetName()
+	})
+
+	return podRecommendation, nil
 }
 
-func (c *ContainerRepository) queryRecommendation(cmd string, granularity int64) ([]*datahub_v1alpha1.PodRecommendation, error) {
+func (c *ContainerRepository) granularityCondition(granularity int64) storage.Condition {
+	if granularity == 0 || granularity == 30 {
+		return storage.EqualToAny(EntityInfluxRecommend.ContainerGranularity, []string{"", "30"})
+	}
+	return storage.EqualTo(EntityInfluxRecommend.ContainerGranularity, strconv.FormatInt(granularity, 10))
+}
+
+func (c *ContainerRepository) queryRecommendation(query storage.Query, granularity int64) ([]*datahub_v1alpha1.PodRecommendation, error) {
 	podRecommendations := make([]*datahub_v1alpha1.PodRecommendation, 0)
 
-	results, err := c.influxDB.QueryDB(cmd, string(RepoInflux.Recommendation))
+	rows, err := c.storage.Query(query)
 	if err != nil {
 		return podRecommendations, err
 	}
 
-	rows := InternalInflux.PackMap(results)
+	return c.rowsToPodRecommendations(rows, granularity), nil
+}
+
+// rowsToPodRecommendations Convert rows of container measurement to pod recommendations, one per point
+func (c *ContainerRepository) rowsToPodRecommendations(rows []*storage.Row, granularity int64) []*datahub_v1alpha1.PodRecommendation {
+	podRecommendations := make([]*datahub_v1alpha1.PodRecommendation, 0)
 
 	for _, row := range rows {
 		for _, data := range row.Data {
@@ -454,5 +535,5 @@ func (c *ContainerRepository) queryRecommendation(cmd string, granularity int64)
 		}
 	}
 
-	return podRecommendations, nil
+	return podRecommendations
 }
@@ -0,0 +1,115 @@
+package recommendation
+
+import (
+	"testing"
+	"time"
+
+	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage/embedded"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// newTestContainerRepository Return repository of the cluster backed by a new embedded engine
+func newTestContainerRepository(t *testing.T, clusterIDs ...string) []*ContainerRepository {
+	storage.SetDefault(embedded.New())
+	t.Cleanup(func() { storage.SetDefault(nil) })
+
+	repositories := make([]*ContainerRepository, 0, len(clusterIDs))
+	for _, clusterID := range clusterIDs {
+		repositories = append(repositories, NewContainerRepository(&InternalInflux.Config{}, clusterID))
+	}
+	return repositories
+}
+
+func newTestPodRecommendation(podName string, startTime, endTime time.Time) *datahub_v1alpha1.PodRecommendation {
+	metricData := func(metricType datahub_v1alpha1.MetricType, value string) *datahub_v1alpha1.MetricData {
+		return &datahub_v1alpha1.MetricData{
+			MetricType: metricType,
+			Data: []*datahub_v1alpha1.Sample{{
+				Time:     &timestamp.Timestamp{Seconds: startTime.Unix()},
+				EndTime:  &timestamp.Timestamp{Seconds: endTime.Unix()},
+				NumValue: value,
+			}},
+		}
+	}
+	return &datahub_v1alpha1.PodRecommendation{
+		NamespacedName: &datahub_v1alpha1.NamespacedName{Namespace: "default", Name: podName},
+		TopController: &datahub_v1alpha1.TopController{
+			NamespacedName: &datahub_v1alpha1.NamespacedName{Namespace: "default", Name: "app"},
+			Kind:           datahub_v1alpha1.Kind_DEPLOYMENT,
+		},
+		ContainerRecommendations: []*datahub_v1alpha1.ContainerRecommendation{{
+			Name: "app",
+			LimitRecommendations: []*datahub_v1alpha1.MetricData{
+				metricData(datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE, "200"),
+				metricData(datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES, "2048"),
+			},
+			RequestRecommendations: []*datahub_v1alpha1.MetricData{
+				metricData(datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE, "100"),
+				metricData(datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES, "1024"),
+			},
+		}},
+	}
+}
+
+func TestListAvailablePodRecommendations(t *testing.T) {
+	repositories := newTestContainerRepository(t, "cluster-a", "cluster-b")
+	repository, otherRepository := repositories[0], repositories[1]
+
+	now := time.Now().Truncate(time.Second)
+	if err := repository.CreateContainerRecommendations(&datahub_v1alpha1.CreatePodRecommendationsRequest{
+		PodRecommendations: []*datahub_v1alpha1.PodRecommendation{
+			newTestPodRecommendation("app-1", now.Add(-time.Hour), now.Add(time.Hour)),
+			newTestPodRecommendation("app-2", now.Add(-time.Hour), now.Add(time.Hour)),
+			newTestPodRecommendation("app-3", now.Add(-time.Hour), now.Add(time.Hour)),
+			newTestPodRecommendation("app-expired", now.Add(-2*time.Hour), now.Add(-time.Hour)),
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	request := &datahub_v1alpha1.ListPodRecommendationsRequest{
+		NamespacedName: &datahub_v1alpha1.NamespacedName{Namespace: "default", Name: "app"},
+		Kind:           datahub_v1alpha1.Kind_DEPLOYMENT,
+		QueryCondition: &datahub_v1alpha1.QueryCondition{
+			TimeRange: &datahub_v1alpha1.TimeRange{ApplyTime: &timestamp.Timestamp{Seconds: now.Unix()}},
+		},
+	}
+	podRecommendations, err := repository.ListAvailablePodRecommendations(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(podRecommendations) != 3 {
+		t.Fatalf("expect 3 available pod recommendations, got %d", len(podRecommendations))
+	}
+	containerRecommendation := podRecommendations[0].GetContainerRecommendations()[0]
+	if containerRecommendation.GetRequestRecommendations()[0].GetData()[0].GetNumValue() != "100" ||
+		containerRecommendation.GetLimitRecommendations()[1].GetData()[0].GetNumValue() != "2048" {
+		t.Errorf("unexpected container recommendation %+v", containerRecommendation)
+	}
+
+	podNames := make([]string, 0)
+	batches := 0
+	if err := repository.ListAvailablePodRecommendationsInBatches(request, 2, func(batch []*datahub_v1alpha1.PodRecommendation) error {
+		batches++
+		for _, podRecommendation := range batch {
+			podNames = append(podNames, podRecommendation.GetNamespacedName().GetName())
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if batches != 2 || len(podNames) != 3 || podNames[0] != "app-1" || podNames[2] != "app-3" {
+		t.Errorf("expect 3 pods in 2 batches, got %v in %d batches", podNames, batches)
+	}
+
+	podRecommendations, err = otherRepository.ListAvailablePodRecommendations(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(podRecommendations) != 0 {
+		t.Errorf("expect recommendations of other cluster invisible, got %d", len(podRecommendations))
+	}
+}
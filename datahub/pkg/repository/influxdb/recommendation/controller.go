@@ -5,26 +5,26 @@ import (
 	RepoInflux "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb"
 	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	InfluxClient "github.com/influxdata/influxdb/client/v2"
+	"github.com/pkg/errors"
 	"strconv"
 	"time"
 )
 
 type ControllerRepository struct {
-	influxDB *InternalInflux.InfluxClient
+	storage storage.Engine
 }
 
-func NewControllerRepository(influxDBCfg *InternalInflux.Config) *ControllerRepository {
+func NewControllerRepository(influxDBCfg *InternalInflux.Config, clusterID string) *ControllerRepository {
 	scope.Infof("influxdb-NewControllerRepository input %v", influxDBCfg)
 	return &ControllerRepository{
-		influxDB: &InternalInflux.InfluxClient{
-			Address:  influxDBCfg.Address,
-			Username: influxDBCfg.Username,
-			Password: influxDBCfg.Password,
-		},
+		storage: RepoInflux.NewClusterStorageEngine(influxDBCfg, clusterID),
 	}
 }
 
@@ -47,7 +47,7 @@ func (c *ControllerRepository) CreateControllerRecommendations(controllerRecomme
 				EntityInfluxRecommend.ControllerCurrentReplicas: recommendedSpec.GetCurrentReplicas(),
 				EntityInfluxRecommend.ControllerDesiredReplicas: recommendedSpec.GetDesiredReplicas(),
 				EntityInfluxRecommend.ControllerCreateTime:      recommendedSpec.GetCreateTime().GetSeconds(),
-				EntityInfluxRecommend.ControllerKind:            recommendedSpec.GetKind().String(),
+				EntityInfluxRecommend.ControllerKind:            DatahubKind.Name(recommendedSpec.GetKind()),
 
 				EntityInfluxRecommend.ControllerCurrentCPURequest: recommendedSpec.GetCurrentCpuRequests(),
 				EntityInfluxRecommend.ControllerCurrentMEMRequest: recommendedSpec.GetCurrentMemRequests(),
@@ -78,7 +78,7 @@ func (c *ControllerRepository) CreateControllerRecommendations(controllerRecomme
 				EntityInfluxRecommend.ControllerCurrentReplicas: recommendedSpec.GetCurrentReplicas(),
 				EntityInfluxRecommend.ControllerDesiredReplicas: recommendedSpec.GetDesiredReplicas(),
 				EntityInfluxRecommend.ControllerCreateTime:      recommendedSpec.GetCreateTime().GetSeconds(),
-				EntityInfluxRecommend.ControllerKind:            recommendedSpec.GetKind().String(),
+				EntityInfluxRecommend.ControllerKind:            DatahubKind.Name(recommendedSpec.GetKind()),
 			}
 
 			pt, err := InfluxClient.NewPoint(string(Controller), tags, fields, time.Unix(recommendedSpec.GetTime().GetSeconds(), 0))
@@ -90,10 +90,13 @@ func (c *ControllerRepository) CreateControllerRecommendations(controllerRecomme
 		}
 	}
 
-	err := c.influxDB.WritePoints(points, InfluxClient.BatchPointsConfig{
-		Database: string(RepoInflux.Recommendation),
-	})
+	storagePoints, err := StorageInflux.NewPoints(points)
+	if err != nil {
+		scope.Error(err.Error())
+		return err
+	}
 
+	err = c.storage.WritePoints(string(RepoInflux.Recommendation), storagePoints)
 	if err != nil {
 		scope.Error(err.Error())
 		return err
@@ -107,42 +110,85 @@ func (c *ControllerRepository) ListControllerRecommendations(in *datahub_v1alpha
 	name := in.GetNamespacedName().GetName()
 	recommendationType := in.GetRecommendedType()
 
-	scope.Infof("influxdb-ListControllerRecommendations input %v, namespace %s, name %s, recommendationtype %d", in, namespace, name, in)
-	influxdbStatement := InternalInflux.Statement{
-		Measurement:    Controller,
-		QueryCondition: DBCommon.BuildQueryConditionV1(in.GetQueryCondition()),
+	scope.Infof("influxdb-ListControllerRecommendations input %v, namespace %s, name %s, recommendationtype %d", in, namespace, name, recommendationType)
+	query := storage.Query{
+		Database:    string(RepoInflux.Recommendation),
+		Measurement: string(Controller),
 	}
 
-	influxdbStatement.AppendWhereClause(EntityInfluxRecommend.ControllerNamespace, "=", namespace)
-	influxdbStatement.AppendWhereClause(EntityInfluxRecommend.ControllerName, "=", name)
-	influxdbStatement.AppendWhereClauseFromTimeCondition()
-	influxdbStatement.SetOrderClauseFromQueryCondition()
-	influxdbStatement.SetLimitClauseFromQueryCondition()
+	query.AppendCondition(storage.EqualTo(EntityInfluxRecommend.ControllerNamespace, namespace))
+	query.AppendCondition(storage.EqualTo(EntityInfluxRecommend.ControllerName, name))
+	query.ApplyQueryCondition(DBCommon.BuildQueryConditionV1(in.GetQueryCondition()))
 
 	if recommendationType != datahub_v1alpha1.ControllerRecommendedType_CRT_Undefined {
-		influxdbStatement.AppendWhereClause(EntityInfluxRecommend.ControllerType, "=", recommendationType.String())
+		query.AppendCondition(storage.EqualTo(EntityInfluxRecommend.ControllerType, recommendationType.String()))
 	}
 
-	cmd := influxdbStatement.BuildQueryCmd()
-
-	results, err := c.influxDB.QueryDB(cmd, string(RepoInflux.Recommendation))
+	rows, err := c.storage.Query(query)
 	if err != nil {
 		scope.Errorf("influxdb-ListControllerRecommendations error %v", err)
 		return make([]*datahub_v1alpha1.ControllerRecommendation, 0), err
 	}
 
-	influxdbRows := InternalInflux.PackMap(results)
-	recommendations := c.getControllersRecommendationsFromInfluxRows(influxdbRows)
+	recommendations := c.getControllersRecommendationsFromRows(rows)
 
 	scope.Infof("influxdb-ListControllerRecommendations return %d %v", len(recommendations), recommendations)
 
 	return recommendations, nil
 }
 
-func (c *ControllerRepository) getControllersRecommendationsFromInfluxRows(rows []*InternalInflux.InfluxRow) []*datahub_v1alpha1.ControllerRecommendation {
+// GetControllerRecommendationGeneration Return the latest generation of the controller's recommendations
+// created at or before the time, nil if there is none
+func (c *ControllerRepository) GetControllerRecommendationGeneration(controllerNamespacedName *datahub_v1alpha1.NamespacedName, recommendationType datahub_v1alpha1.ControllerRecommendedType, until time.Time) (*datahub_v1alpha1.ControllerRecommendation, error) {
+	scope.Infof("influxdb-GetControllerRecommendationGeneration input %v, recommendationtype %s, until %v", controllerNamespacedName, recommendationType, until)
+
+	return c.getLatestControllerRecommendation(c.generationQuery(controllerNamespacedName, recommendationType, until))
+}
+
+// GetLatestControllerRecommendationOfKind Return the latest generation of recommendations of the controller
+// of the kind created at or before the time, nil if there is none
+func (c *ControllerRepository) GetLatestControllerRecommendationOfKind(controllerNamespacedName *datahub_v1alpha1.NamespacedName, kind datahub_v1alpha1.Kind, recommendationType datahub_v1alpha1.ControllerRecommendedType, until time.Time) (*datahub_v1alpha1.ControllerRecommendation, error) {
+	scope.Infof("influxdb-GetLatestControllerRecommendationOfKind input %v, kind %s, recommendationtype %s, until %v", controllerNamespacedName, kind, recommendationType, until)
+
+	query := c.generationQuery(controllerNamespacedName, recommendationType, until)
+	query.AppendCondition(storage.EqualTo(EntityInfluxRecommend.ControllerKind, DatahubKind.Name(kind)))
+	return c.getLatestControllerRecommendation(query)
+}
+
+// generationQuery Build query of the latest recommendation of the controller created at or before the time
+func (c *ControllerRepository) generationQuery(controllerNamespacedName *datahub_v1alpha1.NamespacedName, recommendationType datahub_v1alpha1.ControllerRecommendedType, until time.Time) storage.Query {
+	query := storage.Query{
+		Database:    string(RepoInflux.Recommendation),
+		Measurement: string(Controller),
+		Order:       DBCommon.Desc,
+		Limit:       1,
+	}
+	query.AppendCondition(storage.EqualTo(EntityInfluxRecommend.ControllerNamespace, controllerNamespacedName.GetNamespace()))
+	query.AppendCondition(storage.EqualTo(EntityInfluxRecommend.ControllerName, controllerNamespacedName.GetName()))
+	if recommendationType != datahub_v1alpha1.ControllerRecommendedType_CRT_Undefined {
+		query.AppendCondition(storage.EqualTo(EntityInfluxRecommend.ControllerType, recommendationType.String()))
+	}
+	query.AppendTimeRange(nil, &until)
+	return query
+}
+
+func (c *ControllerRepository) getLatestControllerRecommendation(query storage.Query) (*datahub_v1alpha1.ControllerRecommendation, error) {
+	rows, err := c.storage.Query(query)
+	if err != nil {
+		return nil, errors.Wrap(err, "get latest controller recommendation failed")
+	}
+
+	recommendations := c.getControllersRecommendationsFromRows(rows)
+	if len(recommendations) == 0 {
+		return nil, nil
+	}
+	return recommendations[0], nil
+}
+
+func (c *ControllerRepository) getControllersRecommendationsFromRows(rows []*storage.Row) []*datahub_v1alpha1.ControllerRecommendation {
 	recommendations := make([]*datahub_v1alpha1.ControllerRecommendation, 0)
-	for _, influxdbRow := range rows {
-		for _, data := range influxdbRow.Data {
+	for _, row := range rows {
+		for _, data := range row.Data {
 			currentReplicas, _ := strconv.ParseInt(data[EntityInfluxRecommend.ControllerCurrentReplicas], 10, 64)
 			desiredReplicas, _ := strconv.ParseInt(data[EntityInfluxRecommend.ControllerDesiredReplicas], 10, 64)
 			createTime, _ := strconv.ParseInt(data[EntityInfluxRecommend.ControllerCreateTime], 10, 64)
@@ -167,8 +213,8 @@ func (c *ControllerRepository) getControllersRecommendationsFromInfluxRows(rows
 
 			var commendationKind datahub_v1alpha1.Kind
 			if tempKind, exist := data[EntityInfluxRecommend.ControllerKind]; exist {
-				if value, ok := datahub_v1alpha1.Kind_value[tempKind]; ok {
-					commendationKind = datahub_v1alpha1.Kind(value)
+				if value, ok := DatahubKind.Value(tempKind); ok {
+					commendationKind = value
 				}
 			}
 
@@ -0,0 +1,58 @@
+package recommendation
+
+import (
+	"testing"
+	"time"
+
+	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage/embedded"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+func TestGetLatestControllerRecommendationOfKind(t *testing.T) {
+	storage.SetDefault(embedded.New())
+	defer storage.SetDefault(nil)
+	repository := NewControllerRepository(&InternalInflux.Config{}, "cluster-a")
+
+	now := time.Now().Truncate(time.Second)
+	newRecommendation := func(kind datahub_v1alpha1.Kind, desiredReplicas int32, createTime time.Time) *datahub_v1alpha1.ControllerRecommendation {
+		return &datahub_v1alpha1.ControllerRecommendation{
+			RecommendedType: datahub_v1alpha1.ControllerRecommendedType_CRT_K8s,
+			RecommendedSpecK8S: &datahub_v1alpha1.ControllerRecommendedSpecK8S{
+				NamespacedName:  &datahub_v1alpha1.NamespacedName{Namespace: "default", Name: "app"},
+				Kind:            kind,
+				DesiredReplicas: desiredReplicas,
+				Time:            &timestamp.Timestamp{Seconds: createTime.Unix()},
+			},
+		}
+	}
+	// The StatefulSet with the same name as the Deployment has the newer recommendation
+	if err := repository.CreateControllerRecommendations([]*datahub_v1alpha1.ControllerRecommendation{
+		newRecommendation(datahub_v1alpha1.Kind_DEPLOYMENT, 2, now.Add(-2*time.Minute)),
+		newRecommendation(datahub_v1alpha1.Kind_DEPLOYMENT, 3, now.Add(-time.Minute)),
+		newRecommendation(datahub_v1alpha1.Kind_STATEFULSET, 5, now),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	namespacedName := &datahub_v1alpha1.NamespacedName{Namespace: "default", Name: "app"}
+	recommendation, err := repository.GetLatestControllerRecommendationOfKind(namespacedName, datahub_v1alpha1.Kind_DEPLOYMENT,
+		datahub_v1alpha1.ControllerRecommendedType_CRT_Undefined, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recommendation.GetRecommendedSpecK8S().GetDesiredReplicas() != 3 {
+		t.Errorf("expect latest recommendation of deployment, got %+v", recommendation)
+	}
+
+	recommendation, err = repository.GetLatestControllerRecommendationOfKind(namespacedName, datahub_v1alpha1.Kind_DEPLOYMENTCONFIG,
+		datahub_v1alpha1.ControllerRecommendedType_CRT_Undefined, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recommendation != nil {
+		t.Errorf("expect no recommendation of deploymentconfig, got %+v", recommendation)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	RepoInflux "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb"
 	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 	InfluxClient "github.com/influxdata/influxdb/client/v2"
 	"github.com/pkg/errors"
@@ -17,14 +19,14 @@ var (
 
 // SimulatedSchedulingScoreRepository Repository of simulated_scheduling_score data
 type SimulatedSchedulingScoreRepository struct {
-	influxDB *InternalInflux.InfluxClient
+	storage storage.Engine
 }
 
 // NewRepositoryWithConfig New SimulatedSchedulingScoreRepository with influxdb configuration
-func NewRepositoryWithConfig(cfg InternalInflux.Config) SimulatedSchedulingScoreRepository {
+func NewRepositoryWithConfig(cfg InternalInflux.Config, clusterID string) SimulatedSchedulingScoreRepository {
 	scope.Infof("influxdb-NewRepositoryWithConfig input %v", &cfg)
 	return SimulatedSchedulingScoreRepository{
-		influxDB: InternalInflux.NewClient(&cfg),
+		storage: RepoInflux.NewClusterStorageEngine(&cfg, clusterID),
 	}
 }
 
@@ -35,9 +37,8 @@ func (r SimulatedSchedulingScoreRepository) ListScoresByRequest(request DaoScore
 	var (
 		err error
 
-		results      []InfluxClient.Result
-		influxdbRows []*InternalInflux.InfluxRow
-		scores       = make([]*EntityInfluxScore.SimulatedSchedulingScoreEntity, 0)
+		rows   []*storage.Row
+		scores = make([]*EntityInfluxScore.SimulatedSchedulingScoreEntity, 0)
 	)
 
 	queryCondition := DBCommon.QueryCondition{
@@ -48,25 +49,20 @@ func (r SimulatedSchedulingScoreRepository) ListScoresByRequest(request DaoScore
 		Limit:          request.QueryCondition.Limit,
 	}
 
-	influxdbStatement := InternalInflux.Statement{
-		QueryCondition: &queryCondition,
-		Measurement:    SimulatedSchedulingScore,
+	query := storage.Query{
+		Database:    string(RepoInflux.Score),
+		Measurement: string(SimulatedSchedulingScore),
 	}
+	query.ApplyQueryCondition(&queryCondition)
 
-	influxdbStatement.AppendWhereClauseFromTimeCondition()
-	influxdbStatement.SetOrderClauseFromQueryCondition()
-	influxdbStatement.SetLimitClauseFromQueryCondition()
-	cmd := influxdbStatement.BuildQueryCmd()
-
-	results, err = r.influxDB.QueryDB(cmd, string(RepoInflux.Score))
+	rows, err = r.storage.Query(query)
 	if err != nil {
-		scope.Errorf("influxdb-ListScoresByRequest error %v", results)
+		scope.Errorf("influxdb-ListScoresByRequest error %v", err)
 		return scores, errors.Wrap(err, "list scores failed")
 	}
 
-	influxdbRows = InternalInflux.PackMap(results)
-	for _, influxdbRow := range influxdbRows {
-		for _, data := range influxdbRow.Data {
+	for _, row := range rows {
+		for _, data := range row.Data {
 			scoreEntity := EntityInfluxScore.NewSimulatedSchedulingScoreEntityFromMap(data)
 			scores = append(scores, &scoreEntity)
 		}
@@ -106,9 +102,12 @@ func (r SimulatedSchedulingScoreRepository) CreateScores(scores []*DaoScore.Simu
 		points = append(points, point)
 	}
 
-	err = r.influxDB.WritePoints(points, InfluxClient.BatchPointsConfig{
-		Database: string(RepoInflux.Score),
-	})
+	storagePoints, err := StorageInflux.NewPoints(points)
+	if err != nil {
+		scope.Errorf("influxdb-CreateScores error %v", err)
+		return errors.Wrap(err, "create scores failed")
+	}
+	err = r.storage.WritePoints(string(RepoInflux.Score), storagePoints)
 	if err != nil {
 		scope.Errorf("influxdb-CreateScores error %v", err)
 		return errors.Wrap(err, "create scores failed")
@@ -0,0 +1,23 @@
+package influxdb
+
+import (
+	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
+)
+
+// NewStorageEngine Return the storage engine used by repositories. The engine
+// set by storage.SetDefault is returned if there is one, otherwise an InfluxDB
+// engine is built with the configuration.
+func NewStorageEngine(cfg *InternalInflux.Config) storage.Engine {
+	if engine := storage.Default(); engine != nil {
+		return engine
+	}
+	return StorageInflux.NewWithConfig(*cfg)
+}
+
+// NewClusterStorageEngine Return the engine of NewStorageEngine scoped to the
+// cluster, points of all clusters are accessed if clusterID is empty
+func NewClusterStorageEngine(cfg *InternalInflux.Config, clusterID string) storage.Engine {
+	return storage.WithClusterID(NewStorageEngine(cfg), clusterID)
+}
@@ -4,16 +4,29 @@ import (
 	"fmt"
 	"github.com/containers-ai/alameda/datahub/pkg/apis/keycodes"
 	"github.com/containers-ai/alameda/datahub/pkg/apis/v1alpha1"
+	"github.com/containers-ai/alameda/datahub/pkg/auth"
 	DatahubConfig "github.com/containers-ai/alameda/datahub/pkg/config"
-	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	RepoInflux "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	_ "github.com/containers-ai/alameda/internal/pkg/database/storage/embedded"
+	_ "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
 	OperatorAPIs "github.com/containers-ai/alameda/operator/pkg/apis"
+	DatahubAccuracy "github.com/containers-ai/alameda/pkg/framework/datahub/accuracy"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+	DatahubHistory "github.com/containers-ai/alameda/pkg/framework/datahub/history"
+	DatahubRawdata "github.com/containers-ai/alameda/pkg/framework/datahub/rawdata"
+	DatahubStream "github.com/containers-ai/alameda/pkg/framework/datahub/stream"
+	Instrumentation "github.com/containers-ai/alameda/pkg/instrumentation"
 	K8SUtils "github.com/containers-ai/alameda/pkg/utils/kubernetes"
 	Log "github.com/containers-ai/alameda/pkg/utils/log"
 	DatahubV1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	DatahubKeycodes "github.com/containers-ai/api/datahub/keycodes"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
+	"io"
+	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"net"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -24,8 +37,10 @@ import (
 type Server struct {
 	err       chan error
 	server    *grpc.Server
+	storage   storage.Engine
 	Config    DatahubConfig.Config
 	K8SClient client.Client
+	guard     *auth.Guard
 }
 
 var (
@@ -36,8 +51,9 @@ func NewServer(cfg DatahubConfig.Config) (*Server, error) {
 	var (
 		err error
 
-		server *Server
-		k8sCli client.Client
+		server        *Server
+		k8sCli        client.Client
+		storageEngine storage.Engine
 	)
 
 	// Validate datahub configuration
@@ -45,11 +61,26 @@ func NewServer(cfg DatahubConfig.Config) (*Server, error) {
 		return server, errors.New("Failed to validate datahub configuration: " + err.Error())
 	}
 
+	// Open storage engine used by repositories
+	if storageEngine, err = storage.Open(*cfg.Storage); err != nil {
+		return server, errors.New("Failed to open storage engine: " + err.Error())
+	}
+	storage.SetDefault(storageEngine)
+
 	// Instance kubernetes client
 	if k8sCli, err = K8SUtils.NewK8SClient(); err != nil {
 		return server, err
 	}
 
+	// Calls without cluster id are scoped to the cluster datahub runs in
+	if cfg.ClusterID == "" {
+		if cfg.ClusterID, err = K8SUtils.GetClusterUID(k8sCli); err != nil {
+			scope.Warnf("get cluster uid failed, calls without cluster id access data of all clusters: %s", err.Error())
+		}
+	}
+	// Points written before points were tagged with cluster id belong to the cluster datahub runs in
+	storage.SetLocalClusterID(cfg.ClusterID)
+
 	// Get kubernetes configuration
 	k8sClientConfig, err := config.GetConfig()
 	if err != nil {
@@ -65,8 +96,30 @@ func NewServer(cfg DatahubConfig.Config) (*Server, error) {
 		scope.Error(err.Error())
 	}
 
+	// Authenticate and authorize calls with Kubernetes API server
+	var (
+		authenticator auth.Authenticator
+		authorizer    auth.Authorizer
+		clusterBinder *auth.ClusterBinder
+	)
+	if cfg.Auth.Authentication.Enabled || cfg.Auth.Authorization.Enabled {
+		clientset, err := kubernetes.NewForConfig(k8sClientConfig)
+		if err != nil {
+			return server, errors.New("Failed to create kubernetes clientset: " + err.Error())
+		}
+		if cfg.Auth.Authentication.Enabled {
+			authenticator = auth.NewTokenReviewAuthenticator(clientset, *cfg.Auth.Authentication)
+			clusterBinder = auth.NewClusterBinder(cfg.ClusterID, cfg.Auth.ClusterBindings)
+		}
+		if cfg.Auth.Authorization.Enabled {
+			authorizer = auth.NewSubjectAccessReviewAuthorizer(clientset, *cfg.Auth.Authorization)
+		}
+	}
+
 	server = &Server{
-		err: make(chan error),
+		err:     make(chan error),
+		storage: storageEngine,
+		guard:   auth.NewGuard(authenticator, authorizer, clusterBinder, cfg.Auth.PublicMethods),
 
 		Config:    cfg,
 		K8SClient: k8sCli,
@@ -95,6 +148,8 @@ func (s *Server) Run() error {
 	s.register(server)
 	reflection.Register(server)
 
+	s.Config.Metrics.Start()
+
 	if err := server.Serve(ln); err != nil {
 		s.err <- fmt.Errorf("GRPC server(datahub) failed to serve: %s", err.Error())
 	}
@@ -105,6 +160,12 @@ func (s *Server) Run() error {
 func (s *Server) Stop() error {
 	s.server.Stop()
 
+	if closer, ok := s.storage.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			scope.Errorf("close storage engine failed: %s", err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -112,29 +173,17 @@ func (s *Server) Err() <-chan error {
 	return s.err
 }
 
-func (s *Server) InitInfluxdbDatabase() {
-	influxdbClient := InternalInflux.NewClient(&InternalInflux.Config{
-		Address:                s.Config.InfluxDB.Address,
-		Username:               s.Config.InfluxDB.Username,
-		Password:               s.Config.InfluxDB.Password,
-		RetentionDuration:      s.Config.InfluxDB.RetentionDuration,
-		RetentionShardDuration: s.Config.InfluxDB.RetentionShardDuration,
-	})
-
+func (s *Server) InitStorageDatabases() {
 	databaseList := []string{
-		"alameda_prediction",
-		"alameda_recommendation",
-		"alameda_score",
-		"alameda_event",
+		string(RepoInflux.Prediction),
+		string(RepoInflux.Recommendation),
+		string(RepoInflux.Score),
+		string(RepoInflux.Event),
+		string(RepoInflux.ClusterStatus),
 	}
 
 	for _, db := range databaseList {
-		err := influxdbClient.CreateDatabase(db)
-		if err != nil {
-			scope.Error(err.Error())
-		}
-
-		err = influxdbClient.ModifyDefaultRetentionPolicy(db)
+		err := s.storage.CreateDatabase(db)
 		if err != nil {
 			scope.Error(err.Error())
 		}
@@ -146,7 +195,27 @@ func (s *Server) newGRPCServer() (*grpc.Server, error) {
 		server *grpc.Server
 	)
 
-	server = grpc.NewServer()
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			Instrumentation.UnaryServerInterceptor(),
+			s.guard.UnaryServerInterceptor(),
+			DatahubCluster.UnaryServerInterceptor(s.Config.ClusterID),
+		)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			Instrumentation.StreamServerInterceptor(),
+			s.guard.StreamServerInterceptor(),
+			DatahubCluster.StreamServerInterceptor(s.Config.ClusterID),
+		)),
+	}
+	tlsOpt, err := s.Config.TLS.ServerOption()
+	if err != nil {
+		return nil, errors.Wrap(err, "new gRPC server failed")
+	}
+	if tlsOpt != nil {
+		opts = append(opts, tlsOpt)
+	}
+
+	server = grpc.NewServer(opts...)
 
 	return server, nil
 }
@@ -154,6 +223,11 @@ func (s *Server) newGRPCServer() (*grpc.Server, error) {
 func (s *Server) register(server *grpc.Server) {
 	v1alpha1Srv := v1alpha1.NewService(&s.Config, s.K8SClient)
 	DatahubV1alpha1.RegisterDatahubServiceServer(server, v1alpha1Srv)
+	DatahubStream.RegisterDatahubStreamServiceServer(server, v1alpha1Srv)
+	DatahubHistory.RegisterDatahubRecommendationHistoryServiceServer(server, v1alpha1Srv)
+	DatahubAccuracy.RegisterDatahubPredictionAccuracyServiceServer(server, v1alpha1Srv)
+	DatahubCluster.RegisterDatahubClusterServiceServer(server, v1alpha1Srv)
+	DatahubRawdata.RegisterDatahubRawdataServiceServer(server, v1alpha1Srv)
 
 	keycodesSrv := keycodes.NewService(&s.Config)
 	DatahubKeycodes.RegisterKeycodesServiceServer(server, keycodesSrv)
@@ -41,9 +41,15 @@ func startProbing() {
 	if probeType == PROBE_TYPE_LIVENESS {
 		probe.LivenessProbe(&probe.LivenessProbeConfig{})
 	} else if probeType == PROBE_TYPE_READINESS {
+		datahubDialOpts, err := config.Datahub.DialOptions()
+		if err != nil {
+			scope.Errorf("Readiness probe: build datahub credentials failed due to %s", err.Error())
+			os.Exit(1)
+		}
 		runngingNS := kubernetes.GetRunningNamespace()
 		probe.ReadinessProbe(&probe.ReadinessProbeConfig{
-			DatahubAddr: config.Datahub.Address,
+			DatahubAddr:        config.Datahub.Address,
+			DatahubDialOptions: datahubDialOpts,
 			AdmissionController: &probe.AdmissionController{
 				SvcName:   config.AdmCtr.SvcName,
 				Namespace: runngingNS,
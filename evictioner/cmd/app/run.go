@@ -7,12 +7,15 @@ import (
 
 	"github.com/containers-ai/alameda/cmd/app"
 	"github.com/containers-ai/alameda/evictioner/pkg/eviction"
+	"github.com/containers-ai/alameda/evictioner/pkg/hpa"
 	"github.com/containers-ai/alameda/operator/pkg/apis"
-	k8s_utils "github.com/containers-ai/alameda/pkg/utils/kubernetes"
-	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+	"github.com/containers-ai/alameda/pkg/instrumentation"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	openshift_apps "github.com/openshift/api/apps"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	k8s_config "sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -43,16 +46,6 @@ func displayConfig() {
 }
 
 func startEvictioner() {
-	conn, err := grpc.Dial(config.Datahub.Address, grpc.WithInsecure())
-	if err != nil {
-		scope.Errorf("create pods to datahub failed: %s", err.Error())
-		return
-	}
-
-	defer conn.Close()
-
-	datahubServiceClnt := datahub_v1alpha1.NewDatahubServiceClient(conn)
-
 	k8sClientConfig, err := k8s_config.GetConfig()
 	if err != nil {
 		scope.Error("Get kubernetes configuration failed: " + err.Error())
@@ -65,6 +58,12 @@ func startEvictioner() {
 		return
 	}
 
+	k8sClientset, err := kubernetes.NewForConfig(k8sClientConfig)
+	if err != nil {
+		scope.Error("Create kubernetes clientset failed: " + err.Error())
+		return
+	}
+
 	mgr, err := manager.New(k8sClientConfig, manager.Options{})
 	if err != nil {
 		scope.Error(err.Error())
@@ -76,21 +75,51 @@ func startEvictioner() {
 		scope.Error(err.Error())
 	}
 
-	clusterID, err := k8s_utils.GetClusterUID(k8sCli)
+	clusterID, err := DatahubCluster.ResolveClusterID(config.Datahub.ClusterID, k8sCli)
 	if err != nil {
 		scope.Errorf("Get cluster UID failed: %s", err.Error())
 		return
 	}
 	scope.Debugf("Cluster UID: %s", clusterID)
 
+	dialOpts, err := config.Datahub.DialOptions()
+	if err != nil {
+		scope.Errorf("Build datahub credentials failed: %s", err.Error())
+		return
+	}
+	dialOpts = append(dialOpts,
+		grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(
+			instrumentation.UnaryClientInterceptor(),
+			DatahubCluster.UnaryClientInterceptor(clusterID))),
+		grpc.WithStreamInterceptor(grpc_middleware.ChainStreamClient(
+			instrumentation.StreamClientInterceptor(),
+			DatahubCluster.StreamClientInterceptor(clusterID))))
+	conn, err := grpc.Dial(config.Datahub.Address, dialOpts...)
+	if err != nil {
+		scope.Errorf("create pods to datahub failed: %s", err.Error())
+		return
+	}
+
+	defer conn.Close()
+
+	config.Metrics.Start()
+
 	evictioner := eviction.NewEvictioner(config.Eviction.CheckCycle,
-		datahubServiceClnt,
+		conn,
 		k8sCli,
+		k8sClientset,
 		*config.Eviction,
 		config.Eviction.PurgeContainerCPUMemory,
 		clusterID,
 	)
 	evictioner.Start()
+
+	hpaExecutor := hpa.NewExecutor(conn,
+		k8sCli,
+		*config.HPA,
+		clusterID,
+	)
+	hpaExecutor.Start()
 	var wg sync.WaitGroup
 	wg.Add(1)
 	wg.Wait()
@@ -4,15 +4,19 @@ import (
 	"github.com/containers-ai/alameda/evictioner/pkg/admctr"
 	"github.com/containers-ai/alameda/evictioner/pkg/datahub"
 	"github.com/containers-ai/alameda/evictioner/pkg/eviction"
+	"github.com/containers-ai/alameda/evictioner/pkg/hpa"
+	"github.com/containers-ai/alameda/pkg/instrumentation"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 )
 
 // Config is evict configuration
 type Config struct {
-	Log      *log.Config      `mapstructure:"log"`
-	Eviction *eviction.Config `mapstructure:"eviction"`
-	Datahub  *datahub.Config  `mapstructure:"datahub"`
-	AdmCtr   *admctr.Config   `mapstructure:"admissionController"`
+	Log      *log.Config             `mapstructure:"log"`
+	Eviction *eviction.Config        `mapstructure:"eviction"`
+	Datahub  *datahub.Config         `mapstructure:"datahub"`
+	AdmCtr   *admctr.Config          `mapstructure:"admissionController"`
+	HPA      *hpa.Config             `mapstructure:"hpa"`
+	Metrics  *instrumentation.Config `mapstructure:"metrics"`
 }
 
 // NewDefaultConfig returns Config instance
@@ -23,11 +27,15 @@ func NewDefaultConfig() Config {
 		defaultDatahubConfig  = datahub.NewConfig()
 		defaultEvictionConfig = eviction.NewDefaultConfig()
 		defaultAdmCtlConfig   = admctr.NewConfig()
+		defaultHPAConfig      = hpa.NewDefaultConfig()
+		defaultMetricsConfig  = instrumentation.NewDefaultConfig()
 		config                = Config{
 			Log:      &defaultlogConfig,
 			Datahub:  defaultDatahubConfig,
 			Eviction: &defaultEvictionConfig,
 			AdmCtr:   defaultAdmCtlConfig,
+			HPA:      &defaultHPAConfig,
+			Metrics:  defaultMetricsConfig,
 		}
 	)
 
@@ -35,5 +43,5 @@ func NewDefaultConfig() Config {
 }
 
 func (c *Config) Validate() error {
-	return nil
+	return c.Metrics.Validate()
 }
@@ -5,10 +5,15 @@ import (
 	"net/url"
 
 	datahubutils "github.com/containers-ai/alameda/operator/pkg/utils/datahub"
+	DatahubCredentials "github.com/containers-ai/alameda/pkg/framework/datahub/credentials"
 )
 
 type Config struct {
 	Address string `mapstructure:"address"`
+	// ClusterID scopes calls to datahub, the uid of configmap default/cluster-info is used if it is empty
+	ClusterID string `mapstructure:"clusterID"`
+
+	DatahubCredentials.Config `mapstructure:",squash"`
 }
 
 func NewConfig() *Config {
@@ -20,6 +25,7 @@ func NewConfig() *Config {
 
 func (c *Config) init() {
 	c.Address = datahubutils.GetDatahubAddress()
+	c.Config = *DatahubCredentials.NewDefaultConfig()
 }
 
 func (c *Config) Validate() error {
@@ -31,5 +37,10 @@ func (c *Config) Validate() error {
 		return errors.New("datahub config validate failed: " + err.Error())
 	}
 
+	err = c.Config.Validate()
+	if err != nil {
+		return errors.New("datahub config validate failed: " + err.Error())
+	}
+
 	return nil
 }
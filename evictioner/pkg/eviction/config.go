@@ -10,6 +10,7 @@ type Config struct {
 	CheckCycle              int64 `mapstructure:"checkCycle"`
 	Enable                  bool  `mapstructure:"enable"`
 	PurgeContainerCPUMemory bool  `mapstructure:"purgeContainerCpuMemory"`
+	DryRun                  bool  `mapstructure:"dryRun"`
 }
 
 // NewDefaultConfig returns Config instance
@@ -18,6 +19,7 @@ func NewDefaultConfig() Config {
 		CheckCycle:              3,
 		Enable:                  false,
 		PurgeContainerCPUMemory: false,
+		DryRun:                  false,
 	}
 }
 
@@ -0,0 +1,227 @@
+package eviction
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	datahubutils "github.com/containers-ai/alameda/datahub/pkg/utils"
+	"github.com/containers-ai/alameda/pkg/consts"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	dryRunActionEvict                      = "evict"
+	dryRunActionPurgeTopControllerResource = "purgeTopControllerResources"
+
+	recommendationTypeLimit   = "limit"
+	recommendationTypeRequest = "request"
+)
+
+// resourceDelta is the difference between the current resource setting of a container and its recommendation
+type resourceDelta struct {
+	Container          string  `json:"container"`
+	Resource           string  `json:"resource"`
+	RecommendationType string  `json:"recommendationType"`
+	Current            string  `json:"current"`
+	Recommended        string  `json:"recommended"`
+	DeltaPercentage    float64 `json:"deltaPercentage"`
+	Threshold          float64 `json:"threshold"`
+	ExceedThreshold    bool    `json:"exceedThreshold"`
+}
+
+// dryRunEviction records a pod the evictioner would have evicted if it was not in dry-run mode
+type dryRunEviction struct {
+	Namespace     string          `json:"namespace"`
+	Name          string          `json:"name"`
+	TopController string          `json:"topController"`
+	AlamedaScaler string          `json:"alamedaScaler"`
+	Action        string          `json:"action"`
+	Deltas        []resourceDelta `json:"deltas"`
+
+	pod            *corev1.Pod
+	recommendation *datahub_v1alpha1.PodRecommendation
+}
+
+// dryRunReport summarizes the dry-run evictions of an AlamedaScaler in a check cycle
+type dryRunReport struct {
+	AlamedaScaler string            `json:"alamedaScaler"`
+	PodCount      int               `json:"podCount"`
+	Evictions     []*dryRunEviction `json:"evictions"`
+}
+
+func newDryRunEviction(c *controllerRecommendationInfo, info *podRecommendationInfo, threshold triggerThreshold) *dryRunEviction {
+	return &dryRunEviction{
+		Namespace:     info.pod.GetNamespace(),
+		Name:          info.pod.GetName(),
+		TopController: fmt.Sprintf("%s/%s/%s", c.kind, c.namespace, c.name),
+		AlamedaScaler: fmt.Sprintf("%s/%s", c.alamedaScaler.GetNamespace(), c.alamedaScaler.GetName()),
+		Action:        dryRunActionEvict,
+		Deltas:        buildResourceDeltas(info.pod, info.recommendation, threshold),
+
+		pod:            info.pod,
+		recommendation: info.recommendation,
+	}
+}
+
+// buildResourceDeltas computes the deltas the eviction trigger threshold is checked against for every container of the pod
+func buildResourceDeltas(pod *corev1.Pod, podRecommendation *datahub_v1alpha1.PodRecommendation, threshold triggerThreshold) []resourceDelta {
+	deltas := make([]resourceDelta, 0)
+	for _, container := range pod.Spec.Containers {
+		for _, recContainer := range podRecommendation.GetContainerRecommendations() {
+			if container.Name != recContainer.GetName() {
+				continue
+			}
+			deltas = append(deltas, buildResourceListDeltas(container.Name, recommendationTypeLimit,
+				container.Resources.Limits, recContainer.GetLimitRecommendations(), threshold)...)
+			deltas = append(deltas, buildResourceListDeltas(container.Name, recommendationTypeRequest,
+				container.Resources.Requests, recContainer.GetRequestRecommendations(), threshold)...)
+		}
+	}
+	return deltas
+}
+
+func buildResourceListDeltas(containerName, recommendationType string, resourceList corev1.ResourceList,
+	recommendations []*datahub_v1alpha1.MetricData, threshold triggerThreshold) []resourceDelta {
+
+	deltas := make([]resourceDelta, 0)
+	for _, recommendation := range recommendations {
+		if len(recommendation.GetData()) == 0 {
+			continue
+		}
+
+		var resourceName corev1.ResourceName
+		var thresholdValue float64
+		switch recommendation.GetMetricType() {
+		case datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE:
+			resourceName = corev1.ResourceCPU
+			thresholdValue = threshold.CPU
+		case datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES:
+			resourceName = corev1.ResourceMemory
+			thresholdValue = threshold.Memory
+		default:
+			continue
+		}
+
+		recommendedValue, err := datahubutils.StringToFloat64(recommendation.GetData()[0].GetNumValue())
+		if err != nil {
+			continue
+		}
+		recommendedValue = math.Ceil(recommendedValue)
+
+		delta := resourceDelta{
+			Container:          containerName,
+			Resource:           string(resourceName),
+			RecommendationType: recommendationType,
+			Recommended:        strconv.FormatFloat(recommendedValue, 'f', -1, 64),
+			Threshold:          thresholdValue,
+		}
+
+		quantity, exist := resourceList[resourceName]
+		if !exist {
+			// Container without the resource setting is always evicted
+			delta.ExceedThreshold = true
+			deltas = append(deltas, delta)
+			continue
+		}
+		currentValue := float64(quantity.Value())
+		if resourceName == corev1.ResourceCPU {
+			currentValue = float64(quantity.MilliValue())
+		}
+		delta.Current = strconv.FormatFloat(currentValue, 'f', -1, 64)
+		if currentValue == 0 {
+			delta.ExceedThreshold = true
+		} else {
+			delta.DeltaPercentage = math.Abs(100*(recommendedValue-currentValue)) / currentValue
+			delta.ExceedThreshold = delta.DeltaPercentage >= thresholdValue
+		}
+		deltas = append(deltas, delta)
+	}
+	return deltas
+}
+
+// buildDryRunReports groups dry-run evictions by AlamedaScaler
+func buildDryRunReports(evictions []*dryRunEviction) []*dryRunReport {
+	reportMap := make(map[string]*dryRunReport)
+	for _, eviction := range evictions {
+		report, exist := reportMap[eviction.AlamedaScaler]
+		if !exist {
+			report = &dryRunReport{
+				AlamedaScaler: eviction.AlamedaScaler,
+				Evictions:     make([]*dryRunEviction, 0),
+			}
+			reportMap[eviction.AlamedaScaler] = report
+		}
+		report.Evictions = append(report.Evictions, eviction)
+		report.PodCount++
+	}
+
+	reports := make([]*dryRunReport, 0, len(reportMap))
+	for _, report := range reportMap {
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].AlamedaScaler < reports[j].AlamedaScaler
+	})
+	return reports
+}
+
+func (evictioner *Evictioner) reportDryRunEvictions(evictions []*dryRunEviction) {
+
+	if len(evictions) == 0 {
+		return
+	}
+
+	events := make([]*datahub_v1alpha1.Event, 0, len(evictions))
+	for _, eviction := range evictions {
+		if evictioner.purgeContainerCPUMemory {
+			if needToPurge, err := evictioner.needToPurgeTopControllerOfRecommendation(eviction.recommendation); err != nil {
+				scope.Errorf("Dry run: check purging resources of pod (%s/%s) failed: %s", eviction.Namespace, eviction.Name, err.Error())
+				continue
+			} else if needToPurge {
+				eviction.Action = dryRunActionPurgeTopControllerResource
+			}
+		}
+
+		data, err := json.Marshal(eviction)
+		if err != nil {
+			scope.Errorf("Dry run: encode eviction of pod (%s/%s) failed: %s", eviction.Namespace, eviction.Name, err.Error())
+			continue
+		}
+		scope.Infof("Dry run: pod (%s/%s) would be evicted: %s", eviction.Namespace, eviction.Name, string(data))
+		e := newPodDryRunEvictEvent(evictioner.clusterID, &eviction.pod.ObjectMeta, eviction.pod.TypeMeta, eviction.Action, string(data))
+		events = append(events, &e)
+	}
+
+	for _, report := range buildDryRunReports(evictions) {
+		data, err := json.Marshal(report)
+		if err != nil {
+			scope.Errorf("Dry run: encode report of AlamedaScaler (%s) failed: %s", report.AlamedaScaler, err.Error())
+			continue
+		}
+		scope.Infof("Dry run: %d pods of AlamedaScaler (%s) would be evicted: %s", report.PodCount, report.AlamedaScaler, string(data))
+		e := newDryRunReportEvent(evictioner.clusterID, consts.K8S_KIND_ALAMEDASCALER, report, string(data))
+		events = append(events, &e)
+	}
+
+	if err := evictioner.sendEvents(events); err != nil {
+		scope.Warnf("Send dry run events to datahub failed: %s\n", err.Error())
+	}
+}
+
+func (evictioner *Evictioner) needToPurgeTopControllerOfRecommendation(recommendation *datahub_v1alpha1.PodRecommendation) (bool, error) {
+	topController := recommendation.GetTopController()
+	if topController == nil || topController.GetNamespacedName() == nil {
+		return false, errors.Errorf("get empty topController from PodRecommendation")
+	}
+	topControllerInstance, err := evictioner.getTopController(topController.NamespacedName.Namespace,
+		topController.NamespacedName.Name, topController.Kind)
+	if err != nil {
+		return false, errors.Wrap(err, "get topController failed")
+	}
+	return evictioner.needToPurgeTopControllerContainerResources(topControllerInstance, topController.Kind)
+}
@@ -0,0 +1,80 @@
+package eviction
+
+import (
+	"testing"
+
+	DatahubEvent "github.com/containers-ai/alameda/pkg/framework/datahub/event"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestBuildResourceDeltas(t *testing.T) {
+
+	pod := &core_v1.Pod{
+		Spec: core_v1.PodSpec{
+			Containers: []core_v1.Container{
+				core_v1.Container{
+					Name: "app",
+					Resources: core_v1.ResourceRequirements{
+						Limits: core_v1.ResourceList{
+							core_v1.ResourceCPU: resource.MustParse("200m"),
+						},
+						Requests: core_v1.ResourceList{
+							core_v1.ResourceMemory: resource.MustParse("1000"),
+						},
+					},
+				},
+			},
+		},
+	}
+	podRecommendation := &datahub_v1alpha1.PodRecommendation{
+		ContainerRecommendations: []*datahub_v1alpha1.ContainerRecommendation{
+			&datahub_v1alpha1.ContainerRecommendation{
+				Name: "app",
+				LimitRecommendations: []*datahub_v1alpha1.MetricData{
+					&datahub_v1alpha1.MetricData{
+						MetricType: datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE,
+						Data:       []*datahub_v1alpha1.Sample{&datahub_v1alpha1.Sample{NumValue: "210"}},
+					},
+					&datahub_v1alpha1.MetricData{
+						MetricType: datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES,
+						Data:       []*datahub_v1alpha1.Sample{&datahub_v1alpha1.Sample{NumValue: "2048"}},
+					},
+				},
+				RequestRecommendations: []*datahub_v1alpha1.MetricData{
+					&datahub_v1alpha1.MetricData{
+						MetricType: datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES,
+						Data:       []*datahub_v1alpha1.Sample{&datahub_v1alpha1.Sample{NumValue: "1500"}},
+					},
+				},
+			},
+		},
+	}
+
+	want := []resourceDelta{
+		resourceDelta{Container: "app", Resource: "cpu", RecommendationType: recommendationTypeLimit, Current: "200", Recommended: "210", DeltaPercentage: 5, Threshold: 10, ExceedThreshold: false},
+		resourceDelta{Container: "app", Resource: "memory", RecommendationType: recommendationTypeLimit, Current: "", Recommended: "2048", DeltaPercentage: 0, Threshold: 20, ExceedThreshold: true},
+		resourceDelta{Container: "app", Resource: "memory", RecommendationType: recommendationTypeRequest, Current: "1000", Recommended: "1500", DeltaPercentage: 50, Threshold: 20, ExceedThreshold: true},
+	}
+
+	assert := assert.New(t)
+	actual := buildResourceDeltas(pod, podRecommendation, triggerThreshold{CPU: 10, Memory: 20})
+	assert.Equal(want, actual)
+}
+
+func TestDryRunEventTypes(t *testing.T) {
+
+	pod := &core_v1.Pod{}
+	pod.SetNamespace("default")
+	pod.SetName("app")
+
+	evictEvent := newPodEvictEvent("cluster", pod, pod.TypeMeta)
+	dryRunEvent := newPodDryRunEvictEvent("cluster", pod, pod.TypeMeta, dryRunActionEvict, "")
+	reportEvent := newDryRunReportEvent("cluster", "AlamedaScaler", &dryRunReport{AlamedaScaler: "default/scaler", PodCount: 1}, "")
+
+	assert.Equal(t, datahub_v1alpha1.EventType_EVENT_TYPE_VPA_RECOMMENDATION_EXECUTE, evictEvent.Type)
+	assert.Equal(t, DatahubEvent.EventType_EVENT_TYPE_VPA_RECOMMENDATION_DRY_RUN, dryRunEvent.Type)
+	assert.Equal(t, DatahubEvent.EventType_EVENT_TYPE_VPA_RECOMMENDATION_DRY_RUN, reportEvent.Type)
+}
@@ -2,7 +2,10 @@ package eviction
 
 import (
 	"fmt"
+	"strings"
 
+	autoscalingv1alpha1 "github.com/containers-ai/alameda/operator/pkg/apis/autoscaling/v1alpha1"
+	DatahubEvent "github.com/containers-ai/alameda/pkg/framework/datahub/event"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 
 	"github.com/golang/protobuf/ptypes"
@@ -59,3 +62,69 @@ func newPodEvictEvent(clusterID string, subjectObject metav1.Object, subjectType
 
 	return event
 }
+
+func newPodEvictionRefusedEvent(clusterID string, subjectObject metav1.Object, subjectType metav1.TypeMeta, reason string) datahub_v1alpha1.Event {
+
+	event := newPodEvictEvent(clusterID, subjectObject, subjectType)
+	event.Type = DatahubEvent.EventType_EVENT_TYPE_VPA_RECOMMENDATION_EVICTION_REFUSED
+	event.Level = datahub_v1alpha1.EventLevel_EVENT_LEVEL_WARNING
+	event.Message = fmt.Sprintf("Eviction of pod %s/%s is refused: %s", subjectObject.GetNamespace(), subjectObject.GetName(), reason)
+
+	return event
+}
+
+func newPodQuotaExceededEvent(clusterID string, subjectObject metav1.Object, subjectType metav1.TypeMeta, reason string) datahub_v1alpha1.Event {
+
+	event := newPodEvictEvent(clusterID, subjectObject, subjectType)
+	event.Type = DatahubEvent.EventType_EVENT_TYPE_VPA_RECOMMENDATION_QUOTA_EXCEEDED
+	event.Level = datahub_v1alpha1.EventLevel_EVENT_LEVEL_WARNING
+	event.Message = fmt.Sprintf("Eviction of pod %s/%s is skipped: %s", subjectObject.GetNamespace(), subjectObject.GetName(), reason)
+
+	return event
+}
+
+func newPodDryRunEvictEvent(clusterID string, subjectObject metav1.Object, subjectType metav1.TypeMeta, action, data string) datahub_v1alpha1.Event {
+
+	event := newPodEvictEvent(clusterID, subjectObject, subjectType)
+	event.Type = DatahubEvent.EventType_EVENT_TYPE_VPA_RECOMMENDATION_DRY_RUN
+	event.Message = fmt.Sprintf("Pod %s/%s would be evicted (dry run, action: %s)", subjectObject.GetNamespace(), subjectObject.GetName(), action)
+	event.Data = data
+
+	return event
+}
+
+func newDryRunReportEvent(clusterID string, alamedaScalerKind string, report *dryRunReport, data string) datahub_v1alpha1.Event {
+
+	namespace, name := "", report.AlamedaScaler
+	if names := strings.SplitN(report.AlamedaScaler, "/", 2); len(names) == 2 {
+		namespace, name = names[0], names[1]
+	}
+
+	now := ptypes.TimestampNow()
+	id := uuid.NewUUID()
+	source := datahub_v1alpha1.EventSource{
+		Host:      "",
+		Component: componentName,
+	}
+	subject := datahub_v1alpha1.K8SObjectReference{
+		Kind:       alamedaScalerKind,
+		ApiVersion: autoscalingv1alpha1.SchemeGroupVersion.String(),
+		Namespace:  namespace,
+		Name:       name,
+	}
+
+	event := datahub_v1alpha1.Event{
+		Time:      now,
+		Id:        string(id),
+		ClusterId: clusterID,
+		Source:    &source,
+		Type:      DatahubEvent.EventType_EVENT_TYPE_VPA_RECOMMENDATION_DRY_RUN,
+		Version:   datahub_v1alpha1.EventVersion_EVENT_VERSION_V1,
+		Level:     datahub_v1alpha1.EventLevel_EVENT_LEVEL_INFO,
+		Subject:   &subject,
+		Message:   fmt.Sprintf("%d pods of AlamedaScaler %s would be evicted (dry run)", report.PodCount, report.AlamedaScaler),
+		Data:      data,
+	}
+
+	return event
+}
@@ -1,6 +1,7 @@
 package eviction
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"strconv"
@@ -9,16 +10,25 @@ import (
 	datahubutils "github.com/containers-ai/alameda/datahub/pkg/utils"
 	autoscalingv1alpha1 "github.com/containers-ai/alameda/operator/pkg/apis/autoscaling/v1alpha1"
 	utilsresource "github.com/containers-ai/alameda/operator/pkg/utils/resources"
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
+	requirementsutils "github.com/containers-ai/alameda/pkg/utils/kubernetes/requirements"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	core_v1 "k8s.io/api/core/v1"
+	policy_v1beta1 "k8s.io/api/policy/v1beta1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	k8s_resource "k8s.io/apimachinery/pkg/api/resource"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type EvictionRestriction interface {
 	IsEvictabel(pod *core_v1.Pod) (isEvictabel bool, err error)
+	// GetDesiredPod returns the pod with resources to apply if it is checked by IsEvictabel, otherwise nil
+	GetDesiredPod(pod *core_v1.Pod) *core_v1.Pod
 }
 
 type podReplicaStatus struct {
@@ -60,21 +70,137 @@ func NewPodReplicaStatus(pods []core_v1.Pod, replicasCount int32, maxUnavailable
 	return podReplicaStatus, nil
 }
 
+// quotaExceededError is returned if applying recommendation to the pod would exceed ResourceQuotas
+type quotaExceededError struct {
+	exceeded []string
+}
+
+func (e *quotaExceededError) Error() string {
+	return fmt.Sprintf("applying recommendation would exceed ResourceQuotas: %s", strings.Join(e.exceeded, ", "))
+}
+
+// namespaceConstraints caches LimitRanges and ResourceQuotas of namespaces, usage of pods to evict
+// is reserved into the cached ResourceQuotas so evictions in the same cycle share the quotas
+type namespaceConstraints struct {
+	client client.Client
+
+	namespaceToLimitRangesMap    map[string][]core_v1.LimitRange
+	namespaceToResourceQuotasMap map[string][]core_v1.ResourceQuota
+}
+
+func newNamespaceConstraints(client client.Client) *namespaceConstraints {
+	return &namespaceConstraints{
+		client: client,
+
+		namespaceToLimitRangesMap:    make(map[string][]core_v1.LimitRange),
+		namespaceToResourceQuotasMap: make(map[string][]core_v1.ResourceQuota),
+	}
+}
+
+func (n *namespaceConstraints) getLimitRanges(namespace string) []core_v1.LimitRange {
+
+	if limitRanges, exist := n.namespaceToLimitRangesMap[namespace]; exist {
+		return limitRanges
+	}
+	limitRangeList := &core_v1.LimitRangeList{}
+	if err := n.client.List(context.TODO(), &client.ListOptions{Namespace: namespace}, limitRangeList); err != nil {
+		scope.Warnf("ignore LimitRanges in namespace %s: list LimitRanges failed: %s", namespace, err.Error())
+	}
+	n.namespaceToLimitRangesMap[namespace] = limitRangeList.Items
+	return limitRangeList.Items
+}
+
+func (n *namespaceConstraints) getResourceQuotas(namespace string) []core_v1.ResourceQuota {
+
+	if resourceQuotas, exist := n.namespaceToResourceQuotasMap[namespace]; exist {
+		return resourceQuotas
+	}
+	resourceQuotaList := &core_v1.ResourceQuotaList{}
+	if err := n.client.List(context.TODO(), &client.ListOptions{Namespace: namespace}, resourceQuotaList); err != nil {
+		scope.Warnf("ignore ResourceQuotas in namespace %s: list ResourceQuotas failed: %s", namespace, err.Error())
+	}
+	n.namespaceToResourceQuotasMap[namespace] = resourceQuotaList.Items
+	return resourceQuotaList.Items
+}
+
+// disruptionBudgets caches PodDisruptionBudgets of namespaces and their remaining disruptions, it is shared
+// by eviction restrictions of all controllers in the same cycle so a PodDisruptionBudget selecting pods of
+// several controllers is not exceeded
+type disruptionBudgets struct {
+	client client.Client
+
+	namespaceToPDBsMap           map[string][]policy_v1beta1.PodDisruptionBudget
+	pdbIDToDisruptionsAllowedMap map[string]float64
+}
+
+func newDisruptionBudgets(client client.Client) *disruptionBudgets {
+	return &disruptionBudgets{
+		client: client,
+
+		namespaceToPDBsMap:           make(map[string][]policy_v1beta1.PodDisruptionBudget),
+		pdbIDToDisruptionsAllowedMap: make(map[string]float64),
+	}
+}
+
+// matchPod returns ids of PodDisruptionBudgets selecting the pod
+func (d *disruptionBudgets) matchPod(pod core_v1.Pod) []string {
+
+	namespace := pod.GetNamespace()
+	pdbs, exist := d.namespaceToPDBsMap[namespace]
+	if !exist {
+		var err error
+		pdbs, err = listPodDisruptionBudgets(d.client, namespace)
+		if err != nil {
+			scope.Warnf("ignore PodDisruptionBudgets in namespace %s: %s", namespace, err.Error())
+		}
+		d.namespaceToPDBsMap[namespace] = pdbs
+		for _, pdb := range pdbs {
+			d.pdbIDToDisruptionsAllowedMap[fmt.Sprintf("%s/%s", pdb.GetNamespace(), pdb.GetName())] = float64(pdb.Status.PodDisruptionsAllowed)
+		}
+	}
+	return matchPodDisruptionBudgets(pod, pdbs)
+}
+
+// blockingPDB returns id of the first PodDisruptionBudget allowing no more disruptions, empty if there is none
+func (d *disruptionBudgets) blockingPDB(pdbIDs []string) string {
+	for _, pdbID := range pdbIDs {
+		if d.pdbIDToDisruptionsAllowedMap[pdbID] <= 0 {
+			return pdbID
+		}
+	}
+	return ""
+}
+
+// consume records a disruption of the pod selected by the PodDisruptionBudgets
+func (d *disruptionBudgets) consume(pdbIDs []string) {
+	for _, pdbID := range pdbIDs {
+		d.pdbIDToDisruptionsAllowedMap[pdbID]--
+	}
+}
+
 type evictionRestriction struct {
 	triggerThreshold triggerThreshold
+	limitsPolicy     autoscalingv1alpha1.LimitsPolicy
+	constraints      *namespaceConstraints
 
 	alamedaScalerMap map[string]*autoscalingv1alpha1.AlamedaScaler
 
 	podIDToPodRecommendationMap            map[string]*datahub_v1alpha1.PodRecommendation
+	podIDToDesiredPodMap                   map[string]*core_v1.Pod
 	podIDToAlamedaResourceIDMap            map[string]string
 	alamedaResourceIDToPodReplicaStatusMap map[string]*podReplicaStatus
+
+	podIDToPDBIDsMap map[string][]string
+	budgets          *disruptionBudgets
 }
 
-func NewEvictionRestriction(client client.Client, maxUnavailable string, triggerThreshold triggerThreshold, podRecommendations []*datahub_v1alpha1.PodRecommendation) EvictionRestriction {
+func NewEvictionRestriction(client client.Client, maxUnavailable string, triggerThreshold triggerThreshold, limitsPolicy autoscalingv1alpha1.LimitsPolicy,
+	constraints *namespaceConstraints, budgets *disruptionBudgets, podRecommendations []*datahub_v1alpha1.PodRecommendation) EvictionRestriction {
 
 	podIDToPodRecommendationMap := make(map[string]*datahub_v1alpha1.PodRecommendation)
 	podIDToAlamedaResourceIDMap := make(map[string]string)
 	alamedaResourceIDToPodReplicaStatusMap := make(map[string]*podReplicaStatus)
+	podIDToPDBIDsMap := make(map[string][]string)
 	for _, podRecommendation := range podRecommendations {
 
 		copyPodRecommendation := proto.Clone(podRecommendation)
@@ -108,7 +234,7 @@ func NewEvictionRestriction(client client.Client, maxUnavailable string, trigger
 			getResource := utilsresource.NewGetResource(client)
 			listResource := utilsresource.NewListResources(client)
 
-			controllerKind := datahub_v1alpha1.Kind_name[int32(alamedaResourceKind)]
+			controllerKind := DatahubKind.Name(alamedaResourceKind)
 			replicasCount, err := getResource.GetReplicasCountByController(alamedaResourceNamespace, alamedaResourceName, strings.ToLower(controllerKind))
 			if err != nil {
 				if err != nil {
@@ -127,15 +253,26 @@ func NewEvictionRestriction(client client.Client, maxUnavailable string, trigger
 				continue
 			}
 			alamedaResourceIDToPodReplicaStatusMap[alamedaResourceID] = &podReplicaStatus
+
+			// Record PodDisruptionBudgets selecting pods of the controller
+			for _, pod := range pods {
+				podIDToPDBIDsMap[fmt.Sprintf("%s/%s", pod.GetNamespace(), pod.GetName())] = budgets.matchPod(pod)
+			}
 		}
 	}
 
 	e := &evictionRestriction{
 		triggerThreshold: triggerThreshold,
+		limitsPolicy:     limitsPolicy,
+		constraints:      constraints,
 
 		podIDToPodRecommendationMap:            podIDToPodRecommendationMap,
+		podIDToDesiredPodMap:                   make(map[string]*core_v1.Pod),
 		podIDToAlamedaResourceIDMap:            podIDToAlamedaResourceIDMap,
 		alamedaResourceIDToPodReplicaStatusMap: alamedaResourceIDToPodReplicaStatusMap,
+
+		podIDToPDBIDsMap: podIDToPDBIDsMap,
+		budgets:          budgets,
 	}
 	return e
 }
@@ -151,6 +288,22 @@ func (e *evictionRestriction) IsEvictabel(pod *core_v1.Pod) (bool, error) {
 		return false, nil
 	}
 
+	// Pod recreated with the recommendation must be accepted by LimitRanges and ResourceQuotas
+	desiredPod := e.buildDesiredPod(pod, podRecommendation)
+	e.podIDToDesiredPodMap[podID] = desiredPod
+	if apiequality.Semantic.DeepEqual(pod.Spec.Containers, desiredPod.Spec.Containers) &&
+		apiequality.Semantic.DeepEqual(pod.Spec.InitContainers, desiredPod.Spec.InitContainers) {
+		scope.Infof("Pod (%s) is not evictable, recommendation clamped into LimitRanges equals to current resources, ignore PodRecommendation (%s)",
+			podID, podID)
+		return false, nil
+	}
+	currentUsage := requirementsutils.PodQuotaUsage(pod.Spec)
+	desiredUsage := requirementsutils.PodQuotaUsage(desiredPod.Spec)
+	resourceQuotas := e.constraints.getResourceQuotas(podNamespace)
+	if exceeded := requirementsutils.ExceededResourceQuotas(resourceQuotas, currentUsage, desiredUsage); len(exceeded) > 0 {
+		return false, &quotaExceededError{exceeded: exceeded}
+	}
+
 	ok, err := e.canRollingUpdatePod(podID)
 	if err != nil {
 		scope.Errorf("check if rolling update can perform on pod (%s) failed: %s", podID, err.Error())
@@ -159,9 +312,62 @@ func (e *evictionRestriction) IsEvictabel(pod *core_v1.Pod) (bool, error) {
 		return false, nil
 	}
 
+	requirementsutils.ReserveResourceQuotas(resourceQuotas, currentUsage, desiredUsage)
 	return true, nil
 }
 
+func (e *evictionRestriction) GetDesiredPod(pod *core_v1.Pod) *core_v1.Pod {
+	return e.podIDToDesiredPodMap[fmt.Sprintf("%s/%s", pod.GetNamespace(), pod.GetName())]
+}
+
+// buildDesiredPod returns copy of the pod with resources the admission controller will patch with the recommendation
+func (e *evictionRestriction) buildDesiredPod(pod *core_v1.Pod, podRecommendation *datahub_v1alpha1.PodRecommendation) *core_v1.Pod {
+
+	desiredPod := pod.DeepCopy()
+	limitRanges := e.constraints.getLimitRanges(pod.GetNamespace())
+
+	containerNameMap := make(map[string]*core_v1.Container)
+	for i, container := range desiredPod.Spec.InitContainers {
+		containerNameMap[container.Name] = &desiredPod.Spec.InitContainers[i]
+	}
+	for i, container := range desiredPod.Spec.Containers {
+		containerNameMap[container.Name] = &desiredPod.Spec.Containers[i]
+	}
+	for _, containerRecommendation := range podRecommendation.GetContainerRecommendations() {
+		container, exist := containerNameMap[containerRecommendation.GetName()]
+		if !exist {
+			continue
+		}
+		requests := buildResourceList(containerRecommendation.GetRequestRecommendations())
+		limits := buildResourceList(containerRecommendation.GetLimitRecommendations())
+		requirementsutils.MergeRecommendation(&container.Resources, requests, limits, e.limitsPolicy)
+		requirementsutils.ClampByLimitRanges(&container.Resources, limitRanges)
+	}
+	return desiredPod
+}
+
+// buildResourceList converts recommended cpu millicores and memory bytes into ResourceList
+func buildResourceList(metricData []*datahub_v1alpha1.MetricData) core_v1.ResourceList {
+
+	resourceList := make(core_v1.ResourceList)
+	for _, data := range metricData {
+		if len(data.GetData()) == 0 {
+			continue
+		}
+		value, err := datahubutils.StringToFloat64(data.GetData()[0].GetNumValue())
+		if err != nil {
+			continue
+		}
+		switch data.GetMetricType() {
+		case datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE:
+			resourceList[core_v1.ResourceCPU] = *k8s_resource.NewMilliQuantity(int64(math.Ceil(value)), k8s_resource.DecimalSI)
+		case datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES:
+			resourceList[core_v1.ResourceMemory] = *k8s_resource.NewQuantity(int64(math.Ceil(value)), k8s_resource.BinarySI)
+		}
+	}
+	return resourceList
+}
+
 func (e *evictionRestriction) canRollingUpdatePod(podID string) (bool, error) {
 
 	alamedaResourceID, exist := e.podIDToAlamedaResourceIDMap[podID]
@@ -172,10 +378,7 @@ func (e *evictionRestriction) canRollingUpdatePod(podID string) (bool, error) {
 	if !exist {
 		return false, errors.Errorf("PodReplicaStatus of pod does not exit")
 	}
-	if podReplicaStatus.runningPodCount-podReplicaStatus.evictedPodCount > podReplicaStatus.preservedPodCount {
-		podReplicaStatus.evictedPodCount++
-		return true, nil
-	} else {
+	if podReplicaStatus.runningPodCount-podReplicaStatus.evictedPodCount <= podReplicaStatus.preservedPodCount {
 		podRecommendationID := podID
 		scope.Infof("Pod (%s) is not evictable, current running replicas count %.f is not greater then preseved replicas count %.f , ignore PodRecommendation (%s)",
 			podID,
@@ -185,6 +388,48 @@ func (e *evictionRestriction) canRollingUpdatePod(podID string) (bool, error) {
 		return false, nil
 	}
 
+	// The stricter of maxUnavailable and PodDisruptionBudgets wins
+	pdbIDs := e.podIDToPDBIDsMap[podID]
+	if pdbID := e.budgets.blockingPDB(pdbIDs); pdbID != "" {
+		scope.Infof("Pod (%s) is not evictable, PodDisruptionBudget (%s) allows no more disruptions, ignore PodRecommendation (%s)",
+			podID, pdbID, podID)
+		return false, nil
+	}
+
+	podReplicaStatus.evictedPodCount++
+	e.budgets.consume(pdbIDs)
+	return true, nil
+}
+
+func listPodDisruptionBudgets(c client.Client, namespace string) ([]policy_v1beta1.PodDisruptionBudget, error) {
+
+	pdbList := &policy_v1beta1.PodDisruptionBudgetList{}
+	if err := c.List(context.TODO(), &client.ListOptions{Namespace: namespace}, pdbList); err != nil {
+		return nil, errors.Wrap(err, "list PodDisruptionBudgets failed")
+	}
+	return pdbList.Items, nil
+}
+
+// matchPodDisruptionBudgets returns ids of PodDisruptionBudgets selecting the pod
+func matchPodDisruptionBudgets(pod core_v1.Pod, pdbs []policy_v1beta1.PodDisruptionBudget) []string {
+
+	pdbIDs := make([]string, 0)
+	for _, pdb := range pdbs {
+		if pdb.GetNamespace() != pod.GetNamespace() {
+			continue
+		}
+		selector, err := meta_v1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			scope.Warnf("ignore PodDisruptionBudget (%s/%s) due to invalid selector: %s", pdb.GetNamespace(), pdb.GetName(), err.Error())
+			continue
+		}
+		// A PodDisruptionBudget with empty selector selects no pods
+		if selector.Empty() || !selector.Matches(labels.Set(pod.GetLabels())) {
+			continue
+		}
+		pdbIDs = append(pdbIDs, fmt.Sprintf("%s/%s", pdb.GetNamespace(), pdb.GetName()))
+	}
+	return pdbIDs
 }
 
 func (e *evictionRestriction) isPodEvictable(pod *core_v1.Pod, podRecomm *datahub_v1alpha1.PodRecommendation) bool {
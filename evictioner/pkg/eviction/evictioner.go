@@ -8,9 +8,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/containers-ai/alameda/evictioner/pkg/metrics"
 	autoscalingv1alpha1 "github.com/containers-ai/alameda/operator/pkg/apis/autoscaling/v1alpha1"
 	utilsresource "github.com/containers-ai/alameda/operator/pkg/utils/resources"
 	"github.com/containers-ai/alameda/pkg/consts"
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
+	DatahubStream "github.com/containers-ai/alameda/pkg/framework/datahub/stream"
 	"github.com/containers-ai/alameda/pkg/utils"
 	logUtil "github.com/containers-ai/alameda/pkg/utils/log"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
@@ -19,10 +22,16 @@ import (
 	openshift_apps_v1 "github.com/openshift/api/apps/v1"
 	"github.com/pkg/errors"
 	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc"
 	apps_v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policy_v1beta1 "k8s.io/api/policy/v1beta1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	policy_v1beta1_client "k8s.io/client-go/kubernetes/typed/policy/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -33,8 +42,10 @@ var (
 // Evictioner deletes pods which need to apply recommendation
 type Evictioner struct {
 	checkCycle              int64
+	datahubConn             *grpc.ClientConn
 	datahubClnt             datahub_v1alpha1.DatahubServiceClient
 	k8sClienit              client.Client
+	evictionsGetter         policy_v1beta1_client.EvictionsGetter
 	evictCfg                Config
 	purgeContainerCPUMemory bool
 
@@ -43,15 +54,18 @@ type Evictioner struct {
 
 // NewEvictioner return Evictioner instance
 func NewEvictioner(checkCycle int64,
-	datahubClnt datahub_v1alpha1.DatahubServiceClient,
+	datahubConn *grpc.ClientConn,
 	k8sClienit client.Client,
+	k8sClientset kubernetes.Interface,
 	evictCfg Config,
 	purgeContainerCPUMemory bool,
 	clusterID string) *Evictioner {
 	return &Evictioner{
 		checkCycle:              checkCycle,
-		datahubClnt:             datahubClnt,
+		datahubConn:             datahubConn,
+		datahubClnt:             datahub_v1alpha1.NewDatahubServiceClient(datahubConn),
 		k8sClienit:              k8sClienit,
+		evictionsGetter:         k8sClientset.PolicyV1beta1(),
 		evictCfg:                evictCfg,
 		purgeContainerCPUMemory: purgeContainerCPUMemory,
 		clusterID:               clusterID,
@@ -65,21 +79,27 @@ func (evictioner *Evictioner) Start() {
 
 func (evictioner *Evictioner) evictProcess() {
 	for {
-		if !evictioner.evictCfg.Enable {
+		if !evictioner.evictCfg.Enable && !evictioner.evictCfg.DryRun {
 			scope.Warn("evictioner is not enabled")
 			return
 		}
-		appliablePodRecList, err := evictioner.listAppliablePodRecommendation()
+		appliablePodRecList, evictingPods, dryRunEvictions, err := evictioner.listAppliablePodRecommendation()
 		if err != nil {
 			scope.Error(err.Error())
 		}
 		scope.Debugf("Applicable pod recommendation lists: %s", utils.InterfaceToString(appliablePodRecList))
-		evictioner.evictPods(appliablePodRecList)
+		evictioner.evictPods(appliablePodRecList, evictingPods)
+		evictioner.reportDryRunEvictions(dryRunEvictions)
 		time.Sleep(time.Duration(evictioner.checkCycle) * time.Second)
 	}
 }
 
-func (evictioner *Evictioner) evictPods(recPodList []*datahub_v1alpha1.PodRecommendation) {
+// isDryRun returns true if evictions of all AlamedaScalers are only reported
+func (evictioner *Evictioner) isDryRun() bool {
+	return !evictioner.evictCfg.Enable || evictioner.evictCfg.DryRun
+}
+
+func (evictioner *Evictioner) evictPods(recPodList []*datahub_v1alpha1.PodRecommendation, evictingPods map[string]*evictingPod) {
 
 	events := make([]*datahub_v1alpha1.Event, 0, len(recPodList))
 
@@ -95,6 +115,7 @@ func (evictioner *Evictioner) evictPods(recPodList []*datahub_v1alpha1.PodRecomm
 			}
 			continue
 		}
+		podID := fmt.Sprintf("%s/%s", recPodIns.GetNamespace(), recPodIns.GetName())
 		if evictioner.purgeContainerCPUMemory {
 			topController := recPod.TopController
 			if topController == nil || topController.NamespacedName == nil {
@@ -117,23 +138,11 @@ func (evictioner *Evictioner) evictPods(recPodList []*datahub_v1alpha1.PodRecomm
 					scope.Errorf("Purge pod (%s,%s) resources failed: %s", recPodIns.GetNamespace(), recPodIns.GetName(), err.Error())
 					continue
 				}
-			} else {
-				err = evictioner.k8sClienit.Delete(context.TODO(), recPodIns)
-				if err != nil {
-					scope.Errorf("Evict pod (%s,%s) failed: %s", recPodIns.GetNamespace(), recPodIns.GetName(), err.Error())
-				} else {
-					e := newPodEvictEvent(evictioner.clusterID, &recPodIns.ObjectMeta, recPodIns.TypeMeta)
-					events = append(events, &e)
-				}
-			}
-		} else {
-			err = evictioner.k8sClienit.Delete(context.TODO(), recPodIns)
-			if err != nil {
-				scope.Errorf("Evict pod (%s,%s) failed: %s", recPodIns.GetNamespace(), recPodIns.GetName(), err.Error())
-			} else {
-				e := newPodEvictEvent(evictioner.clusterID, &recPodIns.ObjectMeta, recPodIns.TypeMeta)
-				events = append(events, &e)
+			} else if e := evictioner.evictPod(recPodIns, evictingPods[podID]); e != nil {
+				events = append(events, e)
 			}
+		} else if e := evictioner.evictPod(recPodIns, evictingPods[podID]); e != nil {
+			events = append(events, e)
 		}
 	}
 
@@ -142,6 +151,68 @@ func (evictioner *Evictioner) evictPods(recPodList []*datahub_v1alpha1.PodRecomm
 	}
 }
 
+// evictPod evicts the pod through the Eviction API so PodDisruptionBudgets are honored,
+// and returns the event to send to datahub, or nil if no event should be sent
+func (evictioner *Evictioner) evictPod(pod *corev1.Pod, evicting *evictingPod) *datahub_v1alpha1.Event {
+
+	eviction := &policy_v1beta1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: pod.GetNamespace(),
+			Name:      pod.GetName(),
+		},
+	}
+	err := evictioner.evictionsGetter.Evictions(pod.GetNamespace()).Evict(eviction)
+	if err != nil && k8serrors.IsTooManyRequests(err) {
+		scope.Warnf("Evict pod (%s,%s) is refused: %s", pod.GetNamespace(), pod.GetName(), err.Error())
+		metrics.AddPodEviction(pod.GetNamespace(), metrics.EvictionResultRefused)
+		evicting.addRecommendationHistory(evictioner.k8sClienit, pod, false, err.Error())
+		e := newPodEvictionRefusedEvent(evictioner.clusterID, &pod.ObjectMeta, pod.TypeMeta, err.Error())
+		return &e
+	} else if err != nil {
+		scope.Errorf("Evict pod (%s,%s) failed: %s", pod.GetNamespace(), pod.GetName(), err.Error())
+		metrics.AddPodEviction(pod.GetNamespace(), metrics.EvictionResultFailed)
+		return nil
+	}
+	metrics.AddPodEviction(pod.GetNamespace(), metrics.EvictionResultEvicted)
+
+	evicting.addRecommendationHistory(evictioner.k8sClienit, pod, true, "")
+	e := newPodEvictEvent(evictioner.clusterID, &pod.ObjectMeta, pod.TypeMeta)
+	return &e
+}
+
+// evictingPod is a pod to evict with the pod to be recreated and the controller of the pod
+type evictingPod struct {
+	desiredPod *corev1.Pod
+	controller *controllerRecommendationInfo
+}
+
+// addRecommendationHistory records the attempt to apply recommendation to the pod into AlamedaScaler monitoring its controller,
+// histories are kept per controller so they survive the pod being replaced
+func (e *evictingPod) addRecommendationHistory(k8sClient client.Client, pod *corev1.Pod, applied bool, reason string) {
+
+	if e == nil || e.controller == nil {
+		return
+	}
+	history := autoscalingv1alpha1.AlamedaRecommendationHistory{
+		Time:    metav1.Now(),
+		PodName: pod.GetName(),
+		Applier: autoscalingv1alpha1.RecommendationApplierEvictioner,
+		Applied: applied,
+		Reason:  reason,
+		Before:  autoscalingv1alpha1.NewAlamedaContainers(pod.Spec),
+	}
+	if e.desiredPod != nil {
+		history.After = autoscalingv1alpha1.NewAlamedaContainers(e.desiredPod.Spec)
+	}
+	alamedaScaler := e.controller.alamedaScaler
+	updateResource := utilsresource.NewUpdateResource(k8sClient)
+	if err := updateResource.AddAlamedaScalerRecommendationHistory(alamedaScaler.GetNamespace(), alamedaScaler.GetName(),
+		e.controller.getK8SKind(), e.controller.namespace, e.controller.name, history); err != nil {
+		scope.Warnf("Add recommendation history of pod (%s,%s) to AlamedaScaler (%s/%s) failed: %s",
+			pod.GetNamespace(), pod.GetName(), alamedaScaler.GetNamespace(), alamedaScaler.GetName(), err.Error())
+	}
+}
+
 func (evictioner *Evictioner) getTopController(namespace string, name string, kind datahub_v1alpha1.Kind) (interface{}, error) {
 
 	getResource := utilsresource.NewGetResource(evictioner.k8sClienit)
@@ -153,172 +224,115 @@ func (evictioner *Evictioner) getTopController(namespace string, name string, ki
 		return getResource.GetDeploymentConfig(namespace, name)
 	case datahub_v1alpha1.Kind_STATEFULSET:
 		return getResource.GetStatefulSet(namespace, name)
+	case DatahubKind.Kind_DAEMONSET:
+		return getResource.GetDaemonSet(namespace, name)
+	case DatahubKind.Kind_REPLICASET:
+		return getResource.GetReplicaSet(namespace, name)
 	default:
-		return nil, errors.Errorf("not supported controller type %s", datahub_v1alpha1.Kind_name[int32(kind)])
+		return nil, errors.Errorf("not supported controller type %s", DatahubKind.Name(kind))
 	}
 }
 
-func (evictioner *Evictioner) needToPurgeTopControllerContainerResources(controller interface{}, kind datahub_v1alpha1.Kind) (bool, error) {
+// podTemplateOfTopController returns the pod template in the spec of the controller, pods of Jobs and CronJobs
+// are not evicted so their controllers are not supported
+func podTemplateOfTopController(controller interface{}, kind datahub_v1alpha1.Kind) (*corev1.PodTemplateSpec, error) {
 
 	switch kind {
 	case datahub_v1alpha1.Kind_DEPLOYMENT:
-		deployment := controller.(*apps_v1.Deployment)
-		for _, container := range deployment.Spec.Template.Spec.Containers {
-			resourceLimits := container.Resources.Limits
-			if resourceLimits != nil {
-				_, cpuSpecExist := resourceLimits[corev1.ResourceCPU]
-				_, memorySpecExist := resourceLimits[corev1.ResourceMemory]
-				if cpuSpecExist || memorySpecExist {
-					return true, nil
-				}
-			}
-			resourceRequests := container.Resources.Requests
-			if resourceRequests != nil {
-				_, cpuSpecExist := resourceRequests[corev1.ResourceCPU]
-				_, memorySpecExist := resourceRequests[corev1.ResourceMemory]
-				if cpuSpecExist || memorySpecExist {
-					return true, nil
-				}
-			}
-		}
-		return false, nil
+		return &controller.(*apps_v1.Deployment).Spec.Template, nil
 	case datahub_v1alpha1.Kind_DEPLOYMENTCONFIG:
-		deploymentConfig := controller.(*openshift_apps_v1.DeploymentConfig)
-		for _, container := range deploymentConfig.Spec.Template.Spec.Containers {
-			resourceLimits := container.Resources.Limits
-			if resourceLimits != nil {
-				_, cpuSpecExist := resourceLimits[corev1.ResourceCPU]
-				_, memorySpecExist := resourceLimits[corev1.ResourceMemory]
-				if cpuSpecExist || memorySpecExist {
-					return true, nil
-				}
-			}
-			resourceRequests := container.Resources.Requests
-			if resourceRequests != nil {
-				_, cpuSpecExist := resourceRequests[corev1.ResourceCPU]
-				_, memorySpecExist := resourceRequests[corev1.ResourceMemory]
-				if cpuSpecExist || memorySpecExist {
-					return true, nil
-				}
-			}
-		}
-		return false, nil
+		return controller.(*openshift_apps_v1.DeploymentConfig).Spec.Template, nil
 	case datahub_v1alpha1.Kind_STATEFULSET:
-		statefulSet := controller.(*apps_v1.StatefulSet)
-		for _, container := range statefulSet.Spec.Template.Spec.Containers {
-			resourceLimits := container.Resources.Limits
-			if resourceLimits != nil {
-				_, cpuSpecExist := resourceLimits[corev1.ResourceCPU]
-				_, memorySpecExist := resourceLimits[corev1.ResourceMemory]
-				if cpuSpecExist || memorySpecExist {
-					return true, nil
-				}
-			}
-			resourceRequests := container.Resources.Requests
-			if resourceRequests != nil {
-				_, cpuSpecExist := resourceRequests[corev1.ResourceCPU]
-				_, memorySpecExist := resourceRequests[corev1.ResourceMemory]
-				if cpuSpecExist || memorySpecExist {
-					return true, nil
-				}
-			}
-		}
-		return false, nil
+		return &controller.(*apps_v1.StatefulSet).Spec.Template, nil
+	case DatahubKind.Kind_DAEMONSET:
+		return &controller.(*apps_v1.DaemonSet).Spec.Template, nil
+	case DatahubKind.Kind_REPLICASET:
+		return &controller.(*apps_v1.ReplicaSet).Spec.Template, nil
 	default:
-		return false, errors.Errorf("not supported controller type %s", datahub_v1alpha1.Kind_name[int32(kind)])
+		return nil, errors.Errorf("not supported controller type %s", DatahubKind.Name(kind))
 	}
 }
 
+func (evictioner *Evictioner) needToPurgeTopControllerContainerResources(controller interface{}, kind datahub_v1alpha1.Kind) (bool, error) {
+
+	template, err := podTemplateOfTopController(controller, kind)
+	if err != nil {
+		return false, err
+	}
+	return containerResourcesNeedToPurge(template), nil
+}
+
 func (evictioner *Evictioner) purgeTopControllerContainerResources(controller interface{}, kind datahub_v1alpha1.Kind) error {
 
-	switch kind {
-	case datahub_v1alpha1.Kind_DEPLOYMENT:
-		deployment := controller.(*apps_v1.Deployment)
-		deploymentCopy := deployment.DeepCopy()
-		for _, container := range deploymentCopy.Spec.Template.Spec.Containers {
-			resourceLimits := container.Resources.Limits
-			if resourceLimits != nil {
-				delete(resourceLimits, corev1.ResourceCPU)
-				delete(resourceLimits, corev1.ResourceMemory)
-			}
-			resourceRequests := container.Resources.Requests
-			if resourceRequests != nil {
-				delete(resourceRequests, corev1.ResourceCPU)
-				delete(resourceRequests, corev1.ResourceMemory)
-			}
-		}
-		ctx := context.TODO()
-		err := evictioner.k8sClienit.Update(ctx, deploymentCopy)
-		if err != nil {
-			return errors.Wrapf(err, "purge topController failed: %s", err.Error())
-		}
-		return nil
-	case datahub_v1alpha1.Kind_DEPLOYMENTCONFIG:
-		deploymentConfig := controller.(*openshift_apps_v1.DeploymentConfig)
-		deploymentConfigCopy := deploymentConfig.DeepCopy()
-		for _, container := range deploymentConfigCopy.Spec.Template.Spec.Containers {
-			resourceLimits := container.Resources.Limits
-			if resourceLimits != nil {
-				delete(resourceLimits, corev1.ResourceCPU)
-				delete(resourceLimits, corev1.ResourceMemory)
-			}
-			resourceRequests := container.Resources.Requests
-			if resourceRequests != nil {
-				delete(resourceRequests, corev1.ResourceCPU)
-				delete(resourceRequests, corev1.ResourceMemory)
-			}
-		}
-		ctx := context.TODO()
-		err := evictioner.k8sClienit.Update(ctx, deploymentConfigCopy)
-		if err != nil {
-			return errors.Wrapf(err, "purge topController failed: %s", err.Error())
-		}
-		return nil
-	case datahub_v1alpha1.Kind_STATEFULSET:
-		statefulSet := controller.(*apps_v1.StatefulSet)
-		statefulSetCopy := statefulSet.DeepCopy()
-		for _, container := range statefulSetCopy.Spec.Template.Spec.Containers {
-			resourceLimits := container.Resources.Limits
-			if resourceLimits != nil {
-				delete(resourceLimits, corev1.ResourceCPU)
-				delete(resourceLimits, corev1.ResourceMemory)
-			}
-			resourceRequests := container.Resources.Requests
-			if resourceRequests != nil {
-				delete(resourceRequests, corev1.ResourceCPU)
-				delete(resourceRequests, corev1.ResourceMemory)
+	controllerObject, ok := controller.(runtime.Object)
+	if !ok {
+		return errors.Errorf("not supported controller type %s", DatahubKind.Name(kind))
+	}
+	controllerCopy := controllerObject.DeepCopyObject()
+	template, err := podTemplateOfTopController(controllerCopy, kind)
+	if err != nil {
+		return err
+	}
+	purgeContainerResources(template)
+
+	ctx := context.TODO()
+	err = evictioner.k8sClienit.Update(ctx, controllerCopy)
+	if err != nil {
+		return errors.Wrapf(err, "purge topController failed: %s", err.Error())
+	}
+	return nil
+}
+
+// containerResourcesNeedToPurge returns true if any container of the pod template has cpu or memory limits or requests
+func containerResourcesNeedToPurge(template *corev1.PodTemplateSpec) bool {
+	if template == nil {
+		return false
+	}
+	for _, container := range template.Spec.Containers {
+		for _, resources := range []corev1.ResourceList{container.Resources.Limits, container.Resources.Requests} {
+			_, cpuSpecExist := resources[corev1.ResourceCPU]
+			_, memorySpecExist := resources[corev1.ResourceMemory]
+			if cpuSpecExist || memorySpecExist {
+				return true
 			}
 		}
-		ctx := context.TODO()
-		err := evictioner.k8sClienit.Update(ctx, statefulSetCopy)
-		if err != nil {
-			return errors.Wrapf(err, "purge topController failed: %s", err.Error())
+	}
+	return false
+}
+
+// purgeContainerResources removes cpu and memory limits and requests of containers of the pod template
+func purgeContainerResources(template *corev1.PodTemplateSpec) {
+	if template == nil {
+		return
+	}
+	for _, container := range template.Spec.Containers {
+		for _, resources := range []corev1.ResourceList{container.Resources.Limits, container.Resources.Requests} {
+			delete(resources, corev1.ResourceCPU)
+			delete(resources, corev1.ResourceMemory)
 		}
-		return nil
-	default:
-		return errors.Errorf("not supported controller type %s", datahub_v1alpha1.Kind_name[int32(kind)])
 	}
 }
 
-func (evictioner *Evictioner) listAppliablePodRecommendation() ([]*datahub_v1alpha1.PodRecommendation, error) {
+// listAppliablePodRecommendation returns recommendations to apply by evicting pods with the pods to evict keyed by pod id,
+// and evictions to report in dry run
+func (evictioner *Evictioner) listAppliablePodRecommendation() ([]*datahub_v1alpha1.PodRecommendation, map[string]*evictingPod, []*dryRunEviction, error) {
 
 	appliablePodRecList := []*datahub_v1alpha1.PodRecommendation{}
+	evictingPods := make(map[string]*evictingPod)
+	dryRunEvictions := []*dryRunEviction{}
 	nowTime := time.Now()
 	nowTimestamp := time.Now().Unix()
 
-	resp, err := evictioner.listPodRecommsPossibleToApply(nowTimestamp)
+	podRecommsPossibleToApply, err := evictioner.listPodRecommsPossibleToApply(nowTimestamp)
 	if err != nil {
-		return appliablePodRecList, err
-	} else if resp.Status == nil {
-		return appliablePodRecList, fmt.Errorf("Receive nil status from datahub")
-	} else if resp.Status.Code != int32(code.Code_OK) {
-		return appliablePodRecList, fmt.Errorf("Status code not 0: receive status code: %d,message: %s", resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+		return appliablePodRecList, evictingPods, dryRunEvictions, err
 	}
-
-	podRecommsPossibleToApply := resp.GetPodRecommendations()
 	scope.Debugf("Possible applicable pod recommendation lists: %s", utils.InterfaceToString(podRecommsPossibleToApply))
 
-	controllerRecommendationInfoMap := NewControllerRecommendationInfoMap(evictioner.k8sClienit, podRecommsPossibleToApply)
+	controllerRecommendationInfoMap := NewControllerRecommendationInfoMap(evictioner.k8sClienit, podRecommsPossibleToApply, evictioner.isDryRun())
+	constraints := newNamespaceConstraints(evictioner.k8sClienit)
+	budgets := newDisruptionBudgets(evictioner.k8sClienit)
+	events := make([]*datahub_v1alpha1.Event, 0)
 	for _, controllerRecommendationInfo := range controllerRecommendationInfoMap {
 		podRecommendationInfos := controllerRecommendationInfo.podRecommendationInfos
 		sort.Slice(podRecommendationInfos, func(i, j int) bool {
@@ -327,6 +341,26 @@ func (evictioner *Evictioner) listAppliablePodRecommendation() ([]*datahub_v1alp
 	}
 	for _, controllerRecommendationInfo := range controllerRecommendationInfoMap {
 
+		// Pods of run-to-completion controllers are patched by admission controller on the next run
+		if !controllerRecommendationInfo.isPodsEvictable() {
+			scope.Infof("Skip evicting pods of controller (%s/%s, kind: %s), recommendations are applied on the next run",
+				controllerRecommendationInfo.namespace, controllerRecommendationInfo.name, controllerRecommendationInfo.kind)
+			continue
+		}
+
+		// Hold evictions until the next execution window of AlamedaScaler
+		if executable, err := controllerRecommendationInfo.isExecutableAt(nowTime); err != nil {
+			scope.Errorf("Check execution schedule of controller (%s/%s, kind: %s) failed, skip evicting controller's pod: %s",
+				controllerRecommendationInfo.namespace, controllerRecommendationInfo.name, controllerRecommendationInfo.kind, err.Error())
+			continue
+		} else if !executable {
+			scope.Infof("Hold evicting pods of controller (%s/%s, kind: %s) until next execution window of AlamedaScaler (%s/%s): %s",
+				controllerRecommendationInfo.namespace, controllerRecommendationInfo.name, controllerRecommendationInfo.kind,
+				controllerRecommendationInfo.alamedaScaler.GetNamespace(), controllerRecommendationInfo.alamedaScaler.GetName(),
+				utils.InterfaceToString(controllerRecommendationInfo.alamedaScaler.Status.NextExecutionWindow))
+			continue
+		}
+
 		// Create eviction restriction
 		maxUnavailable := controllerRecommendationInfo.getMaxUnavailable()
 		triggerThreshold, err := controllerRecommendationInfo.buildTriggerThreshold()
@@ -339,7 +373,8 @@ func (evictioner *Evictioner) listAppliablePodRecommendation() ([]*datahub_v1alp
 		for i := range controllerRecommendationInfo.podRecommendationInfos {
 			podRecommendations[i] = controllerRecommendationInfo.podRecommendationInfos[i].recommendation
 		}
-		evictionRestriction := NewEvictionRestriction(evictioner.k8sClienit, maxUnavailable, triggerThreshold, podRecommendations)
+		limitsPolicy := controllerRecommendationInfo.alamedaScaler.GetLimitsPolicy()
+		evictionRestriction := NewEvictionRestriction(evictioner.k8sClienit, maxUnavailable, triggerThreshold, limitsPolicy, constraints, budgets, podRecommendations)
 
 		for _, podRecommendationInfo := range controllerRecommendationInfo.podRecommendationInfos {
 			pod := podRecommendationInfo.pod
@@ -359,6 +394,15 @@ func (evictioner *Evictioner) listAppliablePodRecommendation() ([]*datahub_v1alp
 				continue
 			}
 			if isEvictabel, err := evictionRestriction.IsEvictabel(pod); err != nil {
+				if _, ok := err.(*quotaExceededError); ok {
+					evicting := &evictingPod{
+						desiredPod: evictionRestriction.GetDesiredPod(pod),
+						controller: controllerRecommendationInfo,
+					}
+					evicting.addRecommendationHistory(evictioner.k8sClienit, pod, false, err.Error())
+					e := newPodQuotaExceededEvent(evictioner.clusterID, &pod.ObjectMeta, pod.TypeMeta, err.Error())
+					events = append(events, &e)
+				}
 				scope.Infof("Pod (%s/%s) cannot be evicted due to eviction restriction checking error: %s", pod.GetNamespace(), pod.GetName(), err.Error())
 				continue
 			} else if !isEvictabel {
@@ -366,15 +410,27 @@ func (evictioner *Evictioner) listAppliablePodRecommendation() ([]*datahub_v1alp
 				continue
 			} else {
 				scope.Infof("Pod (%s/%s) can be evicted.", pod.GetNamespace(), pod.GetName())
-				appliablePodRecList = append(appliablePodRecList, podRecommendation)
+				if controllerRecommendationInfo.isDryRun(evictioner.isDryRun()) {
+					dryRunEvictions = append(dryRunEvictions, newDryRunEviction(controllerRecommendationInfo, podRecommendationInfo, triggerThreshold))
+				} else {
+					appliablePodRecList = append(appliablePodRecList, podRecommendation)
+					evictingPods[fmt.Sprintf("%s/%s", pod.GetNamespace(), pod.GetName())] = &evictingPod{
+						desiredPod: evictionRestriction.GetDesiredPod(pod),
+						controller: controllerRecommendationInfo,
+					}
+				}
 			}
 		}
 	}
 
-	return appliablePodRecList, nil
+	if err := evictioner.sendEvents(events); err != nil {
+		scope.Warnf("Send events to datahub failed: %s\n", err.Error())
+	}
+
+	return appliablePodRecList, evictingPods, dryRunEvictions, nil
 }
 
-func (evictioner *Evictioner) listPodRecommsPossibleToApply(nowTimestamp int64) (*datahub_v1alpha1.ListPodRecommendationsResponse, error) {
+func (evictioner *Evictioner) listPodRecommsPossibleToApply(nowTimestamp int64) ([]*datahub_v1alpha1.PodRecommendation, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	in := &datahub_v1alpha1.ListPodRecommendationsRequest{
@@ -390,7 +446,17 @@ func (evictioner *Evictioner) listPodRecommsPossibleToApply(nowTimestamp int64)
 	}
 	scope.Debugf("Request of ListAvailablePodRecommendations is %s.", utils.InterfaceToString(in))
 
-	return evictioner.datahubClnt.ListAvailablePodRecommendations(ctx, in)
+	podRecommendations := make([]*datahub_v1alpha1.PodRecommendation, 0)
+	err := DatahubStream.ForEachAvailablePodRecommendation(ctx, evictioner.datahubConn, in,
+		func(podRecommendation *datahub_v1alpha1.PodRecommendation) error {
+			podRecommendations = append(podRecommendations, podRecommendation)
+			return nil
+		})
+	if err != nil {
+		return nil, errors.Wrap(err, "list available pod recommendations from datahub failed")
+	}
+
+	return podRecommendations, nil
 }
 
 func (evictioner *Evictioner) getPodInfo(namespace, name string) (*corev1.Pod, error) {
@@ -519,10 +585,39 @@ func (c controllerRecommendationInfo) getMaxUnavailable() string {
 	return maxUnavailable
 }
 
+// isDryRun returns true if evictions of the controller are only reported, either because dry-run is
+// enabled globally or by the AlamedaScaler, or because the AlamedaScaler does not enable execution
+func (c controllerRecommendationInfo) isDryRun(globalDryRun bool) bool {
+	return globalDryRun || c.alamedaScaler.IsDryRun() || !c.alamedaScaler.IsEnableExecution()
+}
+
+// getK8SKind returns the kubernetes kind of the controller, such as Deployment
+func (c controllerRecommendationInfo) getK8SKind() string {
+	for k8sKind := range autoscalingv1alpha1.K8SKindToAlamedaControllerType {
+		if strings.EqualFold(k8sKind, c.kind) {
+			return k8sKind
+		}
+	}
+	return c.kind
+}
+
+func (c controllerRecommendationInfo) isExecutableAt(t time.Time) (bool, error) {
+	return c.alamedaScaler.IsExecutableAt(t)
+}
+
 func (c controllerRecommendationInfo) isScalingToolTypeVPA() bool {
 	return c.alamedaScaler.IsScalingToolTypeVPA()
 }
 
+func (c controllerRecommendationInfo) isPodsEvictable() bool {
+	switch c.kind {
+	case DatahubKind.Name(DatahubKind.Kind_JOB), DatahubKind.Name(DatahubKind.Kind_CRONJOB):
+		return false
+	default:
+		return true
+	}
+}
+
 func (c controllerRecommendationInfo) buildTriggerThreshold() (triggerThreshold, error) {
 
 	var triggerThreshold triggerThreshold
@@ -546,7 +641,7 @@ func (c controllerRecommendationInfo) buildTriggerThreshold() (triggerThreshold,
 	return triggerThreshold, nil
 }
 
-func NewControllerRecommendationInfoMap(client client.Client, podRecommendations []*datahub_v1alpha1.PodRecommendation) map[string]*controllerRecommendationInfo {
+func NewControllerRecommendationInfoMap(client client.Client, podRecommendations []*datahub_v1alpha1.PodRecommendation, dryRun bool) map[string]*controllerRecommendationInfo {
 
 	getResource := utilsresource.NewGetResource(client)
 	alamedaScalerMap := make(map[string]*autoscalingv1alpha1.AlamedaScaler)
@@ -562,7 +657,7 @@ func NewControllerRecommendationInfoMap(client client.Client, podRecommendations
 			continue
 		}
 
-		// Get AlamedaScaler owns this PodRecommendation and validate the AlamedaScaler is enabled execution.
+		// Get AlamedaScaler owns this PodRecommendation and validate the AlamedaScaler is enabled execution or dry-run.
 		alamedaRecommendation, err := getResource.GetAlamedaRecommendation(podRecommendation.NamespacedName.Namespace, podRecommendation.NamespacedName.Name)
 		if err != nil {
 			scope.Errorf("skip PodRecommendation (%s/%s) due to get AlamedaRecommendation falied: %s", podRecommendation.NamespacedName.Namespace, podRecommendation.NamespacedName.Name, err.Error())
@@ -586,7 +681,7 @@ func NewControllerRecommendationInfoMap(client client.Client, podRecommendations
 			}
 			alamedaScalerMap[fmt.Sprintf("%s/%s", alamedaScalerNamespace, alamedaScalerName)] = alamedaScaler
 		}
-		if !alamedaScaler.IsEnableExecution() {
+		if !alamedaScaler.IsEnableExecution() && !alamedaScaler.IsDryRun() && !dryRun {
 			scope.Errorf("skip PodRecommendation (%s/%s) because it's execution is not enabled.", recommendationNamespacedName.Namespace, recommendationNamespacedName.Name)
 			continue
 		}
@@ -617,7 +712,7 @@ func NewControllerRecommendationInfoMap(client client.Client, podRecommendations
 			controllerRecommendationInfoMap[controllerID] = &controllerRecommendationInfo{
 				namespace:              controller.NamespacedName.Namespace,
 				name:                   controller.NamespacedName.Name,
-				kind:                   datahub_v1alpha1.Kind_name[int32(controller.Kind)],
+				kind:                   DatahubKind.Name(controller.Kind),
 				alamedaScaler:          alamedaScaler,
 				podRecommendationInfos: make([]*podRecommendationInfo, 0),
 			}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	core_v1 "k8s.io/api/core/v1"
+	policy_v1beta1 "k8s.io/api/policy/v1beta1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -108,3 +109,67 @@ func TestNewPodReplicaStatus(t *testing.T) {
 		assert.Equal(testCase.want, actual)
 	}
 }
+
+func TestCanRollingUpdatePodWithPodDisruptionBudget(t *testing.T) {
+
+	pods := []core_v1.Pod{
+		core_v1.Pod{ObjectMeta: meta_v1.ObjectMeta{Namespace: "ns", Name: "app-0", Labels: map[string]string{"app": "app"}}},
+		core_v1.Pod{ObjectMeta: meta_v1.ObjectMeta{Namespace: "ns", Name: "app-1", Labels: map[string]string{"app": "app"}}},
+		core_v1.Pod{ObjectMeta: meta_v1.ObjectMeta{Namespace: "ns", Name: "app-2", Labels: map[string]string{"app": "app"}}},
+	}
+	pdbs := []policy_v1beta1.PodDisruptionBudget{
+		policy_v1beta1.PodDisruptionBudget{
+			ObjectMeta: meta_v1.ObjectMeta{Namespace: "ns", Name: "app"},
+			Spec: policy_v1beta1.PodDisruptionBudgetSpec{
+				Selector: &meta_v1.LabelSelector{MatchLabels: map[string]string{"app": "app"}},
+			},
+			Status: policy_v1beta1.PodDisruptionBudgetStatus{PodDisruptionsAllowed: 1},
+		},
+		policy_v1beta1.PodDisruptionBudget{
+			ObjectMeta: meta_v1.ObjectMeta{Namespace: "ns", Name: "other"},
+			Spec: policy_v1beta1.PodDisruptionBudgetSpec{
+				Selector: &meta_v1.LabelSelector{MatchLabels: map[string]string{"app": "other"}},
+			},
+		},
+	}
+
+	assert := assert.New(t)
+	assert.Equal([]string{"ns/app"}, matchPodDisruptionBudgets(pods[0], pdbs))
+
+	// PodDisruptionBudgets of the namespace are listed once and shared by controllers in the cycle
+	budgets := &disruptionBudgets{
+		namespaceToPDBsMap:           map[string][]policy_v1beta1.PodDisruptionBudget{"ns": pdbs},
+		pdbIDToDisruptionsAllowedMap: map[string]float64{"ns/app": 1, "ns/other": 0},
+	}
+
+	// maxUnavailable allows evicting two pods of each controller while the PodDisruptionBudget
+	// selecting pods of both controllers allows one
+	newRestriction := func(controller string, pods ...core_v1.Pod) *evictionRestriction {
+		e := &evictionRestriction{
+			podIDToAlamedaResourceIDMap: map[string]string{},
+			alamedaResourceIDToPodReplicaStatusMap: map[string]*podReplicaStatus{
+				controller: &podReplicaStatus{preservedPodCount: 1, runningPodCount: 3},
+			},
+			podIDToPDBIDsMap: map[string][]string{},
+			budgets:          budgets,
+		}
+		for _, pod := range pods {
+			podID := pod.GetNamespace() + "/" + pod.GetName()
+			e.podIDToAlamedaResourceIDMap[podID] = controller
+			e.podIDToPDBIDsMap[podID] = budgets.matchPod(pod)
+		}
+		return e
+	}
+	first := newRestriction("first", pods[0])
+	second := newRestriction("second", pods[1], pods[2])
+
+	ok, err := first.canRollingUpdatePod("ns/app-0")
+	assert.Nil(err)
+	assert.True(ok)
+	ok, err = second.canRollingUpdatePod("ns/app-1")
+	assert.Nil(err)
+	assert.False(ok)
+	ok, err = second.canRollingUpdatePod("ns/app-2")
+	assert.Nil(err)
+	assert.False(ok)
+}
@@ -0,0 +1,150 @@
+package eviction
+
+import (
+	"context"
+	"testing"
+
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	openshift_apps_v1 "github.com/openshift/api/apps/v1"
+	"github.com/stretchr/testify/assert"
+	apps_v1 "k8s.io/api/apps/v1"
+	batch_v1 "k8s.io/api/batch/v1"
+	batch_v1beta1 "k8s.io/api/batch/v1beta1"
+	core_v1 "k8s.io/api/core/v1"
+	k8s_resource "k8s.io/apimachinery/pkg/api/resource"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPurgeTopControllerContainerResources(t *testing.T) {
+
+	objectMeta := meta_v1.ObjectMeta{Namespace: "default", Name: "app"}
+	newTemplate := func() core_v1.PodTemplateSpec {
+		return core_v1.PodTemplateSpec{
+			Spec: core_v1.PodSpec{
+				Containers: []core_v1.Container{
+					core_v1.Container{Name: "app"},
+					core_v1.Container{
+						Name: "sidecar",
+						Resources: core_v1.ResourceRequirements{
+							Limits: core_v1.ResourceList{
+								core_v1.ResourceCPU:              k8s_resource.MustParse("200m"),
+								core_v1.ResourceEphemeralStorage: k8s_resource.MustParse("1Gi"),
+							},
+							Requests: core_v1.ResourceList{
+								core_v1.ResourceMemory: k8s_resource.MustParse("128Mi"),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	deploymentConfigTemplate := newTemplate()
+
+	type testCase struct {
+		kind       datahub_v1alpha1.Kind
+		controller runtime.Object
+		purged     runtime.Object
+	}
+
+	testCases := []testCase{
+		testCase{
+			kind:       datahub_v1alpha1.Kind_DEPLOYMENT,
+			controller: &apps_v1.Deployment{ObjectMeta: objectMeta, Spec: apps_v1.DeploymentSpec{Template: newTemplate()}},
+			purged:     &apps_v1.Deployment{},
+		},
+		testCase{
+			kind: datahub_v1alpha1.Kind_DEPLOYMENTCONFIG,
+			controller: &openshift_apps_v1.DeploymentConfig{ObjectMeta: objectMeta,
+				Spec: openshift_apps_v1.DeploymentConfigSpec{Template: &deploymentConfigTemplate}},
+			purged: &openshift_apps_v1.DeploymentConfig{},
+		},
+		testCase{
+			kind:       datahub_v1alpha1.Kind_STATEFULSET,
+			controller: &apps_v1.StatefulSet{ObjectMeta: objectMeta, Spec: apps_v1.StatefulSetSpec{Template: newTemplate()}},
+			purged:     &apps_v1.StatefulSet{},
+		},
+		testCase{
+			kind:       DatahubKind.Kind_DAEMONSET,
+			controller: &apps_v1.DaemonSet{ObjectMeta: objectMeta, Spec: apps_v1.DaemonSetSpec{Template: newTemplate()}},
+			purged:     &apps_v1.DaemonSet{},
+		},
+		testCase{
+			kind:       DatahubKind.Kind_REPLICASET,
+			controller: &apps_v1.ReplicaSet{ObjectMeta: objectMeta, Spec: apps_v1.ReplicaSetSpec{Template: newTemplate()}},
+			purged:     &apps_v1.ReplicaSet{},
+		},
+	}
+
+	clientScheme := runtime.NewScheme()
+	if err := scheme.AddToScheme(clientScheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := openshift_apps_v1.AddToScheme(clientScheme); err != nil {
+		t.Fatal(err)
+	}
+
+	assert := assert.New(t)
+	for _, testCase := range testCases {
+		evictioner := &Evictioner{k8sClienit: fake.NewFakeClientWithScheme(clientScheme, testCase.controller.DeepCopyObject())}
+
+		needToPurge, err := evictioner.needToPurgeTopControllerContainerResources(testCase.controller, testCase.kind)
+		assert.Nil(err)
+		assert.True(needToPurge, DatahubKind.Name(testCase.kind))
+
+		err = evictioner.purgeTopControllerContainerResources(testCase.controller, testCase.kind)
+		assert.Nil(err)
+
+		err = evictioner.k8sClienit.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "app"}, testCase.purged)
+		assert.Nil(err)
+		template, err := podTemplateOfTopController(testCase.purged, testCase.kind)
+		assert.Nil(err)
+		assert.False(containerResourcesNeedToPurge(template), DatahubKind.Name(testCase.kind))
+		_, ephemeralStorageExist := template.Spec.Containers[1].Resources.Limits[core_v1.ResourceEphemeralStorage]
+		assert.True(ephemeralStorageExist, DatahubKind.Name(testCase.kind))
+
+		// The controller read from cache is not modified
+		needToPurge, err = evictioner.needToPurgeTopControllerContainerResources(testCase.controller, testCase.kind)
+		assert.Nil(err)
+		assert.True(needToPurge, DatahubKind.Name(testCase.kind))
+	}
+
+	// Pods of Jobs and CronJobs are not evicted, so they are not purged either
+	for kind, controller := range map[datahub_v1alpha1.Kind]interface{}{
+		DatahubKind.Kind_JOB:     &batch_v1.Job{ObjectMeta: objectMeta, Spec: batch_v1.JobSpec{Template: newTemplate()}},
+		DatahubKind.Kind_CRONJOB: &batch_v1beta1.CronJob{ObjectMeta: objectMeta},
+	} {
+		evictioner := &Evictioner{}
+		_, err := evictioner.needToPurgeTopControllerContainerResources(controller, kind)
+		assert.NotNil(err, DatahubKind.Name(kind))
+	}
+}
+
+func TestIsPodsEvictable(t *testing.T) {
+
+	type testCase struct {
+		kind datahub_v1alpha1.Kind
+		want bool
+	}
+
+	testCases := []testCase{
+		testCase{kind: datahub_v1alpha1.Kind_DEPLOYMENT, want: true},
+		testCase{kind: datahub_v1alpha1.Kind_DEPLOYMENTCONFIG, want: true},
+		testCase{kind: datahub_v1alpha1.Kind_STATEFULSET, want: true},
+		testCase{kind: DatahubKind.Kind_DAEMONSET, want: true},
+		testCase{kind: DatahubKind.Kind_REPLICASET, want: true},
+		testCase{kind: DatahubKind.Kind_JOB, want: false},
+		testCase{kind: DatahubKind.Kind_CRONJOB, want: false},
+	}
+
+	assert := assert.New(t)
+	for _, testCase := range testCases {
+		c := controllerRecommendationInfo{kind: DatahubKind.Name(testCase.kind)}
+		assert.Equal(testCase.want, c.isPodsEvictable(), DatahubKind.Name(testCase.kind))
+	}
+}
@@ -0,0 +1,27 @@
+package hpa
+
+// Config is configuration of executing recommendations of AlamedaScalers with scaling tool type hpa
+type Config struct {
+	Enable     bool  `mapstructure:"enable"`
+	CheckCycle int64 `mapstructure:"checkCycle"`
+	// ScaleUpCooldown is the minimum seconds between scaling a controller and scaling it up again
+	ScaleUpCooldown int64 `mapstructure:"scaleUpCooldown"`
+	// ScaleDownCooldown is the minimum seconds between scaling a controller and scaling it down again
+	ScaleDownCooldown int64 `mapstructure:"scaleDownCooldown"`
+	DryRun            bool  `mapstructure:"dryRun"`
+}
+
+// NewDefaultConfig returns Config instance
+func NewDefaultConfig() Config {
+	return Config{
+		Enable:            false,
+		CheckCycle:        30,
+		ScaleUpCooldown:   180,
+		ScaleDownCooldown: 300,
+		DryRun:            false,
+	}
+}
+
+func (c *Config) Validate() error {
+	return nil
+}
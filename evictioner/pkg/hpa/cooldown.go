@@ -0,0 +1,36 @@
+package hpa
+
+import (
+	"time"
+)
+
+// cooldown records when controllers are scaled and holds scaling them again until cooled down
+type cooldown struct {
+	scaleUp        time.Duration
+	scaleDown      time.Duration
+	lastScaleTimes map[string]time.Time
+}
+
+func newCooldown(scaleUp, scaleDown time.Duration) *cooldown {
+	return &cooldown{
+		scaleUp:        scaleUp,
+		scaleDown:      scaleDown,
+		lastScaleTimes: make(map[string]time.Time),
+	}
+}
+
+func (c *cooldown) isCooledDown(controllerID string, currentReplicas, desiredReplicas int32, now time.Time) bool {
+	lastScaleTime, exist := c.lastScaleTimes[controllerID]
+	if !exist {
+		return true
+	}
+	duration := c.scaleDown
+	if desiredReplicas > currentReplicas {
+		duration = c.scaleUp
+	}
+	return !now.Before(lastScaleTime.Add(duration))
+}
+
+func (c *cooldown) record(controllerID string, now time.Time) {
+	c.lastScaleTimes[controllerID] = now
+}
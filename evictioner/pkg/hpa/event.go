@@ -0,0 +1,60 @@
+package hpa
+
+import (
+	"fmt"
+
+	DatahubEvent "github.com/containers-ai/alameda/pkg/framework/datahub/event"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/ptypes"
+	openshift_apps_v1 "github.com/openshift/api/apps/v1"
+	apps_v1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+const (
+	componentName = "alameda-evictioner"
+)
+
+func newScaleEvent(clusterID string, target scaleTarget, action scaleAction, data string) datahub_v1alpha1.Event {
+
+	apiVersion := apps_v1.SchemeGroupVersion.String()
+	if target.kind == datahub_v1alpha1.Kind_DEPLOYMENTCONFIG {
+		apiVersion = openshift_apps_v1.SchemeGroupVersion.String()
+	}
+
+	now := ptypes.TimestampNow()
+	id := uuid.NewUUID()
+	source := datahub_v1alpha1.EventSource{
+		Host:      "",
+		Component: componentName,
+	}
+	subject := datahub_v1alpha1.K8SObjectReference{
+		Kind:       datahubKindToK8SKind(target.kind),
+		ApiVersion: apiVersion,
+		Namespace:  target.namespace,
+		Name:       target.name,
+	}
+	eventType := datahub_v1alpha1.EventType_EVENT_TYPE_HPA_RECOMMENDATION_EXECUTE
+	message := fmt.Sprintf("%s %s/%s is scaled from %d to %d replicas", subject.Kind, target.namespace, target.name,
+		action.CurrentReplicas, action.DesiredReplicas)
+	if action.DryRun {
+		eventType = DatahubEvent.EventType_EVENT_TYPE_HPA_RECOMMENDATION_DRY_RUN
+		message = fmt.Sprintf("%s %s/%s would be scaled from %d to %d replicas (dry run)", subject.Kind, target.namespace, target.name,
+			action.CurrentReplicas, action.DesiredReplicas)
+	}
+
+	event := datahub_v1alpha1.Event{
+		Time:      now,
+		Id:        string(id),
+		ClusterId: clusterID,
+		Source:    &source,
+		Type:      eventType,
+		Version:   datahub_v1alpha1.EventVersion_EVENT_VERSION_V1,
+		Level:     datahub_v1alpha1.EventLevel_EVENT_LEVEL_INFO,
+		Subject:   &subject,
+		Message:   message,
+		Data:      data,
+	}
+
+	return event
+}
@@ -0,0 +1,405 @@
+package hpa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	autoscalingv1alpha1 "github.com/containers-ai/alameda/operator/pkg/apis/autoscaling/v1alpha1"
+	utilsresource "github.com/containers-ai/alameda/operator/pkg/utils/resources"
+	"github.com/containers-ai/alameda/pkg/consts"
+	DatahubHistory "github.com/containers-ai/alameda/pkg/framework/datahub/history"
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
+	"github.com/containers-ai/alameda/pkg/utils"
+	logUtil "github.com/containers-ai/alameda/pkg/utils/log"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc"
+	autoscaling_v1 "k8s.io/api/autoscaling/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	scaleMethodHPA      = "horizontalPodAutoscaler"
+	scaleMethodReplicas = "replicas"
+)
+
+var (
+	scope = logUtil.RegisterScope("hpa", "alamedascaler hpa executor", 0)
+)
+
+// Executor applies controller recommendations of AlamedaScalers with scaling tool type hpa
+type Executor struct {
+	checkCycle  int64
+	datahubClnt datahub_v1alpha1.DatahubServiceClient
+	historyClnt DatahubHistory.DatahubRecommendationHistoryServiceClient
+	k8sClient   client.Client
+	cfg         Config
+	cooldown    *cooldown
+
+	clusterID string
+}
+
+// scaleTarget is a controller watched by an AlamedaScaler with scaling tool type hpa
+type scaleTarget struct {
+	namespace     string
+	name          string
+	kind          datahub_v1alpha1.Kind
+	alamedaScaler *autoscalingv1alpha1.AlamedaScaler
+	dryRun        bool
+}
+
+// scaleAction records the replicas change applied to a controller
+type scaleAction struct {
+	Controller      string `json:"controller"`
+	AlamedaScaler   string `json:"alamedaScaler"`
+	Method          string `json:"method"`
+	CurrentReplicas int32  `json:"currentReplicas"`
+	DesiredReplicas int32  `json:"desiredReplicas"`
+	MinReplicas     int32  `json:"minReplicas,omitempty"`
+	MaxReplicas     int32  `json:"maxReplicas,omitempty"`
+	DryRun          bool   `json:"dryRun"`
+}
+
+// NewExecutor return Executor instance
+func NewExecutor(datahubConn *grpc.ClientConn,
+	k8sClient client.Client,
+	cfg Config,
+	clusterID string) *Executor {
+	return &Executor{
+		checkCycle:  cfg.CheckCycle,
+		datahubClnt: datahub_v1alpha1.NewDatahubServiceClient(datahubConn),
+		historyClnt: DatahubHistory.NewDatahubRecommendationHistoryServiceClient(datahubConn),
+		k8sClient:   k8sClient,
+		cfg:         cfg,
+		cooldown: newCooldown(time.Duration(cfg.ScaleUpCooldown)*time.Second,
+			time.Duration(cfg.ScaleDownCooldown)*time.Second),
+		clusterID: clusterID,
+	}
+}
+
+// Start checking controllers need to apply recommendation
+func (executor *Executor) Start() {
+	go executor.executeProcess()
+}
+
+func (executor *Executor) executeProcess() {
+	for {
+		if !executor.cfg.Enable && !executor.cfg.DryRun {
+			scope.Warn("hpa executor is not enabled")
+			return
+		}
+		targets, err := executor.listScaleTargets()
+		if err != nil {
+			scope.Error(err.Error())
+		}
+
+		events := make([]*datahub_v1alpha1.Event, 0)
+		for _, target := range targets {
+			event, err := executor.execute(target, time.Now())
+			if err != nil {
+				scope.Errorf("Scale controller (%s/%s, kind: %s) failed: %s",
+					target.namespace, target.name, target.kind, err.Error())
+				continue
+			}
+			if event != nil {
+				events = append(events, event)
+			}
+		}
+		if err := executor.sendEvents(events); err != nil {
+			scope.Warnf("Send events to datahub failed: %s\n", err.Error())
+		}
+		time.Sleep(time.Duration(executor.checkCycle) * time.Second)
+	}
+}
+
+// isDryRun returns true if replicas changes of all AlamedaScalers are only reported
+func (executor *Executor) isDryRun() bool {
+	return !executor.cfg.Enable || executor.cfg.DryRun
+}
+
+func (executor *Executor) listScaleTargets() ([]scaleTarget, error) {
+
+	listResources := utilsresource.NewListResources(executor.k8sClient)
+	alamedaScalers, err := listResources.ListAllAlamedaScaler()
+	if err != nil {
+		return nil, errors.Wrap(err, "list AlamedaScalers failed")
+	}
+
+	targets := make([]scaleTarget, 0)
+	for i := range alamedaScalers {
+		alamedaScaler := &alamedaScalers[i]
+		if !alamedaScaler.IsScalingToolTypeHPA() {
+			continue
+		}
+		dryRun := executor.isDryRun() || alamedaScaler.IsDryRun() || !alamedaScaler.IsEnableExecution()
+		if !alamedaScaler.IsEnableExecution() && !alamedaScaler.IsDryRun() && !executor.cfg.DryRun {
+			continue
+		}
+
+		controllers := []struct {
+			kind      datahub_v1alpha1.Kind
+			resources map[autoscalingv1alpha1.NamespacedName]autoscalingv1alpha1.AlamedaResource
+		}{
+			{kind: datahub_v1alpha1.Kind_DEPLOYMENT, resources: alamedaScaler.Status.AlamedaController.Deployments},
+			{kind: datahub_v1alpha1.Kind_DEPLOYMENTCONFIG, resources: alamedaScaler.Status.AlamedaController.DeploymentConfigs},
+			{kind: datahub_v1alpha1.Kind_STATEFULSET, resources: alamedaScaler.Status.AlamedaController.StatefulSets},
+		}
+		for _, controller := range controllers {
+			for _, resource := range controller.resources {
+				targets = append(targets, scaleTarget{
+					namespace:     resource.Namespace,
+					name:          resource.Name,
+					kind:          controller.kind,
+					alamedaScaler: alamedaScaler,
+					dryRun:        dryRun,
+				})
+			}
+		}
+	}
+	return targets, nil
+}
+
+// execute applies the latest controller recommendation of target, the returned event is nil if nothing is changed
+func (executor *Executor) execute(target scaleTarget, now time.Time) (*datahub_v1alpha1.Event, error) {
+
+	if executable, err := target.alamedaScaler.IsExecutableAt(now); err != nil {
+		return nil, errors.Wrap(err, "check execution schedule failed")
+	} else if !executable {
+		scope.Infof("Hold scaling controller (%s/%s, kind: %s) until next execution window of AlamedaScaler (%s/%s): %s",
+			target.namespace, target.name, target.kind,
+			target.alamedaScaler.GetNamespace(), target.alamedaScaler.GetName(),
+			utils.InterfaceToString(target.alamedaScaler.Status.NextExecutionWindow))
+		return nil, nil
+	}
+
+	recommendation, err := executor.getLatestControllerRecommendation(target, now)
+	if err != nil {
+		return nil, err
+	} else if recommendation == nil {
+		scope.Debugf("No controller recommendation of controller (%s/%s, kind: %s)", target.namespace, target.name, target.kind)
+		return nil, nil
+	}
+	desiredReplicas := getDesiredReplicas(recommendation)
+	if desiredReplicas <= 0 {
+		return nil, errors.Errorf("invalid desired replicas %d", desiredReplicas)
+	}
+
+	hpa, err := executor.getHorizontalPodAutoscaler(target)
+	if err != nil {
+		return nil, err
+	}
+
+	action := scaleAction{
+		Controller:      fmt.Sprintf("%s/%s/%s", DatahubKind.Name(target.kind), target.namespace, target.name),
+		AlamedaScaler:   fmt.Sprintf("%s/%s", target.alamedaScaler.GetNamespace(), target.alamedaScaler.GetName()),
+		DesiredReplicas: desiredReplicas,
+		DryRun:          target.dryRun,
+	}
+	if hpa != nil {
+		action.Method = scaleMethodHPA
+		action.CurrentReplicas = getHPAMinReplicas(hpa)
+		action.MinReplicas, action.MaxReplicas = buildHPABounds(hpa.Spec.MaxReplicas, desiredReplicas)
+	} else {
+		action.Method = scaleMethodReplicas
+		getResource := utilsresource.NewGetResource(executor.k8sClient)
+		action.CurrentReplicas, err = getResource.GetReplicasCountByController(target.namespace, target.name, getControllerKind(target.kind))
+		if err != nil {
+			return nil, errors.Wrap(err, "get replicas of controller failed")
+		}
+	}
+	if action.CurrentReplicas == desiredReplicas {
+		return nil, nil
+	}
+
+	controllerID := action.Controller
+	if !executor.cooldown.isCooledDown(controllerID, action.CurrentReplicas, desiredReplicas, now) {
+		scope.Infof("Hold scaling controller (%s/%s, kind: %s) from %d to %d replicas until cooled down",
+			target.namespace, target.name, target.kind, action.CurrentReplicas, desiredReplicas)
+		return nil, nil
+	}
+
+	// Dry-run changes nothing, so it does not hold later changes until cooled down
+	if !target.dryRun {
+		if hpa != nil {
+			err = executor.updateHorizontalPodAutoscaler(hpa, action.MinReplicas, action.MaxReplicas)
+		} else {
+			err = executor.updateControllerReplicas(target, desiredReplicas)
+		}
+		if err != nil {
+			return nil, err
+		}
+		executor.cooldown.record(controllerID, now)
+	}
+
+	data, err := json.Marshal(action)
+	if err != nil {
+		return nil, errors.Wrap(err, "encode scale action failed")
+	}
+	scope.Infof("Scale controller (%s/%s, kind: %s): %s", target.namespace, target.name, target.kind, string(data))
+	event := newScaleEvent(executor.clusterID, target, action, string(data))
+	return &event, nil
+}
+
+func (executor *Executor) getLatestControllerRecommendation(target scaleTarget, now time.Time) (*datahub_v1alpha1.ControllerRecommendation, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	in := &DatahubHistory.GetLatestControllerRecommendationRequest{
+		NamespacedName: &datahub_v1alpha1.NamespacedName{
+			Namespace: target.namespace,
+			Name:      target.name,
+		},
+		Kind: target.kind,
+		Time: &timestamp.Timestamp{
+			Seconds: now.Unix(),
+		},
+	}
+	scope.Debugf("Request of GetLatestControllerRecommendation is %s.", utils.InterfaceToString(in))
+
+	resp, err := executor.historyClnt.GetLatestControllerRecommendation(ctx, in)
+	if err != nil {
+		return nil, errors.Wrap(err, "get latest controller recommendation from datahub failed")
+	} else if resp.GetStatus() != nil && resp.GetStatus().GetCode() != int32(code.Code_OK) {
+		return nil, errors.Errorf("get latest controller recommendation from datahub failed: statusCode: %d, message: %s",
+			resp.GetStatus().GetCode(), resp.GetStatus().GetMessage())
+	}
+
+	return resp.GetControllerRecommendation(), nil
+}
+
+func (executor *Executor) getHorizontalPodAutoscaler(target scaleTarget) (*autoscaling_v1.HorizontalPodAutoscaler, error) {
+
+	hpaList := &autoscaling_v1.HorizontalPodAutoscalerList{}
+	if err := executor.k8sClient.List(context.TODO(), &client.ListOptions{Namespace: target.namespace}, hpaList); err != nil {
+		return nil, errors.Wrapf(err, "list HorizontalPodAutoscalers in namespace %s failed", target.namespace)
+	}
+	kind := datahubKindToK8SKind(target.kind)
+	for i := range hpaList.Items {
+		ref := hpaList.Items[i].Spec.ScaleTargetRef
+		if ref.Kind == kind && ref.Name == target.name {
+			return &hpaList.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (executor *Executor) updateHorizontalPodAutoscaler(hpa *autoscaling_v1.HorizontalPodAutoscaler, minReplicas, maxReplicas int32) error {
+
+	hpa.Spec.MinReplicas = &minReplicas
+	hpa.Spec.MaxReplicas = maxReplicas
+	if err := utilsresource.NewUpdateResource(executor.k8sClient).UpdateResource(hpa); err != nil {
+		return errors.Wrapf(err, "update HorizontalPodAutoscaler %s/%s failed", hpa.GetNamespace(), hpa.GetName())
+	}
+	return nil
+}
+
+func (executor *Executor) updateControllerReplicas(target scaleTarget, replicas int32) error {
+
+	getResource := utilsresource.NewGetResource(executor.k8sClient)
+	updateResource := utilsresource.NewUpdateResource(executor.k8sClient)
+
+	var err error
+	switch target.kind {
+	case datahub_v1alpha1.Kind_DEPLOYMENT:
+		deployment, getErr := getResource.GetDeployment(target.namespace, target.name)
+		if getErr != nil {
+			return errors.Wrap(getErr, "get Deployment failed")
+		}
+		deployment.Spec.Replicas = &replicas
+		err = updateResource.UpdateResource(deployment)
+	case datahub_v1alpha1.Kind_DEPLOYMENTCONFIG:
+		deploymentConfig, getErr := getResource.GetDeploymentConfig(target.namespace, target.name)
+		if getErr != nil {
+			return errors.Wrap(getErr, "get DeploymentConfig failed")
+		}
+		deploymentConfig.Spec.Replicas = replicas
+		err = updateResource.UpdateResource(deploymentConfig)
+	case datahub_v1alpha1.Kind_STATEFULSET:
+		statefulSet, getErr := getResource.GetStatefulSet(target.namespace, target.name)
+		if getErr != nil {
+			return errors.Wrap(getErr, "get StatefulSet failed")
+		}
+		statefulSet.Spec.Replicas = &replicas
+		err = updateResource.UpdateResource(statefulSet)
+	default:
+		return errors.Errorf("not supported controller type %s", DatahubKind.Name(target.kind))
+	}
+	if err != nil {
+		return errors.Wrap(err, "update replicas of controller failed")
+	}
+	return nil
+}
+
+func (executor *Executor) sendEvents(events []*datahub_v1alpha1.Event) error {
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	request := datahub_v1alpha1.CreateEventsRequest{
+		Events: events,
+	}
+	status, err := executor.datahubClnt.CreateEvents(context.TODO(), &request)
+	if err != nil {
+		return errors.Errorf("send events to Datahub failed: %s", err.Error())
+	} else if status == nil {
+		return errors.Errorf("send events to Datahub failed: receive nil status")
+	} else if status.Code != int32(code.Code_OK) {
+		return errors.Errorf("send events to Datahub failed: statusCode: %d, message: %s", status.Code, status.Message)
+	}
+
+	return nil
+}
+
+func getDesiredReplicas(recommendation *datahub_v1alpha1.ControllerRecommendation) int32 {
+	if recommendation.GetRecommendedType() == datahub_v1alpha1.ControllerRecommendedType_CRT_K8s {
+		return recommendation.GetRecommendedSpecK8S().GetDesiredReplicas()
+	}
+	return recommendation.GetRecommendedSpec().GetDesiredReplicas()
+}
+
+func getHPAMinReplicas(hpa *autoscaling_v1.HorizontalPodAutoscaler) int32 {
+	// minReplicas of HorizontalPodAutoscaler defaults to 1
+	if hpa.Spec.MinReplicas == nil {
+		return 1
+	}
+	return *hpa.Spec.MinReplicas
+}
+
+// buildHPABounds returns the min/max replicas of HorizontalPodAutoscaler to keep at least desiredReplicas
+// and never lower the existing upper bound
+func buildHPABounds(maxReplicas, desiredReplicas int32) (int32, int32) {
+	if maxReplicas < desiredReplicas {
+		maxReplicas = desiredReplicas
+	}
+	return desiredReplicas, maxReplicas
+}
+
+func getControllerKind(kind datahub_v1alpha1.Kind) string {
+	switch kind {
+	case datahub_v1alpha1.Kind_DEPLOYMENT:
+		return "deployment"
+	case datahub_v1alpha1.Kind_DEPLOYMENTCONFIG:
+		return "deploymentconfig"
+	case datahub_v1alpha1.Kind_STATEFULSET:
+		return "statefulset"
+	default:
+		return ""
+	}
+}
+
+func datahubKindToK8SKind(kind datahub_v1alpha1.Kind) string {
+	switch kind {
+	case datahub_v1alpha1.Kind_DEPLOYMENT:
+		return consts.K8S_KIND_DEPLOYMENT
+	case datahub_v1alpha1.Kind_DEPLOYMENTCONFIG:
+		return consts.K8S_KIND_DEPLOYMENTCONFIG
+	case datahub_v1alpha1.Kind_STATEFULSET:
+		return consts.K8S_KIND_STATEFULSET
+	default:
+		return ""
+	}
+}
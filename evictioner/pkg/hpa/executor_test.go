@@ -0,0 +1,51 @@
+package hpa
+
+import (
+	"testing"
+	"time"
+
+	DatahubEvent "github.com/containers-ai/alameda/pkg/framework/datahub/event"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCooldown(t *testing.T) {
+
+	assert := assert.New(t)
+	now := time.Now()
+	c := newCooldown(3*time.Minute, 5*time.Minute)
+
+	assert.True(c.isCooledDown("deployment/default/app", 2, 3, now))
+	c.record("deployment/default/app", now)
+
+	assert.False(c.isCooledDown("deployment/default/app", 2, 3, now.Add(2*time.Minute)))
+	assert.True(c.isCooledDown("deployment/default/app", 2, 3, now.Add(3*time.Minute)))
+	assert.False(c.isCooledDown("deployment/default/app", 3, 2, now.Add(4*time.Minute)))
+	assert.True(c.isCooledDown("deployment/default/app", 3, 2, now.Add(5*time.Minute)))
+	assert.True(c.isCooledDown("statefulset/default/db", 3, 2, now))
+}
+
+func TestBuildHPABounds(t *testing.T) {
+
+	assert := assert.New(t)
+
+	minReplicas, maxReplicas := buildHPABounds(10, 4)
+	assert.Equal(int32(4), minReplicas)
+	assert.Equal(int32(10), maxReplicas)
+
+	minReplicas, maxReplicas = buildHPABounds(3, 5)
+	assert.Equal(int32(5), minReplicas)
+	assert.Equal(int32(5), maxReplicas)
+}
+
+func TestNewScaleEventType(t *testing.T) {
+
+	assert := assert.New(t)
+	target := scaleTarget{namespace: "default", name: "app", kind: datahub_v1alpha1.Kind_DEPLOYMENT}
+
+	scaled := newScaleEvent("cluster", target, scaleAction{CurrentReplicas: 2, DesiredReplicas: 3}, "")
+	assert.Equal(datahub_v1alpha1.EventType_EVENT_TYPE_HPA_RECOMMENDATION_EXECUTE, scaled.Type)
+
+	dryRun := newScaleEvent("cluster", target, scaleAction{CurrentReplicas: 2, DesiredReplicas: 3, DryRun: true}, "")
+	assert.Equal(DatahubEvent.EventType_EVENT_TYPE_HPA_RECOMMENDATION_DRY_RUN, dryRun.Type)
+}
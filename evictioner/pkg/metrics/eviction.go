@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "alameda_evictioner"
+
+	// EvictionResultEvicted is the result of pods evicted
+	EvictionResultEvicted = "evicted"
+	// EvictionResultRefused is the result of evictions refused by PodDisruptionBudgets
+	EvictionResultRefused = "refused"
+	// EvictionResultFailed is the result of evictions failed by other errors
+	EvictionResultFailed = "failed"
+)
+
+var (
+	podsEvicted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "pods_evicted_total",
+		Help:      "Total number of pod evictions to apply recommendations by result",
+	}, []string{"namespace", "result"})
+)
+
+func init() {
+	prometheus.MustRegister(podsEvicted)
+}
+
+// AddPodEviction Count the eviction of a pod in the namespace with the result
+func AddPodEviction(podNamespace, result string) {
+	podsEvicted.WithLabelValues(podNamespace, result).Inc()
+}
@@ -14,7 +14,7 @@ func LivenessProbe(cfg *LivenessProbeConfig) {
 
 func ReadinessProbe(cfg *ReadinessProbeConfig) {
 	datahubAddr := cfg.DatahubAddr
-	err := queryDatahub(datahubAddr)
+	err := queryDatahub(datahubAddr, cfg.DatahubDialOptions)
 	if err != nil {
 		scope.Errorf("Readiness probe: query datahub failed due to %s", err.Error())
 		os.Exit(1)
@@ -12,6 +12,7 @@ import (
 type ReadinessProbeConfig struct {
 	DatahubAddr         string
 	AdmissionController *AdmissionController
+	DatahubDialOptions  []grpc.DialOption
 }
 
 type AdmissionController struct {
@@ -20,8 +21,11 @@ type AdmissionController struct {
 	Port      int32
 }
 
-func queryDatahub(datahubAddr string) error {
-	conn, err := grpc.Dial(datahubAddr, grpc.WithInsecure())
+func queryDatahub(datahubAddr string, opts []grpc.DialOption) error {
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithInsecure()}
+	}
+	conn, err := grpc.Dial(datahubAddr, opts...)
 	if err != nil {
 		return err
 	}
@@ -3,99 +3,100 @@ module github.com/containers-ai/alameda
 go 1.14
 
 require (
-        cloud.google.com/go v0.29.0 // indirect
-        github.com/appscode/jsonpatch v0.0.0-20190108182946-7c0e3b262f30 // indirect
-        github.com/beorn7/perks v0.0.0-20180321164747-3a771d992973 // indirect
-        github.com/chzyer/readline v0.0.0-20180603132655-2972be24d48e // indirect
-        github.com/containers-ai/api v4.2.466+incompatible
-        github.com/davecgh/go-spew v1.1.1 // indirect
-        github.com/emicklei/go-restful v2.8.0+incompatible // indirect
-        github.com/fsnotify/fsnotify v1.4.9 // indirect
-        github.com/ghodss/yaml v1.0.0
-        github.com/go-logr/logr v0.1.0 // indirect
-        github.com/go-logr/zapr v0.1.0 // indirect
-        github.com/gobuffalo/envy v1.6.4 // indirect
-        github.com/gogo/protobuf v1.1.1 // indirect
-        github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b // indirect
-        github.com/golang/groupcache v0.0.0-20180924190550-6f2cf27854a4 // indirect
-        github.com/golang/protobuf v1.3.2
-        github.com/google/btree v1.0.0 // indirect
-        github.com/google/gofuzz v0.0.0-20170612174753-24818f796faf // indirect
-        github.com/google/uuid v1.0.0 // indirect
-        github.com/googleapis/gnostic v0.2.0 // indirect
-        github.com/gregjones/httpcache v0.0.0-20180305231024-9cad4c3443a7 // indirect
-        github.com/grpc-ecosystem/go-grpc-middleware v1.0.0
-        github.com/hashicorp/golang-lru v0.5.0 // indirect
-        github.com/hashicorp/hcl v1.0.0 // indirect
-        github.com/hpcloud/tail v1.0.0 // indirect
-        github.com/imdario/mergo v0.3.6 // indirect
-        github.com/inconshreveable/mousetrap v1.0.0 // indirect
-        github.com/influxdata/influxdb v1.7.7
-        github.com/joho/godotenv v1.3.0 // indirect
-        github.com/json-iterator/go v1.1.5 // indirect
-        github.com/juju/ansiterm v0.0.0-20180109212912-720a0952cc2a // indirect
-        github.com/lunixbochs/vtclean v1.0.0 // indirect
-        github.com/magiconair/properties v1.8.0 // indirect
-        github.com/manifoldco/promptui v0.3.1
-        github.com/markbates/inflect v1.0.1 // indirect
-        github.com/mattbaird/jsonpatch v0.0.0-20171005235357-81af80346b1a
-        github.com/mattn/go-colorable v0.1.2 // indirect
-        github.com/mattn/go-isatty v0.0.9 // indirect
-        github.com/matttproud/golang_protobuf_extensions v1.0.1 // indirect
-        github.com/mitchellh/mapstructure v1.1.2 // indirect
-        github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
-        github.com/modern-go/reflect2 v0.0.0-20180701023420-4b7aa43c6742 // indirect
-        github.com/onsi/ginkgo v1.6.0 // indirect
-        github.com/onsi/gomega v1.4.2
-        github.com/openshift/api v0.0.0-20190129160438-bbc4289c54e0
-        github.com/openshift/client-go v3.9.0+incompatible
-        github.com/pborman/uuid v0.0.0-20180906182336-adf5a7427709 // indirect
-        github.com/pelletier/go-toml v1.2.0 // indirect
-        github.com/petar/GoLLRB v0.0.0-20130427215148-53be0d36a84c // indirect
-        github.com/peterbourgon/diskv v2.0.1+incompatible // indirect
-        github.com/pkg/errors v0.8.0
-        github.com/pmezard/go-difflib v1.0.0 // indirect
-        github.com/prometheus/client_golang v0.9.2 // indirect
-        github.com/prometheus/client_model v0.0.0-20190115171406-56726106282f // indirect
-        github.com/prometheus/common v0.2.0 // indirect
-        github.com/prometheus/procfs v0.0.0-20190117184657-bf6a532e95b1 // indirect
-        github.com/robfig/cron v1.2.0
-        github.com/spf13/afero v1.1.2 // indirect
-        github.com/spf13/cast v1.3.0 // indirect
-        github.com/spf13/cobra v0.0.3
-        github.com/spf13/jwalterweatherman v1.0.0 // indirect
-        github.com/spf13/pflag v1.0.3 // indirect
-        github.com/spf13/viper v1.2.1
-        github.com/streadway/amqp v0.0.0-20190404075320-75d898a42a94
-        github.com/stretchr/testify v1.3.0
-        go.uber.org/atomic v1.3.2 // indirect
-        go.uber.org/multierr v1.1.0 // indirect
-        go.uber.org/zap v1.9.1
-        golang.org/x/crypto v0.0.0-20181001203147-e3636079e1a4 // indirect
-        golang.org/x/net v0.0.0-20181201002055-351d144fa1fc
-        golang.org/x/oauth2 v0.0.0-20181003184128-c57b0facaced // indirect
-        golang.org/x/text v0.3.0 // indirect
-        golang.org/x/time v0.0.0-20180412165947-fbb02b2291d2 // indirect
-        golang.org/x/tools v0.0.0-20181004163742-59602fdee893 // indirect
-        google.golang.org/appengine v1.2.0 // indirect
-        google.golang.org/genproto v0.0.0-20181101192439-c830210a61df
-        google.golang.org/grpc v1.16.0
-        gopkg.in/inf.v0 v0.9.1 // indirect
-        gopkg.in/natefinch/lumberjack.v2 v2.0.0-20170531160350-a96e63847dc3
-        gopkg.in/tomb.v1 v1.0.0-20141024135613-dd632973f1e7 // indirect
-        gopkg.in/yaml.v2 v2.2.1 // indirect
-        k8s.io/api v0.0.0-20190202010724-74b699b93c15
-        k8s.io/apiextensions-apiserver v0.0.0-20190202013456-d4288ab64945
-        k8s.io/apimachinery v0.0.0-20190117220443-572dfc7bdfcb
-        k8s.io/client-go v0.0.0-20190202011228-6e4752048fde
-        k8s.io/code-generator v0.0.0-20190215220509-095ce2f23e83 // indirect
-        k8s.io/gengo v0.0.0-20190128074634-0689ccc1d7d6 // indirect
-        k8s.io/klog v0.1.0 // indirect
-        k8s.io/kube-openapi v0.0.0-20180928202339-9dfdf9be683f // indirect
-        sigs.k8s.io/controller-runtime v0.1.10
-        sigs.k8s.io/controller-tools v0.1.10 // indirect
-        sigs.k8s.io/testing_frameworks v0.1.1 // indirect
-        sigs.k8s.io/yaml v1.1.0 // indirect
+	cloud.google.com/go v0.29.0 // indirect
+	github.com/appscode/jsonpatch v0.0.0-20190108182946-7c0e3b262f30 // indirect
+	github.com/beorn7/perks v0.0.0-20180321164747-3a771d992973 // indirect
+	github.com/chzyer/readline v0.0.0-20180603132655-2972be24d48e // indirect
+	github.com/containers-ai/api v4.2.466+incompatible
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/emicklei/go-restful v2.8.0+incompatible // indirect
+	github.com/fsnotify/fsnotify v1.4.9 // indirect
+	github.com/ghodss/yaml v1.0.0
+	github.com/go-logr/logr v0.1.0 // indirect
+	github.com/go-logr/zapr v0.1.0 // indirect
+	github.com/gobuffalo/envy v1.6.4 // indirect
+	github.com/gogo/protobuf v1.1.1 // indirect
+	github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b // indirect
+	github.com/golang/groupcache v0.0.0-20180924190550-6f2cf27854a4 // indirect
+	github.com/golang/protobuf v1.3.2
+	github.com/google/btree v1.0.0 // indirect
+	github.com/google/gofuzz v0.0.0-20170612174753-24818f796faf // indirect
+	github.com/google/uuid v1.0.0 // indirect
+	github.com/googleapis/gnostic v0.2.0 // indirect
+	github.com/gregjones/httpcache v0.0.0-20180305231024-9cad4c3443a7 // indirect
+	github.com/grpc-ecosystem/go-grpc-middleware v1.0.0
+	github.com/hashicorp/golang-lru v0.5.0 // indirect
+	github.com/hashicorp/hcl v1.0.0 // indirect
+	github.com/hpcloud/tail v1.0.0 // indirect
+	github.com/imdario/mergo v0.3.6 // indirect
+	github.com/inconshreveable/mousetrap v1.0.0 // indirect
+	github.com/influxdata/influxdb v1.7.7
+	github.com/joho/godotenv v1.3.0 // indirect
+	github.com/json-iterator/go v1.1.5 // indirect
+	github.com/juju/ansiterm v0.0.0-20180109212912-720a0952cc2a // indirect
+	github.com/lunixbochs/vtclean v1.0.0 // indirect
+	github.com/magiconair/properties v1.8.0 // indirect
+	github.com/manifoldco/promptui v0.3.1
+	github.com/markbates/inflect v1.0.1 // indirect
+	github.com/mattbaird/jsonpatch v0.0.0-20171005235357-81af80346b1a
+	github.com/mattn/go-colorable v0.1.2 // indirect
+	github.com/mattn/go-isatty v0.0.9 // indirect
+	github.com/matttproud/golang_protobuf_extensions v1.0.1 // indirect
+	github.com/mitchellh/mapstructure v1.1.2 // indirect
+	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
+	github.com/modern-go/reflect2 v0.0.0-20180701023420-4b7aa43c6742 // indirect
+	github.com/onsi/ginkgo v1.6.0 // indirect
+	github.com/onsi/gomega v1.4.2
+	github.com/openshift/api v0.0.0-20190129160438-bbc4289c54e0
+	github.com/openshift/client-go v3.9.0+incompatible
+	github.com/pborman/uuid v0.0.0-20180906182336-adf5a7427709 // indirect
+	github.com/pelletier/go-toml v1.2.0 // indirect
+	github.com/petar/GoLLRB v0.0.0-20130427215148-53be0d36a84c // indirect
+	github.com/peterbourgon/diskv v2.0.1+incompatible // indirect
+	github.com/pkg/errors v0.8.0
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/prometheus/client_golang v0.9.2
+	github.com/prometheus/client_model v0.0.0-20190115171406-56726106282f // indirect
+	github.com/prometheus/common v0.2.0 // indirect
+	github.com/prometheus/procfs v0.0.0-20190117184657-bf6a532e95b1 // indirect
+	github.com/robfig/cron v1.2.0
+	github.com/spf13/afero v1.1.2 // indirect
+	github.com/spf13/cast v1.3.0 // indirect
+	github.com/spf13/cobra v0.0.3
+	github.com/spf13/jwalterweatherman v1.0.0 // indirect
+	github.com/spf13/pflag v1.0.3 // indirect
+	github.com/spf13/viper v1.2.1
+	github.com/streadway/amqp v0.0.0-20190404075320-75d898a42a94
+	github.com/stretchr/testify v1.3.0
+	go.uber.org/atomic v1.3.2 // indirect
+	go.uber.org/multierr v1.1.0 // indirect
+	go.uber.org/zap v1.9.1
+	golang.org/x/crypto v0.0.0-20181001203147-e3636079e1a4 // indirect
+	golang.org/x/net v0.0.0-20181201002055-351d144fa1fc
+	golang.org/x/oauth2 v0.0.0-20181003184128-c57b0facaced // indirect
+	golang.org/x/text v0.3.0 // indirect
+	golang.org/x/time v0.0.0-20180412165947-fbb02b2291d2 // indirect
+	golang.org/x/tools v0.0.0-20181004163742-59602fdee893 // indirect
+	google.golang.org/appengine v1.2.0 // indirect
+	google.golang.org/genproto v0.0.0-20181101192439-c830210a61df
+	google.golang.org/grpc v1.16.0
+	gopkg.in/inf.v0 v0.9.1 // indirect
+	gopkg.in/natefinch/lumberjack.v2 v2.0.0-20170531160350-a96e63847dc3
+	gopkg.in/tomb.v1 v1.0.0-20141024135613-dd632973f1e7 // indirect
+	gopkg.in/yaml.v2 v2.2.1 // indirect
+	k8s.io/api v0.0.0-20190202010724-74b699b93c15
+	k8s.io/apiextensions-apiserver v0.0.0-20190202013456-d4288ab64945
+	k8s.io/apimachinery v0.0.0-20190117220443-572dfc7bdfcb
+	k8s.io/client-go v0.0.0-20190202011228-6e4752048fde
+	k8s.io/code-generator v0.0.0-20190215220509-095ce2f23e83 // indirect
+	k8s.io/gengo v0.0.0-20190128074634-0689ccc1d7d6 // indirect
+	k8s.io/klog v0.1.0 // indirect
+	k8s.io/kube-openapi v0.0.0-20180928202339-9dfdf9be683f // indirect
+	moul.io/http2curl v1.0.0
+	sigs.k8s.io/controller-runtime v0.1.10
+	sigs.k8s.io/controller-tools v0.1.10 // indirect
+	sigs.k8s.io/testing_frameworks v0.1.1 // indirect
+	sigs.k8s.io/yaml v1.1.0 // indirect
 )
 
-replace gopkg.in/fsnotify.v1 => github.com/fsnotify/fsnotify v1.4.7
\ No newline at end of file
+replace gopkg.in/fsnotify.v1 => github.com/fsnotify/fsnotify v1.4.7
@@ -14,6 +14,7 @@ type Options struct {
 	Timeout               *time.Time
 	StepTime              *time.Duration
 	AggregateOverTimeFunc AggregateFunction
+	ClusterID             string
 }
 
 type Option func(*Options)
@@ -56,3 +57,9 @@ func AggregateOverTimeFunc(f AggregateFunction) Option {
 		o.AggregateOverTimeFunc = f
 	}
 }
+
+func ClusterID(clusterID string) Option {
+	return func(o *Options) {
+		o.ClusterID = clusterID
+	}
+}
@@ -0,0 +1,121 @@
+package influxdb
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	identifierReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	literalReplacer    = strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`)
+)
+
+// QuoteIdentifier Return the identifier double quoted with quotes in it escaped
+func QuoteIdentifier(identifier string) string {
+	return `"` + identifierReplacer.Replace(identifier) + `"`
+}
+
+// QuoteLiteral Return the string literal single quoted with quotes in it escaped
+func QuoteLiteral(literal string) string {
+	return `'` + literalReplacer.Replace(literal) + `'`
+}
+
+// QuoteMeasurement Return the measurement of FROM clause quoted. Regular expressions
+// "/.../" are kept as is, measurements qualified by database and retention policy
+// e.g. "db.rp.measurement" are quoted part by part and others are quoted as a whole.
+func QuoteMeasurement(measurement string) string {
+	if isRegex(measurement) {
+		return measurement
+	}
+	parts := splitMeasurement(measurement)
+	if parts == nil {
+		return QuoteIdentifier(measurement)
+	}
+	for i, part := range parts {
+		if part != "" && !isRegex(part) {
+			parts[i] = QuoteIdentifier(part)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// SplitMeasurement Return database, retention policy and name of the measurement of
+// FROM clause, database and retention policy are empty if they are not qualified
+func SplitMeasurement(measurement string) (database, retentionPolicy, name string) {
+	parts := splitMeasurement(measurement)
+	switch len(parts) {
+	case 2:
+		return "", parts[0], parts[1]
+	case 3:
+		return parts[0], parts[1], parts[2]
+	}
+	return "", "", measurement
+}
+
+// splitMeasurement Split measurement qualified by retention policy "rp.measurement",
+// or by database and retention policy "db.rp.measurement" and "db..measurement",
+// into parts. The name may be a regular expression. Nil is returned if the
+// measurement is not qualified or any part is not a plain identifier.
+func splitMeasurement(measurement string) []string {
+	var qualifier, name string
+	if i := strings.Index(measurement, "/"); i >= 0 {
+		qualifier, name = measurement[:i], measurement[i:]
+		if !strings.HasSuffix(qualifier, ".") || !isRegex(name) {
+			return nil
+		}
+		qualifier = strings.TrimSuffix(qualifier, ".")
+	} else if i := strings.LastIndex(measurement, "."); i >= 0 {
+		qualifier, name = measurement[:i], measurement[i+1:]
+		if !isPlainIdentifier(name) {
+			return nil
+		}
+	} else {
+		return nil
+	}
+
+	parts := strings.Split(qualifier, ".")
+	switch {
+	case len(parts) == 1 && isPlainIdentifier(parts[0]):
+	case len(parts) == 2 && isPlainIdentifier(parts[0]) && (parts[1] == "" || isPlainIdentifier(parts[1])):
+	default:
+		return nil
+	}
+	return append(parts, name)
+}
+
+// isPlainIdentifier Check the identifier is valid InfluxQL identifier without quotes
+func isPlainIdentifier(identifier string) bool {
+	if identifier == "" {
+		return false
+	}
+	for i, r := range identifier {
+		if r == '_' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r)) {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// isRegex Check the string is a single InfluxQL regular expression literal "/.../"
+// which compiles
+func isRegex(regex string) bool {
+	if len(regex) < 2 || !strings.HasPrefix(regex, "/") || !strings.HasSuffix(regex, "/") {
+		return false
+	}
+	body := regex[1 : len(regex)-1]
+	for i := 0; i < len(body); i++ {
+		switch body[i] {
+		case '\\':
+			i++
+			if i == len(body) {
+				return false
+			}
+		case '/', '\n':
+			return false
+		}
+	}
+	_, err := regexp.Compile(strings.Replace(body, `\/`, `/`, -1))
+	return err == nil
+}
@@ -0,0 +1,106 @@
+package influxdb
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAppendWhereClauseWithParams(t *testing.T) {
+	testCases := []struct {
+		clause   string
+		expected string
+		params   map[string]interface{}
+	}{
+		{clause: "", expected: ""},
+		{clause: "WHERE", expected: ""},
+		{
+			clause:   `WHERE "name"='node1'`,
+			expected: `WHERE ("name"=$p0) `,
+			params:   map[string]interface{}{"p0": "node1"},
+		},
+		{
+			clause:   `where "name"='DROP; SELECT' and value > 1.5 OR ("select"='a\'b' AND up=true)`,
+			expected: `WHERE ("name"=$p0 AND "value">$p1 OR ("select"=$p2 AND "up"=$p3)) `,
+			params:   map[string]interface{}{"p0": "DROP; SELECT", "p1": float64(1.5), "p2": "a'b", "p3": true},
+		},
+		{
+			clause:   `WHERE time > now() - 1h AND "pod_name" =~ /^alameda-\/.*$/ AND value <= -2`,
+			expected: `WHERE ("time">now() - 1h AND "pod_name"=~/^alameda-\/.*$/ AND "value"<=$p0) `,
+			params:   map[string]interface{}{"p0": int64(-2)},
+		},
+	}
+	for _, testCase := range testCases {
+		statement := Statement{}
+		if err := statement.AppendWhereClauseWithParams(testCase.clause); err != nil {
+			t.Errorf("expect %q valid, got %v", testCase.clause, err)
+			continue
+		}
+		if statement.WhereClause != testCase.expected {
+			t.Errorf("expect where clause %q of %q, got %q", testCase.expected, testCase.clause, statement.WhereClause)
+		}
+		if len(testCase.params) > 0 && !reflect.DeepEqual(statement.Params, testCase.params) {
+			t.Errorf("expect params %v of %q, got %v", testCase.params, testCase.clause, statement.Params)
+		}
+	}
+
+	invalid := []string{
+		`WHERE "name"='node1'; DROP DATABASE alameda_cluster_status`,
+		`WHERE "name"='node1' INTO "other"`,
+		`WHERE "name" IN (SELECT "name" FROM "node")`,
+		`WHERE "name"=(SELECT "name" FROM "node")`,
+		`WHERE "name"='node1`,
+		`WHERE "name"='node1' -- AND time > 0`,
+		`WHERE "name"='node1' /* AND time > 0 */`,
+		`WHERE "name"=node1`,
+		`WHERE "name"=~'node1'`,
+		`WHERE "name"=~/node1/ OR 1=1 /`,
+		`WHERE "name"=/node1/`,
+		`WHERE time > now() - 1h; DROP`,
+	}
+	for _, clause := range invalid {
+		statement := Statement{}
+		if err := statement.AppendWhereClauseWithParams(clause); err == nil {
+			t.Errorf("expect %q invalid, got %q", clause, statement.WhereClause)
+		}
+	}
+}
+
+func TestQuoteMeasurement(t *testing.T) {
+	testCases := []struct {
+		measurement string
+		expected    string
+	}{
+		{measurement: "node", expected: `"node"`},
+		{measurement: `node" ; DROP`, expected: `"node\" ; DROP"`},
+		{measurement: "/^kubernetes_.*/", expected: "/^kubernetes_.*/"},
+		{measurement: "autogen.node", expected: `"autogen"."node"`},
+		{measurement: "telegraf.autogen.node", expected: `"telegraf"."autogen"."node"`},
+		{measurement: "telegraf..node", expected: `"telegraf".."node"`},
+		{measurement: "telegraf.autogen./^kubernetes_.*/", expected: `"telegraf"."autogen"./^kubernetes_.*/`},
+		{measurement: "/a/ ; DROP /b/", expected: `"/a/ ; DROP /b/"`},
+		{measurement: "a.b.c.d", expected: `"a.b.c.d"`},
+	}
+	for _, testCase := range testCases {
+		if actual := QuoteMeasurement(testCase.measurement); actual != testCase.expected {
+			t.Errorf("expect measurement %q quoted as %q, got %q", testCase.measurement, testCase.expected, actual)
+		}
+	}
+
+	if database, retentionPolicy, name := SplitMeasurement("alameda_keycode.autogen.keycode"); database != "alameda_keycode" || retentionPolicy != "autogen" || name != "keycode" {
+		t.Errorf("unexpected split %q %q %q", database, retentionPolicy, name)
+	}
+}
+
+func TestStatementBindsParams(t *testing.T) {
+	statement := Statement{Measurement: `node" ; DROP`}
+	statement.AppendWhereClause("name", "=", `a' OR 1=1`)
+	statement.AppendWhereClauseWithParam("value", ">", float64(1))
+
+	expected := `SELECT * FROM "node\" ; DROP" WHERE "name"='a\' OR 1=1' AND "value">$p0`
+	if cmd := statement.BuildQueryCmd(); cmd[:len(expected)] != expected {
+		t.Errorf("expect command %q, got %q", expected, cmd)
+	}
+	if value, ok := statement.Params["p0"]; !ok || value != float64(1) {
+		t.Errorf("expect value bound to p0, got %v", statement.Params)
+	}
+}
@@ -2,6 +2,7 @@ package influxdb
 
 import (
 	"fmt"
+	Instrumentation "github.com/containers-ai/alameda/pkg/instrumentation"
 	Client "github.com/influxdata/influxdb/client/v2"
 	"strings"
 	"time"
@@ -26,7 +27,11 @@ func (p *InfluxClient) DeleteMeasurement(db, measurement string) error {
 }
 
 // Write points to database
-func (p *InfluxClient) WritePoints(points []*Client.Point, bpCfg Client.BatchPointsConfig) error {
+func (p *InfluxClient) WritePoints(points []*Client.Point, bpCfg Client.BatchPointsConfig) (err error) {
+	defer func(start time.Time) {
+		Instrumentation.ObserveBackendRequest("influxdb", "write", start, err)
+	}(time.Now())
+
 	client := p.newHttpClient()
 	defer client.Close()
 
@@ -60,13 +65,22 @@ func (p *InfluxClient) WritePoints(points []*Client.Point, bpCfg Client.BatchPoi
 
 // Query database
 func (p *InfluxClient) QueryDB(cmd, database string) (res []Client.Result, err error) {
+	return p.QueryDBWithParameters(cmd, database, nil)
+}
+
+// Query database with values bound to placeholders of the command
+func (p *InfluxClient) QueryDBWithParameters(cmd, database string, parameters map[string]interface{}) (res []Client.Result, err error) {
+	defer func(start time.Time) {
+		Instrumentation.ObserveBackendRequest("influxdb", "query", start, err)
+	}(time.Now())
+
 	client := p.newHttpClient()
 	defer client.Close()
 
-	q := Client.Query{
-		Command:  cmd,
-		Database: database,
+	if parameters == nil {
+		parameters = make(map[string]interface{})
 	}
+	q := Client.NewQueryWithParameters(cmd, database, "", parameters)
 
 	if response, err := client.Query(q); err == nil {
 		if response.Error() != nil {
@@ -110,7 +124,7 @@ func (p *InfluxClient) newHttpClient() Client.Client {
 		Addr:               p.Address,
 		Username:           p.Username,
 		Password:           p.Password,
-		InsecureSkipVerify: true,
+		InsecureSkipVerify: p.InsecureSkipVerify,
 	})
 	if err != nil {
 		scope.Error(err.Error())
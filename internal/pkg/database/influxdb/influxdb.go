@@ -20,6 +20,7 @@ type InfluxClient struct {
 	Address                string
 	Username               string
 	Password               string
+	InsecureSkipVerify     bool
 	RetentionDuration      string
 	RetentionShardDuration string
 }
@@ -30,6 +31,7 @@ func NewClient(influxCfg *Config) *InfluxClient {
 		Address:                influxCfg.Address,
 		Username:               influxCfg.Username,
 		Password:               influxCfg.Password,
+		InsecureSkipVerify:     influxCfg.InsecureSkipVerify,
 		RetentionDuration:      influxCfg.RetentionDuration,
 		RetentionShardDuration: influxCfg.RetentionShardDuration,
 	}
@@ -16,12 +16,15 @@ func ReadRawdata(config *Config, queries []*Common.Query) ([]*Common.ReadRawdata
 
 	for _, query := range queries {
 		statement := NewStatement(query)
+		if err := statement.AppendWhereClauseWithParams(query.GetCondition().GetWhereClause()); err != nil {
+			return make([]*Common.ReadRawdata, 0), err
+		}
 		statement.AppendWhereClauseFromTimeCondition()
 		statement.SetLimitClauseFromQueryCondition()
 		statement.SetOrderClauseFromQueryCondition()
 		cmd := statement.BuildQueryCmd()
 
-		results, err := influxClient.QueryDB(cmd, query.Database)
+		results, err := influxClient.QueryDBWithParameters(cmd, query.Database, statement.Params)
 		if err != nil {
 			scope.Errorf("failed to read rawdata from InfluxDB: %v", err)
 			return make([]*Common.ReadRawdata, 0), err
@@ -35,6 +38,18 @@ func ReadRawdata(config *Config, queries []*Common.Query) ([]*Common.ReadRawdata
 	return rawdata, nil
 }
 
+// ReadRawdataWithStatement Read rawdata of the statement, query is returned in
+// the rawdata to tell clients which query the rawdata belongs to
+func ReadRawdataWithStatement(config *Config, statement *Statement, query *Common.Query) (*Common.ReadRawdata, error) {
+	influxClient := NewClient(config)
+	results, err := influxClient.QueryDBWithParameters(statement.BuildQueryCmd(), string(statement.Database), statement.Params)
+	if err != nil {
+		scope.Errorf("failed to read rawdata from InfluxDB: %v", err)
+		return nil, err
+	}
+	return InfluxResultToReadRawdata(results, query), nil
+}
+
 func WriteRawdata(config *Config, writeRawdata []*Common.WriteRawdata) error {
 	var influxClient = NewClient(config)
 	var err error
@@ -52,7 +67,7 @@ func WriteRawdata(config *Config, writeRawdata []*Common.WriteRawdata) error {
 				case Common.ColumnType_COLUMNTYPE_TAG:
 					tags[rawdata.GetColumns()[index]] = value
 				case Common.ColumnType_COLUMNTYPE_FIELD:
-					fields[rawdata.GetColumns()[index]] = changeFormat(value, rawdata.GetDataTypes()[index])
+					fields[rawdata.GetColumns()[index]] = ChangeFormat(value, rawdata.GetDataTypes()[index])
 				default:
 					fmt.Println("not support")
 				}
@@ -233,7 +248,8 @@ func CompareRawdataWithInfluxResults(readRawdata *Common.ReadRawdata, results []
 	return nil
 }
 
-func changeFormat(value string, dataType Common.DataType) interface{} {
+// ChangeFormat Convert the value to the data type, value is returned as is if the data type is not supported
+func ChangeFormat(value string, dataType Common.DataType) interface{} {
 	switch dataType {
 	case Common.DataType_DATATYPE_BOOL:
 		valueBool, _ := strconv.ParseBool(value)
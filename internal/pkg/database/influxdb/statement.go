@@ -17,8 +17,12 @@ type Statement struct {
 	WhereClause    string
 	OrderClause    string
 	LimitClause    string
+	// Params are values bound to placeholders of the statement, see BindParam
+	Params map[string]interface{}
 }
 
+// NewStatement Build statement of the query, where clause of the query is not
+// trusted and is appended by AppendWhereClauseWithParams
 func NewStatement(query *Common.Query) *Statement {
 	if query == nil {
 		return &Statement{}
@@ -32,7 +36,6 @@ func NewStatement(query *Common.Query) *Statement {
 		Measurement:    Measurement(query.GetTable()),
 		SelectedFields: query.GetCondition().GetSelects(),
 		GroupByTags:    query.GetCondition().GetGroups(),
-		WhereClause:    query.GetCondition().GetWhereClause(),
 	}
 
 	return &statement
@@ -43,11 +46,22 @@ func (s *Statement) AppendWhereClause(key string, operator string, value string)
 		return
 	}
 
-	if s.WhereClause == "" {
-		s.WhereClause += fmt.Sprintf("WHERE \"%s\"%s'%s' ", key, operator, value)
-	} else {
-		s.WhereClause += fmt.Sprintf("AND \"%s\"%s'%s' ", key, operator, value)
+	s.AppendWhereClauseDirectly(fmt.Sprintf("%s%s%s", QuoteIdentifier(key), operator, QuoteLiteral(value)))
+}
+
+// AppendWhereClauseWithParam Append condition comparing key with value bound as parameter
+func (s *Statement) AppendWhereClauseWithParam(key string, operator string, value interface{}) {
+	s.AppendWhereClauseDirectly(fmt.Sprintf("%s%s%s", QuoteIdentifier(key), operator, s.BindParam(value)))
+}
+
+// BindParam Bind the value to a new placeholder of the statement and return the placeholder
+func (s *Statement) BindParam(value interface{}) string {
+	if s.Params == nil {
+		s.Params = make(map[string]interface{})
 	}
+	name := fmt.Sprintf("p%d", len(s.Params))
+	s.Params[name] = value
+	return "$" + name
 }
 
 func (s *Statement) AppendWhereClauseByList(key string, operator string, listOperator string, values []string) {
@@ -57,7 +71,7 @@ func (s *Statement) AppendWhereClauseByList(key string, operator string, listOpe
 
 	condition := "("
 	for _, value := range values {
-		condition += fmt.Sprintf("\"%s\"%s'%s' %s ", key, operator, value, listOperator)
+		condition += fmt.Sprintf("%s%s%s %s ", QuoteIdentifier(key), operator, QuoteLiteral(value), listOperator)
 	}
 	condition = strings.TrimSuffix(condition, fmt.Sprintf("%s ", listOperator))
 	condition += ")"
@@ -137,7 +151,7 @@ func (s Statement) BuildQueryCmd() string {
 	if len(s.SelectedFields) > 0 {
 		fieldsStr = ""
 		for _, field := range s.SelectedFields {
-			fieldsStr += fmt.Sprintf(`"%s",`, field)
+			fieldsStr += QuoteIdentifier(field) + ","
 		}
 		fieldsStr = strings.TrimSuffix(fieldsStr, ",")
 	}
@@ -145,13 +159,13 @@ func (s Statement) BuildQueryCmd() string {
 	if len(s.GroupByTags) > 0 {
 		groupByStr = "GROUP BY "
 		for _, field := range s.GroupByTags {
-			groupByStr += fmt.Sprintf(`"%s",`, field)
+			groupByStr += QuoteIdentifier(field) + ","
 		}
 		groupByStr = strings.TrimSuffix(groupByStr, ",")
 	}
 
 	cmd = fmt.Sprintf("SELECT %s FROM %s %s %s %s %s",
-		fieldsStr, s.Measurement, s.WhereClause,
+		fieldsStr, QuoteMeasurement(string(s.Measurement)), s.WhereClause,
 		groupByStr, s.OrderClause, s.LimitClause)
 
 	return cmd
@@ -0,0 +1,268 @@
+package influxdb
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	durationPattern = regexp.MustCompile(`^[0-9]+(ns|u|µ|ms|s|m|h|d|w)$`)
+	numberPattern   = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+	whereOperators = []string{"=~", "!~", "!=", "<>", "<=", ">=", "=", "<", ">"}
+)
+
+// AppendWhereClauseWithParams Append where clause sent by clients. The clause is
+// parsed and only comparisons of keys with values joined by AND and OR are
+// accepted, string, number and boolean values are bound as parameters.
+func (s *Statement) AppendWhereClauseWithParams(clause string) error {
+	clause = strings.TrimSpace(clause)
+	if clause == "" {
+		return nil
+	}
+
+	parser := &whereParser{input: []rune(clause), statement: s}
+	if parser.acceptKeyword("WHERE") && parser.eof() {
+		return nil
+	}
+	condition, err := parser.parseExpr()
+	if err != nil {
+		return errors.Wrap(err, "parse where clause failed")
+	}
+	if !parser.eof() {
+		return errors.Errorf("parse where clause failed: unexpected %q", string(parser.input[parser.pos:]))
+	}
+
+	s.AppendWhereClauseDirectly("(" + condition + ")")
+	return nil
+}
+
+// whereParser Parses where clause into InfluxQL with values replaced by
+// placeholders bound to the statement
+type whereParser struct {
+	input     []rune
+	pos       int
+	statement *Statement
+}
+
+// parseExpr Parse comparisons joined by AND and OR
+func (p *whereParser) parseExpr() (string, error) {
+	expr, err := p.parseTerm()
+	if err != nil {
+		return "", err
+	}
+	for {
+		var operator string
+		switch {
+		case p.acceptKeyword("AND"):
+			operator = "AND"
+		case p.acceptKeyword("OR"):
+			operator = "OR"
+		default:
+			return expr, nil
+		}
+		term, err := p.parseTerm()
+		if err != nil {
+			return "", err
+		}
+		expr = expr + " " + operator + " " + term
+	}
+}
+
+// parseTerm Parse parenthesized expression or comparison of key with value
+func (p *whereParser) parseTerm() (string, error) {
+	if p.accept("(") {
+		expr, err := p.parseExpr()
+		if err != nil {
+			return "", err
+		}
+		if !p.accept(")") {
+			return "", errors.New("missing )")
+		}
+		return "(" + expr + ")", nil
+	}
+
+	key, err := p.parseKey()
+	if err != nil {
+		return "", err
+	}
+	operator := ""
+	for _, op := range whereOperators {
+		if p.accept(op) {
+			operator = op
+			break
+		}
+	}
+	if operator == "" {
+		return "", errors.Errorf("missing operator after key %s", key)
+	}
+	value, err := p.parseValue(operator == "=~" || operator == "!~")
+	if err != nil {
+		return "", err
+	}
+	return key + operator + value, nil
+}
+
+func (p *whereParser) parseKey() (string, error) {
+	p.skipSpaces()
+	if p.peek() == '"' {
+		key, err := p.scanQuoted('"')
+		if err != nil {
+			return "", err
+		}
+		return QuoteIdentifier(key), nil
+	}
+	if word := p.scanWord(); isPlainIdentifier(word) {
+		return QuoteIdentifier(word), nil
+	}
+	return "", errors.Errorf("invalid key at %q", string(p.input[p.pos:]))
+}
+
+// parseValue Parse value compared with key, regular expressions are accepted
+// only if the key is matched against a pattern
+func (p *whereParser) parseValue(pattern bool) (string, error) {
+	p.skipSpaces()
+	if pattern {
+		start := p.pos
+		if p.peek() != '/' {
+			return "", errors.New("regular expression is required by =~ and !~")
+		}
+		if _, err := p.scanQuoted('/'); err != nil {
+			return "", err
+		}
+		regex := string(p.input[start:p.pos])
+		if !isRegex(regex) {
+			return "", errors.Errorf("invalid regular expression %s", regex)
+		}
+		return regex, nil
+	}
+
+	if p.peek() == '\'' {
+		value, err := p.scanQuoted('\'')
+		if err != nil {
+			return "", err
+		}
+		return p.statement.BindParam(value), nil
+	}
+
+	if p.acceptKeyword("now") {
+		if !p.accept("(") || !p.accept(")") {
+			return "", errors.New("invalid call of now()")
+		}
+		value := "now()"
+		for _, sign := range []string{"+", "-"} {
+			if p.accept(sign) {
+				p.skipSpaces()
+				duration := p.scanWord()
+				if !durationPattern.MatchString(duration) {
+					return "", errors.Errorf("invalid duration %q", duration)
+				}
+				value = value + " " + sign + " " + duration
+				break
+			}
+		}
+		return value, nil
+	}
+
+	negative := p.accept("-")
+	p.skipSpaces()
+	word := p.scanWord()
+	switch {
+	case numberPattern.MatchString(word):
+		if negative {
+			word = "-" + word
+		}
+		if integer, err := strconv.ParseInt(word, 10, 64); err == nil {
+			return p.statement.BindParam(integer), nil
+		}
+		number, err := strconv.ParseFloat(word, 64)
+		if err != nil {
+			return "", errors.Errorf("invalid number %q", word)
+		}
+		return p.statement.BindParam(number), nil
+	case !negative && durationPattern.MatchString(word):
+		return word, nil
+	case !negative && (strings.EqualFold(word, "true") || strings.EqualFold(word, "false")):
+		return p.statement.BindParam(strings.EqualFold(word, "true")), nil
+	}
+	return "", errors.Errorf("invalid value %q", word)
+}
+
+// scanQuoted Scan the literal enclosed by the quote and return it unescaped
+func (p *whereParser) scanQuoted(quote rune) (string, error) {
+	var literal strings.Builder
+	for p.pos++; p.pos < len(p.input); p.pos++ {
+		r := p.input[p.pos]
+		if r == '\\' && p.pos+1 < len(p.input) {
+			p.pos++
+			r = p.input[p.pos]
+			if quote == '/' && r != '/' {
+				literal.WriteRune('\\')
+			}
+			literal.WriteRune(r)
+			continue
+		}
+		if r == quote {
+			p.pos++
+			return literal.String(), nil
+		}
+		literal.WriteRune(r)
+	}
+	return "", errors.Errorf("unterminated %c", quote)
+}
+
+// scanWord Scan letters, digits, underscores and dots
+func (p *whereParser) scanWord() string {
+	start := p.pos
+	for p.pos < len(p.input) {
+		r := p.input[p.pos]
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '.' {
+			break
+		}
+		p.pos++
+	}
+	return string(p.input[start:p.pos])
+}
+
+// accept Consume the token if the input continues with it
+func (p *whereParser) accept(token string) bool {
+	p.skipSpaces()
+	if strings.HasPrefix(string(p.input[p.pos:]), token) {
+		p.pos += len([]rune(token))
+		return true
+	}
+	return false
+}
+
+// acceptKeyword Consume the keyword case-insensitively if the input continues with it
+func (p *whereParser) acceptKeyword(keyword string) bool {
+	p.skipSpaces()
+	start := p.pos
+	if word := p.scanWord(); strings.EqualFold(word, keyword) {
+		return true
+	}
+	p.pos = start
+	return false
+}
+
+func (p *whereParser) skipSpaces() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *whereParser) peek() rune {
+	if p.pos < len(p.input) {
+		return p.input[p.pos]
+	}
+	return 0
+}
+
+func (p *whereParser) eof() bool {
+	p.skipSpaces()
+	return p.pos >= len(p.input)
+}
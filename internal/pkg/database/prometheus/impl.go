@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/containers-ai/alameda/datahub/pkg/utils"
 	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
+	Instrumentation "github.com/containers-ai/alameda/pkg/instrumentation"
 	"github.com/pkg/errors"
 	"net/http"
 	"net/url"
@@ -32,6 +33,9 @@ func (p *Prometheus) Query(query string, startTime, timeout *time.Time) (Respons
 
 		response Response
 	)
+	defer func(start time.Time) {
+		Instrumentation.ObserveBackendRequest("prometheus", "query", start, err)
+	}(time.Now())
 
 	queryParameters.Set("query", query)
 
@@ -99,6 +103,9 @@ func (p *Prometheus) QueryRange(query string, startTime, endTime *time.Time, ste
 
 		response Response
 	)
+	defer func(start time.Time) {
+		Instrumentation.ObserveBackendRequest("prometheus", "query_range", start, err)
+	}(time.Now())
 
 	if endTime == nil {
 		tmpTime := time.Now()
@@ -0,0 +1,71 @@
+package storage
+
+const (
+	// ClusterIDTag Tag of points holding id of the cluster the points belong to
+	ClusterIDTag = "cluster_id"
+)
+
+var (
+	localClusterID string
+)
+
+// SetLocalClusterID Set id of the cluster datahub runs in. Points written before
+// points were tagged with cluster id have no cluster id, they are treated as
+// points of the local cluster when they are queried and dropped.
+func SetLocalClusterID(clusterID string) {
+	localClusterID = clusterID
+}
+
+// ClusterCondition Build condition matching points of the cluster, points without
+// cluster id are also matched if the cluster is the local cluster, return nil if clusterID is empty
+func ClusterCondition(clusterID string) Condition {
+	if clusterID == "" {
+		return nil
+	}
+	if clusterID == localClusterID {
+		return AnyOf(EqualTo(ClusterIDTag, clusterID), Compare(ClusterIDTag, Equal, ""))
+	}
+	return EqualTo(ClusterIDTag, clusterID)
+}
+
+// clusterScopedEngine Engine tagging written points with the cluster id and
+// restricting queries and deletions to points of the cluster
+type clusterScopedEngine struct {
+	Engine
+	clusterID string
+}
+
+// WithClusterID Return engine scoped to the cluster, engine is returned as is if clusterID is empty
+func WithClusterID(engine Engine, clusterID string) Engine {
+	if clusterID == "" {
+		return engine
+	}
+	return &clusterScopedEngine{
+		Engine:    engine,
+		clusterID: clusterID,
+	}
+}
+
+func (e *clusterScopedEngine) WritePoints(database string, points []*Point) error {
+	scopedPoints := make([]*Point, len(points))
+	for i, point := range points {
+		tags := make(map[string]string, len(point.Tags)+1)
+		for key, value := range point.Tags {
+			tags[key] = value
+		}
+		tags[ClusterIDTag] = e.clusterID
+		scopedPoint := *point
+		scopedPoint.Tags = tags
+		scopedPoints[i] = &scopedPoint
+	}
+	return e.Engine.WritePoints(database, scopedPoints)
+}
+
+func (e *clusterScopedEngine) Query(query Query) ([]*Row, error) {
+	query.AppendCondition(ClusterCondition(e.clusterID))
+	return e.Engine.Query(query)
+}
+
+func (e *clusterScopedEngine) DropSeries(database, measurement string, condition Condition) error {
+	return e.Engine.DropSeries(database, measurement, AllOf(condition, ClusterCondition(e.clusterID)))
+}
@@ -0,0 +1,92 @@
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage/embedded"
+)
+
+func TestWithClusterID(t *testing.T) {
+	engine := embedded.New()
+	clusterA := storage.WithClusterID(engine, "cluster-a")
+	clusterB := storage.WithClusterID(engine, "cluster-b")
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, e := range []storage.Engine{clusterA, clusterB} {
+		point := &storage.Point{
+			Measurement: "node",
+			Tags:        map[string]string{"name": "node1"},
+			Fields:      map[string]interface{}{"value": float64(1)},
+			Time:        now,
+		}
+		if err := e.WritePoints("db", []*storage.Point{point}); err != nil {
+			t.Fatal(err)
+		}
+		if len(point.Tags) != 1 {
+			t.Errorf("expect written point not modified, got tags %v", point.Tags)
+		}
+	}
+
+	query := storage.Query{Database: "db", Measurement: "node", Condition: storage.EqualTo("name", "node1")}
+	rows, err := clusterA.Query(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || len(rows[0].Data) != 1 || rows[0].Data[0][storage.ClusterIDTag] != "cluster-a" {
+		t.Fatalf("expect 1 point of cluster-a, got %+v", rows)
+	}
+	if rows, _ := storage.WithClusterID(engine, "").Query(query); len(rows) != 1 || len(rows[0].Data) != 2 {
+		t.Fatalf("expect points of both clusters listed without cluster id, got %+v", rows)
+	}
+
+	if err := clusterA.DropSeries("db", "node", storage.EqualTo("name", "node1")); err != nil {
+		t.Fatal(err)
+	}
+	if rows, _ := clusterA.Query(query); len(rows) != 0 {
+		t.Errorf("expect points of cluster-a dropped, got %+v", rows)
+	}
+	if rows, _ := clusterB.Query(query); len(rows) != 1 || len(rows[0].Data) != 1 {
+		t.Errorf("expect points of cluster-b kept, got %+v", rows)
+	}
+}
+
+func TestWithClusterIDLegacyPoints(t *testing.T) {
+	storage.SetLocalClusterID("cluster-local")
+	defer storage.SetLocalClusterID("")
+
+	engine := embedded.New()
+	legacy := &storage.Point{
+		Measurement: "node",
+		Tags:        map[string]string{"name": "node1"},
+		Fields:      map[string]interface{}{"value": float64(1)},
+		Time:        time.Now().UTC().Truncate(time.Second),
+	}
+	if err := engine.WritePoints("db", []*storage.Point{legacy}); err != nil {
+		t.Fatal(err)
+	}
+
+	local := storage.WithClusterID(engine, "cluster-local")
+	remote := storage.WithClusterID(engine, "cluster-remote")
+	query := storage.Query{Database: "db", Measurement: "node", Condition: storage.EqualTo("name", "node1")}
+	if rows, _ := local.Query(query); len(rows) != 1 || len(rows[0].Data) != 1 {
+		t.Fatalf("expect point without cluster id listed by local cluster, got %+v", rows)
+	}
+	if rows, _ := remote.Query(query); len(rows) != 0 {
+		t.Fatalf("expect point without cluster id hidden from other clusters, got %+v", rows)
+	}
+
+	if err := remote.DropSeries("db", "node", storage.EqualTo("name", "node1")); err != nil {
+		t.Fatal(err)
+	}
+	if rows, _ := local.Query(query); len(rows) != 1 {
+		t.Fatalf("expect point without cluster id kept when other cluster drops series, got %+v", rows)
+	}
+	if err := local.DropSeries("db", "node", storage.EqualTo("name", "node1")); err != nil {
+		t.Fatal(err)
+	}
+	if rows, _ := local.Query(query); len(rows) != 0 {
+		t.Errorf("expect point without cluster id dropped by local cluster, got %+v", rows)
+	}
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/pkg/errors"
+)
+
+// EngineName Name of storage engine
+type EngineName = string
+
+// Storage engines definition
+const (
+	EngineInfluxDB EngineName = "influxdb"
+	EngineEmbedded EngineName = "embedded"
+)
+
+const (
+	defaultEngineName        = EngineInfluxDB
+	defaultEmbeddedDirectory = "/var/lib/alameda/datahub"
+)
+
+// Config Configuration of storage engine
+type Config struct {
+	Engine   EngineName             `mapstructure:"engine"`
+	InfluxDB *InternalInflux.Config `mapstructure:"-"`
+	Embedded *EmbeddedConfig        `mapstructure:"embedded"`
+}
+
+// EmbeddedConfig Configuration of embedded storage engine
+type EmbeddedConfig struct {
+	// Directory stores data files of the engine, data is kept in memory only if it is empty
+	Directory string `mapstructure:"directory"`
+	// RetentionDuration and RetentionShardDuration are InfluxDB duration literals of the retention
+	// policy, they default to the ones of influxdb configuration and points are kept forever if both are empty
+	RetentionDuration      string `mapstructure:"retentionDuration"`
+	RetentionShardDuration string `mapstructure:"retentionShardDuration"`
+}
+
+// NewDefaultConfig Provide default configuration of storage engine
+func NewDefaultConfig() *Config {
+	var config = Config{
+		Engine: defaultEngineName,
+		Embedded: &EmbeddedConfig{
+			Directory: defaultEmbeddedDirectory,
+		},
+	}
+	return &config
+}
+
+// Validate Confirm the storage engine configuration is validated
+func (c *Config) Validate() error {
+	if !isRegistered(c.Engine) {
+		return errors.Errorf("storage engine \"%s\" is not supported", c.Engine)
+	}
+	switch c.Engine {
+	case EngineInfluxDB:
+		if c.InfluxDB == nil {
+			return errors.New("influxdb configuration is required by storage engine influxdb")
+		}
+	case EngineEmbedded:
+		if c.Embedded == nil {
+			return errors.New("embedded configuration is required by storage engine embedded")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,397 @@
+package embedded
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	"github.com/containers-ai/alameda/pkg/utils/log"
+	"github.com/pkg/errors"
+)
+
+var (
+	scope = log.RegisterScope("storage_embedded", "embedded storage engine", 0)
+)
+
+func init() {
+	storage.RegisterFactory(storage.EngineEmbedded, func(config storage.Config) (storage.Engine, error) {
+		if config.Embedded == nil {
+			return nil, errors.New("embedded configuration is required")
+		}
+		embeddedConfig := *config.Embedded
+		if embeddedConfig.RetentionDuration == "" && config.InfluxDB != nil {
+			embeddedConfig.RetentionDuration = config.InfluxDB.RetentionDuration
+			embeddedConfig.RetentionShardDuration = config.InfluxDB.RetentionShardDuration
+		}
+		return NewWithConfig(embeddedConfig)
+	})
+}
+
+// Engine Storage engine keeping points in process memory. When a directory is
+// configured, every write and drop is appended to a log file of the database
+// which is replayed and compacted when the engine is opened. When a retention
+// duration is configured, expired points are dropped periodically.
+type Engine struct {
+	lock      sync.RWMutex
+	directory string
+	databases map[string]*database
+	retention *retentionPolicy
+	stop      chan struct{}
+	closed    bool
+}
+
+type database struct {
+	name         string
+	measurements map[string]*measurement
+	log          *logFile
+}
+
+type measurement struct {
+	series map[string]*series
+}
+
+type series struct {
+	key    string
+	tags   map[string]string
+	points map[int64]map[string]interface{}
+}
+
+type record struct {
+	time   time.Time
+	series *series
+	fields map[string]interface{}
+}
+
+// New Constructor of embedded engine keeping data in memory only
+func New() *Engine {
+	return &Engine{
+		databases: make(map[string]*database),
+	}
+}
+
+// NewWithConfig Constructor of embedded engine, data files in the configured directory are loaded
+func NewWithConfig(config storage.EmbeddedConfig) (*Engine, error) {
+	retention, err := newRetentionPolicy(config.RetentionDuration, config.RetentionShardDuration)
+	if err != nil {
+		return nil, errors.Wrap(err, "open embedded storage failed")
+	}
+
+	e := New()
+	e.directory = config.Directory
+	e.retention = retention
+	if e.directory != "" {
+		names, err := listLogFiles(e.directory)
+		if err != nil {
+			return nil, errors.Wrap(err, "open embedded storage failed")
+		}
+		for _, name := range names {
+			if _, err := e.openDatabase(name); err != nil {
+				e.Close()
+				return nil, errors.Wrap(err, "open embedded storage failed")
+			}
+		}
+	}
+
+	if e.retention != nil {
+		if err := e.enforceRetention(time.Now()); err != nil {
+			e.Close()
+			return nil, errors.Wrap(err, "open embedded storage failed")
+		}
+		e.stop = make(chan struct{})
+		go e.runRetention(e.stop)
+	}
+	return e, nil
+}
+
+// Close Release data files of the engine
+func (e *Engine) Close() error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.stop != nil && !e.closed {
+		close(e.stop)
+	}
+	e.closed = true
+	var retErr error
+	for _, db := range e.databases {
+		if db.log == nil {
+			continue
+		}
+		if err := db.log.close(); err != nil {
+			retErr = err
+		}
+	}
+	return retErr
+}
+
+// CreateDatabase Method implementation of storage.Engine
+func (e *Engine) CreateDatabase(name string) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.closed {
+		return errors.New("embedded storage is closed")
+	}
+	_, err := e.openDatabase(name)
+	return err
+}
+
+// WritePoints Method implementation of storage.Engine
+func (e *Engine) WritePoints(name string, points []*storage.Point) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.closed {
+		return errors.New("embedded storage is closed")
+	}
+	db, err := e.openDatabase(name)
+	if err != nil {
+		return err
+	}
+
+	if db.log != nil {
+		if err := db.log.appendWrite(points); err != nil {
+			return errors.Wrapf(err, "write points to database %s failed", name)
+		}
+	}
+	for _, point := range points {
+		db.write(point)
+	}
+
+	return e.compactIfNeeded(db)
+}
+
+// Query Method implementation of storage.Engine
+func (e *Engine) Query(query storage.Query) ([]*storage.Row, error) {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	if e.closed {
+		return nil, errors.New("embedded storage is closed")
+	}
+	rows := make([]*storage.Row, 0)
+	db, exist := e.databases[query.Database]
+	if !exist {
+		return rows, nil
+	}
+	m, exist := db.measurements[query.Measurement]
+	if !exist {
+		return rows, nil
+	}
+
+	groups := make(map[string][]*record)
+	groupTags := make(map[string]map[string]string)
+	for _, s := range m.series {
+		for nano, fields := range s.points {
+			r := &record{time: time.Unix(0, nano).UTC(), series: s, fields: fields}
+			if !r.match(query.Condition) {
+				continue
+			}
+			tags := make(map[string]string, len(query.GroupByTags))
+			for _, tag := range query.GroupByTags {
+				tags[tag] = s.tags[tag]
+			}
+			groupKey := seriesKey(tags)
+			groups[groupKey] = append(groups[groupKey], r)
+			groupTags[groupKey] = tags
+		}
+	}
+
+	groupKeys := make([]string, 0, len(groups))
+	for groupKey := range groups {
+		groupKeys = append(groupKeys, groupKey)
+	}
+	sort.Strings(groupKeys)
+	if query.SeriesOffset > 0 {
+		if query.SeriesOffset >= len(groupKeys) {
+			return rows, nil
+		}
+		groupKeys = groupKeys[query.SeriesOffset:]
+	}
+	if query.SeriesLimit > 0 && len(groupKeys) > query.SeriesLimit {
+		groupKeys = groupKeys[:query.SeriesLimit]
+	}
+
+	for _, groupKey := range groupKeys {
+		records := groups[groupKey]
+		sort.Slice(records, func(i, j int) bool {
+			if !records[i].time.Equal(records[j].time) {
+				if query.Order == DBCommon.Desc {
+					return records[i].time.After(records[j].time)
+				}
+				return records[i].time.Before(records[j].time)
+			}
+			return records[i].series.key < records[j].series.key
+		})
+		if query.Limit > 0 && len(records) > query.Limit {
+			records = records[:query.Limit]
+		}
+
+		row := &storage.Row{Name: query.Measurement, Data: make([]map[string]string, 0, len(records))}
+		if len(query.GroupByTags) > 0 {
+			row.Tags = groupTags[groupKey]
+		}
+		for _, r := range records {
+			row.Data = append(row.Data, r.data())
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// DropSeries Method implementation of storage.Engine
+func (e *Engine) DropSeries(name, measurementName string, condition storage.Condition) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.closed {
+		return errors.New("embedded storage is closed")
+	}
+	db, exist := e.databases[name]
+	if !exist {
+		return nil
+	}
+	m, exist := db.measurements[measurementName]
+	if !exist {
+		return nil
+	}
+
+	keys := make([]string, 0)
+	for key, s := range m.series {
+		if (&record{series: s}).matchTags(condition) {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if db.log != nil {
+		if err := db.log.appendDrop(measurementName, keys); err != nil {
+			return errors.Wrapf(err, "drop series from database %s failed", name)
+		}
+	}
+	db.drop(measurementName, keys)
+
+	return e.compactIfNeeded(db)
+}
+
+// Ping Method implementation of storage.Engine
+func (e *Engine) Ping() error {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	if e.closed {
+		return errors.New("embedded storage is closed")
+	}
+	return nil
+}
+
+// openDatabase Return the database, load or create it if it is not opened yet. Caller must hold the write lock.
+func (e *Engine) openDatabase(name string) (*database, error) {
+	if db, exist := e.databases[name]; exist {
+		return db, nil
+	}
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return nil, errors.Errorf("invalid database name \"%s\"", name)
+	}
+
+	db := &database{
+		name:         name,
+		measurements: make(map[string]*measurement),
+	}
+	if e.directory != "" {
+		logFile, err := openLogFile(e.directory, name, db)
+		if err != nil {
+			return nil, errors.Wrapf(err, "open database %s failed", name)
+		}
+		db.log = logFile
+	}
+	e.databases[name] = db
+
+	return db, nil
+}
+
+func (e *Engine) compactIfNeeded(db *database) error {
+	if db.log == nil || !db.log.needCompaction() {
+		return nil
+	}
+	scope.Debugf("compact data file of database %s", db.name)
+	return db.log.compact(db)
+}
+
+func (db *database) write(point *storage.Point) {
+	m, exist := db.measurements[point.Measurement]
+	if !exist {
+		m = &measurement{series: make(map[string]*series)}
+		db.measurements[point.Measurement] = m
+	}
+
+	key := seriesKey(point.Tags)
+	s, exist := m.series[key]
+	if !exist {
+		tags := make(map[string]string, len(point.Tags))
+		for k, v := range point.Tags {
+			tags[k] = v
+		}
+		s = &series{key: key, tags: tags, points: make(map[int64]map[string]interface{})}
+		m.series[key] = s
+	}
+
+	nano := point.Time.UnixNano()
+	fields, exist := s.points[nano]
+	if !exist {
+		fields = make(map[string]interface{}, len(point.Fields))
+		s.points[nano] = fields
+	}
+	for k, v := range point.Fields {
+		fields[k] = normalizeValue(v)
+	}
+}
+
+func (db *database) drop(measurementName string, keys []string) {
+	m, exist := db.measurements[measurementName]
+	if !exist {
+		return
+	}
+	for _, key := range keys {
+		delete(m.series, key)
+	}
+	if len(m.series) == 0 {
+		delete(db.measurements, measurementName)
+	}
+}
+
+// seriesKey Return the identity of tag set
+func seriesKey(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var builder strings.Builder
+	for _, k := range keys {
+		builder.WriteString(k)
+		builder.WriteByte(0)
+		builder.WriteString(tags[k])
+		builder.WriteByte(0)
+	}
+	return builder.String()
+}
+
+func (r *record) data() map[string]string {
+	data := make(map[string]string, len(r.series.tags)+len(r.fields)+1)
+	for k, v := range r.fields {
+		data[k] = formatValue(v)
+	}
+	for k, v := range r.series.tags {
+		data[k] = v
+	}
+	data[storage.TimeKey] = r.time.Format(time.RFC3339Nano)
+	return data
+}
@@ -0,0 +1,227 @@
+package embedded
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+)
+
+func writeTestPoints(t *testing.T, e *Engine) time.Time {
+	now := time.Now().UTC().Truncate(time.Second)
+	points := make([]*storage.Point, 0)
+	for i, pod := range []string{"pod1", "pod2"} {
+		for j := 0; j < 3; j++ {
+			points = append(points, &storage.Point{
+				Measurement: "container",
+				Tags:        map[string]string{"namespace": "ns1", "pod_name": pod},
+				Fields:      map[string]interface{}{"value": float64(i*10 + j), "policy": "stable"},
+				Time:        now.Add(time.Duration(j) * time.Minute),
+			})
+		}
+	}
+	if err := e.WritePoints("db", points); err != nil {
+		t.Fatal(err)
+	}
+	return now
+}
+
+func TestQuery(t *testing.T) {
+	e := New()
+	now := writeTestPoints(t, e)
+
+	query := storage.Query{
+		Database:    "db",
+		Measurement: "container",
+		Condition:   storage.EqualTo("pod_name", "pod2"),
+		Order:       DBCommon.Desc,
+		Limit:       2,
+	}
+	rows, err := e.Query(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || len(rows[0].Data) != 2 {
+		t.Fatalf("expect 1 row with 2 points, got %+v", rows)
+	}
+	if rows[0].Data[0]["value"] != "12" || rows[0].Data[0]["time"] != now.Add(2*time.Minute).Format(time.RFC3339Nano) {
+		t.Errorf("expect latest point first, got %v", rows[0].Data[0])
+	}
+
+	query = storage.Query{
+		Database:    "db",
+		Measurement: "container",
+		GroupByTags: []string{"pod_name"},
+		Limit:       1,
+	}
+	start := now.Add(time.Minute)
+	query.AppendTimeRange(&start, nil)
+	query.AppendCondition(storage.Compare("value", storage.Less, 11))
+	rows, err = e.Query(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("expect 1 group, got %d", len(rows))
+	}
+	if rows[0].Tags["pod_name"] != "pod1" || len(rows[0].Data) != 1 || rows[0].Data[0]["value"] != "1" {
+		t.Errorf("unexpected group %+v", rows[0])
+	}
+
+	query = storage.Query{
+		Database:     "db",
+		Measurement:  "container",
+		GroupByTags:  []string{"pod_name"},
+		SeriesLimit:  1,
+		SeriesOffset: 1,
+	}
+	rows, err = e.Query(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || rows[0].Tags["pod_name"] != "pod2" || len(rows[0].Data) != 3 {
+		t.Errorf("expect second group only, got %+v", rows)
+	}
+	query.SeriesOffset = 2
+	if rows, err = e.Query(query); err != nil || len(rows) != 0 {
+		t.Errorf("expect no group after the last one, got %+v, %v", rows, err)
+	}
+}
+
+func TestDropSeries(t *testing.T) {
+	e := New()
+	writeTestPoints(t, e)
+
+	if err := e.DropSeries("db", "container", storage.EqualTo("pod_name", "pod1")); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := e.Query(storage.Query{Database: "db", Measurement: "container"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || len(rows[0].Data) != 3 {
+		t.Fatalf("expect 3 points left, got %+v", rows)
+	}
+	for _, data := range rows[0].Data {
+		if data["pod_name"] != "pod2" {
+			t.Errorf("expect points of pod1 dropped, got %v", data)
+		}
+	}
+}
+
+func TestPersistence(t *testing.T) {
+	directory, err := ioutil.TempDir("", "alameda-storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	e, err := NewWithConfig(storage.EmbeddedConfig{Directory: directory})
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeTestPoints(t, e)
+	if err := e.DropSeries("db", "container", storage.EqualTo("pod_name", "pod1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	e, err = NewWithConfig(storage.EmbeddedConfig{Directory: directory})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	rows, err := e.Query(storage.Query{Database: "db", Measurement: "container"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || len(rows[0].Data) != 3 {
+		t.Fatalf("expect 3 points reloaded, got %+v", rows)
+	}
+	if rows[0].Data[2]["value"] != "12" || rows[0].Data[2]["policy"] != "stable" {
+		t.Errorf("unexpected reloaded point %v", rows[0].Data[2])
+	}
+}
+
+func TestEnforceRetention(t *testing.T) {
+	directory, err := ioutil.TempDir("", "alameda-storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	config := storage.EmbeddedConfig{Directory: directory, RetentionDuration: "30d", RetentionShardDuration: "1d"}
+	e, err := NewWithConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().UTC().Truncate(24 * time.Hour).Add(12 * time.Hour)
+	points := make([]*storage.Point, 0)
+	for _, age := range []time.Duration{31 * 24 * time.Hour, 30*24*time.Hour + time.Hour, 29 * 24 * time.Hour} {
+		points = append(points, &storage.Point{
+			Measurement: "container",
+			Tags:        map[string]string{"pod_name": "pod1"},
+			Fields:      map[string]interface{}{"value": float64(age / time.Hour)},
+			Time:        now.Add(-age),
+		})
+	}
+	if err := e.WritePoints("db", points); err != nil {
+		t.Fatal(err)
+	}
+
+	// the point 30 days and 1 hour ago is kept until its whole shard group expires
+	if err := e.EnforceRetention(now); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := e.Query(storage.Query{Database: "db", Measurement: "container"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || len(rows[0].Data) != 2 || rows[0].Data[0]["value"] != "721" {
+		t.Fatalf("expect 2 points kept, got %+v", rows)
+	}
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	e, err = NewWithConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	rows, err = e.Query(storage.Query{Database: "db", Measurement: "container"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || len(rows[0].Data) != 2 {
+		t.Fatalf("expect expired point removed from data file, got %+v", rows)
+	}
+}
+
+func TestParseInfluxDuration(t *testing.T) {
+	tests := []struct {
+		literal string
+		want    time.Duration
+		wantErr bool
+	}{
+		{literal: "", want: 0},
+		{literal: "INF", want: 0},
+		{literal: "0", want: 0},
+		{literal: "30d", want: 30 * 24 * time.Hour},
+		{literal: "1w", want: 7 * 24 * time.Hour},
+		{literal: "1h30m", want: 90 * time.Minute},
+		{literal: "30", wantErr: true},
+		{literal: "1y", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseInfluxDuration(tt.literal)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("parseInfluxDuration(%q) = %v, %v, want %v, error %t", tt.literal, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package embedded
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	"github.com/pkg/errors"
+)
+
+const (
+	logFileSuffix = ".log"
+
+	// compactionThreshold Number of entries appended after the last compaction that triggers compaction
+	compactionThreshold = 4096
+)
+
+const (
+	opWrite = "write"
+	opDrop  = "drop"
+)
+
+// logEntry One line of the data file of database
+type logEntry struct {
+	Op          string      `json:"op"`
+	Measurement string      `json:"measurement,omitempty"`
+	Points      []*logPoint `json:"points,omitempty"`
+	Series      []string    `json:"series,omitempty"`
+}
+
+type logPoint struct {
+	Measurement string                 `json:"measurement"`
+	Tags        map[string]string      `json:"tags,omitempty"`
+	Fields      map[string]interface{} `json:"fields"`
+	Time        int64                  `json:"time"`
+}
+
+type logFile struct {
+	path     string
+	file     *os.File
+	appended int
+}
+
+func listLogFiles(directory string) ([]string, error) {
+	if err := os.MkdirAll(directory, 0755); err != nil {
+		return nil, errors.Wrapf(err, "create directory %s failed", directory)
+	}
+	fileInfos, err := ioutil.ReadDir(directory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read directory %s failed", directory)
+	}
+
+	names := make([]string, 0)
+	for _, fileInfo := range fileInfos {
+		if fileInfo.IsDir() || !strings.HasSuffix(fileInfo.Name(), logFileSuffix) {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(fileInfo.Name(), logFileSuffix))
+	}
+	return names, nil
+}
+
+// openLogFile Replay the data file into database and compact it
+func openLogFile(directory, name string, db *database) (*logFile, error) {
+	if err := os.MkdirAll(directory, 0755); err != nil {
+		return nil, errors.Wrapf(err, "create directory %s failed", directory)
+	}
+
+	l := &logFile{path: filepath.Join(directory, name+logFileSuffix)}
+	if err := l.replay(db); err != nil {
+		return nil, err
+	}
+	if err := l.compact(db); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *logFile) replay(db *database) error {
+	file, err := os.Open(l.path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return errors.Wrapf(err, "open file %s failed", l.path)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for lineNumber := 1; ; lineNumber++ {
+		line, readErr := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			entry := logEntry{}
+			decoder := json.NewDecoder(strings.NewReader(string(line)))
+			decoder.UseNumber()
+			if err := decoder.Decode(&entry); err != nil {
+				// A partially written last line is left by crash, drop it
+				if readErr != nil {
+					scope.Warnf("skip incomplete line %d of file %s: %s", lineNumber, l.path, err.Error())
+					return nil
+				}
+				return errors.Wrapf(err, "decode line %d of file %s failed", lineNumber, l.path)
+			}
+			entry.apply(db)
+		}
+		if readErr != nil {
+			return nil
+		}
+	}
+}
+
+func (entry *logEntry) apply(db *database) {
+	switch entry.Op {
+	case opWrite:
+		for _, p := range entry.Points {
+			db.write(&storage.Point{
+				Measurement: p.Measurement,
+				Tags:        p.Tags,
+				Fields:      p.Fields,
+				Time:        time.Unix(0, p.Time),
+			})
+		}
+	case opDrop:
+		db.drop(entry.Measurement, entry.Series)
+	}
+}
+
+func (l *logFile) appendWrite(points []*storage.Point) error {
+	entry := logEntry{Op: opWrite, Points: make([]*logPoint, 0, len(points))}
+	for _, point := range points {
+		entry.Points = append(entry.Points, &logPoint{
+			Measurement: point.Measurement,
+			Tags:        point.Tags,
+			Fields:      point.Fields,
+			Time:        point.Time.UnixNano(),
+		})
+	}
+	return l.append(&entry)
+}
+
+func (l *logFile) appendDrop(measurement string, seriesKeys []string) error {
+	return l.append(&logEntry{Op: opDrop, Measurement: measurement, Series: seriesKeys})
+}
+
+func (l *logFile) append(entry *logEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "encode entry failed")
+	}
+	if _, err := l.file.Write(append(b, '\n')); err != nil {
+		return errors.Wrapf(err, "write file %s failed", l.path)
+	}
+	l.appended++
+	return nil
+}
+
+func (l *logFile) needCompaction() bool {
+	return l.appended >= compactionThreshold
+}
+
+// compact Rewrite the data file with current points of database
+func (l *logFile) compact(db *database) error {
+	tmpPath := l.path + ".tmp"
+	tmpFile, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "create file %s failed", tmpPath)
+	}
+
+	writer := bufio.NewWriter(tmpFile)
+	encoder := json.NewEncoder(writer)
+	for measurementName, m := range db.measurements {
+		entry := logEntry{Op: opWrite, Points: make([]*logPoint, 0)}
+		for _, s := range m.series {
+			for nano, fields := range s.points {
+				entry.Points = append(entry.Points, &logPoint{
+					Measurement: measurementName,
+					Tags:        s.tags,
+					Fields:      fields,
+					Time:        nano,
+				})
+			}
+		}
+		if err := encoder.Encode(&entry); err != nil {
+			tmpFile.Close()
+			return errors.Wrapf(err, "write file %s failed", tmpPath)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		tmpFile.Close()
+		return errors.Wrapf(err, "write file %s failed", tmpPath)
+	}
+	if err := tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		return errors.Wrapf(err, "sync file %s failed", tmpPath)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return errors.Wrapf(err, "close file %s failed", tmpPath)
+	}
+
+	if l.file != nil {
+		l.file.Close()
+		l.file = nil
+	}
+	if err := os.Rename(tmpPath, l.path); err != nil {
+		return errors.Wrapf(err, "rename file %s failed", tmpPath)
+	}
+
+	file, err := os.OpenFile(l.path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "open file %s failed", l.path)
+	}
+	l.file = file
+	l.appended = 0
+
+	return nil
+}
+
+func (l *logFile) close() error {
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
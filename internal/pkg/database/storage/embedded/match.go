@@ -0,0 +1,235 @@
+package embedded
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+)
+
+// match Return true if the record matches the condition, nil condition matches all records
+func (r *record) match(condition storage.Condition) bool {
+	switch c := condition.(type) {
+	case nil:
+		return true
+	case storage.Comparison:
+		return r.compare(c)
+	case storage.And:
+		for _, sub := range c {
+			if !r.match(sub) {
+				return false
+			}
+		}
+		return true
+	case storage.Or:
+		for _, sub := range c {
+			if r.match(sub) {
+				return true
+			}
+		}
+		return len(c) == 0
+	}
+	return false
+}
+
+// matchTags Return true if tags of the series match the condition, comparisons of time and fields are ignored
+func (r *record) matchTags(condition storage.Condition) bool {
+	switch c := condition.(type) {
+	case nil:
+		return true
+	case storage.Comparison:
+		if c.Key == storage.TimeKey {
+			return true
+		}
+		return r.compare(c)
+	case storage.And:
+		for _, sub := range c {
+			if !r.matchTags(sub) {
+				return false
+			}
+		}
+		return true
+	case storage.Or:
+		for _, sub := range c {
+			if r.matchTags(sub) {
+				return true
+			}
+		}
+		return len(c) == 0
+	}
+	return false
+}
+
+func (r *record) compare(c storage.Comparison) bool {
+	if c.Key == storage.TimeKey {
+		if t, ok := c.Value.(time.Time); ok {
+			return compareOrdered(compareTime(r.time, t), c.Operator)
+		}
+	}
+
+	var (
+		actual interface{}
+		exist  bool
+	)
+	if actual, exist = r.series.tags[c.Key]; !exist {
+		actual, exist = r.fields[c.Key]
+	}
+	if !exist {
+		// Like InfluxDB, missing tag equals to empty string
+		if value, ok := c.Value.(string); ok && value == "" {
+			return c.Operator == storage.Equal
+		}
+		return false
+	}
+
+	switch value := c.Value.(type) {
+	case string:
+		return compareOrdered(compareString(formatValue(actual), value), c.Operator)
+	case bool:
+		b, ok := actual.(bool)
+		if !ok {
+			return false
+		}
+		switch c.Operator {
+		case storage.Equal:
+			return b == value
+		case storage.NotEqual:
+			return b != value
+		}
+		return false
+	default:
+		expected, ok := toFloat(value)
+		if !ok {
+			return false
+		}
+		got, ok := toFloat(actual)
+		if !ok {
+			return false
+		}
+		return compareOrdered(compareFloat(got, expected), c.Operator)
+	}
+}
+
+func compareOrdered(result int, operator storage.Operator) bool {
+	switch operator {
+	case storage.Equal:
+		return result == 0
+	case storage.NotEqual:
+		return result != 0
+	case storage.Greater:
+		return result > 0
+	case storage.GreaterEqual:
+		return result >= 0
+	case storage.Less:
+		return result < 0
+	case storage.LessEqual:
+		return result <= 0
+	}
+	return false
+}
+
+func compareTime(a, b time.Time) int {
+	if a.Before(b) {
+		return -1
+	} else if a.After(b) {
+		return 1
+	}
+	return 0
+}
+
+func compareString(a, b string) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+func compareFloat(a, b float64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// normalizeValue Convert field value to one of float64, int64, string and bool like InfluxDB stores it
+func normalizeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case float32:
+		return float64(v)
+	case int:
+		return int64(v)
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case uint:
+		return int64(v)
+	case uint8:
+		return int64(v)
+	case uint16:
+		return int64(v)
+	case uint32:
+		return int64(v)
+	case uint64:
+		return int64(v)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return value
+}
+
+// formatValue Format field value the same as InfluxDB query response
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		}
+		return string(b)
+	}
+	b, _ := json.Marshal(value)
+	return string(b)
+}
@@ -0,0 +1,175 @@
+package embedded
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// retentionCheckInterval Interval of enforcing retention, same as the default check-interval of InfluxDB
+	retentionCheckInterval = 30 * time.Minute
+)
+
+var (
+	durationPartPattern = regexp.MustCompile(`([0-9]+)(ns|u|µ|ms|s|m|h|d|w)`)
+	durationUnits       = map[string]time.Duration{
+		"ns": time.Nanosecond,
+		"u":  time.Microsecond,
+		"µ":  time.Microsecond,
+		"ms": time.Millisecond,
+		"s":  time.Second,
+		"m":  time.Minute,
+		"h":  time.Hour,
+		"d":  24 * time.Hour,
+		"w":  7 * 24 * time.Hour,
+	}
+)
+
+// retentionPolicy Like InfluxDB retention policy, points are dropped per shard
+// group once the whole shard group is older than the duration
+type retentionPolicy struct {
+	duration      time.Duration
+	shardDuration time.Duration
+}
+
+// newRetentionPolicy Build retention policy from durations of InfluxDB duration literal, nil is returned
+// if duration is empty, "0" or "INF" which keep points forever
+func newRetentionPolicy(duration, shardDuration string) (*retentionPolicy, error) {
+	retention, err := parseInfluxDuration(duration)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse retention duration failed")
+	} else if retention == 0 {
+		return nil, nil
+	}
+
+	shard, err := parseInfluxDuration(shardDuration)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse retention shard duration failed")
+	} else if shard == 0 {
+		shard = defaultShardDuration(retention)
+	}
+
+	return &retentionPolicy{duration: retention, shardDuration: shard}, nil
+}
+
+// expiredBefore Return the time points before which are expired at now, shard groups
+// start at multiples of the shard duration like InfluxDB does
+func (p *retentionPolicy) expiredBefore(now time.Time) time.Time {
+	return now.Add(-p.duration).Truncate(p.shardDuration)
+}
+
+// defaultShardDuration Return the shard duration InfluxDB uses for the retention duration
+func defaultShardDuration(retention time.Duration) time.Duration {
+	switch {
+	case retention < 2*24*time.Hour:
+		return time.Hour
+	case retention <= 6*30*24*time.Hour:
+		return 24 * time.Hour
+	}
+	return 7 * 24 * time.Hour
+}
+
+// parseInfluxDuration Parse InfluxDB duration literal like "30d" or "1h30m", zero is returned for
+// empty literal and "INF"
+func parseInfluxDuration(literal string) (time.Duration, error) {
+	literal = strings.TrimSpace(literal)
+	if literal == "" || strings.EqualFold(literal, "INF") {
+		return 0, nil
+	}
+
+	var duration time.Duration
+	matched := 0
+	for _, part := range durationPartPattern.FindAllStringSubmatchIndex(literal, -1) {
+		if part[0] != matched {
+			break
+		}
+		value, err := strconv.ParseInt(literal[part[2]:part[3]], 10, 64)
+		if err != nil {
+			return 0, errors.Errorf("invalid duration %s", literal)
+		}
+		duration += time.Duration(value) * durationUnits[literal[part[4]:part[5]]]
+		matched = part[1]
+	}
+	if matched != len(literal) && literal != "0" {
+		return 0, errors.Errorf("invalid duration %s", literal)
+	}
+	return duration, nil
+}
+
+// EnforceRetention Drop points expired at now of every database and compact data files of
+// databases with points dropped
+func (e *Engine) EnforceRetention(now time.Time) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.closed {
+		return errors.New("embedded storage is closed")
+	}
+	return e.enforceRetention(now)
+}
+
+// enforceRetention Caller must hold the write lock
+func (e *Engine) enforceRetention(now time.Time) error {
+	if e.retention == nil {
+		return nil
+	}
+
+	before := e.retention.expiredBefore(now).UnixNano()
+	for _, db := range e.databases {
+		dropped := db.dropPointsBefore(before)
+		if dropped == 0 {
+			continue
+		}
+		scope.Debugf("drop %d points expired of database %s", dropped, db.name)
+		if db.log == nil {
+			continue
+		}
+		if err := db.log.compact(db); err != nil {
+			return errors.Wrapf(err, "enforce retention of database %s failed", db.name)
+		}
+	}
+	return nil
+}
+
+// runRetention Enforce retention periodically until the engine is closed
+func (e *Engine) runRetention(stop <-chan struct{}) {
+	ticker := time.NewTicker(retentionCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			if err := e.EnforceRetention(now); err != nil {
+				scope.Errorf("enforce retention of embedded storage failed: %s", err.Error())
+			}
+		}
+	}
+}
+
+// dropPointsBefore Drop points before the time in unix nanoseconds, return number of points dropped
+func (db *database) dropPointsBefore(before int64) int {
+	dropped := 0
+	for measurementName, m := range db.measurements {
+		for key, s := range m.series {
+			for nano := range s.points {
+				if nano < before {
+					delete(s.points, nano)
+					dropped++
+				}
+			}
+			if len(s.points) == 0 {
+				delete(m.series, key)
+			}
+		}
+		if len(m.series) == 0 {
+			delete(db.measurements, measurementName)
+		}
+	}
+	return dropped
+}
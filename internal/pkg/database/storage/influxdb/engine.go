@@ -0,0 +1,221 @@
+package influxdb
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
+	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	"github.com/containers-ai/alameda/pkg/utils/log"
+	InfluxClient "github.com/influxdata/influxdb/client/v2"
+	"github.com/pkg/errors"
+)
+
+var (
+	scope = log.RegisterScope("storage_influxdb", "influxdb storage engine", 0)
+)
+
+func init() {
+	storage.RegisterFactory(storage.EngineInfluxDB, func(config storage.Config) (storage.Engine, error) {
+		if config.InfluxDB == nil {
+			return nil, errors.New("influxdb configuration is required")
+		}
+		return NewWithConfig(*config.InfluxDB), nil
+	})
+}
+
+type engine struct {
+	influxDB *InternalInflux.InfluxClient
+}
+
+// NewWithConfig Constructor of storage engine persisting points into InfluxDB
+func NewWithConfig(config InternalInflux.Config) storage.Engine {
+	return &engine{
+		influxDB: InternalInflux.NewClient(&config),
+	}
+}
+
+// CreateDatabase Method implementation of storage.Engine
+func (e *engine) CreateDatabase(database string) error {
+	if err := e.influxDB.CreateDatabase(database); err != nil {
+		return errors.Wrapf(err, "create database %s failed", database)
+	}
+	if e.influxDB.RetentionDuration != "" && e.influxDB.RetentionShardDuration != "" {
+		if err := e.influxDB.ModifyDefaultRetentionPolicy(database); err != nil {
+			return errors.Wrapf(err, "modify retention policy of database %s failed", database)
+		}
+	}
+	return nil
+}
+
+// WritePoints Method implementation of storage.Engine
+func (e *engine) WritePoints(database string, points []*storage.Point) error {
+	influxPoints := make([]*InfluxClient.Point, 0, len(points))
+	for _, point := range points {
+		influxPoint, err := InfluxClient.NewPoint(point.Measurement, point.Tags, point.Fields, point.Time)
+		if err != nil {
+			return errors.Wrap(err, "build influxdb point failed")
+		}
+		influxPoints = append(influxPoints, influxPoint)
+	}
+
+	return e.influxDB.WritePoints(influxPoints, InfluxClient.BatchPointsConfig{
+		Database: database,
+	})
+}
+
+// Query Method implementation of storage.Engine
+func (e *engine) Query(query storage.Query) ([]*storage.Row, error) {
+	cmd := BuildQueryCmd(query)
+	scope.Debugf("query command: %s", cmd)
+
+	results, err := e.influxDB.QueryDB(cmd, query.Database)
+	if err != nil {
+		return nil, errors.Wrap(err, "query influxdb failed")
+	}
+
+	rows := make([]*storage.Row, 0)
+	if len(results) == 0 {
+		return rows, nil
+	}
+	for _, influxRow := range InternalInflux.PackMap(results) {
+		rows = append(rows, &storage.Row{
+			Name: influxRow.Name,
+			Tags: influxRow.Tags,
+			Data: influxRow.Data,
+		})
+	}
+	return rows, nil
+}
+
+// DropSeries Method implementation of storage.Engine
+func (e *engine) DropSeries(database, measurement string, condition storage.Condition) error {
+	cmd := buildDropSeriesCmd(measurement, condition)
+	scope.Debugf("drop series command: %s", cmd)
+
+	if _, err := e.influxDB.QueryDB(cmd, database); err != nil {
+		return errors.Wrap(err, "drop series from influxdb failed")
+	}
+	return nil
+}
+
+func buildDropSeriesCmd(measurement string, condition storage.Condition) string {
+	cmd := fmt.Sprintf("DROP SERIES FROM %s", InternalInflux.QuoteMeasurement(measurement))
+	if whereStr := BuildCondition(condition); whereStr != "" {
+		cmd = fmt.Sprintf("%s WHERE %s", cmd, whereStr)
+	}
+	return cmd
+}
+
+// Ping Method implementation of storage.Engine
+func (e *engine) Ping() error {
+	return e.influxDB.Ping()
+}
+
+// BuildQueryCmd Build InfluxQL statement of the query
+func BuildQueryCmd(query storage.Query) string {
+	cmd := fmt.Sprintf("SELECT * FROM %s", InternalInflux.QuoteMeasurement(query.Measurement))
+
+	if whereStr := BuildCondition(query.Condition); whereStr != "" {
+		cmd = fmt.Sprintf("%s WHERE %s", cmd, whereStr)
+	}
+
+	if len(query.GroupByTags) > 0 {
+		tags := make([]string, 0, len(query.GroupByTags))
+		for _, tag := range query.GroupByTags {
+			tags = append(tags, InternalInflux.QuoteIdentifier(tag))
+		}
+		cmd = fmt.Sprintf("%s GROUP BY %s", cmd, strings.Join(tags, ","))
+	}
+
+	switch query.Order {
+	case DBCommon.Desc:
+		cmd = fmt.Sprintf("%s ORDER BY time DESC", cmd)
+	default:
+		cmd = fmt.Sprintf("%s ORDER BY time ASC", cmd)
+	}
+
+	if query.Limit > 0 {
+		cmd = fmt.Sprintf("%s LIMIT %d", cmd, query.Limit)
+	}
+
+	if query.SeriesLimit > 0 {
+		cmd = fmt.Sprintf("%s SLIMIT %d", cmd, query.SeriesLimit)
+	}
+	if query.SeriesOffset > 0 {
+		cmd = fmt.Sprintf("%s SOFFSET %d", cmd, query.SeriesOffset)
+	}
+
+	return cmd
+}
+
+// BuildCondition Build InfluxQL expression of the condition
+func BuildCondition(condition storage.Condition) string {
+	switch c := condition.(type) {
+	case storage.Comparison:
+		return buildComparison(c)
+	case storage.And:
+		return joinConditions([]storage.Condition(c), "AND")
+	case storage.Or:
+		return joinConditions([]storage.Condition(c), "OR")
+	}
+	return ""
+}
+
+func joinConditions(conditions []storage.Condition, operator string) string {
+	exprs := make([]string, 0, len(conditions))
+	for _, condition := range conditions {
+		if expr := BuildCondition(condition); expr != "" {
+			exprs = append(exprs, expr)
+		}
+	}
+	switch len(exprs) {
+	case 0:
+		return ""
+	case 1:
+		return exprs[0]
+	}
+	return fmt.Sprintf("(%s)", strings.Join(exprs, fmt.Sprintf(" %s ", operator)))
+}
+
+// buildComparison Build InfluxQL expression of the comparison, keys are quoted as identifiers and
+// values except numbers and bools are quoted as string literals
+func buildComparison(c storage.Comparison) string {
+	if c.Key == storage.TimeKey {
+		if t, ok := c.Value.(time.Time); ok {
+			return fmt.Sprintf("time%s%s", c.Operator, InternalInflux.QuoteLiteral(t.UTC().Format(time.RFC3339Nano)))
+		}
+	}
+
+	key := InternalInflux.QuoteIdentifier(c.Key)
+	switch value := c.Value.(type) {
+	case string:
+		return fmt.Sprintf("%s%s%s", key, c.Operator, InternalInflux.QuoteLiteral(value))
+	case time.Time:
+		return fmt.Sprintf("%s%s%s", key, c.Operator, InternalInflux.QuoteLiteral(value.UTC().Format(time.RFC3339Nano)))
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprintf("%s%s%v", key, c.Operator, value)
+	default:
+		return fmt.Sprintf("%s%s%s", key, c.Operator, InternalInflux.QuoteLiteral(fmt.Sprint(value)))
+	}
+}
+
+// NewPoints Convert points built by InfluxDB entities
+func NewPoints(influxPoints []*InfluxClient.Point) ([]*storage.Point, error) {
+	points := make([]*storage.Point, 0, len(influxPoints))
+	for _, influxPoint := range influxPoints {
+		fields, err := influxPoint.Fields()
+		if err != nil {
+			return nil, errors.Wrap(err, "get fields of influxdb point failed")
+		}
+		points = append(points, &storage.Point{
+			Measurement: influxPoint.Name(),
+			Tags:        influxPoint.Tags(),
+			Fields:      fields,
+			Time:        influxPoint.Time(),
+		})
+	}
+	return points, nil
+}
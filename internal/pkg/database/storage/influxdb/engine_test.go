@@ -0,0 +1,70 @@
+package influxdb
+
+import (
+	"testing"
+	"time"
+
+	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+)
+
+func TestBuildCondition(t *testing.T) {
+	testCases := []struct {
+		condition storage.Condition
+		expected  string
+	}{
+		{condition: storage.EqualTo("name", "node1"), expected: `"name"='node1'`},
+		{condition: storage.EqualTo("name", `a\' OR 1=1 --`), expected: `"name"='a\\\' OR 1=1 --'`},
+		{condition: storage.EqualTo("name", `a" OR "b`), expected: `"name"='a" OR "b'`},
+		{condition: storage.EqualTo(`na"me\`, "node1"), expected: `"na\"me\\"='node1'`},
+		{condition: storage.Compare("value", storage.Greater, 1.5), expected: `"value">1.5`},
+		{condition: storage.Compare("up", storage.Equal, true), expected: `"up"=true`},
+		{condition: storage.Compare("value", storage.Equal, []string{"1' OR '1"}), expected: `"value"='[1\' OR \'1]'`},
+		{
+			condition: storage.Compare(storage.TimeKey, storage.GreaterEqual, time.Unix(0, 0)),
+			expected:  `time>='1970-01-01T00:00:00Z'`,
+		},
+		{
+			condition: storage.AllOf(storage.EqualTo("namespace", "default"), storage.EqualToAny("name", []string{"a'", `b\`})),
+			expected:  `("namespace"='default' AND ("name"='a\'' OR "name"='b\\'))`,
+		},
+	}
+	for _, testCase := range testCases {
+		if got := BuildCondition(testCase.condition); got != testCase.expected {
+			t.Errorf("expect condition %s, got %s", testCase.expected, got)
+		}
+	}
+}
+
+func TestBuildQueryCmd(t *testing.T) {
+	testCases := []struct {
+		query    storage.Query
+		expected string
+	}{
+		{
+			query:    storage.Query{Measurement: "node", Order: DBCommon.Desc, Limit: 1},
+			expected: `SELECT * FROM "node" ORDER BY time DESC LIMIT 1`,
+		},
+		{
+			query: storage.Query{
+				Measurement: `no"de\`,
+				Condition:   storage.EqualTo("name", `\'`),
+				GroupByTags: []string{"namespace", `na"me`},
+			},
+			expected: `SELECT * FROM "no\"de\\" WHERE "name"='\\\'' GROUP BY "namespace","na\"me" ORDER BY time ASC`,
+		},
+	}
+	for _, testCase := range testCases {
+		if got := BuildQueryCmd(testCase.query); got != testCase.expected {
+			t.Errorf("expect command %s, got %s", testCase.expected, got)
+		}
+	}
+}
+
+func TestBuildDropSeriesCmd(t *testing.T) {
+	cmd := buildDropSeriesCmd(`no"de`, storage.EqualTo(storage.ClusterIDTag, `a\' OR 1=1 --`))
+	expected := `DROP SERIES FROM "no\"de" WHERE "cluster_id"='a\\\' OR 1=1 --'`
+	if cmd != expected {
+		t.Errorf("expect command %s, got %s", expected, cmd)
+	}
+}
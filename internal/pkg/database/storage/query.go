@@ -0,0 +1,147 @@
+package storage
+
+import (
+	"time"
+
+	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
+)
+
+const (
+	// TimeKey Key of condition comparing timestamp of points
+	TimeKey = "time"
+)
+
+// Operator Comparison operator of condition
+type Operator string
+
+// Comparison operators definition
+const (
+	Equal        Operator = "="
+	NotEqual     Operator = "!="
+	Greater      Operator = ">"
+	GreaterEqual Operator = ">="
+	Less         Operator = "<"
+	LessEqual    Operator = "<="
+)
+
+// Condition Filter of points, one of Comparison, And and Or
+type Condition interface {
+	isCondition()
+}
+
+// Comparison Compare the tag, field or time of point with value. Value is
+// compared as string if it is string, as time if key is TimeKey, otherwise as number or bool.
+type Comparison struct {
+	Key      string
+	Operator Operator
+	Value    interface{}
+}
+
+// And Condition matching points matched by all conditions
+type And []Condition
+
+// Or Condition matching points matched by any condition
+type Or []Condition
+
+func (Comparison) isCondition() {}
+func (And) isCondition()        {}
+func (Or) isCondition()         {}
+
+// Compare Build comparison condition
+func Compare(key string, operator Operator, value interface{}) Condition {
+	return Comparison{Key: key, Operator: operator, Value: value}
+}
+
+// EqualTo Build equality condition, return nil if value is empty
+func EqualTo(key, value string) Condition {
+	if value == "" {
+		return nil
+	}
+	return Comparison{Key: key, Operator: Equal, Value: value}
+}
+
+// EqualToAny Build condition matching any of values, return nil if values is empty
+func EqualToAny(key string, values []string) Condition {
+	if len(values) == 0 {
+		return nil
+	}
+	or := make(Or, 0, len(values))
+	for _, value := range values {
+		or = append(or, Comparison{Key: key, Operator: Equal, Value: value})
+	}
+	return or
+}
+
+// AllOf Build condition matching all non-nil conditions, return nil if there is none
+func AllOf(conditions ...Condition) Condition {
+	and := make(And, 0, len(conditions))
+	for _, condition := range conditions {
+		if condition != nil {
+			and = append(and, condition)
+		}
+	}
+	switch len(and) {
+	case 0:
+		return nil
+	case 1:
+		return and[0]
+	}
+	return and
+}
+
+// AnyOf Build condition matching any non-nil condition, return nil if there is none
+func AnyOf(conditions ...Condition) Condition {
+	or := make(Or, 0, len(conditions))
+	for _, condition := range conditions {
+		if condition != nil {
+			or = append(or, condition)
+		}
+	}
+	switch len(or) {
+	case 0:
+		return nil
+	case 1:
+		return or[0]
+	}
+	return or
+}
+
+// Query Query of points in a measurement. Like InfluxDB, Limit is applied to
+// each group of points with the same values of GroupByTags, SeriesLimit and
+// SeriesOffset page through the groups ordered by their tag values.
+type Query struct {
+	Database     string
+	Measurement  string
+	Condition    Condition
+	GroupByTags  []string
+	Order        DBCommon.Order
+	Limit        int
+	SeriesLimit  int
+	SeriesOffset int
+}
+
+// AppendCondition Add condition which points must also match, nil condition is ignored
+func (q *Query) AppendCondition(condition Condition) {
+	q.Condition = AllOf(q.Condition, condition)
+}
+
+// AppendTimeRange Add condition of time range, nil boundary or boundary at
+// unix epoch (the value of unset protobuf timestamp) is ignored
+func (q *Query) AppendTimeRange(startTime, endTime *time.Time) {
+	if startTime != nil && startTime.Unix() != 0 {
+		q.AppendCondition(Compare(TimeKey, GreaterEqual, *startTime))
+	}
+	if endTime != nil && endTime.Unix() != 0 {
+		q.AppendCondition(Compare(TimeKey, LessEqual, *endTime))
+	}
+}
+
+// ApplyQueryCondition Apply time range, order and limit of the query condition
+func (q *Query) ApplyQueryCondition(queryCondition *DBCommon.QueryCondition) {
+	if queryCondition == nil {
+		return
+	}
+	q.AppendTimeRange(queryCondition.StartTime, queryCondition.EndTime)
+	q.Order = queryCondition.TimestampOrder
+	q.Limit = queryCondition.Limit
+}
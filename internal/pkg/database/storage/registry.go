@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Factory Constructor of storage engine
+type Factory func(config Config) (Engine, error)
+
+var (
+	factoriesLock = sync.RWMutex{}
+	factories     = map[EngineName]Factory{}
+
+	defaultEngineLock = sync.RWMutex{}
+	defaultEngine     Engine
+)
+
+// RegisterFactory Register the constructor of storage engine,
+// implementations call it from their init function.
+func RegisterFactory(name EngineName, factory Factory) {
+	factoriesLock.Lock()
+	defer factoriesLock.Unlock()
+
+	factories[name] = factory
+}
+
+func isRegistered(name EngineName) bool {
+	factoriesLock.RLock()
+	defer factoriesLock.RUnlock()
+
+	_, exist := factories[name]
+	return exist
+}
+
+// Open Build storage engine selected in configuration
+func Open(config Config) (Engine, error) {
+	factoriesLock.RLock()
+	factory, exist := factories[config.Engine]
+	factoriesLock.RUnlock()
+
+	if !exist {
+		return nil, errors.Errorf("storage engine \"%s\" is not registered", config.Engine)
+	}
+
+	return factory(config)
+}
+
+// SetDefault Set the engine used by repositories of the process
+func SetDefault(engine Engine) {
+	defaultEngineLock.Lock()
+	defer defaultEngineLock.Unlock()
+
+	defaultEngine = engine
+}
+
+// Default Return the engine used by repositories of the process, nil if it is not set
+func Default() Engine {
+	defaultEngineLock.RLock()
+	defer defaultEngineLock.RUnlock()
+
+	return defaultEngine
+}
@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"time"
+)
+
+// Engine Storage engine persisting time series points for datahub repositories
+type Engine interface {
+	// CreateDatabase creates the database if it does not exist
+	CreateDatabase(database string) error
+	// WritePoints writes points into the database, fields of points with the same measurement, tags and time are merged
+	WritePoints(database string, points []*Point) error
+	// Query lists points matching the query
+	Query(query Query) ([]*Row, error)
+	// DropSeries deletes points of series whose tags match the condition
+	DropSeries(database, measurement string, condition Condition) error
+	// Ping checks if the engine is available
+	Ping() error
+}
+
+// Point Time series point
+type Point struct {
+	Measurement string
+	Tags        map[string]string
+	Fields      map[string]interface{}
+	Time        time.Time
+}
+
+// Row Points of a series group returned by query. Data holds one map per
+// point containing the time, tags and fields of the point formatted as string.
+type Row struct {
+	Name string
+	Tags map[string]string
+	Data []map[string]string
+}
@@ -2,6 +2,8 @@ package eventmgt
 
 import (
 	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
 	InternalRabbitMQ "github.com/containers-ai/alameda/internal/pkg/message-queue/rabbitmq"
 
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
@@ -14,7 +16,7 @@ var (
 
 type EventMgt struct {
 	RabbitMQConfig *InternalRabbitMQ.Config
-	influxDB       *InternalInflux.InfluxClient
+	storage        storage.Engine
 }
 
 func InitEventMgt(influxDBCfg *InternalInflux.Config, rabbitMQConfig *InternalRabbitMQ.Config) {
@@ -23,12 +25,12 @@ func InitEventMgt(influxDBCfg *InternalInflux.Config, rabbitMQConfig *InternalRa
 }
 
 func NewEventMgt(influxDBCfg *InternalInflux.Config, rabbitMQConfig *InternalRabbitMQ.Config) *EventMgt {
+	engine := storage.Default()
+	if engine == nil {
+		engine = StorageInflux.NewWithConfig(*influxDBCfg)
+	}
 	return &EventMgt{
-		influxDB: &InternalInflux.InfluxClient{
-			Address:  influxDBCfg.Address,
-			Username: influxDBCfg.Username,
-			Password: influxDBCfg.Password,
-		},
+		storage:        engine,
 		RabbitMQConfig: rabbitMQConfig,
 	}
 }
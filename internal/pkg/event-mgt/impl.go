@@ -6,8 +6,10 @@ import (
 	//RepoInflux "github.com/containers-ai/alameda/datahub/pkg/repository/influxdb"
 	"encoding/json"
 	DBCommon "github.com/containers-ai/alameda/internal/pkg/database/common"
-	InternalInflux "github.com/containers-ai/alameda/internal/pkg/database/influxdb"
+	"github.com/containers-ai/alameda/internal/pkg/database/storage"
+	StorageInflux "github.com/containers-ai/alameda/internal/pkg/database/storage/influxdb"
 	"github.com/containers-ai/alameda/internal/pkg/message-queue/rabbitmq"
+	DatahubEvent "github.com/containers-ai/alameda/pkg/framework/datahub/event"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/golang/protobuf/ptypes"
@@ -27,7 +29,7 @@ func (e *EventMgt) PostEvents(in *datahub_v1alpha1.CreateEventsRequest) error {
 			EntityInfluxEvent.EventClusterId:         event.GetClusterId(),
 			EntityInfluxEvent.EventSourceHost:        event.GetSource().GetHost(),
 			EntityInfluxEvent.EventSourceComponent:   event.GetSource().GetComponent(),
-			EntityInfluxEvent.EventType:              event.GetType().String(),
+			EntityInfluxEvent.EventType:              DatahubEvent.Name(event.GetType()),
 			EntityInfluxEvent.EventVersion:           event.GetVersion().String(),
 			EntityInfluxEvent.EventLevel:             event.GetLevel().String(),
 			EntityInfluxEvent.EventSubjectKind:       event.GetSubject().GetKind(),
@@ -51,10 +53,13 @@ func (e *EventMgt) PostEvents(in *datahub_v1alpha1.CreateEventsRequest) error {
 		points = append(points, pt)
 	}
 
-	err := e.influxDB.WritePoints(points, InfluxClient.BatchPointsConfig{
-		Database: string(EntityInflux.Event),
-	})
+	storagePoints, err := StorageInflux.NewPoints(points)
+	if err != nil {
+		scope.Error(err.Error())
+		return err
+	}
 
+	err = e.storage.WritePoints(string(EntityInflux.Event), storagePoints)
 	if err != nil {
 		scope.Error(err.Error())
 		return err
@@ -76,7 +81,7 @@ func (e *EventMgt) ListEvents(in *datahub_v1alpha1.ListEventsRequest) ([]*datahu
 
 	eventTypeList := make([]string, 0)
 	for _, eventType := range in.GetType() {
-		eventTypeList = append(eventTypeList, eventType.String())
+		eventTypeList = append(eventTypeList, DatahubEvent.Name(eventType))
 	}
 
 	eventVersionList := make([]string, 0)
@@ -89,39 +94,33 @@ func (e *EventMgt) ListEvents(in *datahub_v1alpha1.ListEventsRequest) ([]*datahu
 		eventLevelList = append(eventLevelList, eventLevel.String())
 	}
 
-	influxdbStatement := InternalInflux.Statement{
-		Measurement:    EntityInfluxEvent.EventMeasurement,
-		QueryCondition: DBCommon.BuildQueryConditionV1(in.GetQueryCondition()),
+	query := storage.Query{
+		Database:    string(EntityInflux.Event),
+		Measurement: string(EntityInfluxEvent.EventMeasurement),
 	}
 
-	influxdbStatement.AppendWhereClauseByList(EntityInfluxEvent.EventId, "=", "OR", idList)
-	influxdbStatement.AppendWhereClauseByList(EntityInfluxEvent.EventClusterId, "=", "OR", clusterIdList)
-	influxdbStatement.AppendWhereClauseByList(EntityInfluxEvent.EventType, "=", "OR", eventTypeList)
-	influxdbStatement.AppendWhereClauseByList(EntityInfluxEvent.EventVersion, "=", "OR", eventVersionList)
-	influxdbStatement.AppendWhereClauseByList(EntityInfluxEvent.EventLevel, "=", "OR", eventLevelList)
-
-	influxdbStatement.AppendWhereClauseFromTimeCondition()
-	influxdbStatement.SetOrderClauseFromQueryCondition()
-	influxdbStatement.SetLimitClauseFromQueryCondition()
-
-	cmd := influxdbStatement.BuildQueryCmd()
+	query.AppendCondition(storage.EqualToAny(EntityInfluxEvent.EventId, idList))
+	query.AppendCondition(storage.EqualToAny(EntityInfluxEvent.EventClusterId, clusterIdList))
+	query.AppendCondition(storage.EqualToAny(EntityInfluxEvent.EventType, eventTypeList))
+	query.AppendCondition(storage.EqualToAny(EntityInfluxEvent.EventVersion, eventVersionList))
+	query.AppendCondition(storage.EqualToAny(EntityInfluxEvent.EventLevel, eventLevelList))
+	query.ApplyQueryCondition(DBCommon.BuildQueryConditionV1(in.GetQueryCondition()))
 
-	results, err := e.influxDB.QueryDB(cmd, string(EntityInflux.Event))
+	rows, err := e.storage.Query(query)
 	if err != nil {
 		return make([]*datahub_v1alpha1.Event, 0), err
 	}
 
-	influxdbRows := InternalInflux.PackMap(results)
-	events := e.getEventsFromInfluxRows(influxdbRows)
+	events := e.getEventsFromRows(rows)
 
 	return events, nil
 }
 
-func (e *EventMgt) getEventsFromInfluxRows(rows []*InternalInflux.InfluxRow) []*datahub_v1alpha1.Event {
+func (e *EventMgt) getEventsFromRows(rows []*storage.Row) []*datahub_v1alpha1.Event {
 	events := make([]*datahub_v1alpha1.Event, 0)
 
-	for _, influxdbRow := range rows {
-		for _, data := range influxdbRow.Data {
+	for _, row := range rows {
+		for _, data := range row.Data {
 			t, _ := time.Parse(time.RFC3339Nano, data[EntityInfluxEvent.EventTime])
 			tempTime, _ := ptypes.TimestampProto(t)
 
@@ -139,8 +138,8 @@ func (e *EventMgt) getEventsFromInfluxRows(rows []*InternalInflux.InfluxRow) []*
 
 			eventType := datahub_v1alpha1.EventType_EVENT_TYPE_UNDEFINED
 			if tempType, exist := data[EntityInfluxEvent.EventType]; exist {
-				if value, ok := datahub_v1alpha1.EventType_value[tempType]; ok {
-					eventType = datahub_v1alpha1.EventType(value)
+				if value, ok := DatahubEvent.Value(tempType); ok {
+					eventType = value
 				}
 			}
 
@@ -0,0 +1,35 @@
+package rabbitmq
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/streadway/amqp"
+)
+
+// PublishWithConfirm Publish the message in confirm mode and wait for broker to confirm it,
+// an error is returned if broker nacks the message or does not confirm it within timeout.
+// Channel is put into confirm mode by the function, it should be used for confirmed publishing only.
+func PublishWithConfirm(ch *amqp.Channel, exchange, key string, msg amqp.Publishing, timeout time.Duration) error {
+	if err := ch.Confirm(false); err != nil {
+		return errors.Wrap(err, "put channel into confirm mode failed")
+	}
+	confirms := ch.NotifyPublish(make(chan amqp.Confirmation, 1))
+
+	if err := ch.Publish(exchange, key, false, false, msg); err != nil {
+		return errors.Wrap(err, "publish message failed")
+	}
+
+	select {
+	case confirm, ok := <-confirms:
+		if !ok {
+			return errors.New("channel closed before message is confirmed")
+		}
+		if !confirm.Ack {
+			return errors.Errorf("message with delivery tag %d is nacked by broker", confirm.DeliveryTag)
+		}
+		return nil
+	case <-time.After(timeout):
+		return errors.Errorf("message is not confirmed by broker in %v", timeout)
+	}
+}
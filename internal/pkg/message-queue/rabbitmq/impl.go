@@ -1,53 +1,54 @@
 package rabbitmq
 
 import (
-	"github.com/streadway/amqp"
 	"time"
+
+	"github.com/pkg/errors"
+	"github.com/streadway/amqp"
 )
 
 func (s *RabbitMQSender) SendJsonString(queueName, jsonStr string) error {
 	publishRetryTime := s.rabbitMQConfig.Retry.PublishTime
 	publishRetryIntervalMS := s.rabbitMQConfig.Retry.PublishIntervalMS
+	var err error
 	for retry := 0; retry < publishRetryTime; retry++ {
-		queueCH, err := s.conn.Channel()
-
-		if err != nil {
-			continue
-		}
-		defer queueCH.Close()
-		q, err := queueCH.QueueDeclare(
-			queueName, // name
-			true,      // durable
-			false,     // delete when unused
-			false,     // exclusive
-			false,     // no-wait
-			nil,       // arguments
-		)
-		if err != nil {
-			continue
+		if retry > 0 {
+			time.Sleep(time.Duration(publishRetryIntervalMS) * time.Millisecond)
 		}
-
-		err = queueCH.Publish(
-			"",     // exchange
-			q.Name, // routing key
-			false,  // mandatory
-			false,  // immediate
-			amqp.Publishing{
-				ContentType:  "text/plain",
-				Body:         []byte(jsonStr),
-				DeliveryMode: 2, // 2 means persistent
-			})
-
-		if err == nil {
-			break
+		if err = s.publish(queueName, jsonStr); err == nil {
+			return nil
 		}
+	}
+	return errors.Wrapf(err, "send message to queue %s failed after %d retries", queueName, publishRetryTime)
+}
 
-		if retry == (publishRetryTime - 1) {
-			return err
-		}
-		time.Sleep(time.Duration(publishRetryIntervalMS) * time.Millisecond)
+func (s *RabbitMQSender) publish(queueName, jsonStr string) error {
+	queueCH, err := s.conn.Channel()
+	if err != nil {
+		return errors.Wrap(err, "open channel failed")
 	}
-	return nil
+	defer queueCH.Close()
+
+	q, err := queueCH.QueueDeclare(
+		queueName, // name
+		true,      // durable
+		false,     // delete when unused
+		false,     // exclusive
+		false,     // no-wait
+		nil,       // arguments
+	)
+	if err != nil {
+		return errors.Wrap(err, "declare queue failed")
+	}
+
+	return PublishWithConfirm(queueCH,
+		"",     // exchange
+		q.Name, // routing key
+		amqp.Publishing{
+			ContentType:  "text/plain",
+			Body:         []byte(jsonStr),
+			DeliveryMode: 2, // 2 means persistent
+		}, time.Duration(s.rabbitMQConfig.Retry.ConfirmTimeoutMS)*time.Millisecond)
 }
 
 func (s *RabbitMQSender) Close() error {
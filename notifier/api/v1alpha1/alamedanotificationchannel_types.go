@@ -24,21 +24,98 @@ import (
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
+// AlamedaSecretKeyRef refers to a key of a Secret in the namespace configured by channelSecret.namespace,
+// Secrets of other namespaces are rejected
+type AlamedaSecretKeyRef struct {
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,"`
+	Key       string `json:"key,"`
+}
+
+// AlamedaConfigMapKeyRef refers to a key of a ConfigMap in the namespace configured by channelSecret.namespace,
+// ConfigMaps of other namespaces are rejected
+type AlamedaConfigMapKeyRef struct {
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,"`
+	Key       string `json:"key,"`
+}
+
+// AlamedaNotificationTemplate renders subject and body of notifications with Go templates
+type AlamedaNotificationTemplate struct {
+	// Subject is the text/template of subject of emails and title of chat messages
+	Subject string `json:"subject,omitempty"`
+	// SubjectConfigMapRef refers to the subject template in a ConfigMap, it takes place of subject
+	SubjectConfigMapRef *AlamedaConfigMapKeyRef `json:"subjectConfigMapRef,omitempty"`
+	// Body is the template of body, it is a html/template for emails and a text/template for other channels
+	Body string `json:"body,omitempty"`
+	// BodyConfigMapRef refers to the body template in a ConfigMap, it takes place of body
+	BodyConfigMapRef *AlamedaConfigMapKeyRef `json:"bodyConfigMapRef,omitempty"`
+}
+
 type AlamedaEmail struct {
-	Server     string `json:"server,"`
-	Port       uint16 `json:"port,"`
-	From       string `json:"from,"`
-	Username   string `json:"username,"`
-	Password   string `json:"password,"`
-	Encryption string `json:"encryption,omitempty"`
+	Server   string `json:"server,"`
+	Port     uint16 `json:"port,"`
+	From     string `json:"from,"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	// UsernameSecretRef and PasswordSecretRef refer to credentials in Secrets, they take place of username and password
+	UsernameSecretRef *AlamedaSecretKeyRef `json:"usernameSecretRef,omitempty"`
+	PasswordSecretRef *AlamedaSecretKeyRef `json:"passwordSecretRef,omitempty"`
+	Encryption        string               `json:"encryption,omitempty"`
+}
+
+const (
+	ChannelTypeEmail   = "email"
+	ChannelTypeWebhook = "webhook"
+	ChannelTypeSlack   = "slack"
+	ChannelTypeMSTeams = "msteams"
+)
+
+// ChannelTypes list supported types of notification channels
+var ChannelTypes = []string{ChannelTypeEmail, ChannelTypeWebhook, ChannelTypeSlack, ChannelTypeMSTeams}
+
+// AlamedaWebhook posts events in JSON to the url
+type AlamedaWebhook struct {
+	URL     string            `json:"url,"`
+	Headers map[string]string `json:"headers,omitempty"`
+	// Secret signs body in header X-Alameda-Signature with HMAC-SHA256 if it is not empty
+	Secret string `json:"secret,omitempty"`
+	// SecretRef refers to the secret in a Secret, it takes place of secret
+	SecretRef *AlamedaSecretKeyRef `json:"secretRef,omitempty"`
+	// HeaderSecretRefs are headers with values in Secrets such as Authorization
+	HeaderSecretRefs map[string]AlamedaSecretKeyRef `json:"headerSecretRefs,omitempty"`
+	// BodyTemplate is the text/template rendering JSON body from the event, a default body is posted if it is empty
+	BodyTemplate       string `json:"bodyTemplate,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+// AlamedaSlack posts events to the incoming webhook of Slack
+type AlamedaSlack struct {
+	WebhookURL string `json:"webhookUrl,omitempty"`
+	// WebhookURLSecretRef refers to the url containing its token in a Secret, it takes place of webhookUrl
+	WebhookURLSecretRef *AlamedaSecretKeyRef `json:"webhookUrlSecretRef,omitempty"`
+	Channel             string               `json:"channel,omitempty"`
+	Username            string               `json:"username,omitempty"`
+}
+
+// AlamedaMSTeams posts events to the incoming webhook of Microsoft Teams
+type AlamedaMSTeams struct {
+	WebhookURL string `json:"webhookUrl,omitempty"`
+	// WebhookURLSecretRef refers to the url containing its token in a Secret, it takes place of webhookUrl
+	WebhookURLSecretRef *AlamedaSecretKeyRef `json:"webhookUrlSecretRef,omitempty"`
 }
 
 // AlamedaNotificationChannelSpec defines the desired state of AlamedaNotificationChannel
 type AlamedaNotificationChannelSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
-	Type  string       `json:"type,"`
-	Email AlamedaEmail `json:"email,omitempty"`
+	Type    string          `json:"type,"`
+	Email   AlamedaEmail    `json:"email,omitempty"`
+	Webhook *AlamedaWebhook `json:"webhook,omitempty"`
+	Slack   *AlamedaSlack   `json:"slack,omitempty"`
+	MSTeams *AlamedaMSTeams `json:"msteams,omitempty"`
+	// Template renders notifications sent by the channel, the template of topics takes place of it
+	Template *AlamedaNotificationTemplate `json:"template,omitempty"`
 }
 
 // AlamedaNotificationChannelStatus defines the observed state of AlamedaNotificationChannel
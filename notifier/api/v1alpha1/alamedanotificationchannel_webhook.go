@@ -20,8 +20,11 @@ import (
 	"context"
 	b64 "encoding/base64"
 	"fmt"
+	"net/url"
 	"strings"
 
+	"github.com/containers-ai/alameda/notifier/event"
+	notifier_utils "github.com/containers-ai/alameda/notifier/utils"
 	"github.com/containers-ai/alameda/pkg/utils"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -71,6 +74,10 @@ func (r *AlamedaNotificationChannel) Default() {
 	if oldChannel.Spec.Email.Password != r.Spec.Email.Password {
 		r.Spec.Email.Password = b64.StdEncoding.EncodeToString([]byte(r.Spec.Email.Password))
 	}
+	if r.Spec.Webhook != nil && r.Spec.Webhook.Secret != "" &&
+		(oldChannel.Spec.Webhook == nil || oldChannel.Spec.Webhook.Secret != r.Spec.Webhook.Secret) {
+		r.Spec.Webhook.Secret = b64.StdEncoding.EncodeToString([]byte(r.Spec.Webhook.Secret))
+	}
 
 	annotations := r.GetAnnotations()
 	testVal, ok := annotations["notifying.containers.ai/test-channel"]
@@ -86,7 +93,7 @@ var _ webhook.Validator = &AlamedaNotificationChannel{}
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (r *AlamedaNotificationChannel) ValidateCreate() error {
-	return r.validateAlamedaNotificationChannel()
+	return r.validateAlamedaNotificationChannel(nil)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -96,10 +103,12 @@ func (r *AlamedaNotificationChannel) ValidateDelete() error {
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *AlamedaNotificationChannel) ValidateUpdate(old runtime.Object) error {
-	return r.validateAlamedaNotificationChannel()
+	oldChannel, _ := old.(*AlamedaNotificationChannel)
+	return r.validateAlamedaNotificationChannel(oldChannel)
 }
 
-func (r *AlamedaNotificationChannel) validateAlamedaNotificationChannel() error {
+// validateAlamedaNotificationChannel Validate the channel, oldChannel is nil when the channel is created
+func (r *AlamedaNotificationChannel) validateAlamedaNotificationChannel(oldChannel *AlamedaNotificationChannel) error {
 	var allErrs field.ErrorList
 	channelType := r.Spec.Type
 
@@ -122,9 +131,46 @@ func (r *AlamedaNotificationChannel) validateAlamedaNotificationChannel() error
 		}
 	}
 
-	if channelType != "" && channelType != "email" {
+	if channelType != "" && !isChannelTypeSupported(channelType) {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("type"),
-			channelType, fmt.Sprintf("channel type %s is not supported, please email instead", channelType)))
+			channelType, fmt.Sprintf("channel type %s is not supported, please use %s instead",
+				channelType, strings.Join(ChannelTypes, ", "))))
+	}
+	switch channelType {
+	case ChannelTypeWebhook:
+		webhookPath := field.NewPath("spec").Child("webhook")
+		if r.Spec.Webhook == nil {
+			allErrs = append(allErrs, field.Required(webhookPath, "webhook is required by webhook channel"))
+			break
+		}
+		allErrs = append(allErrs, validateChannelURL(webhookPath.Child("url"), r.Spec.Webhook.URL)...)
+		allErrs = append(allErrs, validateSecretKeyRef(webhookPath.Child("secretRef"), r.Spec.Webhook.SecretRef)...)
+		for header, ref := range r.Spec.Webhook.HeaderSecretRefs {
+			ref := ref
+			allErrs = append(allErrs, validateSecretKeyRef(webhookPath.Child("headerSecretRefs").Key(header), &ref)...)
+		}
+		if r.Spec.Webhook.BodyTemplate != "" {
+			if _, err := notifier_utils.ParseTemplate(r.Name, r.Spec.Webhook.BodyTemplate); err != nil {
+				allErrs = append(allErrs, field.Invalid(webhookPath.Child("bodyTemplate"),
+					r.Spec.Webhook.BodyTemplate, err.Error()))
+			}
+		}
+	case ChannelTypeSlack:
+		slackPath := field.NewPath("spec").Child("slack")
+		if r.Spec.Slack == nil {
+			allErrs = append(allErrs, field.Required(slackPath, "slack is required by slack channel"))
+			break
+		}
+		allErrs = append(allErrs, validateIncomingWebhookURL(slackPath,
+			r.Spec.Slack.WebhookURL, r.Spec.Slack.WebhookURLSecretRef)...)
+	case ChannelTypeMSTeams:
+		msTeamsPath := field.NewPath("spec").Child("msteams")
+		if r.Spec.MSTeams == nil {
+			allErrs = append(allErrs, field.Required(msTeamsPath, "msteams is required by msteams channel"))
+			break
+		}
+		allErrs = append(allErrs, validateIncomingWebhookURL(msTeamsPath,
+			r.Spec.MSTeams.WebhookURL, r.Spec.MSTeams.WebhookURLSecretRef)...)
 	}
 	if channelType == "email" {
 		from := r.Spec.Email.From
@@ -138,6 +184,14 @@ func (r *AlamedaNotificationChannel) validateAlamedaNotificationChannel() error
 				encryption, fmt.Sprintf("encryption %s is not supported, please use ssl, tls and starttls instead",
 					r.Spec.Email.Encryption)))
 		}
+		emailPath := field.NewPath("spec").Child("email")
+		allErrs = append(allErrs, validateSecretKeyRef(emailPath.Child("usernameSecretRef"), r.Spec.Email.UsernameSecretRef)...)
+		allErrs = append(allErrs, validateSecretKeyRef(emailPath.Child("passwordSecretRef"), r.Spec.Email.PasswordSecretRef)...)
+	}
+	allErrs = append(allErrs, validateNotificationTemplate(field.NewPath("spec").Child("template"), r.Name,
+		r.Spec.Template, channelType == "" || channelType == ChannelTypeEmail)...)
+	if notifier_utils.InlineChannelCredentialsRejected() {
+		allErrs = append(allErrs, r.validateNoNewInlineCredentials(oldChannel)...)
 	}
 	if len(allErrs) == 0 {
 		return nil
@@ -146,3 +200,154 @@ func (r *AlamedaNotificationChannel) validateAlamedaNotificationChannel() error
 		schema.GroupKind{Group: "notifying.containers.ai", Kind: "AlamedaNotificationChannel"},
 		r.Name, allErrs)
 }
+
+func isChannelTypeSupported(channelType string) bool {
+	for _, supportedType := range ChannelTypes {
+		if channelType == supportedType {
+			return true
+		}
+	}
+	return false
+}
+
+// validateChannelURL Validate the url channel posts events to is an absolute http or https url,
+// the url is not shown in errors since urls of incoming webhooks contain their tokens
+func validateChannelURL(path *field.Path, rawURL string) field.ErrorList {
+	if rawURL == "" {
+		return field.ErrorList{field.Required(path, "url is required")}
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return field.ErrorList{field.Invalid(path, "<redacted>", "url must be an absolute http or https url")}
+	}
+	return nil
+}
+
+// validateIncomingWebhookURL Validate url of incoming webhook set either inline or in a Secret
+func validateIncomingWebhookURL(path *field.Path, rawURL string, ref *AlamedaSecretKeyRef) field.ErrorList {
+	if ref != nil {
+		return validateSecretKeyRef(path.Child("webhookUrlSecretRef"), ref)
+	}
+	return validateChannelURL(path.Child("webhookUrl"), rawURL)
+}
+
+func validateSecretKeyRef(path *field.Path, ref *AlamedaSecretKeyRef) field.ErrorList {
+	if ref == nil {
+		return nil
+	}
+	allErrs := field.ErrorList{}
+	if ref.Name == "" {
+		allErrs = append(allErrs, field.Required(path.Child("name"), "name of secret is required"))
+	}
+	if ref.Key == "" {
+		allErrs = append(allErrs, field.Required(path.Child("key"), "key of secret is required"))
+	}
+	if _, err := notifier_utils.ChannelRefNamespace(ref.Namespace); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("namespace"), ref.Namespace, err.Error()))
+	}
+	return allErrs
+}
+
+func validateConfigMapKeyRef(path *field.Path, ref *AlamedaConfigMapKeyRef) field.ErrorList {
+	if ref == nil {
+		return nil
+	}
+	allErrs := field.ErrorList{}
+	if ref.Name == "" {
+		allErrs = append(allErrs, field.Required(path.Child("name"), "name of configmap is required"))
+	}
+	if ref.Key == "" {
+		allErrs = append(allErrs, field.Required(path.Child("key"), "key of configmap is required"))
+	}
+	if _, err := notifier_utils.ChannelRefNamespace(ref.Namespace); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("namespace"), ref.Namespace, err.Error()))
+	}
+	return allErrs
+}
+
+// validateNotificationTemplate Validate inline templates by rendering them with the channel test event,
+// body is also validated as html/template if htmlBody is true since emails are rendered in HTML.
+// Templates in ConfigMaps are validated when they are rendered.
+func validateNotificationTemplate(path *field.Path, name string,
+	tmpl *AlamedaNotificationTemplate, htmlBody bool) field.ErrorList {
+	if tmpl == nil {
+		return nil
+	}
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateConfigMapKeyRef(path.Child("subjectConfigMapRef"), tmpl.SubjectConfigMapRef)...)
+	allErrs = append(allErrs, validateConfigMapKeyRef(path.Child("bodyConfigMapRef"), tmpl.BodyConfigMapRef)...)
+
+	sample := notifier_utils.NewEventTemplateData(event.GetChannelTestEvent(name, ""), nil)
+	if tmpl.Subject != "" {
+		if err := notifier_utils.ValidateTemplate(name, tmpl.Subject, false, sample); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("subject"), tmpl.Subject, err.Error()))
+		}
+	}
+	if tmpl.Body != "" {
+		err := notifier_utils.ValidateTemplate(name, tmpl.Body, false, sample)
+		if err == nil && htmlBody {
+			err = notifier_utils.ValidateTemplate(name, tmpl.Body, true, sample)
+		}
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("body"), tmpl.Body, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+type inlineCredential struct {
+	path  string
+	value string
+}
+
+// inlineCredentials Return inline credentials of the channel with their paths
+func (r *AlamedaNotificationChannel) inlineCredentials() []inlineCredential {
+	credentials := []inlineCredential{
+		{path: "spec.email.username", value: r.Spec.Email.Username},
+		{path: "spec.email.password", value: r.Spec.Email.Password},
+	}
+	if r.Spec.Webhook != nil {
+		credentials = append(credentials, inlineCredential{path: "spec.webhook.secret", value: r.Spec.Webhook.Secret})
+	}
+	if r.Spec.Slack != nil {
+		credentials = append(credentials, inlineCredential{path: "spec.slack.webhookUrl", value: r.Spec.Slack.WebhookURL})
+	}
+	if r.Spec.MSTeams != nil {
+		credentials = append(credentials, inlineCredential{path: "spec.msteams.webhookUrl", value: r.Spec.MSTeams.WebhookURL})
+	}
+	return credentials
+}
+
+// HasInlineCredentials Return whether credentials are set inline in the channel rather than in Secrets
+func (r *AlamedaNotificationChannel) HasInlineCredentials() bool {
+	for _, credential := range r.inlineCredentials() {
+		if credential.value != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// validateNoNewInlineCredentials Reject inline credentials set or changed in the channel,
+// unchanged ones of channels not migrated yet are allowed so that the channels can still be updated
+func (r *AlamedaNotificationChannel) validateNoNewInlineCredentials(oldChannel *AlamedaNotificationChannel) field.ErrorList {
+	oldCredentials := map[string]string{}
+	if oldChannel != nil {
+		for _, credential := range oldChannel.inlineCredentials() {
+			oldCredentials[credential.path] = credential.value
+		}
+	}
+	allErrs := field.ErrorList{}
+	for _, credential := range r.inlineCredentials() {
+		if credential.value != "" && credential.value != oldCredentials[credential.path] {
+			allErrs = append(allErrs, field.Forbidden(fieldPath(credential.path),
+				"inline credentials are not allowed, please set them in a secret and refer to it with the secret ref instead"))
+		}
+	}
+	return allErrs
+}
+
+func fieldPath(path string) *field.Path {
+	names := strings.Split(path, ".")
+	return field.NewPath(names[0], names[1:]...)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2019 The Alameda Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AlamedaNotificationSilenceSpec defines the desired state of AlamedaNotificationSilence
+type AlamedaNotificationSilenceSpec struct {
+	// Topics are events muted, they are matched the same as topics of AlamedaNotificationTopic
+	Topics []*AlamedaTopic `json:"topics,"`
+	// NotificationTopics are names of AlamedaNotificationTopic muted, all topics are muted if it is empty
+	NotificationTopics []string `json:"notificationTopics,omitempty"`
+	// StartsAt is the time in RFC3339 the silence starts, it starts once created if it is empty
+	StartsAt string `json:"startsAt,omitempty"`
+	// EndsAt is the time in RFC3339 the silence ends
+	EndsAt  string `json:"endsAt,"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=alamedanotificationsilences,scope=Cluster
+// AlamedaNotificationSilence is the Schema for the alamedanotificationsilences API
+type AlamedaNotificationSilence struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AlamedaNotificationSilenceSpec `json:"spec,omitempty"`
+}
+
+// IsActive Return whether the silence mutes events at the time
+func (r *AlamedaNotificationSilence) IsActive(now time.Time) bool {
+	if r.Spec.StartsAt != "" {
+		startsAt, err := time.Parse(time.RFC3339, r.Spec.StartsAt)
+		if err != nil || now.Before(startsAt) {
+			return false
+		}
+	}
+	endsAt, err := time.Parse(time.RFC3339, r.Spec.EndsAt)
+	return err == nil && now.Before(endsAt)
+}
+
+// IsNotificationTopicMuted Return whether events of the notification topic are muted by the silence
+func (r *AlamedaNotificationSilence) IsNotificationTopicMuted(topicName string) bool {
+	if len(r.Spec.NotificationTopics) == 0 {
+		return true
+	}
+	for _, name := range r.Spec.NotificationTopics {
+		if name == topicName {
+			return true
+		}
+	}
+	return false
+}
+
+// +kubebuilder:object:root=true
+
+// AlamedaNotificationSilenceList contains a list of AlamedaNotificationSilence
+type AlamedaNotificationSilenceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AlamedaNotificationSilence `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AlamedaNotificationSilence{}, &AlamedaNotificationSilenceList{})
+}
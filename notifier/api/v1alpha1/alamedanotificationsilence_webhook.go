@@ -0,0 +1,85 @@
+/*
+Copyright 2019 The Alameda Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (r *AlamedaNotificationSilence) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-notifying-containers-ai-v1alpha1-alamedanotificationsilence,mutating=false,failurePolicy=fail,groups=notifying.containers.ai,resources=alamedanotificationsilences,verbs=create;update,versions=v1alpha1,name=valamedanotificationsilence.containers.ai
+
+var _ webhook.Validator = &AlamedaNotificationSilence{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *AlamedaNotificationSilence) ValidateCreate() error {
+	return r.validateAlamedaNotificationSilence()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *AlamedaNotificationSilence) ValidateDelete() error {
+	return nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *AlamedaNotificationSilence) ValidateUpdate(old runtime.Object) error {
+	return r.validateAlamedaNotificationSilence()
+}
+
+func (r *AlamedaNotificationSilence) validateAlamedaNotificationSilence() error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	if len(r.Spec.Topics) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("topics"), "topics of muted events are required"))
+	}
+	allErrs = append(allErrs, validateTopics(specPath.Child("topics"), r.Spec.Topics)...)
+
+	var startsAt, endsAt time.Time
+	var err error
+	if r.Spec.StartsAt != "" {
+		if startsAt, err = time.Parse(time.RFC3339, r.Spec.StartsAt); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("startsAt"), r.Spec.StartsAt,
+				"startsAt must be in RFC3339 format"))
+		}
+	}
+	if endsAt, err = time.Parse(time.RFC3339, r.Spec.EndsAt); err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("endsAt"), r.Spec.EndsAt,
+			"endsAt must be in RFC3339 format"))
+	} else if !startsAt.IsZero() && !endsAt.After(startsAt) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("endsAt"), r.Spec.EndsAt,
+			"endsAt must be after startsAt"))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: "notifying.containers.ai", Kind: "AlamedaNotificationSilence"},
+		r.Name, allErrs)
+}
@@ -27,6 +27,14 @@ type AlamedaSubject struct {
 	Namespace  string `json:"namespace,omitempty"`
 	Name       string `json:"name,omitempty"`
 	APIVersion string `json:"apiVersion,omitempty"`
+	// NamespacePattern and NamePattern are glob patterns such as team-a-* the namespace and name match
+	NamespacePattern string `json:"namespacePattern,omitempty"`
+	NamePattern      string `json:"namePattern,omitempty"`
+	// NamespaceRegex and NameRegex are regular expressions the whole namespace and name match
+	NamespaceRegex string `json:"namespaceRegex,omitempty"`
+	NameRegex      string `json:"nameRegex,omitempty"`
+	// NamespaceSelector selects namespaces of subjects by their labels
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
 }
 
 type AlamedaTopic struct {
@@ -34,6 +42,12 @@ type AlamedaTopic struct {
 	Subject []*AlamedaSubject `json:"subject,omitempty"`
 	Level   []string          `json:"level,omitempty"`
 	Source  []*AlamedaSource  `json:"source,omitempty"`
+	// MinLevel matches events of the level or higher
+	MinLevel string `json:"minLevel,omitempty"`
+	// ExcludeType, ExcludeSubject and ExcludeSource exclude events matching any of them from the topic
+	ExcludeType    []string          `json:"excludeType,omitempty"`
+	ExcludeSubject []*AlamedaSubject `json:"excludeSubject,omitempty"`
+	ExcludeSource  []*AlamedaSource  `json:"excludeSource,omitempty"`
 }
 
 type AlamedaSource struct {
@@ -42,7 +56,10 @@ type AlamedaSource struct {
 }
 
 type AlamedaChannel struct {
-	Emails []*AlamedaEmailChannel `json:"emails,omitempty"`
+	Emails   []*AlamedaEmailChannel   `json:"emails,omitempty"`
+	Webhooks []*AlamedaWebhookChannel `json:"webhooks,omitempty"`
+	Slacks   []*AlamedaSlackChannel   `json:"slacks,omitempty"`
+	MSTeams  []*AlamedaMSTeamsChannel `json:"msteams,omitempty"`
 }
 
 type AlamedaEmailChannel struct {
@@ -51,6 +68,50 @@ type AlamedaEmailChannel struct {
 	Cc   []string `json:"cc,omitempty"`
 }
 
+type AlamedaWebhookChannel struct {
+	Name string `json:"name,"`
+}
+
+type AlamedaSlackChannel struct {
+	Name string `json:"name,"`
+	// Channel overrides channel of the notification channel if it is not empty
+	Channel string `json:"channel,omitempty"`
+}
+
+type AlamedaMSTeamsChannel struct {
+	Name string `json:"name,"`
+}
+
+const (
+	EventFieldType    = "type"
+	EventFieldLevel   = "level"
+	EventFieldSubject = "subject"
+	EventFieldSource  = "source"
+	EventFieldMessage = "message"
+)
+
+// DigestGroupByFields list fields events can be grouped by in digests
+var DigestGroupByFields = []string{EventFieldType, EventFieldLevel, EventFieldSubject, EventFieldSource}
+
+// DedupKeyFields list fields composing dedup keys of events
+var DedupKeyFields = []string{EventFieldType, EventFieldLevel, EventFieldSubject, EventFieldSource, EventFieldMessage}
+
+// AlamedaDigest groups events matching the topic in a window into one message
+type AlamedaDigest struct {
+	// WindowSeconds is the period events are grouped in since the first one of the group arrives
+	WindowSeconds int64 `json:"windowSeconds,"`
+	// GroupBy are fields of events grouped in the same message, type and subject are used if it is empty
+	GroupBy []string `json:"groupBy,omitempty"`
+}
+
+// AlamedaDedup drops events of the same key notified within the repeat interval
+type AlamedaDedup struct {
+	// Keys are fields of events composing the dedup key, type, level, subject and message are used if it is empty
+	Keys []string `json:"keys,omitempty"`
+	// RepeatIntervalSeconds is the period events of the same key are not notified again
+	RepeatIntervalSeconds int64 `json:"repeatIntervalSeconds,"`
+}
+
 // AlamedaNotificationTopicSpec defines the desired state of AlamedaNotificationTopic
 type AlamedaNotificationTopicSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
@@ -58,6 +119,10 @@ type AlamedaNotificationTopicSpec struct {
 	Disabled bool            `json:"disabled,omitempty"`
 	Topics   []*AlamedaTopic `json:"topics,"`
 	Channel  *AlamedaChannel `json:"channel,"`
+	Digest   *AlamedaDigest  `json:"digest,omitempty"`
+	Dedup    *AlamedaDedup   `json:"dedup,omitempty"`
+	// Template renders notifications of the topic, it takes place of the template of channels
+	Template *AlamedaNotificationTemplate `json:"template,omitempty"`
 }
 
 type AlamedaChannelCondition struct {
@@ -73,6 +138,9 @@ type AlamedaNotificationTopicStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 	ChannelCondictions []*AlamedaChannelCondition `json:"channelConditions,"`
+	// InvalidPatterns are glob patterns and regular expressions of subjects which are invalid,
+	// subjects with them match no events
+	InvalidPatterns []string `json:"invalidPatterns,omitempty"`
 }
 
 // +kubebuilder:object:root=true
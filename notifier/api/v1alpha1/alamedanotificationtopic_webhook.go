@@ -18,12 +18,15 @@ package v1alpha1
 
 import (
 	"fmt"
+	"path"
+	"regexp"
 	"strings"
 
 	"github.com/containers-ai/alameda/notifier/event"
 	"github.com/containers-ai/alameda/pkg/utils"
 	"github.com/containers-ai/alameda/pkg/utils/log"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -47,11 +50,14 @@ var _ webhook.Defaulter = &AlamedaNotificationTopic{}
 
 // Default implements webhook.Defaulter so a webhook will be registered for the type
 func (r *AlamedaNotificationTopic) Default() {
+	if r.Spec.Channel == nil {
+		return
+	}
 	channelConditions := []*AlamedaChannelCondition{}
-	for _, emailChannel := range r.Spec.Channel.Emails {
+	for _, channelRef := range r.Spec.Channel.references() {
 		found := false
 		for _, curCondition := range r.Status.ChannelCondictions {
-			if strings.ToLower(curCondition.Type) == "email" {
+			if strings.ToLower(curCondition.Type) == channelRef.Type && curCondition.Name == channelRef.Name {
 				channelConditions = append(channelConditions, curCondition)
 				found = true
 				break
@@ -59,8 +65,8 @@ func (r *AlamedaNotificationTopic) Default() {
 		}
 		if !found {
 			channelConditions = append(channelConditions, &AlamedaChannelCondition{
-				Type: "email",
-				Name: emailChannel.Name,
+				Type: channelRef.Type,
+				Name: channelRef.Name,
 			})
 		}
 	}
@@ -88,6 +94,18 @@ func (r *AlamedaNotificationTopic) ValidateUpdate(old runtime.Object) error {
 
 func (r *AlamedaNotificationTopic) validateAlamedaNotificationTopic() error {
 	var allErrs field.ErrorList
+	if r.Spec.Channel == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec").Child("channel"), "channel is required"))
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: "notifying.containers.ai", Kind: "AlamedaNotificationTopic"},
+			r.Name, allErrs)
+	}
+	for _, channelRef := range r.Spec.Channel.references() {
+		if channelRef.Name == "" {
+			allErrs = append(allErrs, field.Required(channelRef.path.Child("name"),
+				fmt.Sprintf("name of %s channel is required", channelRef.Type)))
+		}
+	}
 	for emailIdx, email := range r.Spec.Channel.Emails {
 		for itoIdx, ito := range email.To {
 			if ito != "" && !utils.IsEmailValid(ito) {
@@ -107,24 +125,26 @@ func (r *AlamedaNotificationTopic) validateAlamedaNotificationTopic() error {
 		}
 	}
 
-	for topicIdx, topic := range r.Spec.Topics {
-		for typeIdx, iType := range topic.Type {
-			if iType != "" && !event.IsEventTypeYamlKeySupported(iType) {
-				allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("topics").
-					Index(topicIdx).Child("type").Index(typeIdx),
-					iType, fmt.Sprintf("topic type %s is not in support list (%s)",
-						iType, strings.Join(event.ListEventTypeYamlKey(), ","))))
-			}
+	allErrs = append(allErrs, validateTopics(field.NewPath("spec").Child("topics"), r.Spec.Topics)...)
+
+	if digest := r.Spec.Digest; digest != nil {
+		digestPath := field.NewPath("spec").Child("digest")
+		if digest.WindowSeconds <= 0 {
+			allErrs = append(allErrs, field.Invalid(digestPath.Child("windowSeconds"), digest.WindowSeconds,
+				"window of digest must be greater than 0"))
 		}
-		for levelIdx, level := range topic.Level {
-			if level != "" && !event.IsEventLevelYamlKeySupported(level) {
-				allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("topics").
-					Index(topicIdx).Child("level").Index(levelIdx),
-					level, fmt.Sprintf("topic level %s is not in support list (%s)",
-						level, strings.Join(event.ListEventLevelYamlKey(), ","))))
-			}
+		allErrs = append(allErrs, validateEventFields(digestPath.Child("groupBy"), digest.GroupBy, DigestGroupByFields)...)
+	}
+	if dedup := r.Spec.Dedup; dedup != nil {
+		dedupPath := field.NewPath("spec").Child("dedup")
+		if dedup.RepeatIntervalSeconds <= 0 {
+			allErrs = append(allErrs, field.Invalid(dedupPath.Child("repeatIntervalSeconds"), dedup.RepeatIntervalSeconds,
+				"repeat interval of dedup must be greater than 0"))
 		}
+		allErrs = append(allErrs, validateEventFields(dedupPath.Child("keys"), dedup.Keys, DedupKeyFields)...)
 	}
+	allErrs = append(allErrs, validateNotificationTemplate(field.NewPath("spec").Child("template"), r.Name,
+		r.Spec.Template, len(r.Spec.Channel.Emails) > 0)...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -133,3 +153,121 @@ func (r *AlamedaNotificationTopic) validateAlamedaNotificationTopic() error {
 		schema.GroupKind{Group: "notifying.containers.ai", Kind: "AlamedaNotificationTopic"},
 		r.Name, allErrs)
 }
+
+// validateTopics Validate types, levels and subjects of events matched by the topics
+func validateTopics(topicsPath *field.Path, topics []*AlamedaTopic) field.ErrorList {
+	var allErrs field.ErrorList
+	for topicIdx, topic := range topics {
+		topicPath := topicsPath.Index(topicIdx)
+		allErrs = append(allErrs, validateEventTypes(topicPath.Child("type"), topic.Type)...)
+		allErrs = append(allErrs, validateEventTypes(topicPath.Child("excludeType"), topic.ExcludeType)...)
+		for levelIdx, level := range topic.Level {
+			if level != "" && !event.IsEventLevelYamlKeySupported(level) {
+				allErrs = append(allErrs, field.Invalid(topicPath.Child("level").Index(levelIdx),
+					level, fmt.Sprintf("topic level %s is not in support list (%s)",
+						level, strings.Join(event.ListEventLevelYamlKey(), ","))))
+			}
+		}
+		if topic.MinLevel != "" && !event.IsEventLevelYamlKeySupported(topic.MinLevel) {
+			allErrs = append(allErrs, field.Invalid(topicPath.Child("minLevel"),
+				topic.MinLevel, fmt.Sprintf("topic min level %s is not in support list (%s)",
+					topic.MinLevel, strings.Join(event.ListEventLevelYamlKey(), ","))))
+		}
+		allErrs = append(allErrs, validateSubjects(topicPath.Child("subject"), topic.Subject)...)
+		allErrs = append(allErrs, validateSubjects(topicPath.Child("excludeSubject"), topic.ExcludeSubject)...)
+	}
+	return allErrs
+}
+
+func validateEventTypes(typesPath *field.Path, types []string) field.ErrorList {
+	var allErrs field.ErrorList
+	for typeIdx, iType := range types {
+		if iType != "" && !event.IsEventTypeYamlKeySupported(iType) {
+			allErrs = append(allErrs, field.Invalid(typesPath.Index(typeIdx),
+				iType, fmt.Sprintf("topic type %s is not in support list (%s)",
+					iType, strings.Join(event.ListEventTypeYamlKey(), ","))))
+		}
+	}
+	return allErrs
+}
+
+// validateSubjects Validate glob patterns, regular expressions and namespace selectors of the subjects
+func validateSubjects(subjectsPath *field.Path, subjects []*AlamedaSubject) field.ErrorList {
+	var allErrs field.ErrorList
+	for subjectIdx, subject := range subjects {
+		subjectPath := subjectsPath.Index(subjectIdx)
+		for _, pattern := range []struct{ child, value string }{
+			{child: "namespacePattern", value: subject.NamespacePattern},
+			{child: "namePattern", value: subject.NamePattern},
+		} {
+			if _, err := path.Match(pattern.value, ""); err != nil {
+				allErrs = append(allErrs, field.Invalid(subjectPath.Child(pattern.child), pattern.value,
+					fmt.Sprintf("invalid glob pattern: %s", err.Error())))
+			}
+		}
+		for _, regex := range []struct{ child, value string }{
+			{child: "namespaceRegex", value: subject.NamespaceRegex},
+			{child: "nameRegex", value: subject.NameRegex},
+		} {
+			if _, err := regexp.Compile(regex.value); err != nil {
+				allErrs = append(allErrs, field.Invalid(subjectPath.Child(regex.child), regex.value,
+					fmt.Sprintf("invalid regular expression: %s", err.Error())))
+			}
+		}
+		if subject.NamespaceSelector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(subject.NamespaceSelector); err != nil {
+				allErrs = append(allErrs, field.Invalid(subjectPath.Child("namespaceSelector"),
+					subject.NamespaceSelector, err.Error()))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateEventFields Validate the event fields are in the supported ones
+func validateEventFields(path *field.Path, fields, supportedFields []string) field.ErrorList {
+	var allErrs field.ErrorList
+	for idx, eventField := range fields {
+		supported := false
+		for _, supportedField := range supportedFields {
+			if eventField == supportedField {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			allErrs = append(allErrs, field.NotSupported(path.Index(idx), eventField, supportedFields))
+		}
+	}
+	return allErrs
+}
+
+// channelReference is a notification channel referred by topic
+type channelReference struct {
+	Type string
+	Name string
+	path *field.Path
+}
+
+// references List notification channels of all types referred by the channel
+func (c *AlamedaChannel) references() []channelReference {
+	channelPath := field.NewPath("spec").Child("channel")
+	refs := []channelReference{}
+	for idx, email := range c.Emails {
+		refs = append(refs, channelReference{Type: ChannelTypeEmail, Name: email.Name,
+			path: channelPath.Child("emails").Index(idx)})
+	}
+	for idx, webhook := range c.Webhooks {
+		refs = append(refs, channelReference{Type: ChannelTypeWebhook, Name: webhook.Name,
+			path: channelPath.Child("webhooks").Index(idx)})
+	}
+	for idx, slack := range c.Slacks {
+		refs = append(refs, channelReference{Type: ChannelTypeSlack, Name: slack.Name,
+			path: channelPath.Child("slacks").Index(idx)})
+	}
+	for idx, msTeams := range c.MSTeams {
+		refs = append(refs, channelReference{Type: ChannelTypeMSTeams, Name: msTeams.Name,
+			path: channelPath.Child("msteams").Index(idx)})
+	}
+	return refs
+}
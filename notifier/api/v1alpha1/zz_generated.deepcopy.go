@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -38,6 +39,39 @@ func (in *AlamedaChannel) DeepCopyInto(out *AlamedaChannel) {
 			}
 		}
 	}
+	if in.Webhooks != nil {
+		in, out := &in.Webhooks, &out.Webhooks
+		*out = make([]*AlamedaWebhookChannel, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AlamedaWebhookChannel)
+				**out = **in
+			}
+		}
+	}
+	if in.Slacks != nil {
+		in, out := &in.Slacks, &out.Slacks
+		*out = make([]*AlamedaSlackChannel, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AlamedaSlackChannel)
+				**out = **in
+			}
+		}
+	}
+	if in.MSTeams != nil {
+		in, out := &in.MSTeams, &out.MSTeams
+		*out = make([]*AlamedaMSTeamsChannel, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AlamedaMSTeamsChannel)
+				**out = **in
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaChannel.
@@ -80,9 +114,74 @@ func (in *AlamedaChannelTest) DeepCopy() *AlamedaChannelTest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlamedaConfigMapKeyRef) DeepCopyInto(out *AlamedaConfigMapKeyRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaConfigMapKeyRef.
+func (in *AlamedaConfigMapKeyRef) DeepCopy() *AlamedaConfigMapKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(AlamedaConfigMapKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlamedaDedup) DeepCopyInto(out *AlamedaDedup) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaDedup.
+func (in *AlamedaDedup) DeepCopy() *AlamedaDedup {
+	if in == nil {
+		return nil
+	}
+	out := new(AlamedaDedup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlamedaDigest) DeepCopyInto(out *AlamedaDigest) {
+	*out = *in
+	if in.GroupBy != nil {
+		in, out := &in.GroupBy, &out.GroupBy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaDigest.
+func (in *AlamedaDigest) DeepCopy() *AlamedaDigest {
+	if in == nil {
+		return nil
+	}
+	out := new(AlamedaDigest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlamedaEmail) DeepCopyInto(out *AlamedaEmail) {
 	*out = *in
+	if in.UsernameSecretRef != nil {
+		in, out := &in.UsernameSecretRef, &out.UsernameSecretRef
+		*out = new(AlamedaSecretKeyRef)
+		**out = **in
+	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(AlamedaSecretKeyRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaEmail.
@@ -120,12 +219,47 @@ func (in *AlamedaEmailChannel) DeepCopy() *AlamedaEmailChannel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlamedaMSTeams) DeepCopyInto(out *AlamedaMSTeams) {
+	*out = *in
+	if in.WebhookURLSecretRef != nil {
+		in, out := &in.WebhookURLSecretRef, &out.WebhookURLSecretRef
+		*out = new(AlamedaSecretKeyRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaMSTeams.
+func (in *AlamedaMSTeams) DeepCopy() *AlamedaMSTeams {
+	if in == nil {
+		return nil
+	}
+	out := new(AlamedaMSTeams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlamedaMSTeamsChannel) DeepCopyInto(out *AlamedaMSTeamsChannel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaMSTeamsChannel.
+func (in *AlamedaMSTeamsChannel) DeepCopy() *AlamedaMSTeamsChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(AlamedaMSTeamsChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlamedaNotificationChannel) DeepCopyInto(out *AlamedaNotificationChannel) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -182,7 +316,27 @@ func (in *AlamedaNotificationChannelList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlamedaNotificationChannelSpec) DeepCopyInto(out *AlamedaNotificationChannelSpec) {
 	*out = *in
-	out.Email = in.Email
+	in.Email.DeepCopyInto(&out.Email)
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(AlamedaWebhook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(AlamedaSlack)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MSTeams != nil {
+		in, out := &in.MSTeams, &out.MSTeams
+		*out = new(AlamedaMSTeams)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(AlamedaNotificationTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaNotificationChannelSpec.
@@ -215,6 +369,120 @@ func (in *AlamedaNotificationChannelStatus) DeepCopy() *AlamedaNotificationChann
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlamedaNotificationSilence) DeepCopyInto(out *AlamedaNotificationSilence) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaNotificationSilence.
+func (in *AlamedaNotificationSilence) DeepCopy() *AlamedaNotificationSilence {
+	if in == nil {
+		return nil
+	}
+	out := new(AlamedaNotificationSilence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlamedaNotificationSilence) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlamedaNotificationSilenceList) DeepCopyInto(out *AlamedaNotificationSilenceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AlamedaNotificationSilence, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaNotificationSilenceList.
+func (in *AlamedaNotificationSilenceList) DeepCopy() *AlamedaNotificationSilenceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlamedaNotificationSilenceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlamedaNotificationSilenceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlamedaNotificationSilenceSpec) DeepCopyInto(out *AlamedaNotificationSilenceSpec) {
+	*out = *in
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]*AlamedaTopic, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AlamedaTopic)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.NotificationTopics != nil {
+		in, out := &in.NotificationTopics, &out.NotificationTopics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaNotificationSilenceSpec.
+func (in *AlamedaNotificationSilenceSpec) DeepCopy() *AlamedaNotificationSilenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlamedaNotificationSilenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlamedaNotificationTemplate) DeepCopyInto(out *AlamedaNotificationTemplate) {
+	*out = *in
+	if in.SubjectConfigMapRef != nil {
+		in, out := &in.SubjectConfigMapRef, &out.SubjectConfigMapRef
+		*out = new(AlamedaConfigMapKeyRef)
+		**out = **in
+	}
+	if in.BodyConfigMapRef != nil {
+		in, out := &in.BodyConfigMapRef, &out.BodyConfigMapRef
+		*out = new(AlamedaConfigMapKeyRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaNotificationTemplate.
+func (in *AlamedaNotificationTemplate) DeepCopy() *AlamedaNotificationTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(AlamedaNotificationTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlamedaNotificationTopic) DeepCopyInto(out *AlamedaNotificationTopic) {
 	*out = *in
@@ -293,6 +561,21 @@ func (in *AlamedaNotificationTopicSpec) DeepCopyInto(out *AlamedaNotificationTop
 		*out = new(AlamedaChannel)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Digest != nil {
+		in, out := &in.Digest, &out.Digest
+		*out = new(AlamedaDigest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dedup != nil {
+		in, out := &in.Dedup, &out.Dedup
+		*out = new(AlamedaDedup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(AlamedaNotificationTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaNotificationTopicSpec.
@@ -319,6 +602,11 @@ func (in *AlamedaNotificationTopicStatus) DeepCopyInto(out *AlamedaNotificationT
 			}
 		}
 	}
+	if in.InvalidPatterns != nil {
+		in, out := &in.InvalidPatterns, &out.InvalidPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaNotificationTopicStatus.
@@ -331,6 +619,56 @@ func (in *AlamedaNotificationTopicStatus) DeepCopy() *AlamedaNotificationTopicSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlamedaSecretKeyRef) DeepCopyInto(out *AlamedaSecretKeyRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaSecretKeyRef.
+func (in *AlamedaSecretKeyRef) DeepCopy() *AlamedaSecretKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(AlamedaSecretKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlamedaSlack) DeepCopyInto(out *AlamedaSlack) {
+	*out = *in
+	if in.WebhookURLSecretRef != nil {
+		in, out := &in.WebhookURLSecretRef, &out.WebhookURLSecretRef
+		*out = new(AlamedaSecretKeyRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaSlack.
+func (in *AlamedaSlack) DeepCopy() *AlamedaSlack {
+	if in == nil {
+		return nil
+	}
+	out := new(AlamedaSlack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlamedaSlackChannel) DeepCopyInto(out *AlamedaSlackChannel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaSlackChannel.
+func (in *AlamedaSlackChannel) DeepCopy() *AlamedaSlackChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(AlamedaSlackChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlamedaSource) DeepCopyInto(out *AlamedaSource) {
 	*out = *in
@@ -349,6 +687,11 @@ func (in *AlamedaSource) DeepCopy() *AlamedaSource {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlamedaSubject) DeepCopyInto(out *AlamedaSubject) {
 	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaSubject.
@@ -376,7 +719,7 @@ func (in *AlamedaTopic) DeepCopyInto(out *AlamedaTopic) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(AlamedaSubject)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
@@ -396,6 +739,33 @@ func (in *AlamedaTopic) DeepCopyInto(out *AlamedaTopic) {
 			}
 		}
 	}
+	if in.ExcludeType != nil {
+		in, out := &in.ExcludeType, &out.ExcludeType
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeSubject != nil {
+		in, out := &in.ExcludeSubject, &out.ExcludeSubject
+		*out = make([]*AlamedaSubject, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AlamedaSubject)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ExcludeSource != nil {
+		in, out := &in.ExcludeSource, &out.ExcludeSource
+		*out = make([]*AlamedaSource, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AlamedaSource)
+				**out = **in
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaTopic.
@@ -407,3 +777,52 @@ func (in *AlamedaTopic) DeepCopy() *AlamedaTopic {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlamedaWebhook) DeepCopyInto(out *AlamedaWebhook) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(AlamedaSecretKeyRef)
+		**out = **in
+	}
+	if in.HeaderSecretRefs != nil {
+		in, out := &in.HeaderSecretRefs, &out.HeaderSecretRefs
+		*out = make(map[string]AlamedaSecretKeyRef, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaWebhook.
+func (in *AlamedaWebhook) DeepCopy() *AlamedaWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(AlamedaWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlamedaWebhookChannel) DeepCopyInto(out *AlamedaWebhookChannel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaWebhookChannel.
+func (in *AlamedaWebhookChannel) DeepCopy() *AlamedaWebhookChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(AlamedaWebhookChannel)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,41 @@
+package channel
+
+import (
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+	notifier_utils "github.com/containers-ai/alameda/notifier/utils"
+	"github.com/containers-ai/alameda/pkg/utils/log"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/pkg/errors"
+)
+
+var scope = log.RegisterScope("channel", "notification channel", 0)
+
+// Channel sends events to receivers of a notification channel
+type Channel interface {
+	SendEvent(evt *datahub_v1alpha1.Event) error
+}
+
+var (
+	_ Channel = &EmailClient{}
+	_ Channel = &WebhookClient{}
+	_ Channel = &SlackClient{}
+	_ Channel = &MSTeamsClient{}
+)
+
+// NewChannel Build channel sending events to receivers set in the notification channel,
+// email channels are not supported since their recipients are set by topics
+func NewChannel(notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel,
+	clusterInfo *notifier_utils.ClusterInfo) (Channel, error) {
+	switch notificationChannel.Spec.Type {
+	case notifyingv1alpha1.ChannelTypeWebhook:
+		return NewWebhookClient(notificationChannel, clusterInfo)
+	case notifyingv1alpha1.ChannelTypeSlack:
+		return NewSlackClient(notificationChannel, &notifyingv1alpha1.AlamedaSlackChannel{
+			Name: notificationChannel.GetName(),
+		}, clusterInfo)
+	case notifyingv1alpha1.ChannelTypeMSTeams:
+		return NewMSTeamsClient(notificationChannel, clusterInfo)
+	}
+	return nil, errors.Errorf("channel %s of type %s cannot send events without receivers",
+		notificationChannel.GetName(), notificationChannel.Spec.Type)
+}
@@ -11,14 +11,11 @@ import (
 
 	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
 	notifier_utils "github.com/containers-ai/alameda/notifier/utils"
-	"github.com/containers-ai/alameda/pkg/utils/log"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 	"github.com/pkg/errors"
 	"gopkg.in/mail.v2"
 )
 
-var scope = log.RegisterScope("email", "email", 0)
-
 type EmailClient struct {
 	notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel
 	emailChannel        *notifyingv1alpha1.AlamedaEmailChannel
@@ -26,6 +23,7 @@ type EmailClient struct {
 	auth                smtp.Auth
 	mailAddr            string
 	clusterInfo         *notifier_utils.ClusterInfo
+	template            *notificationTemplate
 }
 
 func NewEmailClient(notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel,
@@ -33,6 +31,10 @@ func NewEmailClient(notificationChannel *notifyingv1alpha1.AlamedaNotificationCh
 	host := notificationChannel.Spec.Email.Server
 	port := notificationChannel.Spec.Email.Port
 
+	tmpl, err := newNotificationTemplate(notificationChannel, true)
+	if err != nil {
+		return nil, err
+	}
 	client, err := getSMTPClient(notificationChannel)
 
 	if err != nil {
@@ -45,12 +47,23 @@ func NewEmailClient(notificationChannel *notifyingv1alpha1.AlamedaNotificationCh
 		mailAddr:            fmt.Sprintf("%s:%v", host, port),
 		client:              client,
 		clusterInfo:         clusterInfo,
+		template:            tmpl,
 	}, nil
 }
 
 func (emailClient *EmailClient) SendEvent(evt *datahub_v1alpha1.Event) error {
 	msg := evt.GetMessage()
-	subject := notifier_utils.EventEmailSubject(evt)
+	subject, msgHTML, err := emailClient.template.render(
+		notifier_utils.NewEventTemplateData(evt, emailClient.clusterInfo))
+	if err != nil {
+		return err
+	}
+	if subject == "" {
+		subject = notifier_utils.EventEmailSubject(evt)
+	}
+	if msgHTML == "" {
+		msgHTML = notifier_utils.EventHTMLMsg(evt, emailClient.clusterInfo)
+	}
 	from := emailClient.notificationChannel.Spec.Email.From
 	recipients := emailClient.emailChannel.To
 	ccs := emailClient.emailChannel.Cc
@@ -58,7 +71,7 @@ func (emailClient *EmailClient) SendEvent(evt *datahub_v1alpha1.Event) error {
 	attachments := map[string]string{}
 	scope.Infof("Start sending email (subject: %s, from: %s, to: %s, cc:%s, body: %s)",
 		subject, from, strings.Join(recipients, ";"), strings.Join(ccs, ";"), msg)
-	err := emailClient.SendEmailBySMTP(subject, from, recipients, notifier_utils.EventHTMLMsg(evt, emailClient.clusterInfo),
+	err = emailClient.SendEmailBySMTP(subject, from, recipients, msgHTML,
 		notifier_utils.RemoveEmptyStr(ccs), attachments)
 	if err != nil {
 		return err
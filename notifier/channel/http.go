@@ -0,0 +1,70 @@
+package channel
+
+import (
+	"bytes"
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// httpTimeout is the timeout of posting an event
+	httpTimeout = 10 * time.Second
+	// maxErrorBodySize is the max size of response body kept in errors
+	maxErrorBodySize = 512
+)
+
+func newHTTPClient(insecureSkipVerify bool) *http.Client {
+	return &http.Client{
+		Timeout: httpTimeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify},
+		},
+	}
+}
+
+// postJSON Post the JSON body to the url, an error is returned unless the response status is 2xx.
+// Only host of the url is kept in errors since urls of incoming webhooks contain their tokens.
+func postJSON(client *http.Client, rawURL string, headers map[string]string, body []byte) error {
+	host := redactURL(rawURL)
+	req, err := http.NewRequest(http.MethodPost, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Errorf("build request to %s failed", host)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		if urlErr, ok := err.(*url.Error); ok {
+			err = urlErr.Err
+		}
+		return errors.Wrapf(err, "post to %s failed", host)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return errors.Errorf("post to %s failed with status %s: %s",
+			host, resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
+
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "<invalid url>"
+	}
+	return u.Scheme + "://" + u.Host
+}
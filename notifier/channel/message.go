@@ -0,0 +1,54 @@
+package channel
+
+import (
+	"fmt"
+	"strings"
+
+	notifier_utils "github.com/containers-ai/alameda/notifier/utils"
+)
+
+// eventFact is a name/value pair of event shown in chat messages
+type eventFact struct {
+	name  string
+	value string
+}
+
+// eventTitle Return title of chat messages of the event, it is the same as subject of emails
+func eventTitle(data *notifier_utils.EventTemplateData) string {
+	return fmt.Sprintf("Federator.ai Notification: %s - %s", strings.Title(data.Level), data.Message)
+}
+
+// eventFacts Return facts of the event listed the same as in emails
+func eventFacts(data *notifier_utils.EventTemplateData) []eventFact {
+	return []eventFact{
+		{name: "Cluster Id", value: data.ClusterID},
+		{name: "Master Node Hostname", value: data.MasterNodeHostname},
+		{name: "Master Node IP", value: data.MasterNodeIP},
+		{name: "Time", value: data.Time},
+		{name: "Level", value: strings.Title(data.Level)},
+		{name: "Message", value: data.Message},
+		{name: "Event Type", value: data.Type},
+		{name: "Resource Name", value: data.Subject.Name},
+		{name: "Resource Kind", value: data.Subject.Kind},
+		{name: "Namespace", value: data.Subject.Namespace},
+	}
+}
+
+type eventSeverity int
+
+const (
+	severityNormal eventSeverity = iota
+	severityWarning
+	severityDanger
+)
+
+// eventLevelSeverity Return severity of the event level deciding colors of chat messages
+func eventLevelSeverity(level string) eventSeverity {
+	switch strings.ToLower(level) {
+	case "error", "fatal":
+		return severityDanger
+	case "warning":
+		return severityWarning
+	}
+	return severityNormal
+}
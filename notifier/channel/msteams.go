@@ -0,0 +1,97 @@
+package channel
+
+import (
+	"encoding/json"
+	"net/http"
+
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+	notifier_utils "github.com/containers-ai/alameda/notifier/utils"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/pkg/errors"
+)
+
+var msTeamsColors = map[eventSeverity]string{
+	severityNormal:  "2EB886",
+	severityWarning: "DAA038",
+	severityDanger:  "A30200",
+}
+
+// msTeamsMessage is the legacy actionable message card accepted by incoming webhooks of Microsoft Teams
+type msTeamsMessage struct {
+	Type       string           `json:"@type"`
+	Context    string           `json:"@context"`
+	ThemeColor string           `json:"themeColor,omitempty"`
+	Summary    string           `json:"summary"`
+	Title      string           `json:"title"`
+	Sections   []msTeamsSection `json:"sections,omitempty"`
+}
+
+type msTeamsSection struct {
+	Text  string        `json:"text,omitempty"`
+	Facts []msTeamsFact `json:"facts,omitempty"`
+}
+
+type msTeamsFact struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// MSTeamsClient posts events to the incoming webhook of Microsoft Teams
+type MSTeamsClient struct {
+	notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel
+	client              *http.Client
+	clusterInfo         *notifier_utils.ClusterInfo
+	template            *notificationTemplate
+}
+
+func NewMSTeamsClient(notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel,
+	clusterInfo *notifier_utils.ClusterInfo) (*MSTeamsClient, error) {
+	if notificationChannel.Spec.MSTeams == nil || notificationChannel.Spec.MSTeams.WebhookURL == "" {
+		return nil, errors.Errorf("webhook url of msteams channel %s is not set", notificationChannel.GetName())
+	}
+	tmpl, err := newNotificationTemplate(notificationChannel, false)
+	if err != nil {
+		return nil, err
+	}
+	return &MSTeamsClient{
+		notificationChannel: notificationChannel,
+		client:              newHTTPClient(false),
+		clusterInfo:         clusterInfo,
+		template:            tmpl,
+	}, nil
+}
+
+func (msTeamsClient *MSTeamsClient) SendEvent(evt *datahub_v1alpha1.Event) error {
+	data := notifier_utils.NewEventTemplateData(evt, msTeamsClient.clusterInfo)
+	title, text, err := msTeamsClient.template.render(data)
+	if err != nil {
+		return err
+	}
+	if title == "" {
+		title = eventTitle(data)
+	}
+
+	section := msTeamsSection{Text: text}
+	// facts of the event are listed only if the body is not rendered by template
+	if text == "" {
+		for _, fact := range eventFacts(data) {
+			section.Facts = append(section.Facts, msTeamsFact{Name: fact.name, Value: fact.value})
+		}
+	}
+	msg := msTeamsMessage{
+		Type:       "MessageCard",
+		Context:    "https://schema.org/extensions",
+		ThemeColor: msTeamsColors[eventLevelSeverity(data.Level)],
+		Summary:    title,
+		Title:      title,
+		Sections:   []msTeamsSection{section},
+	}
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(err, "encode msteams message failed")
+	}
+	scope.Infof("Start posting event to msteams channel %s (message: %s)",
+		msTeamsClient.notificationChannel.GetName(), evt.GetMessage())
+	return postJSON(msTeamsClient.client, msTeamsClient.notificationChannel.Spec.MSTeams.WebhookURL, nil, body)
+}
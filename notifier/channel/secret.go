@@ -0,0 +1,93 @@
+package channel
+
+import (
+	"context"
+	b64 "encoding/base64"
+
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+	notifier_utils "github.com/containers-ai/alameda/notifier/utils"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveSecretRefs Return a copy of the notification channel with credentials referred by secret refs
+// set in their inline fields. Username, password and webhook secret are base64 encoded the same as
+// the mutating webhook stores inline values, so clients decode them in one way.
+func ResolveSecretRefs(reader client.Reader,
+	notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel) (*notifyingv1alpha1.AlamedaNotificationChannel, error) {
+	resolved := notificationChannel.DeepCopy()
+
+	email := &resolved.Spec.Email
+	if email.UsernameSecretRef != nil {
+		username, err := GetSecretValue(reader, email.UsernameSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		email.Username = b64.StdEncoding.EncodeToString(username)
+	}
+	if email.PasswordSecretRef != nil {
+		password, err := GetSecretValue(reader, email.PasswordSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		email.Password = b64.StdEncoding.EncodeToString(password)
+	}
+
+	if webhook := resolved.Spec.Webhook; webhook != nil {
+		if webhook.SecretRef != nil {
+			secret, err := GetSecretValue(reader, webhook.SecretRef)
+			if err != nil {
+				return nil, err
+			}
+			webhook.Secret = b64.StdEncoding.EncodeToString(secret)
+		}
+		for header, ref := range webhook.HeaderSecretRefs {
+			ref := ref
+			value, err := GetSecretValue(reader, &ref)
+			if err != nil {
+				return nil, err
+			}
+			if webhook.Headers == nil {
+				webhook.Headers = map[string]string{}
+			}
+			webhook.Headers[header] = string(value)
+		}
+	}
+
+	if slack := resolved.Spec.Slack; slack != nil && slack.WebhookURLSecretRef != nil {
+		webhookURL, err := GetSecretValue(reader, slack.WebhookURLSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		slack.WebhookURL = string(webhookURL)
+	}
+	if msTeams := resolved.Spec.MSTeams; msTeams != nil && msTeams.WebhookURLSecretRef != nil {
+		webhookURL, err := GetSecretValue(reader, msTeams.WebhookURLSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		msTeams.WebhookURL = string(webhookURL)
+	}
+	return resolved, nil
+}
+
+// GetSecretValue Return value of the key in the Secret referred by the ref
+func GetSecretValue(reader client.Reader, ref *notifyingv1alpha1.AlamedaSecretKeyRef) ([]byte, error) {
+	namespace, err := notifier_utils.ChannelRefNamespace(ref.Namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get secret %s failed", ref.Name)
+	}
+	secret := &corev1.Secret{}
+	if err := reader.Get(context.TODO(), client.ObjectKey{
+		Namespace: namespace,
+		Name:      ref.Name,
+	}, secret); err != nil {
+		return nil, errors.Wrapf(err, "get secret %s/%s failed", namespace, ref.Name)
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return nil, errors.Errorf("key %s not found in secret %s/%s", ref.Key, namespace, ref.Name)
+	}
+	return value, nil
+}
@@ -0,0 +1,83 @@
+package channel
+
+import (
+	b64 "encoding/base64"
+	"testing"
+
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+	"github.com/spf13/viper"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestResolveSecretRefs(t *testing.T) {
+	viper.Set("channelSecret.namespace", "alameda")
+	reader := fake.NewFakeClient(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "alameda", Name: "smtp"},
+		Data: map[string][]byte{
+			"username": []byte("user"),
+			"password": []byte("pass"),
+		},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "alameda", Name: "itsm"},
+		Data: map[string][]byte{
+			"token":  []byte("Bearer token"),
+			"secret": []byte("secret"),
+		},
+	})
+
+	notificationChannel := &notifyingv1alpha1.AlamedaNotificationChannel{
+		Spec: notifyingv1alpha1.AlamedaNotificationChannelSpec{
+			Email: notifyingv1alpha1.AlamedaEmail{
+				UsernameSecretRef: &notifyingv1alpha1.AlamedaSecretKeyRef{Name: "smtp", Key: "username"},
+				PasswordSecretRef: &notifyingv1alpha1.AlamedaSecretKeyRef{Name: "smtp", Key: "password"},
+			},
+			Webhook: &notifyingv1alpha1.AlamedaWebhook{
+				URL:       "https://itsm.example.com",
+				SecretRef: &notifyingv1alpha1.AlamedaSecretKeyRef{Namespace: "alameda", Name: "itsm", Key: "secret"},
+				HeaderSecretRefs: map[string]notifyingv1alpha1.AlamedaSecretKeyRef{
+					"Authorization": {Name: "itsm", Key: "token"},
+				},
+			},
+		},
+	}
+	resolved, err := ResolveSecretRefs(reader, notificationChannel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.Spec.Email.Username != b64.StdEncoding.EncodeToString([]byte("user")) ||
+		resolved.Spec.Email.Password != b64.StdEncoding.EncodeToString([]byte("pass")) {
+		t.Errorf("unexpected email credentials %+v", resolved.Spec.Email)
+	}
+	if resolved.Spec.Webhook.Secret != b64.StdEncoding.EncodeToString([]byte("secret")) ||
+		resolved.Spec.Webhook.Headers["Authorization"] != "Bearer token" {
+		t.Errorf("unexpected webhook credentials %+v", resolved.Spec.Webhook)
+	}
+	if notificationChannel.Spec.Email.Password != "" || notificationChannel.Spec.Webhook.Headers != nil {
+		t.Error("expect credentials not set in the original channel")
+	}
+
+	notificationChannel.Spec.Email.PasswordSecretRef.Key = "missing"
+	if _, err := ResolveSecretRefs(reader, notificationChannel); err == nil {
+		t.Error("expect error of missing key")
+	}
+
+	notificationChannel.Spec.Email.PasswordSecretRef.Key = "password"
+	notificationChannel.Spec.Webhook.SecretRef.Namespace = "kube-system"
+	if _, err := ResolveSecretRefs(reader, notificationChannel); err == nil {
+		t.Error("expect error of secret out of namespace of channel secrets")
+	}
+}
+
+func TestGetSecretValue(t *testing.T) {
+	viper.Set("channelSecret.namespace", "alameda")
+	reader := fake.NewFakeClient(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "token"},
+		Data:       map[string][]byte{"token": []byte("token")},
+	})
+	if _, err := GetSecretValue(reader, &notifyingv1alpha1.AlamedaSecretKeyRef{
+		Namespace: "kube-system", Name: "token", Key: "token"}); err == nil {
+		t.Error("expect secret out of namespace of channel secrets not read")
+	}
+}
@@ -0,0 +1,107 @@
+package channel
+
+import (
+	"encoding/json"
+	"net/http"
+
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+	notifier_utils "github.com/containers-ai/alameda/notifier/utils"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/pkg/errors"
+)
+
+var slackColors = map[eventSeverity]string{
+	severityNormal:  "good",
+	severityWarning: "warning",
+	severityDanger:  "danger",
+}
+
+type slackMessage struct {
+	Channel     string            `json:"channel,omitempty"`
+	Username    string            `json:"username,omitempty"`
+	Text        string            `json:"text"`
+	Attachments []slackAttachment `json:"attachments,omitempty"`
+}
+
+type slackAttachment struct {
+	Color  string       `json:"color,omitempty"`
+	Text   string       `json:"text,omitempty"`
+	Fields []slackField `json:"fields,omitempty"`
+}
+
+type slackField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+// SlackClient posts events to the incoming webhook of Slack
+type SlackClient struct {
+	notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel
+	slackChannel        *notifyingv1alpha1.AlamedaSlackChannel
+	client              *http.Client
+	clusterInfo         *notifier_utils.ClusterInfo
+	template            *notificationTemplate
+}
+
+func NewSlackClient(notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel,
+	slackChannel *notifyingv1alpha1.AlamedaSlackChannel, clusterInfo *notifier_utils.ClusterInfo) (*SlackClient, error) {
+	if notificationChannel.Spec.Slack == nil || notificationChannel.Spec.Slack.WebhookURL == "" {
+		return nil, errors.Errorf("webhook url of slack channel %s is not set", notificationChannel.GetName())
+	}
+	tmpl, err := newNotificationTemplate(notificationChannel, false)
+	if err != nil {
+		return nil, err
+	}
+	return &SlackClient{
+		notificationChannel: notificationChannel,
+		slackChannel:        slackChannel,
+		client:              newHTTPClient(false),
+		clusterInfo:         clusterInfo,
+		template:            tmpl,
+	}, nil
+}
+
+func (slackClient *SlackClient) SendEvent(evt *datahub_v1alpha1.Event) error {
+	slack := slackClient.notificationChannel.Spec.Slack
+	data := notifier_utils.NewEventTemplateData(evt, slackClient.clusterInfo)
+	title, text, err := slackClient.template.render(data)
+	if err != nil {
+		return err
+	}
+	if title == "" {
+		title = eventTitle(data)
+	}
+
+	attachment := slackAttachment{
+		Color: slackColors[eventLevelSeverity(data.Level)],
+		Text:  text,
+	}
+	// facts of the event are listed only if the body is not rendered by template
+	if text == "" {
+		for _, fact := range eventFacts(data) {
+			attachment.Fields = append(attachment.Fields, slackField{
+				Title: fact.name,
+				Value: fact.value,
+				Short: fact.name != "Message",
+			})
+		}
+	}
+	msg := slackMessage{
+		Channel:     slack.Channel,
+		Username:    slack.Username,
+		Text:        title,
+		Attachments: []slackAttachment{attachment},
+	}
+	if slackClient.slackChannel != nil && slackClient.slackChannel.Channel != "" {
+		msg.Channel = slackClient.slackChannel.Channel
+	}
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(err, "encode slack message failed")
+	}
+	scope.Infof("Start posting event to slack channel %s (channel: %s, message: %s)",
+		slackClient.notificationChannel.GetName(), msg.Channel, evt.GetMessage())
+	return postJSON(slackClient.client, slack.WebhookURL, nil, body)
+}
@@ -0,0 +1,124 @@
+package channel
+
+import (
+	"context"
+	"text/template"
+
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+	notifier_utils "github.com/containers-ai/alameda/notifier/utils"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveTemplateRefs Return a copy of the notification channel with the template of the topic taking place
+// of the template of the channel if it is set, templates referred by ConfigMap refs are set in their inline fields
+func ResolveTemplateRefs(reader client.Reader, notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel,
+	topicTemplate *notifyingv1alpha1.AlamedaNotificationTemplate) (*notifyingv1alpha1.AlamedaNotificationChannel, error) {
+	resolved := notificationChannel.DeepCopy()
+	if topicTemplate != nil {
+		resolved.Spec.Template = topicTemplate.DeepCopy()
+	}
+
+	tmpl := resolved.Spec.Template
+	if tmpl == nil {
+		return resolved, nil
+	}
+	if tmpl.SubjectConfigMapRef != nil {
+		subject, err := GetConfigMapValue(reader, tmpl.SubjectConfigMapRef)
+		if err != nil {
+			return nil, err
+		}
+		tmpl.Subject = subject
+	}
+	if tmpl.BodyConfigMapRef != nil {
+		body, err := GetConfigMapValue(reader, tmpl.BodyConfigMapRef)
+		if err != nil {
+			return nil, err
+		}
+		tmpl.Body = body
+	}
+	return resolved, nil
+}
+
+// GetConfigMapValue Return value of the key in the ConfigMap referred by the ref
+func GetConfigMapValue(reader client.Reader, ref *notifyingv1alpha1.AlamedaConfigMapKeyRef) (string, error) {
+	namespace, err := notifier_utils.ChannelRefNamespace(ref.Namespace)
+	if err != nil {
+		return "", errors.Wrapf(err, "get configmap %s failed", ref.Name)
+	}
+	configMap := &corev1.ConfigMap{}
+	if err := reader.Get(context.TODO(), client.ObjectKey{
+		Namespace: namespace,
+		Name:      ref.Name,
+	}, configMap); err != nil {
+		return "", errors.Wrapf(err, "get configmap %s/%s failed", namespace, ref.Name)
+	}
+	value, ok := configMap.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf("key %s not found in configmap %s/%s", ref.Key, namespace, ref.Name)
+	}
+	return value, nil
+}
+
+// notificationTemplate is the parsed template of the notification channel
+type notificationTemplate struct {
+	subject *template.Template
+	body    notifier_utils.Template
+}
+
+// newNotificationTemplate Parse the template of the notification channel, body is parsed by html/template
+// if html is true. Nil is returned if the channel has no template.
+func newNotificationTemplate(notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel,
+	html bool) (*notificationTemplate, error) {
+	tmpl := notificationChannel.Spec.Template
+	if tmpl == nil {
+		return nil, nil
+	}
+
+	notificationTmpl := &notificationTemplate{}
+	if tmpl.Subject != "" {
+		subject, err := notifier_utils.ParseTemplate(notificationChannel.GetName()+"-subject", tmpl.Subject)
+		if err != nil {
+			return nil, err
+		}
+		notificationTmpl.subject = subject
+	}
+	if tmpl.Body != "" && html {
+		body, err := notifier_utils.ParseHTMLTemplate(notificationChannel.GetName()+"-body", tmpl.Body)
+		if err != nil {
+			return nil, err
+		}
+		notificationTmpl.body = body
+	} else if tmpl.Body != "" {
+		body, err := notifier_utils.ParseTemplate(notificationChannel.GetName()+"-body", tmpl.Body)
+		if err != nil {
+			return nil, err
+		}
+		notificationTmpl.body = body
+	}
+	return notificationTmpl, nil
+}
+
+// render Render subject and body with the data, they are empty if their templates are not set
+func (t *notificationTemplate) render(data *notifier_utils.EventTemplateData) (string, string, error) {
+	if t == nil {
+		return "", "", nil
+	}
+	subject, body := "", ""
+	if t.subject != nil {
+		rendered, err := notifier_utils.ExecuteTemplate(t.subject, data)
+		if err != nil {
+			return "", "", err
+		}
+		subject = string(rendered)
+	}
+	if t.body != nil {
+		rendered, err := notifier_utils.ExecuteTemplate(t.body, data)
+		if err != nil {
+			return "", "", err
+		}
+		body = string(rendered)
+	}
+	return subject, body, nil
+}
@@ -0,0 +1,103 @@
+package channel
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+	"github.com/containers-ai/alameda/notifier/event"
+	"github.com/spf13/viper"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestResolveTemplateRefs(t *testing.T) {
+	viper.Set("channelSecret.namespace", "alameda")
+	reader := fake.NewFakeClient(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "alameda", Name: "templates"},
+		Data: map[string]string{
+			"body": "{{ .Message }}",
+		},
+	})
+
+	notificationChannel := &notifyingv1alpha1.AlamedaNotificationChannel{
+		Spec: notifyingv1alpha1.AlamedaNotificationChannelSpec{
+			Template: &notifyingv1alpha1.AlamedaNotificationTemplate{Subject: "channel subject"},
+		},
+	}
+	resolved, err := ResolveTemplateRefs(reader, notificationChannel, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.Spec.Template.Subject != "channel subject" {
+		t.Errorf("expect template of channel kept, got %+v", resolved.Spec.Template)
+	}
+
+	resolved, err = ResolveTemplateRefs(reader, notificationChannel, &notifyingv1alpha1.AlamedaNotificationTemplate{
+		Subject:          "topic subject",
+		BodyConfigMapRef: &notifyingv1alpha1.AlamedaConfigMapKeyRef{Name: "templates", Key: "body"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.Spec.Template.Subject != "topic subject" || resolved.Spec.Template.Body != "{{ .Message }}" {
+		t.Errorf("expect template of topic with body in configmap, got %+v", resolved.Spec.Template)
+	}
+	if notificationChannel.Spec.Template.Subject != "channel subject" {
+		t.Error("expect notification channel not modified")
+	}
+
+	_, err = ResolveTemplateRefs(reader, notificationChannel, &notifyingv1alpha1.AlamedaNotificationTemplate{
+		BodyConfigMapRef: &notifyingv1alpha1.AlamedaConfigMapKeyRef{Name: "templates", Key: "subject"},
+	})
+	if err == nil {
+		t.Error("expect error of key not found")
+	}
+
+	_, err = ResolveTemplateRefs(reader, notificationChannel, &notifyingv1alpha1.AlamedaNotificationTemplate{
+		BodyConfigMapRef: &notifyingv1alpha1.AlamedaConfigMapKeyRef{Namespace: "kube-system", Name: "templates", Key: "body"},
+	})
+	if err == nil {
+		t.Error("expect error of configmap out of namespace of channel secrets")
+	}
+}
+
+func TestSlackClient_SendEventWithTemplate(t *testing.T) {
+	server, received := newReceiver(t, http.StatusOK)
+	defer server.Close()
+
+	notificationChannel := &notifyingv1alpha1.AlamedaNotificationChannel{
+		ObjectMeta: metav1.ObjectMeta{Name: "slack"},
+		Spec: notifyingv1alpha1.AlamedaNotificationChannelSpec{
+			Type:  notifyingv1alpha1.ChannelTypeSlack,
+			Slack: &notifyingv1alpha1.AlamedaSlack{WebhookURL: server.URL},
+			Template: &notifyingv1alpha1.AlamedaNotificationTemplate{
+				Subject: "{{ .Subject.Kind }} {{ .Subject.Name }}",
+				Body:    "scale from {{ .Data.currentReplicas }} to {{ .Data.desiredReplicas }}",
+			},
+		},
+	}
+	slackClient, err := NewSlackClient(notificationChannel, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := slackClient.SendEvent(event.GetChannelTestEvent("slack", "cluster-uid")); err != nil {
+		t.Fatal(err)
+	}
+
+	msg := slackMessage{}
+	if err := json.Unmarshal((<-received).body, &msg); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Text != "AlamedaNotificationChannel slack" || len(msg.Attachments) != 1 ||
+		msg.Attachments[0].Text != "scale from 2 to 3" || len(msg.Attachments[0].Fields) != 0 {
+		t.Errorf("unexpected slack message %+v", msg)
+	}
+
+	// fields missing in data of the event fail rendering rather than sending an incomplete message
+	if err := slackClient.SendEvent(testEvent()); err == nil {
+		t.Error("expect error of event without data")
+	}
+}
@@ -0,0 +1,109 @@
+package channel
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	b64 "encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"text/template"
+
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+	notifier_utils "github.com/containers-ai/alameda/notifier/utils"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/pkg/errors"
+)
+
+const (
+	// WebhookSignatureHeader is the header of HMAC-SHA256 signature of the body in format "sha256=<hex>"
+	WebhookSignatureHeader = "X-Alameda-Signature"
+)
+
+// WebhookClient posts events in JSON to the url of webhook channel
+type WebhookClient struct {
+	notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel
+	client              *http.Client
+	bodyTemplate        *template.Template
+	secret              []byte
+	clusterInfo         *notifier_utils.ClusterInfo
+}
+
+func NewWebhookClient(notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel,
+	clusterInfo *notifier_utils.ClusterInfo) (*WebhookClient, error) {
+	webhook := notificationChannel.Spec.Webhook
+	if webhook == nil || webhook.URL == "" {
+		return nil, errors.Errorf("url of webhook channel %s is not set", notificationChannel.GetName())
+	}
+
+	webhookClient := &WebhookClient{
+		notificationChannel: notificationChannel,
+		client:              newHTTPClient(webhook.InsecureSkipVerify),
+		clusterInfo:         clusterInfo,
+	}
+	// body of the notification template takes place of body template of the webhook
+	bodyTemplate := webhook.BodyTemplate
+	if tmpl := notificationChannel.Spec.Template; tmpl != nil && tmpl.Body != "" {
+		bodyTemplate = tmpl.Body
+	}
+	if bodyTemplate != "" {
+		tmpl, err := notifier_utils.ParseTemplate(notificationChannel.GetName(), bodyTemplate)
+		if err != nil {
+			return nil, err
+		}
+		webhookClient.bodyTemplate = tmpl
+	}
+	if webhook.Secret != "" {
+		secret, err := b64.StdEncoding.DecodeString(webhook.Secret)
+		if err != nil {
+			return nil, errors.Wrap(err, "decode secret failed")
+		}
+		webhookClient.secret = secret
+	}
+	return webhookClient, nil
+}
+
+func (webhookClient *WebhookClient) SendEvent(evt *datahub_v1alpha1.Event) error {
+	body, err := webhookClient.buildBody(evt)
+	if err != nil {
+		return err
+	}
+
+	webhook := webhookClient.notificationChannel.Spec.Webhook
+	headers := map[string]string{}
+	for key, value := range webhook.Headers {
+		headers[key] = value
+	}
+	if len(webhookClient.secret) > 0 {
+		headers[WebhookSignatureHeader] = SignWebhookBody(webhookClient.secret, body)
+	}
+
+	scope.Infof("Start posting event to webhook channel %s (message: %s)",
+		webhookClient.notificationChannel.GetName(), evt.GetMessage())
+	return postJSON(webhookClient.client, webhook.URL, headers, body)
+}
+
+// buildBody Render body of the event with the body template, event is encoded in JSON if no template is set
+func (webhookClient *WebhookClient) buildBody(evt *datahub_v1alpha1.Event) ([]byte, error) {
+	data := notifier_utils.NewEventTemplateData(evt, webhookClient.clusterInfo)
+	if webhookClient.bodyTemplate == nil {
+		return json.Marshal(data)
+	}
+
+	body, err := notifier_utils.ExecuteTemplate(webhookClient.bodyTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(body) {
+		return nil, errors.Errorf("body rendered by template of webhook channel %s is not valid JSON",
+			webhookClient.notificationChannel.GetName())
+	}
+	return body, nil
+}
+
+// SignWebhookBody Return signature of the body in header X-Alameda-Signature signed with the secret
+func SignWebhookBody(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
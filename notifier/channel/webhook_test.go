@@ -0,0 +1,136 @@
+package channel
+
+import (
+	b64 "encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/spf13/viper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func newReceiver(t *testing.T, status int) (*httptest.Server, chan receivedRequest) {
+	received := make(chan receivedRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		received <- receivedRequest{header: r.Header, body: body}
+		w.WriteHeader(status)
+	}))
+	return server, received
+}
+
+func testEvent() *datahub_v1alpha1.Event {
+	return &datahub_v1alpha1.Event{
+		Time:      &timestamp.Timestamp{Seconds: 1570000000},
+		ClusterId: "cluster-uid",
+		Level:     datahub_v1alpha1.EventLevel_EVENT_LEVEL_WARNING,
+		Subject: &datahub_v1alpha1.K8SObjectReference{
+			Kind:      "Pod",
+			Namespace: "default",
+			Name:      "pod1",
+		},
+		Message: `pod "pod1" is evicted`,
+	}
+}
+
+func TestWebhookClient_SendEvent(t *testing.T) {
+	server, received := newReceiver(t, http.StatusOK)
+	defer server.Close()
+
+	notificationChannel := &notifyingv1alpha1.AlamedaNotificationChannel{
+		ObjectMeta: metav1.ObjectMeta{Name: "webhook"},
+		Spec: notifyingv1alpha1.AlamedaNotificationChannelSpec{
+			Type: notifyingv1alpha1.ChannelTypeWebhook,
+			Webhook: &notifyingv1alpha1.AlamedaWebhook{
+				URL:          server.URL,
+				Headers:      map[string]string{"Authorization": "Bearer token"},
+				Secret:       b64.StdEncoding.EncodeToString([]byte("secret")),
+				BodyTemplate: `{"summary": {{ json .Message }}, "pod": "{{ .Subject.Namespace }}/{{ .Subject.Name }}"}`,
+			},
+		},
+	}
+	webhookClient, err := NewWebhookClient(notificationChannel, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := webhookClient.SendEvent(testEvent()); err != nil {
+		t.Fatal(err)
+	}
+
+	req := <-received
+	body := map[string]string{}
+	if err := json.Unmarshal(req.body, &body); err != nil {
+		t.Fatalf("expect JSON body, got %s: %v", req.body, err)
+	}
+	if body["summary"] != `pod "pod1" is evicted` || body["pod"] != "default/pod1" {
+		t.Errorf("unexpected body %s", req.body)
+	}
+	if req.header.Get("Authorization") != "Bearer token" {
+		t.Errorf("expect header set, got %v", req.header)
+	}
+	if signature := req.header.Get(WebhookSignatureHeader); signature != SignWebhookBody([]byte("secret"), req.body) {
+		t.Errorf("unexpected signature %s", signature)
+	}
+}
+
+func TestSlackAndMSTeamsClient_SendEvent(t *testing.T) {
+	viper.Set("eventLevel.3", "warning")
+	server, received := newReceiver(t, http.StatusInternalServerError)
+	defer server.Close()
+
+	notificationChannel := &notifyingv1alpha1.AlamedaNotificationChannel{
+		Spec: notifyingv1alpha1.AlamedaNotificationChannelSpec{
+			Type:    notifyingv1alpha1.ChannelTypeSlack,
+			Slack:   &notifyingv1alpha1.AlamedaSlack{WebhookURL: server.URL + "/services/token"},
+			MSTeams: &notifyingv1alpha1.AlamedaMSTeams{WebhookURL: server.URL + "/webhook/token"},
+		},
+	}
+	slackClient, err := NewSlackClient(notificationChannel, &notifyingv1alpha1.AlamedaSlackChannel{Channel: "#ops"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = slackClient.SendEvent(testEvent())
+	if err == nil {
+		t.Fatal("expect error of status 500")
+	}
+	if strings.Contains(err.Error(), "token") {
+		t.Errorf("expect url redacted in error, got %s", err.Error())
+	}
+	msg := slackMessage{}
+	if err := json.Unmarshal((<-received).body, &msg); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Channel != "#ops" || len(msg.Attachments) != 1 || msg.Attachments[0].Color != "warning" {
+		t.Errorf("unexpected slack message %+v", msg)
+	}
+
+	msTeamsClient, err := NewMSTeamsClient(notificationChannel, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := msTeamsClient.SendEvent(testEvent()); err == nil {
+		t.Fatal("expect error of status 500")
+	}
+	card := msTeamsMessage{}
+	if err := json.Unmarshal((<-received).body, &card); err != nil {
+		t.Fatal(err)
+	}
+	if card.Type != "MessageCard" || len(card.Sections) != 1 || len(card.Sections[0].Facts) == 0 {
+		t.Errorf("unexpected msteams message %+v", card)
+	}
+}
@@ -18,15 +18,20 @@ package controllers
 
 import (
 	"context"
+	b64 "encoding/base64"
 	"fmt"
 	"time"
 
 	k8s_utils "github.com/containers-ai/alameda/pkg/utils/kubernetes"
 	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
 	"github.com/containers-ai/alameda/notifier/channel"
+	"github.com/containers-ai/alameda/notifier/event"
 	notifier_utils "github.com/containers-ai/alameda/notifier/utils"
 	logUtil "github.com/containers-ai/alameda/pkg/utils/log"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	k8sapierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -38,10 +43,15 @@ var (
 // AlamedaNotificationChannelReconciler reconciles a AlamedaNotificationChannel object
 type AlamedaNotificationChannelReconciler struct {
 	client.Client
+	// APIReader reads Secrets and ConfigMaps referred by channels from apiserver so that they are not cached
+	APIReader client.Reader
 }
 
 // +kubebuilder:rbac:groups=notifying.containers.ai,resources=alamedanotificationchannels,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=notifying.containers.ai,resources=alamedanotificationchannels/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",namespace=alameda,resources=secrets,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups="",namespace=alameda,resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,resourceNames=cluster-info,verbs=get
 
 func (r *AlamedaNotificationChannelReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
@@ -54,49 +64,74 @@ func (r *AlamedaNotificationChannelReconciler) Reconcile(req ctrl.Request) (ctrl
 		}
 	}
 
+	if notifier_utils.ChannelSecretMigrationEnabled() && alamedaNotificationChannel.HasInlineCredentials() {
+		if err := r.migrateInlineCredentials(ctx, alamedaNotificationChannel); err != nil {
+			channelScope.Errorf("move inline credentials of channel %s into secret failed: %s", req.Name, err.Error())
+			return ctrl.Result{}, err
+		}
+		channelScope.Infof("inline credentials of channel %s are moved into secret", req.Name)
+	}
+
 	if alamedaNotificationChannel.GetAnnotations()["notifying.containers.ai/test-channel"] == "start" {
-		if alamedaNotificationChannel.Spec.Type == "email" {
-			channelScope.Infof("start testing email channel %s", req.Name)
-			err = r.testEmailChannel(alamedaNotificationChannel)
-			if err != nil {
-				alamedaNotificationChannel.Status.ChannelTest = &notifyingv1alpha1.AlamedaChannelTest{
-					Success: false,
-					Time:    time.Now().Format(time.RFC3339),
-					Message: err.Error(),
-				}
-				channelScope.Errorf("test email channel %s failed: %s", req.Name, err.Error())
-
-				annotations := alamedaNotificationChannel.GetAnnotations()
-				annotations["notifying.containers.ai/test-channel"] = "done"
-				alamedaNotificationChannel.SetAnnotations(annotations)
-
-				if updateErr := r.Update(ctx, alamedaNotificationChannel); updateErr != nil {
-					channelScope.Errorf("update test annotation and status for email channel %s failed: %s",
-						req.Name, err.Error())
-					return ctrl.Result{}, updateErr
-				}
-				return ctrl.Result{}, err
-			}
-
-			channelScope.Infof("test email channel %s successful", req.Name)
-			annotations := alamedaNotificationChannel.GetAnnotations()
-			annotations["notifying.containers.ai/test-channel"] = "done"
-			alamedaNotificationChannel.SetAnnotations(annotations)
-			alamedaNotificationChannel.Status.ChannelTest = &notifyingv1alpha1.AlamedaChannelTest{
-				Success: true,
-				Time:    time.Now().Format(time.RFC3339),
-			}
-			if updateErr := r.Update(ctx, alamedaNotificationChannel); updateErr != nil {
-				channelScope.Errorf("update test annotation and status for email channel %s failed: %s",
-					req.Name, err.Error())
-				return ctrl.Result{}, updateErr
-			}
+		channelType := alamedaNotificationChannel.Spec.Type
+		channelScope.Infof("start testing %s channel %s", channelType, req.Name)
+		err = r.testChannel(alamedaNotificationChannel)
+		channelTest := &notifyingv1alpha1.AlamedaChannelTest{
+			Success: err == nil,
+			Time:    time.Now().Format(time.RFC3339),
+		}
+		if err != nil {
+			channelTest.Message = err.Error()
+			channelScope.Errorf("test %s channel %s failed: %s", channelType, req.Name, err.Error())
+		} else {
+			channelScope.Infof("test %s channel %s successful", channelType, req.Name)
 		}
+
+		annotations := alamedaNotificationChannel.GetAnnotations()
+		annotations["notifying.containers.ai/test-channel"] = "done"
+		alamedaNotificationChannel.SetAnnotations(annotations)
+		alamedaNotificationChannel.Status.ChannelTest = channelTest
+		if updateErr := r.Update(ctx, alamedaNotificationChannel); updateErr != nil {
+			channelScope.Errorf("update test annotation and status for %s channel %s failed: %s",
+				channelType, req.Name, updateErr.Error())
+			return ctrl.Result{}, updateErr
+		}
+		return ctrl.Result{}, err
 	}
 
 	return ctrl.Result{}, nil
 }
 
+// testChannel Send a test message with the channel, email channels and channels without type
+// send it to the recipient in annotation notifying.containers.ai/test-channel-to. The test message
+// is rendered by the template of the channel if it is set.
+func (r *AlamedaNotificationChannelReconciler) testChannel(
+	alamedaNotificationChannel *notifyingv1alpha1.AlamedaNotificationChannel) error {
+	alamedaNotificationChannel, err := channel.ResolveSecretRefs(r.APIReader, alamedaNotificationChannel)
+	if err != nil {
+		return err
+	}
+	alamedaNotificationChannel, err = channel.ResolveTemplateRefs(r.APIReader, alamedaNotificationChannel, nil)
+	if err != nil {
+		return err
+	}
+	if channelType := alamedaNotificationChannel.Spec.Type; channelType == "" || channelType == notifyingv1alpha1.ChannelTypeEmail {
+		return r.testEmailChannel(alamedaNotificationChannel)
+	}
+
+	clusterInfo, err := r.getClusterInfo()
+	if err != nil {
+		channelScope.Errorf("unable to send test message due to get cluster info fail: %s", err.Error())
+		return err
+	}
+	notificationChannel, err := channel.NewChannel(alamedaNotificationChannel, clusterInfo)
+	if err != nil {
+		return err
+	}
+	return notificationChannel.SendEvent(
+		event.GetChannelTestEvent(alamedaNotificationChannel.GetName(), clusterInfo.UID))
+}
+
 func (r *AlamedaNotificationChannelReconciler) testEmailChannel(
 	alamedaNotificationChannel *notifyingv1alpha1.AlamedaNotificationChannel) error {
 	annotations := alamedaNotificationChannel.GetAnnotations()
@@ -108,23 +143,22 @@ func (r *AlamedaNotificationChannelReconciler) testEmailChannel(
 		return fmt.Errorf(errMsg)
 	}
 
-	clusterInfo, err := notifier_utils.GetClusterInfo(r.Client)
+	clusterInfo, err := r.getClusterInfo()
 	if err != nil {
 		channelScope.Errorf("unable to send test email due to get cluster info fail: %s", err.Error())
 		return err
 	}
-	uid, err := k8s_utils.GetClusterUID(r.Client)
-	if err != nil {
-		channelScope.Errorf("unable to send test email due to get cluster id fail: %s", err.Error())
-		return err
-	}
-	clusterInfo.UID = uid
 
-	emailChannel := &notifyingv1alpha1.AlamedaEmailChannel{}
-	emailClient, err := channel.NewEmailClient(alamedaNotificationChannel, emailChannel, &clusterInfo)
+	emailChannel := &notifyingv1alpha1.AlamedaEmailChannel{
+		To: []string{to},
+	}
+	emailClient, err := channel.NewEmailClient(alamedaNotificationChannel, emailChannel, clusterInfo)
 	if err != nil {
 		return err
 	}
+	if alamedaNotificationChannel.Spec.Template != nil {
+		return emailClient.SendEvent(event.GetChannelTestEvent(alamedaNotificationChannel.GetName(), clusterInfo.UID))
+	}
 	subject := "Test Email"
 	recipients := []string{to}
 	ccs := []string{}
@@ -151,6 +185,124 @@ func (r *AlamedaNotificationChannelReconciler) testEmailChannel(
 	return nil
 }
 
+// migrateInlineCredentials Move inline credentials of the channel into the Secret owned by the channel
+// and refer to them by secret refs instead
+func (r *AlamedaNotificationChannelReconciler) migrateInlineCredentials(ctx context.Context,
+	alamedaNotificationChannel *notifyingv1alpha1.AlamedaNotificationChannel) error {
+	namespace := notifier_utils.ChannelSecretNamespace()
+	if namespace == "" {
+		return errors.New("namespace of channel secrets is not configured")
+	}
+	secretName := notifier_utils.ChannelSecretNamePrefix + alamedaNotificationChannel.GetName()
+	secretData := map[string][]byte{}
+	newSecretKeyRef := func(key string, value []byte) *notifyingv1alpha1.AlamedaSecretKeyRef {
+		secretData[key] = value
+		return &notifyingv1alpha1.AlamedaSecretKeyRef{Namespace: namespace, Name: secretName, Key: key}
+	}
+
+	spec := &alamedaNotificationChannel.Spec
+	if spec.Email.Username != "" {
+		username, err := b64.StdEncoding.DecodeString(spec.Email.Username)
+		if err != nil {
+			return errors.Wrap(err, "decode username failed")
+		}
+		spec.Email.UsernameSecretRef = newSecretKeyRef("username", username)
+		spec.Email.Username = ""
+	}
+	if spec.Email.Password != "" {
+		password, err := b64.StdEncoding.DecodeString(spec.Email.Password)
+		if err != nil {
+			return errors.Wrap(err, "decode password failed")
+		}
+		spec.Email.PasswordSecretRef = newSecretKeyRef("password", password)
+		spec.Email.Password = ""
+	}
+	if spec.Webhook != nil && spec.Webhook.Secret != "" {
+		secret, err := b64.StdEncoding.DecodeString(spec.Webhook.Secret)
+		if err != nil {
+			return errors.Wrap(err, "decode webhook secret failed")
+		}
+		spec.Webhook.SecretRef = newSecretKeyRef("webhookSecret", secret)
+		spec.Webhook.Secret = ""
+	}
+	if spec.Slack != nil && spec.Slack.WebhookURL != "" {
+		spec.Slack.WebhookURLSecretRef = newSecretKeyRef("slackWebhookUrl", []byte(spec.Slack.WebhookURL))
+		spec.Slack.WebhookURL = ""
+	}
+	if spec.MSTeams != nil && spec.MSTeams.WebhookURL != "" {
+		spec.MSTeams.WebhookURLSecretRef = newSecretKeyRef("msteamsWebhookUrl", []byte(spec.MSTeams.WebhookURL))
+		spec.MSTeams.WebhookURL = ""
+	}
+
+	if err := r.applyChannelSecret(ctx, alamedaNotificationChannel, namespace, secretName, secretData); err != nil {
+		return err
+	}
+	if err := r.Update(ctx, alamedaNotificationChannel); err != nil {
+		return errors.Wrap(err, "update secret refs of channel failed")
+	}
+	return nil
+}
+
+// applyChannelSecret Create the Secret owned by the channel with the data or add the data to the existing one
+func (r *AlamedaNotificationChannelReconciler) applyChannelSecret(ctx context.Context,
+	alamedaNotificationChannel *notifyingv1alpha1.AlamedaNotificationChannel,
+	namespace, name string, data map[string][]byte) error {
+	secret := &corev1.Secret{}
+	err := r.APIReader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret)
+	if err != nil && !k8sapierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "get secret %s/%s failed", namespace, name)
+	}
+	if k8sapierrors.IsNotFound(err) {
+		isController := true
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: notifyingv1alpha1.GroupVersion.String(),
+						Kind:       "AlamedaNotificationChannel",
+						Name:       alamedaNotificationChannel.GetName(),
+						UID:        alamedaNotificationChannel.GetUID(),
+						Controller: &isController,
+					},
+				},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: data,
+		}
+		if err := r.Create(ctx, secret); err != nil {
+			return errors.Wrapf(err, "create secret %s/%s failed", namespace, name)
+		}
+		return nil
+	}
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	for key, value := range data {
+		secret.Data[key] = value
+	}
+	if err := r.Update(ctx, secret); err != nil {
+		return errors.Wrapf(err, "update secret %s/%s failed", namespace, name)
+	}
+	return nil
+}
+
+// getClusterInfo Return information of the cluster with its id
+func (r *AlamedaNotificationChannelReconciler) getClusterInfo() (*notifier_utils.ClusterInfo, error) {
+	clusterInfo, err := notifier_utils.GetClusterInfo(r.Client)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := k8s_utils.GetClusterUID(r.Client)
+	if err != nil {
+		return nil, errors.Wrap(err, "get cluster id failed")
+	}
+	clusterInfo.UID = uid
+	return &clusterInfo, nil
+}
+
 func (r *AlamedaNotificationChannelReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&notifyingv1alpha1.AlamedaNotificationChannel{}).
@@ -18,8 +18,12 @@ package controllers
 
 import (
 	"context"
+	"reflect"
+	"strings"
 
+	"github.com/containers-ai/alameda/notifier/notifying"
 	logUtil "github.com/containers-ai/alameda/pkg/utils/log"
+	k8sapierrors "k8s.io/apimachinery/pkg/api/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -38,8 +42,32 @@ type AlamedaNotificationTopicReconciler struct {
 // +kubebuilder:rbac:groups=notifying.containers.ai,resources=alamedanotificationtopics,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=notifying.containers.ai,resources=alamedanotificationtopics/status,verbs=get;update;patch
 
+// Reconcile Compile regular expressions of subjects of the topic ahead of events and report
+// the invalid glob patterns and regular expressions in status of the topic
 func (r *AlamedaNotificationTopicReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
-	_ = context.Background()
+	ctx := context.Background()
+	alamedaNotificationTopic := &notifyingv1alpha1.AlamedaNotificationTopic{}
+	if err := r.Get(ctx, req.NamespacedName, alamedaNotificationTopic); err != nil {
+		if k8sapierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		topicScope.Errorf(err.Error())
+		return ctrl.Result{}, err
+	}
+
+	invalidPatterns := notifying.InvalidPatterns(alamedaNotificationTopic.Spec.Topics)
+	if len(invalidPatterns) > 0 {
+		topicScope.Warnf("topic %s has invalid patterns, subjects with them match no events: %s",
+			req.Name, strings.Join(invalidPatterns, "; "))
+	}
+	if reflect.DeepEqual(alamedaNotificationTopic.Status.InvalidPatterns, invalidPatterns) {
+		return ctrl.Result{}, nil
+	}
+	alamedaNotificationTopic.Status.InvalidPatterns = invalidPatterns
+	if err := r.Update(ctx, alamedaNotificationTopic); err != nil {
+		topicScope.Errorf("update invalid patterns status of topic %s failed: %s", req.Name, err.Error())
+		return ctrl.Result{}, err
+	}
 	return ctrl.Result{}, nil
 }
 
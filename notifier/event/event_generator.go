@@ -1,6 +1,8 @@
 package event
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/containers-ai/alameda/pkg/utils"
@@ -25,3 +27,47 @@ func GetEmailNotificationEvent(msg, podName, clusterId string) *datahub_v1alpha1
 		Message: msg,
 	}
 }
+
+// channelTestEventData is data of channel test events, it has fields of data of recommendations
+// executed by evictioner so that notification templates referring to them can be tested
+var channelTestEventData = map[string]interface{}{
+	"controller":      "Deployment/default/example",
+	"alamedaScaler":   "default/example",
+	"currentReplicas": 2,
+	"desiredReplicas": 3,
+	"dryRun":          true,
+	"deltas": []map[string]interface{}{
+		{
+			"container":          "example",
+			"resource":           "cpu",
+			"recommendationType": "limit",
+			"current":            "500m",
+			"recommended":        "750m",
+			"deltaPercentage":    50.0,
+			"threshold":          20.0,
+			"exceedThreshold":    true,
+		},
+	},
+}
+
+// GetChannelTestEvent Return event sent to test the notification channel, it is also the sample
+// event notification templates are validated with
+func GetChannelTestEvent(channelName, clusterId string) *datahub_v1alpha1.Event {
+	data, _ := json.Marshal(channelTestEventData)
+	return &datahub_v1alpha1.Event{
+		Time: &timestamp.Timestamp{
+			Seconds: time.Now().Unix(),
+		},
+		ClusterId: clusterId,
+		Type:      datahub_v1alpha1.EventType_EVENT_TYPE_EMAIL_NOTIFICATION,
+		Version:   datahub_v1alpha1.EventVersion_EVENT_VERSION_V1,
+		Level:     datahub_v1alpha1.EventLevel_EVENT_LEVEL_INFO,
+		Subject: &datahub_v1alpha1.K8SObjectReference{
+			Kind:       "AlamedaNotificationChannel",
+			Name:       channelName,
+			ApiVersion: "notifying.containers.ai/v1alpha1",
+		},
+		Message: fmt.Sprintf("This is a test message of Federator.ai notification channel %s", channelName),
+		Data:    string(data),
+	}
+}
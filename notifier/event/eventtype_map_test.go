@@ -0,0 +1,37 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestEventTypeYamlKeysOfExtendedTypes(t *testing.T) {
+	config := viper.New()
+	config.SetConfigFile("../etc/notifier.toml")
+	if err := config.ReadInConfig(); err != nil {
+		t.Fatalf("read notifier config failed: %s", err.Error())
+	}
+	viper.Set("eventType", config.GetStringMap("eventType"))
+
+	// Event types defined in pkg/framework/datahub/event of alameda instead of containers-ai/api
+	tests := []struct {
+		yamlKey   string
+		eventType int32
+	}{
+		{yamlKey: "VPARecommendationEvictionRefused", eventType: 101},
+		{yamlKey: "VPARecommendationQuotaExceeded", eventType: 102},
+		{yamlKey: "VPARecommendationDryRun", eventType: 103},
+		{yamlKey: "HPARecommendationDryRun", eventType: 104},
+	}
+	for _, tt := range tests {
+		t.Run(tt.yamlKey, func(t *testing.T) {
+			if got := EventTypeYamlKeyToIntMap(tt.yamlKey); got != tt.eventType {
+				t.Errorf("EventTypeYamlKeyToIntMap() = %d, want %d", got, tt.eventType)
+			}
+			if got := EventTypeIntToYamlKeyMap(tt.eventType); got != tt.yamlKey {
+				t.Errorf("EventTypeIntToYamlKeyMap() = %s, want %s", got, tt.yamlKey)
+			}
+		})
+	}
+}
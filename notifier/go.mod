@@ -10,6 +10,7 @@ require (
 	github.com/google/gofuzz v1.0.0 // indirect
 	github.com/grpc-ecosystem/go-grpc-middleware v1.0.0
 	github.com/pkg/errors v0.8.1
+	github.com/prometheus/client_golang v0.9.3
 	github.com/spf13/cobra v0.0.5 // indirect
 	github.com/spf13/pflag v1.0.3
 	github.com/spf13/viper v1.4.0
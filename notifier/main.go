@@ -24,7 +24,9 @@ import (
 	"github.com/containers-ai/alameda/notifier/controllers"
 	"github.com/containers-ai/alameda/notifier/probe"
 	"github.com/containers-ai/alameda/notifier/queue"
+	notifier_utils "github.com/containers-ai/alameda/notifier/utils"
 	"github.com/containers-ai/alameda/pkg/utils/log"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -33,6 +35,7 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	// +kubebuilder:scaffold:imports
 )
@@ -107,7 +110,8 @@ func main() {
 		os.Exit(1)
 	}
 	if err = (&controllers.AlamedaNotificationChannelReconciler{
-		Client: mgr.GetClient(),
+		Client:    mgr.GetClient(),
+		APIReader: mgr.GetAPIReader(),
 	}).SetupWithManager(mgr); err != nil {
 		scope.Errorf("unable to create controller: %s", err.Error())
 		os.Exit(1)
@@ -121,6 +125,10 @@ func main() {
 		scope.Errorf("unable to create webhook: %s", err.Error())
 		os.Exit(1)
 	}
+	if err = (&notifyingv1alpha1.AlamedaNotificationSilence{}).SetupWebhookWithManager(mgr); err != nil {
+		scope.Errorf("unable to create webhook: %s", err.Error())
+		os.Exit(1)
+	}
 
 	if viper.IsSet("k8sWebhook.port") {
 		whSrv := mgr.GetWebhookServer()
@@ -129,9 +137,26 @@ func main() {
 
 	datahubAddr := viper.GetString("datahub.address")
 	datahubConnRetry := viper.GetInt("datahub.connRetry")
-	conn, err := grpc.Dial(datahubAddr, grpc.WithInsecure(),
-		grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(
-			grpc_retry.WithMax(uint(datahubConnRetry)))))
+	dialOpts, err := notifier_utils.DatahubDialOptions()
+	if err != nil {
+		scope.Errorf("build datahub credentials failed: %s", err.Error())
+		os.Exit(1)
+	}
+	// cache of manager is not started yet, cluster id is read with a client reading from API server
+	k8sClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: scheme})
+	if err != nil {
+		scope.Errorf("unable to create kubernetes client: %s", err.Error())
+		os.Exit(1)
+	}
+	datahubClusterID, err := notifier_utils.DatahubClusterID(k8sClient)
+	if err != nil {
+		scope.Errorf("get cluster id failed: %s", err.Error())
+		os.Exit(1)
+	}
+	dialOpts = append(dialOpts, grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(
+		notifier_utils.DatahubClusterInterceptor(datahubClusterID),
+		grpc_retry.UnaryClientInterceptor(grpc_retry.WithMax(uint(datahubConnRetry))))))
+	conn, err := grpc.Dial(datahubAddr, dialOpts...)
 	// +kubebuilder:scaffold:builder
 	go launchQueueConsumer(mgr, conn)
 
@@ -0,0 +1,90 @@
+package notifying
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+)
+
+const (
+	// dedupPruneInterval is the interval expired dedup keys are removed
+	dedupPruneInterval = time.Minute
+)
+
+var defaultDedupKeys = []string{
+	notifyingv1alpha1.EventFieldType,
+	notifyingv1alpha1.EventFieldLevel,
+	notifyingv1alpha1.EventFieldSubject,
+	notifyingv1alpha1.EventFieldMessage,
+}
+
+// eventKey Return the key of the event composed of the fields
+func eventKey(fields []string, evt *datahub_v1alpha1.Event) string {
+	parts := []string{}
+	for _, eventField := range fields {
+		switch eventField {
+		case notifyingv1alpha1.EventFieldType:
+			parts = append(parts, fmt.Sprintf("type=%d", int32(evt.GetType())))
+		case notifyingv1alpha1.EventFieldLevel:
+			parts = append(parts, fmt.Sprintf("level=%d", int32(evt.GetLevel())))
+		case notifyingv1alpha1.EventFieldSubject:
+			subject := evt.GetSubject()
+			parts = append(parts, fmt.Sprintf("subject=%s/%s/%s/%s", subject.GetApiVersion(),
+				subject.GetKind(), subject.GetNamespace(), subject.GetName()))
+		case notifyingv1alpha1.EventFieldSource:
+			parts = append(parts, fmt.Sprintf("source=%s/%s", evt.GetSource().GetHost(), evt.GetSource().GetComponent()))
+		case notifyingv1alpha1.EventFieldMessage:
+			parts = append(parts, fmt.Sprintf("message=%s", evt.GetMessage()))
+		}
+	}
+	return strings.Join(parts, "|")
+}
+
+// dedupCache keeps dedup keys of events notified by topics until their repeat intervals end,
+// keys are kept in memory so events may be notified again once notifier restarts
+type dedupCache struct {
+	lock       sync.Mutex
+	expiresAt  map[string]time.Time
+	lastPruned time.Time
+}
+
+func newDedupCache() *dedupCache {
+	return &dedupCache{
+		expiresAt: map[string]time.Time{},
+	}
+}
+
+// accept Return whether the event is notified by the topic, events of the same dedup key as
+// one notified within the repeat interval of the topic are not
+func (c *dedupCache) accept(topic *notifyingv1alpha1.AlamedaNotificationTopic,
+	evt *datahub_v1alpha1.Event, now time.Time) bool {
+	dedup := topic.Spec.Dedup
+	if dedup == nil || dedup.RepeatIntervalSeconds <= 0 {
+		return true
+	}
+	keys := dedup.Keys
+	if len(keys) == 0 {
+		keys = defaultDedupKeys
+	}
+	key := topic.GetName() + "|" + eventKey(keys, evt)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if now.Sub(c.lastPruned) >= dedupPruneInterval {
+		for cachedKey, expiresAt := range c.expiresAt {
+			if !now.Before(expiresAt) {
+				delete(c.expiresAt, cachedKey)
+			}
+		}
+		c.lastPruned = now
+	}
+	if expiresAt, ok := c.expiresAt[key]; ok && now.Before(expiresAt) {
+		return false
+	}
+	c.expiresAt[key] = now.Add(time.Duration(dedup.RepeatIntervalSeconds) * time.Second)
+	return true
+}
@@ -0,0 +1,168 @@
+package notifying
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+)
+
+const (
+	// digestFlushInterval is the interval digests are checked whether their windows end
+	digestFlushInterval = time.Second
+	// maxDigestMessages is the max number of distinct messages listed in a digest
+	maxDigestMessages = 10
+)
+
+var defaultDigestGroupBy = []string{
+	notifyingv1alpha1.EventFieldType,
+	notifyingv1alpha1.EventFieldSubject,
+}
+
+// digestGroup is events of a topic grouped in one message until the window ends
+type digestGroup struct {
+	topic  *notifyingv1alpha1.AlamedaNotificationTopic
+	events []*datahub_v1alpha1.Event
+	window time.Duration
+	dueAt  time.Time
+}
+
+// digestBuffer holds events of topics with digest until windows of their groups end
+type digestBuffer struct {
+	lock   sync.Mutex
+	groups map[string]*digestGroup
+}
+
+func newDigestBuffer() *digestBuffer {
+	return &digestBuffer{
+		groups: map[string]*digestGroup{},
+	}
+}
+
+// add Add the event to its digest group of the topic, false is returned if the topic does not digest events
+func (b *digestBuffer) add(topic *notifyingv1alpha1.AlamedaNotificationTopic,
+	evt *datahub_v1alpha1.Event, now time.Time) bool {
+	digest := topic.Spec.Digest
+	if digest == nil || digest.WindowSeconds <= 0 {
+		return false
+	}
+	groupBy := digest.GroupBy
+	if len(groupBy) == 0 {
+		groupBy = defaultDigestGroupBy
+	}
+	key := topic.GetName() + "|" + eventKey(groupBy, evt)
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	group, ok := b.groups[key]
+	if !ok {
+		window := time.Duration(digest.WindowSeconds) * time.Second
+		group = &digestGroup{
+			window: window,
+			dueAt:  now.Add(window),
+		}
+		b.groups[key] = group
+	}
+	// the latest topic is used to send the digest in case its channels are changed
+	group.topic = topic.DeepCopy()
+	group.events = append(group.events, evt)
+	return true
+}
+
+// popDue Remove and return digest groups whose windows end by the time
+func (b *digestBuffer) popDue(now time.Time) []*digestGroup {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	keys := []string{}
+	for key, group := range b.groups {
+		if !now.Before(group.dueAt) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	groups := []*digestGroup{}
+	for _, key := range keys {
+		groups = append(groups, b.groups[key])
+		delete(b.groups, key)
+	}
+	return groups
+}
+
+// event Build the event notifying events of the group, the only event is notified as it is.
+// Fields shared by all events are kept and the highest level is used.
+func (g *digestGroup) event() *datahub_v1alpha1.Event {
+	first := g.events[0]
+	if len(g.events) == 1 {
+		return first
+	}
+
+	subject := &datahub_v1alpha1.K8SObjectReference{
+		Kind:       first.GetSubject().GetKind(),
+		Namespace:  first.GetSubject().GetNamespace(),
+		Name:       first.GetSubject().GetName(),
+		ApiVersion: first.GetSubject().GetApiVersion(),
+	}
+	source := &datahub_v1alpha1.EventSource{
+		Host:      first.GetSource().GetHost(),
+		Component: first.GetSource().GetComponent(),
+	}
+	level := first.GetLevel()
+	messages := []string{}
+	messageCounts := map[string]int{}
+	for _, evt := range g.events {
+		if subject.Kind != evt.GetSubject().GetKind() {
+			subject.Kind = ""
+		}
+		if subject.Namespace != evt.GetSubject().GetNamespace() {
+			subject.Namespace = ""
+		}
+		if subject.Name != evt.GetSubject().GetName() {
+			subject.Name = ""
+		}
+		if subject.ApiVersion != evt.GetSubject().GetApiVersion() {
+			subject.ApiVersion = ""
+		}
+		if source.Host != evt.GetSource().GetHost() {
+			source.Host = ""
+		}
+		if source.Component != evt.GetSource().GetComponent() {
+			source.Component = ""
+		}
+		if evt.GetLevel() > level {
+			level = evt.GetLevel()
+		}
+		if _, ok := messageCounts[evt.GetMessage()]; !ok {
+			messages = append(messages, evt.GetMessage())
+		}
+		messageCounts[evt.GetMessage()]++
+	}
+
+	summaries := []string{}
+	for idx, msg := range messages {
+		if idx == maxDigestMessages {
+			summaries = append(summaries, fmt.Sprintf("and %d more", len(messages)-maxDigestMessages))
+			break
+		}
+		if count := messageCounts[msg]; count > 1 {
+			msg = fmt.Sprintf("%s (x%d)", msg, count)
+		}
+		summaries = append(summaries, msg)
+	}
+
+	return &datahub_v1alpha1.Event{
+		Time:      g.events[len(g.events)-1].GetTime(),
+		ClusterId: first.GetClusterId(),
+		Source:    source,
+		Type:      first.GetType(),
+		Version:   first.GetVersion(),
+		Level:     level,
+		Subject:   subject,
+		Message: fmt.Sprintf("%d events in %s: %s", len(g.events), g.window,
+			strings.Join(summaries, "; ")),
+	}
+}
@@ -0,0 +1,163 @@
+package notifying
+
+import (
+	"context"
+	"path"
+
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+	"github.com/containers-ai/alameda/notifier/event"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// namespaceLabelsFunc Return labels of the namespace, it is called only if subjects select namespaces by labels
+type namespaceLabelsFunc func(namespace string) (map[string]string, error)
+
+// topicsMatched Return whether the event matches any of the topics. A topic matches the event if its
+// subject, type, level and source lists all match and none of its exclusion lists matches,
+// an empty list matches any event.
+func topicsMatched(name string, topics []*notifyingv1alpha1.AlamedaTopic, evt *datahub_v1alpha1.Event,
+	namespaceLabels namespaceLabelsFunc) bool {
+	for specTopicIdx, specTopic := range topics {
+		subMatched := subjectMatched(specTopic.Subject, evt, namespaceLabels)
+		typeMatched := typeMatched(specTopic.Type, evt)
+		lvlMatched := levelMatched(specTopic.Level, evt) && minLevelMatched(specTopic.MinLevel, evt)
+		srcMatched := sourceMatched(specTopic.Source, evt)
+		excluded := topicExcluded(specTopic, evt, namespaceLabels)
+
+		scope.Debugf("topic %s (%d/%d) subject matched: %t, type matched: %t, level matched: %t, source matched: %t, excluded: %t",
+			name, specTopicIdx+1, len(topics), subMatched, typeMatched, lvlMatched, srcMatched, excluded)
+		if subMatched && typeMatched && lvlMatched && srcMatched && !excluded {
+			return true
+		}
+	}
+	return false
+}
+
+// topicExcluded Return whether the event matches any of the exclusion lists of the topic
+func topicExcluded(topic *notifyingv1alpha1.AlamedaTopic, evt *datahub_v1alpha1.Event,
+	namespaceLabels namespaceLabelsFunc) bool {
+	return (len(topic.ExcludeType) > 0 && typeMatched(topic.ExcludeType, evt)) ||
+		(len(topic.ExcludeSubject) > 0 && subjectMatched(topic.ExcludeSubject, evt, namespaceLabels)) ||
+		(len(topic.ExcludeSource) > 0 && sourceMatched(topic.ExcludeSource, evt))
+}
+
+func subjectMatched(subjects []*notifyingv1alpha1.AlamedaSubject, evt *datahub_v1alpha1.Event,
+	namespaceLabels namespaceLabelsFunc) bool {
+	if len(subjects) == 0 {
+		return true
+	}
+	evtSubject := evt.GetSubject()
+	for _, sub := range subjects {
+		if valueMatched(sub.Namespace, sub.NamespacePattern, sub.NamespaceRegex, evtSubject.GetNamespace()) &&
+			valueMatched(sub.Name, sub.NamePattern, sub.NameRegex, evtSubject.GetName()) &&
+			(sub.Kind == "" || sub.Kind == evtSubject.GetKind()) &&
+			(sub.APIVersion == "" || sub.APIVersion == evtSubject.GetApiVersion()) &&
+			namespaceSelected(sub.NamespaceSelector, evtSubject.GetNamespace(), namespaceLabels) {
+			return true
+		}
+	}
+	return false
+}
+
+// valueMatched Return whether the value equals exact, matches the glob pattern and matches the whole
+// regular expression, empty ones of them match any value and invalid ones match no value
+func valueMatched(exact, pattern, regex, value string) bool {
+	if exact != "" && exact != value {
+		return false
+	}
+	if pattern != "" {
+		if matched, err := path.Match(pattern, value); err != nil || !matched {
+			return false
+		}
+	}
+	if regex != "" {
+		re, err := compileRegex(regex)
+		if err != nil || !re.MatchString(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// namespaceSelected Return whether labels of the namespace are selected by the selector,
+// subjects without namespace are not selected by any selector
+func namespaceSelected(selector *metav1.LabelSelector, namespace string, namespaceLabels namespaceLabelsFunc) bool {
+	if selector == nil {
+		return true
+	}
+	if namespace == "" || namespaceLabels == nil {
+		return false
+	}
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		scope.Errorf("parse namespace selector failed: %s", err.Error())
+		return false
+	}
+	nsLabels, err := namespaceLabels(namespace)
+	if err != nil {
+		scope.Errorf("get labels of namespace %s failed: %s", namespace, err.Error())
+		return false
+	}
+	return labelSelector.Matches(labels.Set(nsLabels))
+}
+
+func typeMatched(types []string, evt *datahub_v1alpha1.Event) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, ty := range types {
+		if ty == "" || event.EventTypeYamlKeyToIntMap(ty) == int32(evt.GetType()) {
+			return true
+		}
+	}
+	return false
+}
+
+func levelMatched(levels []string, evt *datahub_v1alpha1.Event) bool {
+	if len(levels) == 0 {
+		return true
+	}
+	for _, lvl := range levels {
+		if lvl == "" || event.EventLevelYamlKeyToIntMap(lvl) == int32(evt.GetLevel()) {
+			return true
+		}
+	}
+	return false
+}
+
+// minLevelMatched Return whether the event is of the min level or higher
+func minLevelMatched(minLevel string, evt *datahub_v1alpha1.Event) bool {
+	if minLevel == "" {
+		return true
+	}
+	return int32(evt.GetLevel()) >= event.EventLevelYamlKeyToIntMap(minLevel)
+}
+
+func sourceMatched(sources []*notifyingv1alpha1.AlamedaSource, evt *datahub_v1alpha1.Event) bool {
+	if len(sources) == 0 {
+		return true
+	}
+	evtSource := evt.GetSource()
+	for _, src := range sources {
+		if (src.Host == "" || src.Host == evtSource.GetHost()) &&
+			(src.Component == "" || src.Component == evtSource.GetComponent()) {
+			return true
+		}
+	}
+	return false
+}
+
+// getNamespaceLabels Return labels of the namespace, namespaces are read from cache of the manager
+func (notifier *notifier) getNamespaceLabels(namespace string) (map[string]string, error) {
+	ns := &corev1.Namespace{}
+	if err := notifier.k8sClient.Get(context.TODO(), client.ObjectKey{Name: namespace}, ns); err != nil {
+		return nil, err
+	}
+	return ns.GetLabels(), nil
+}
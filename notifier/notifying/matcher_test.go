@@ -0,0 +1,227 @@
+package notifying
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testNamespaceLabels(namespace string) (map[string]string, error) {
+	namespaceLabels := map[string]map[string]string{
+		"team-a-prod":    {"team": "a", "env": "prod"},
+		"team-a-sandbox": {"team": "a", "env": "sandbox"},
+		"team-b-prod":    {"team": "b", "env": "prod"},
+	}
+	if labels, ok := namespaceLabels[namespace]; ok {
+		return labels, nil
+	}
+	return nil, fmt.Errorf("namespace %s not found", namespace)
+}
+
+func TestTopicsMatched(t *testing.T) {
+	newEvent := func(evtType datahub_v1alpha1.EventType, level datahub_v1alpha1.EventLevel,
+		namespace, name string) *datahub_v1alpha1.Event {
+		evt := newTestEvent(evtType, namespace, name, "")
+		evt.Level = level
+		evt.Source = &datahub_v1alpha1.EventSource{Component: "alameda-evictioner"}
+		return evt
+	}
+	execute := datahub_v1alpha1.EventType_EVENT_TYPE_VPA_RECOMMENDATION_EXECUTE
+	deregister := datahub_v1alpha1.EventType_EVENT_TYPE_POD_DEREGISTER
+	info := datahub_v1alpha1.EventLevel_EVENT_LEVEL_INFO
+	warning := datahub_v1alpha1.EventLevel_EVENT_LEVEL_WARNING
+
+	tests := []struct {
+		name  string
+		topic notifyingv1alpha1.AlamedaTopic
+		evt   *datahub_v1alpha1.Event
+		want  bool
+	}{
+		{
+			name:  "empty topic matches any event",
+			topic: notifyingv1alpha1.AlamedaTopic{},
+			evt:   newEvent(execute, info, "default", "app"),
+			want:  true,
+		},
+		{
+			name: "exact fields match",
+			topic: notifyingv1alpha1.AlamedaTopic{
+				Type:    []string{"VPARecommendationExecute"},
+				Level:   []string{"info"},
+				Subject: []*notifyingv1alpha1.AlamedaSubject{{Kind: "Deployment", Namespace: "default", Name: "app"}},
+				Source:  []*notifyingv1alpha1.AlamedaSource{{Component: "alameda-evictioner"}},
+			},
+			evt:  newEvent(execute, info, "default", "app"),
+			want: true,
+		},
+		{
+			name: "exact namespace does not match",
+			topic: notifyingv1alpha1.AlamedaTopic{
+				Subject: []*notifyingv1alpha1.AlamedaSubject{{Namespace: "kube-system"}},
+			},
+			evt:  newEvent(execute, info, "default", "app"),
+			want: false,
+		},
+		{
+			name: "namespace glob pattern matches",
+			topic: notifyingv1alpha1.AlamedaTopic{
+				Subject: []*notifyingv1alpha1.AlamedaSubject{{NamespacePattern: "team-a-*"}},
+			},
+			evt:  newEvent(execute, info, "team-a-prod", "app"),
+			want: true,
+		},
+		{
+			name: "namespace glob pattern does not match",
+			topic: notifyingv1alpha1.AlamedaTopic{
+				Subject: []*notifyingv1alpha1.AlamedaSubject{{NamespacePattern: "team-a-*"}},
+			},
+			evt:  newEvent(execute, info, "team-b-prod", "app"),
+			want: false,
+		},
+		{
+			name: "name regex matches the whole name",
+			topic: notifyingv1alpha1.AlamedaTopic{
+				Subject: []*notifyingv1alpha1.AlamedaSubject{{NameRegex: "(web|api)-[0-9]+"}},
+			},
+			evt:  newEvent(execute, info, "default", "api-2"),
+			want: true,
+		},
+		{
+			name: "name regex does not match part of the name",
+			topic: notifyingv1alpha1.AlamedaTopic{
+				Subject: []*notifyingv1alpha1.AlamedaSubject{{NameRegex: "api"}},
+			},
+			evt:  newEvent(execute, info, "default", "api-2"),
+			want: false,
+		},
+		{
+			name: "pattern excluding namespace",
+			topic: notifyingv1alpha1.AlamedaTopic{
+				Subject:        []*notifyingv1alpha1.AlamedaSubject{{NamespacePattern: "team-a-*"}},
+				ExcludeSubject: []*notifyingv1alpha1.AlamedaSubject{{Namespace: "team-a-sandbox"}},
+			},
+			evt:  newEvent(execute, info, "team-a-sandbox", "app"),
+			want: false,
+		},
+		{
+			name: "pattern with exclusion not matching",
+			topic: notifyingv1alpha1.AlamedaTopic{
+				Subject:        []*notifyingv1alpha1.AlamedaSubject{{NamespacePattern: "team-a-*"}},
+				ExcludeSubject: []*notifyingv1alpha1.AlamedaSubject{{Namespace: "team-a-sandbox"}},
+			},
+			evt:  newEvent(execute, info, "team-a-prod", "app"),
+			want: true,
+		},
+		{
+			name: "excluded type",
+			topic: notifyingv1alpha1.AlamedaTopic{
+				ExcludeType: []string{"PodDeregister"},
+			},
+			evt:  newEvent(deregister, info, "default", "app"),
+			want: false,
+		},
+		{
+			name: "excluded source",
+			topic: notifyingv1alpha1.AlamedaTopic{
+				ExcludeSource: []*notifyingv1alpha1.AlamedaSource{{Component: "alameda-evictioner"}},
+			},
+			evt:  newEvent(execute, info, "default", "app"),
+			want: false,
+		},
+		{
+			name:  "level below min level",
+			topic: notifyingv1alpha1.AlamedaTopic{MinLevel: "warning"},
+			evt:   newEvent(execute, info, "default", "app"),
+			want:  false,
+		},
+		{
+			name:  "level of min level",
+			topic: notifyingv1alpha1.AlamedaTopic{MinLevel: "warning"},
+			evt:   newEvent(execute, warning, "default", "app"),
+			want:  true,
+		},
+		{
+			name: "namespace selected by labels",
+			topic: notifyingv1alpha1.AlamedaTopic{
+				Subject: []*notifyingv1alpha1.AlamedaSubject{{
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"team": "a"},
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "env", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"sandbox"}},
+						},
+					},
+				}},
+			},
+			evt:  newEvent(execute, info, "team-a-prod", "app"),
+			want: true,
+		},
+		{
+			name: "namespace not selected by labels",
+			topic: notifyingv1alpha1.AlamedaTopic{
+				Subject: []*notifyingv1alpha1.AlamedaSubject{{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+				}},
+			},
+			evt:  newEvent(execute, info, "team-b-prod", "app"),
+			want: false,
+		},
+		{
+			name: "namespace without labels not selected",
+			topic: notifyingv1alpha1.AlamedaTopic{
+				Subject: []*notifyingv1alpha1.AlamedaSubject{{
+					NamespaceSelector: &metav1.LabelSelector{},
+				}},
+			},
+			evt:  newEvent(execute, info, "unknown", "app"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			topics := []*notifyingv1alpha1.AlamedaTopic{&tt.topic}
+			if got := topicsMatched("topic", topics, tt.evt, testNamespaceLabels); got != tt.want {
+				t.Errorf("topicsMatched() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvalidPatterns(t *testing.T) {
+	topics := []*notifyingv1alpha1.AlamedaTopic{
+		{
+			Subject: []*notifyingv1alpha1.AlamedaSubject{
+				{NamePattern: "app-*", NameRegex: "app-[0-9]+"},
+				{NamespacePattern: "team-[a", NamespaceRegex: "team-(a"},
+			},
+		},
+		{
+			ExcludeSubject: []*notifyingv1alpha1.AlamedaSubject{{NameRegex: "*canary"}},
+		},
+	}
+	invalids := InvalidPatterns(topics)
+	wantPrefixes := []string{
+		"topics[0].subject[1].namespacePattern: ",
+		"topics[0].subject[1].namespaceRegex: ",
+		"topics[1].excludeSubject[0].nameRegex: ",
+	}
+	if len(invalids) != len(wantPrefixes) {
+		t.Fatalf("InvalidPatterns() = %v, want %d invalid patterns", invalids, len(wantPrefixes))
+	}
+	for i, prefix := range wantPrefixes {
+		if !strings.HasPrefix(invalids[i], prefix) {
+			t.Errorf("InvalidPatterns()[%d] = %q, want prefix %q", i, invalids[i], prefix)
+		}
+	}
+
+	evt := newTestEvent(datahub_v1alpha1.EventType_EVENT_TYPE_VPA_RECOMMENDATION_EXECUTE, "team-(a", "app", "")
+	invalidTopics := []*notifyingv1alpha1.AlamedaTopic{{
+		Subject: []*notifyingv1alpha1.AlamedaSubject{{NamespaceRegex: "team-(a"}},
+	}}
+	if topicsMatched("topic", invalidTopics, evt, testNamespaceLabels) {
+		t.Errorf("topicsMatched() = true, want subjects with invalid regular expression to match no events")
+	}
+}
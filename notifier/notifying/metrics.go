@@ -0,0 +1,50 @@
+package notifying
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	notificationsSent = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "alameda_notifier",
+		Name:      "notifications_sent_total",
+		Help:      "Total number of notifications sent to channels by result",
+	}, []string{"channel_type", "channel", "result"})
+	eventsSuppressed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "alameda_notifier",
+		Name:      "events_suppressed_total",
+		Help:      "Total number of events matching topics not notified by reason",
+	}, []string{"topic", "reason"})
+	eventsDigested = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "alameda_notifier",
+		Name:      "events_digested_total",
+		Help:      "Total number of events notified in digests",
+	}, []string{"topic"})
+)
+
+const (
+	suppressedReasonSilenced     = "silenced"
+	suppressedReasonDeduplicated = "deduplicated"
+)
+
+func init() {
+	// Served on the metrics endpoint of the controller manager
+	metrics.Registry.MustRegister(notificationsSent, eventsSuppressed, eventsDigested)
+}
+
+func addNotificationSent(channelType, channelName string, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	notificationsSent.WithLabelValues(channelType, channelName, result).Inc()
+}
+
+func addEventSuppressed(topicName, reason string) {
+	eventsSuppressed.WithLabelValues(topicName, reason).Inc()
+}
+
+func addEventsDigested(topicName string, count int) {
+	eventsDigested.WithLabelValues(topicName).Add(float64(count))
+}
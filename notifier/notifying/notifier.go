@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
@@ -24,17 +25,32 @@ var scope = log.RegisterScope("notifier", "notifier", 0)
 
 type notifier struct {
 	k8sClient     client.Client
+	secretReader  client.Reader
 	datahubClient datahub_v1alpha1.DatahubServiceClient
 	clusterInfo   *notifier_utils.ClusterInfo
+	dedup         *dedupCache
+	digests       *digestBuffer
+	// namespaceLabels returns labels of namespaces selected by topics
+	namespaceLabels namespaceLabelsFunc
+	// sendLock serializes sending events with updating channel conditions of topics
+	sendLock sync.Mutex
+	// send sends the event with channels of the topic
+	send func(evt *datahub_v1alpha1.Event, notificationTopic *notifyingv1alpha1.AlamedaNotificationTopic)
 }
 
 func NewNotifier(mgr manager.Manager, datahubClient datahub_v1alpha1.DatahubServiceClient,
                  clusterInfo *notifier_utils.ClusterInfo) *notifier {
-	return &notifier{
+	notifier := &notifier{
 		k8sClient:     mgr.GetClient(),
+		secretReader:  mgr.GetAPIReader(),
 		clusterInfo:   clusterInfo,
 		datahubClient: datahubClient,
+		dedup:         newDedupCache(),
+		digests:       newDigestBuffer(),
 	}
+	notifier.send = notifier.sendEvtBaseOnTopic
+	notifier.namespaceLabels = notifier.getNamespaceLabels
+	return notifier
 }
 
 func (notifier *notifier) NotifyEvents(evts []*datahub_v1alpha1.Event) {
@@ -45,82 +61,93 @@ func (notifier *notifier) NotifyEvents(evts []*datahub_v1alpha1.Event) {
 		return
 	}
 
-	for _, topic := range alamedaNotificationTopicList.Items {
+	now := time.Now()
+	silences := notifier.listActiveSilences(now)
+	for topicIdx := range alamedaNotificationTopicList.Items {
 		for _, evt := range evts {
-			notifier.sendEvtBaseOnTopic(evt, &topic)
+			notifier.notifyEvtByTopic(evt, &alamedaNotificationTopicList.Items[topicIdx], silences, now)
 		}
 	}
 }
 
-func (notifier *notifier) sendEvtBaseOnTopic(evt *datahub_v1alpha1.Event,
-	notificationTopic *notifyingv1alpha1.AlamedaNotificationTopic) {
-	if notificationTopic.Spec.Disabled {
-		return
-	}
-
-	toSend := false
-	for specTopicIdx, specTopic := range notificationTopic.Spec.Topics {
-		subMatched := (specTopic.Subject == nil || len(specTopic.Subject) == 0)
-		for _, sub := range specTopic.Subject {
-			if (sub.Namespace == "" || sub.Namespace == evt.Subject.Namespace) &&
-				(sub.Name == "" || sub.Name == evt.Subject.Name) &&
-				(sub.Kind == "" || sub.Kind == evt.Subject.Kind) &&
-				(sub.APIVersion == "" || sub.APIVersion == evt.Subject.ApiVersion) {
-				subMatched = true
-				break
-			}
-		}
-		typeMatched := (specTopic.Type == nil || len(specTopic.Type) == 0)
-		for _, ty := range specTopic.Type {
-			if ty == "" || event.EventTypeYamlKeyToIntMap(ty) == int32(evt.Type) {
-				typeMatched = true
-				break
-			}
-		}
-		lvlMatched := (specTopic.Level == nil || len(specTopic.Level) == 0)
-		for _, lvl := range specTopic.Level {
-			if lvl == "" || event.EventLevelYamlKeyToIntMap(lvl) == int32(evt.Level) {
-				lvlMatched = true
-				break
-			}
-		}
-		srcMatched := (specTopic.Source == nil || len(specTopic.Source) == 0)
-		for _, src := range specTopic.Source {
-			if (src.Host == "" || src.Host == evt.Source.Host) &&
-				(src.Component == "" || src.Component == evt.Source.Component) {
-				srcMatched = true
-			}
+// StartDigestFlusher Send digests of topics once their windows end
+func (notifier *notifier) StartDigestFlusher() {
+	go func() {
+		ticker := time.NewTicker(digestFlushInterval)
+		defer ticker.Stop()
+		for now := range ticker.C {
+			notifier.flushDigests(now)
 		}
+	}()
+}
 
-		scope.Debugf("topic %s (%d/%d) subject matched: %t, type matched: %t, level matched: %t, source matched: %t",
-			notificationTopic.Name, specTopicIdx+1, len(notificationTopic.Spec.Topics),
-			subMatched, typeMatched, lvlMatched, srcMatched)
-		if subMatched && typeMatched && lvlMatched && srcMatched {
-			toSend = true
-			break
-		}
+func (notifier *notifier) flushDigests(now time.Time) {
+	for _, group := range notifier.digests.popDue(now) {
+		addEventsDigested(group.topic.GetName(), len(group.events))
+		notifier.send(group.event(), group.topic)
 	}
+}
 
-	if !toSend {
+// notifyEvtByTopic Notify the event if it matches the topic and is neither muted by silences nor
+// deduplicated, events are held in digests until their windows end if the topic digests events
+func (notifier *notifier) notifyEvtByTopic(evt *datahub_v1alpha1.Event,
+	notificationTopic *notifyingv1alpha1.AlamedaNotificationTopic,
+	silences []notifyingv1alpha1.AlamedaNotificationSilence, now time.Time) {
+	if notificationTopic.Spec.Disabled || notificationTopic.Spec.Channel == nil ||
+		!topicsMatched(notificationTopic.Name, notificationTopic.Spec.Topics, evt, notifier.namespaceLabels) {
+		return
+	}
+	if silence := findSilence(silences, notificationTopic.Name, evt, notifier.namespaceLabels); silence != nil {
+		scope.Debugf("event (%s) of topic %s is muted by silence %s",
+			evt.GetMessage(), notificationTopic.Name, silence.Name)
+		addEventSuppressed(notificationTopic.Name, suppressedReasonSilenced)
 		return
 	}
+	if !notifier.dedup.accept(notificationTopic, evt, now) {
+		scope.Debugf("event (%s) of topic %s is deduplicated", evt.GetMessage(), notificationTopic.Name)
+		addEventSuppressed(notificationTopic.Name, suppressedReasonDeduplicated)
+		return
+	}
+	if notifier.digests.add(notificationTopic, evt, now) {
+		return
+	}
+	notifier.send(evt, notificationTopic)
+}
+
+// sendEvtBaseOnTopic Send the event with channels of the topic and update channel conditions of the topic
+func (notifier *notifier) sendEvtBaseOnTopic(evt *datahub_v1alpha1.Event,
+	notificationTopic *notifyingv1alpha1.AlamedaNotificationTopic) {
+	notifier.sendLock.Lock()
+	defer notifier.sendLock.Unlock()
 
 	channelConditions := []*notifyingv1alpha1.AlamedaChannelCondition{}
 	for _, emailChannel := range notificationTopic.Spec.Channel.Emails {
-		err := notifier.sendEvtByEmails(evt, emailChannel)
-		channelCondition := &notifyingv1alpha1.AlamedaChannelCondition{
-			Type:    "email",
-			Name:    emailChannel.Name,
-			Success: err == nil,
-			Time:    time.Now().Format(time.RFC3339),
-		}
-
-		if err != nil {
-			channelCondition.Message = fmt.Sprintf(
-				"topic %s failed to send message with email channel %s. %s",
-				notificationTopic.Name, emailChannel.Name, err.Error())
-		}
-		channelConditions = append(channelConditions, channelCondition)
+		channelConditions = append(channelConditions, notifier.sendEvtByChannel(evt, notificationTopic,
+			notifyingv1alpha1.ChannelTypeEmail, emailChannel.Name,
+			func(notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel) (channel.Channel, error) {
+				return channel.NewEmailClient(notificationChannel, emailChannel, notifier.clusterInfo)
+			}))
+	}
+	for _, webhookChannel := range notificationTopic.Spec.Channel.Webhooks {
+		channelConditions = append(channelConditions, notifier.sendEvtByChannel(evt, notificationTopic,
+			notifyingv1alpha1.ChannelTypeWebhook, webhookChannel.Name,
+			func(notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel) (channel.Channel, error) {
+				return channel.NewWebhookClient(notificationChannel, notifier.clusterInfo)
+			}))
+	}
+	for _, slackChannel := range notificationTopic.Spec.Channel.Slacks {
+		channelConditions = append(channelConditions, notifier.sendEvtByChannel(evt, notificationTopic,
+			notifyingv1alpha1.ChannelTypeSlack, slackChannel.Name,
+			func(notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel) (channel.Channel, error) {
+				return channel.NewSlackClient(notificationChannel, slackChannel, notifier.clusterInfo)
+			}))
+	}
+	for _, msTeamsChannel := range notificationTopic.Spec.Channel.MSTeams {
+		channelConditions = append(channelConditions, notifier.sendEvtByChannel(evt, notificationTopic,
+			notifyingv1alpha1.ChannelTypeMSTeams, msTeamsChannel.Name,
+			func(notificationChannel *notifyingv1alpha1.AlamedaNotificationChannel) (channel.Channel, error) {
+				return channel.NewMSTeamsClient(notificationChannel, notifier.clusterInfo)
+			}))
 	}
 
 	topicEventResendTime := viper.GetInt64("topicEventResendTime")
@@ -172,20 +199,58 @@ func (notifier *notifier) sendEvtBaseOnTopic(evt *datahub_v1alpha1.Event,
 	}
 }
 
-func (notifier *notifier) sendEvtByEmails(evt *datahub_v1alpha1.Event,
-	emailChannel *notifyingv1alpha1.AlamedaEmailChannel) error {
+// sendEvtByChannel Send the event with the notification channel of the name referred by the topic,
+// newChannel builds the channel from the notification channel with receivers set in the topic
+func (notifier *notifier) sendEvtByChannel(evt *datahub_v1alpha1.Event,
+	notificationTopic *notifyingv1alpha1.AlamedaNotificationTopic, channelType, channelName string,
+	newChannel func(*notifyingv1alpha1.AlamedaNotificationChannel) (channel.Channel, error)) *notifyingv1alpha1.AlamedaChannelCondition {
+	err := notifier.sendEvt(evt, notificationTopic.Spec.Template, channelType, channelName, newChannel)
+	addNotificationSent(channelType, channelName, err)
+	channelCondition := &notifyingv1alpha1.AlamedaChannelCondition{
+		Type:    channelType,
+		Name:    channelName,
+		Success: err == nil,
+		Time:    time.Now().Format(time.RFC3339),
+	}
+
+	if err != nil {
+		channelCondition.Message = fmt.Sprintf(
+			"topic %s failed to send message with %s channel %s. %s",
+			notificationTopic.Name, channelType, channelName, err.Error())
+	}
+	return channelCondition
+}
+
+// sendEvt Send the event with the notification channel, the template of the topic takes place of
+// the template of the channel if it is set
+func (notifier *notifier) sendEvt(evt *datahub_v1alpha1.Event,
+	topicTemplate *notifyingv1alpha1.AlamedaNotificationTemplate, channelType, channelName string,
+	newChannel func(*notifyingv1alpha1.AlamedaNotificationChannel) (channel.Channel, error)) error {
 	alamedaNotificationChannel := &notifyingv1alpha1.AlamedaNotificationChannel{}
 	err := notifier.k8sClient.Get(context.TODO(), client.ObjectKey{
-		Name: emailChannel.Name,
+		Name: channelName,
 	}, alamedaNotificationChannel)
 
 	if err != nil {
 		return err
 	}
-	emailNotificationChannel, err := channel.NewEmailClient(
-		alamedaNotificationChannel, emailChannel, notifier.clusterInfo)
+	// channels created before types other than email are supported may have no type
+	if alamedaNotificationChannel.Spec.Type != "" && alamedaNotificationChannel.Spec.Type != channelType {
+		return fmt.Errorf("channel %s is of type %s rather than %s",
+			channelName, alamedaNotificationChannel.Spec.Type, channelType)
+	}
+	alamedaNotificationChannel, err = channel.ResolveSecretRefs(notifier.secretReader, alamedaNotificationChannel)
+	if err != nil {
+		return err
+	}
+	alamedaNotificationChannel, err = channel.ResolveTemplateRefs(notifier.secretReader,
+		alamedaNotificationChannel, topicTemplate)
+	if err != nil {
+		return err
+	}
+	notificationChannel, err := newChannel(alamedaNotificationChannel)
 	if err != nil {
 		return err
 	}
-	return emailNotificationChannel.SendEvent(evt)
+	return notificationChannel.SendEvent(evt)
 }
@@ -0,0 +1,186 @@
+package notifying
+
+import (
+	"testing"
+	"time"
+
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/spf13/viper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	viper.Set("eventType", map[string]interface{}{
+		"10": "PodDeregister",
+		"15": "VPARecommendationExecute",
+	})
+	viper.Set("eventLevel", map[string]interface{}{
+		"2": "info",
+		"3": "warning",
+	})
+}
+
+type sentEvent struct {
+	topic string
+	evt   *datahub_v1alpha1.Event
+}
+
+func newTestNotifier() (*notifier, *[]sentEvent) {
+	sent := &[]sentEvent{}
+	return &notifier{
+		dedup:   newDedupCache(),
+		digests: newDigestBuffer(),
+		send: func(evt *datahub_v1alpha1.Event, notificationTopic *notifyingv1alpha1.AlamedaNotificationTopic) {
+			*sent = append(*sent, sentEvent{topic: notificationTopic.Name, evt: evt})
+		},
+	}, sent
+}
+
+func newTestTopic(name string) *notifyingv1alpha1.AlamedaNotificationTopic {
+	return &notifyingv1alpha1.AlamedaNotificationTopic{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: notifyingv1alpha1.AlamedaNotificationTopicSpec{
+			Topics: []*notifyingv1alpha1.AlamedaTopic{
+				{
+					Type:    []string{"VPARecommendationExecute"},
+					Subject: []*notifyingv1alpha1.AlamedaSubject{{Namespace: "default"}},
+				},
+			},
+			Channel: &notifyingv1alpha1.AlamedaChannel{
+				Emails: []*notifyingv1alpha1.AlamedaEmailChannel{{Name: "email", To: []string{"to@example.com"}}},
+			},
+		},
+	}
+}
+
+func newTestEvent(evtType datahub_v1alpha1.EventType, namespace, name, msg string) *datahub_v1alpha1.Event {
+	return &datahub_v1alpha1.Event{
+		Time:  &timestamp.Timestamp{Seconds: 1570000000},
+		Type:  evtType,
+		Level: datahub_v1alpha1.EventLevel_EVENT_LEVEL_INFO,
+		Subject: &datahub_v1alpha1.K8SObjectReference{
+			Kind:      "Deployment",
+			Namespace: namespace,
+			Name:      name,
+		},
+		Message: msg,
+	}
+}
+
+func TestNotifyEvtByTopic_Silence(t *testing.T) {
+	now := time.Now()
+	topic := newTestTopic("topic")
+	silences := []notifyingv1alpha1.AlamedaNotificationSilence{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "maintenance"},
+			Spec: notifyingv1alpha1.AlamedaNotificationSilenceSpec{
+				Topics: []*notifyingv1alpha1.AlamedaTopic{
+					{Subject: []*notifyingv1alpha1.AlamedaSubject{{Namespace: "default", Name: "nginx"}}},
+				},
+				EndsAt: now.Add(time.Hour).Format(time.RFC3339),
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-topic"},
+			Spec: notifyingv1alpha1.AlamedaNotificationSilenceSpec{
+				Topics:             []*notifyingv1alpha1.AlamedaTopic{{}},
+				NotificationTopics: []string{"other"},
+				EndsAt:             now.Add(time.Hour).Format(time.RFC3339),
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		evt      *datahub_v1alpha1.Event
+		expected bool
+	}{
+		{"muted subject", newTestEvent(datahub_v1alpha1.EventType_EVENT_TYPE_VPA_RECOMMENDATION_EXECUTE,
+			"default", "nginx", "scaled"), false},
+		{"other subject", newTestEvent(datahub_v1alpha1.EventType_EVENT_TYPE_VPA_RECOMMENDATION_EXECUTE,
+			"default", "redis", "scaled"), true},
+		{"not matching topic", newTestEvent(datahub_v1alpha1.EventType_EVENT_TYPE_POD_DEREGISTER,
+			"default", "redis", "deregistered"), false},
+	}
+	for _, test := range tests {
+		notifier, sent := newTestNotifier()
+		notifier.notifyEvtByTopic(test.evt, topic, silences, now)
+		if (len(*sent) == 1) != test.expected {
+			t.Errorf("%s: expect sent %t, got %d events sent", test.name, test.expected, len(*sent))
+		}
+	}
+
+	if silences[0].IsActive(now.Add(2 * time.Hour)) {
+		t.Error("expect silence inactive after it ends")
+	}
+	silences[0].Spec.StartsAt = now.Add(time.Minute).Format(time.RFC3339)
+	if silences[0].IsActive(now) {
+		t.Error("expect silence inactive before it starts")
+	}
+}
+
+func TestNotifyEvtByTopic_Dedup(t *testing.T) {
+	now := time.Now()
+	topic := newTestTopic("topic")
+	topic.Spec.Dedup = &notifyingv1alpha1.AlamedaDedup{RepeatIntervalSeconds: 60}
+	notifier, sent := newTestNotifier()
+
+	evtType := datahub_v1alpha1.EventType_EVENT_TYPE_VPA_RECOMMENDATION_EXECUTE
+	notifier.notifyEvtByTopic(newTestEvent(evtType, "default", "nginx", "scaled"), topic, nil, now)
+	notifier.notifyEvtByTopic(newTestEvent(evtType, "default", "nginx", "scaled"), topic, nil, now.Add(59*time.Second))
+	notifier.notifyEvtByTopic(newTestEvent(evtType, "default", "nginx", "scaled again"), topic, nil, now.Add(59*time.Second))
+	if len(*sent) != 2 {
+		t.Fatalf("expect 2 events sent within repeat interval, got %d", len(*sent))
+	}
+	notifier.notifyEvtByTopic(newTestEvent(evtType, "default", "nginx", "scaled"), topic, nil, now.Add(60*time.Second))
+	if len(*sent) != 3 {
+		t.Errorf("expect event sent again after repeat interval, got %d events sent", len(*sent))
+	}
+}
+
+func TestNotifyEvtByTopic_Digest(t *testing.T) {
+	now := time.Now()
+	topic := newTestTopic("topic")
+	topic.Spec.Digest = &notifyingv1alpha1.AlamedaDigest{WindowSeconds: 300}
+	notifier, sent := newTestNotifier()
+
+	evtType := datahub_v1alpha1.EventType_EVENT_TYPE_VPA_RECOMMENDATION_EXECUTE
+	evts := []*datahub_v1alpha1.Event{
+		newTestEvent(evtType, "default", "nginx", "scaled to 2"),
+		newTestEvent(evtType, "default", "nginx", "scaled to 3"),
+		newTestEvent(evtType, "default", "nginx", "scaled to 2"),
+		newTestEvent(evtType, "default", "redis", "scaled to 2"),
+		newTestEvent(datahub_v1alpha1.EventType_EVENT_TYPE_POD_DEREGISTER, "default", "nginx", "deregistered"),
+	}
+	evts[1].Level = datahub_v1alpha1.EventLevel_EVENT_LEVEL_WARNING
+	for _, evt := range evts {
+		notifier.notifyEvtByTopic(evt, topic, nil, now)
+	}
+
+	notifier.flushDigests(now.Add(299 * time.Second))
+	if len(*sent) != 0 {
+		t.Fatalf("expect no event sent before window ends, got %d", len(*sent))
+	}
+	notifier.flushDigests(now.Add(300 * time.Second))
+	if len(*sent) != 2 {
+		t.Fatalf("expect digests of 2 subjects sent, got %d", len(*sent))
+	}
+
+	digest := (*sent)[0].evt
+	if expected := "3 events in 5m0s: scaled to 2 (x2); scaled to 3"; digest.Message != expected {
+		t.Errorf("expect digest message %q, got %q", expected, digest.Message)
+	}
+	if digest.Level != datahub_v1alpha1.EventLevel_EVENT_LEVEL_WARNING || digest.Subject.Name != "nginx" {
+		t.Errorf("expect digest of nginx with the highest level, got %+v", digest)
+	}
+	if (*sent)[1].evt != evts[3] {
+		t.Errorf("expect the only event of redis sent as it is, got %+v", (*sent)[1].evt)
+	}
+
+	notifier.flushDigests(now.Add(600 * time.Second))
+	if len(*sent) != 2 {
+		t.Errorf("expect digests sent once, got %d events sent", len(*sent))
+	}
+}
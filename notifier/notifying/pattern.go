@@ -0,0 +1,78 @@
+package notifying
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sync"
+
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+)
+
+// compiledRegex Regular expression of subjects compiled, err is set if it does not compile
+type compiledRegex struct {
+	re  *regexp.Regexp
+	err error
+}
+
+// compiledRegexes Caches regular expressions of subjects by their source so that each of them is
+// compiled once instead of on every event
+var compiledRegexes sync.Map
+
+// compileRegex Return the regular expression matching the whole value, compiled ones are cached
+// and failures are logged once when the expression is compiled
+func compileRegex(regex string) (*regexp.Regexp, error) {
+	if cached, ok := compiledRegexes.Load(regex); ok {
+		compiled := cached.(*compiledRegex)
+		return compiled.re, compiled.err
+	}
+	re, err := regexp.Compile("^(?:" + regex + ")$")
+	if err != nil {
+		scope.Errorf("compile regular expression %q of subjects failed, subjects with it match no events: %s",
+			regex, err.Error())
+	}
+	compiledRegexes.Store(regex, &compiledRegex{re: re, err: err})
+	return re, err
+}
+
+// InvalidPatterns Compile regular expressions of subjects of the topics ahead of events and
+// return messages of the glob patterns and regular expressions which are invalid
+func InvalidPatterns(topics []*notifyingv1alpha1.AlamedaTopic) []string {
+	var invalids []string
+	for topicIdx, topic := range topics {
+		invalids = append(invalids, invalidSubjectPatterns(
+			fmt.Sprintf("topics[%d].subject", topicIdx), topic.Subject)...)
+		invalids = append(invalids, invalidSubjectPatterns(
+			fmt.Sprintf("topics[%d].excludeSubject", topicIdx), topic.ExcludeSubject)...)
+	}
+	return invalids
+}
+
+func invalidSubjectPatterns(subjectsPath string, subjects []*notifyingv1alpha1.AlamedaSubject) []string {
+	var invalids []string
+	for subjectIdx, subject := range subjects {
+		subjectPath := fmt.Sprintf("%s[%d]", subjectsPath, subjectIdx)
+		for _, pattern := range []struct{ field, value string }{
+			{field: "namespacePattern", value: subject.NamespacePattern},
+			{field: "namePattern", value: subject.NamePattern},
+		} {
+			if _, err := path.Match(pattern.value, ""); err != nil {
+				invalids = append(invalids, fmt.Sprintf("%s.%s: invalid glob pattern %q: %s",
+					subjectPath, pattern.field, pattern.value, err.Error()))
+			}
+		}
+		for _, regex := range []struct{ field, value string }{
+			{field: "namespaceRegex", value: subject.NamespaceRegex},
+			{field: "nameRegex", value: subject.NameRegex},
+		} {
+			if regex.value == "" {
+				continue
+			}
+			if _, err := compileRegex(regex.value); err != nil {
+				invalids = append(invalids, fmt.Sprintf("%s.%s: invalid regular expression %q: %s",
+					subjectPath, regex.field, regex.value, err.Error()))
+			}
+		}
+	}
+	return invalids
+}
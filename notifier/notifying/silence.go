@@ -0,0 +1,41 @@
+package notifying
+
+import (
+	"context"
+	"time"
+
+	notifyingv1alpha1 "github.com/containers-ai/alameda/notifier/api/v1alpha1"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+)
+
+// +kubebuilder:rbac:groups=notifying.containers.ai,resources=alamedanotificationsilences,verbs=get;list;watch
+
+// listActiveSilences List silences muting events at the time, no event is muted if silences cannot be listed
+func (notifier *notifier) listActiveSilences(now time.Time) []notifyingv1alpha1.AlamedaNotificationSilence {
+	silenceList := &notifyingv1alpha1.AlamedaNotificationSilenceList{}
+	if err := notifier.k8sClient.List(context.TODO(), silenceList); err != nil {
+		scope.Errorf("list notification silences failed: %s", err.Error())
+		return nil
+	}
+	silences := []notifyingv1alpha1.AlamedaNotificationSilence{}
+	for _, silence := range silenceList.Items {
+		if silence.IsActive(now) {
+			silences = append(silences, silence)
+		}
+	}
+	return silences
+}
+
+// findSilence Return the silence muting the event of the topic, nil is returned if the event is not muted.
+// Events are matched by topics of silences the same as by topics of AlamedaNotificationTopic.
+func findSilence(silences []notifyingv1alpha1.AlamedaNotificationSilence, topicName string,
+	evt *datahub_v1alpha1.Event, namespaceLabels namespaceLabelsFunc) *notifyingv1alpha1.AlamedaNotificationSilence {
+	for idx := range silences {
+		silence := &silences[idx]
+		if silence.IsNotificationTopicMuted(topicName) &&
+			topicsMatched(silence.GetName(), silence.Spec.Topics, evt, namespaceLabels) {
+			return silence
+		}
+	}
+	return nil
+}
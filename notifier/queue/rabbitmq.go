@@ -103,6 +103,7 @@ func (client *rabbitmqClient) Start() {
 	}
 	scope.Infof("clusterInfo: %#v", clusterInfo)
 	notifier := notifying.NewNotifier(client.mgr, datahubClient, &clusterInfo)
+	notifier.StartDigestFlusher()
 	go func() {
 		for d := range msgs {
 			scope.Infof("Received events: %s", d.Body)
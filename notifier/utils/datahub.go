@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	k8s_utils "github.com/containers-ai/alameda/pkg/utils/kubernetes"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// datahubMetadataKeyClusterID Key of gRPC metadata carrying the cluster id calls to datahub are scoped to
+	datahubMetadataKeyClusterID = "x-alameda-cluster-id"
+)
+
+// DatahubClusterID Return the cluster id calls to datahub are scoped to, datahub.clusterID is returned
+// if it is set, otherwise the uid of configmap default/cluster-info like other components calling datahub
+func DatahubClusterID(k8sClient client.Client) (string, error) {
+	if clusterID := viper.GetString("datahub.clusterID"); clusterID != "" {
+		return clusterID, nil
+	}
+	clusterID, err := k8s_utils.GetClusterUID(k8sClient)
+	if err != nil {
+		return "", errors.Wrap(err, "get datahub cluster id failed")
+	}
+	return clusterID, nil
+}
+
+// DatahubClusterInterceptor Return interceptor sending the cluster id to datahub on every unary call
+func DatahubClusterInterceptor(clusterID string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if clusterID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, datahubMetadataKeyClusterID, clusterID)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// DatahubDialOptions Return options dialing datahub with credentials configured
+// in section datahub, the ServiceAccount token in datahub.tokenFile is sent on
+// every call and datahub.tls enables tls connection
+func DatahubDialOptions() ([]grpc.DialOption, error) {
+	opts := []grpc.DialOption{}
+
+	tlsEnabled := viper.GetBool("datahub.tls.enabled")
+	if tlsEnabled {
+		tlsConfig := &tls.Config{
+			ServerName:         viper.GetString("datahub.tls.serverName"),
+			InsecureSkipVerify: viper.GetBool("datahub.tls.insecureSkipVerify"),
+		}
+		if caFile := viper.GetString("datahub.tls.caFile"); caFile != "" {
+			ca, err := ioutil.ReadFile(caFile)
+			if err != nil {
+				return nil, errors.Wrap(err, "read datahub ca file failed")
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(ca) {
+				return nil, errors.Errorf("no certificate found in datahub ca file %s", caFile)
+			}
+			tlsConfig.RootCAs = pool
+		}
+		if certFile := viper.GetString("datahub.tls.certFile"); certFile != "" {
+			cert, err := tls.LoadX509KeyPair(certFile, viper.GetString("datahub.tls.keyFile"))
+			if err != nil {
+				return nil, errors.Wrap(err, "load datahub client certificate failed")
+			}
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	if tokenFile := viper.GetString("datahub.tokenFile"); tokenFile != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&tokenFileCredentials{
+			path:       tokenFile,
+			requireTLS: tlsEnabled,
+		}))
+	}
+	return opts, nil
+}
+
+// tokenFileCredentials Sends the token in the file as bearer token, the file is
+// read on every call since kubelet rotates projected ServiceAccount tokens
+type tokenFileCredentials struct {
+	path       string
+	requireTLS bool
+}
+
+func (c *tokenFileCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	content, err := ioutil.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "read token file %s failed", c.path)
+	}
+	return map[string]string{
+		"authorization": "Bearer " + strings.TrimSpace(string(content)),
+	}, nil
+}
+
+func (c *tokenFileCredentials) RequireTransportSecurity() bool {
+	return c.requireTLS
+}
@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"github.com/containers-ai/alameda/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+const (
+	// ChannelSecretNamePrefix is the prefix of Secrets created by moving inline credentials of notification channels
+	ChannelSecretNamePrefix = "alameda-notification-channel-"
+)
+
+// ChannelSecretNamespace Return namespace of Secrets referred by notification channels without namespace,
+// it is channelSecret.namespace or the namespace notifier runs in if it is not set
+func ChannelSecretNamespace() string {
+	if namespace := viper.GetString("channelSecret.namespace"); namespace != "" {
+		return namespace
+	}
+	return utils.GetRunningNamespace()
+}
+
+// ChannelRefNamespace Return namespace of the Secret or ConfigMap referred by a notification channel,
+// refs can only name the namespace of channel secrets since notifier is not granted Secrets of other namespaces
+func ChannelRefNamespace(namespace string) (string, error) {
+	channelSecretNamespace := ChannelSecretNamespace()
+	if namespace != "" && namespace != channelSecretNamespace {
+		return "", errors.Errorf("namespace %s is not namespace %s of channel secrets", namespace, channelSecretNamespace)
+	}
+	return channelSecretNamespace, nil
+}
+
+// ChannelSecretMigrationEnabled Return whether inline credentials of notification channels are moved into Secrets
+func ChannelSecretMigrationEnabled() bool {
+	return viper.GetBool("channelSecret.migrate")
+}
+
+// InlineChannelCredentialsRejected Return whether notification channels setting new inline credentials are rejected
+func InlineChannelCredentialsRejected() bool {
+	return viper.GetBool("channelSecret.rejectInline")
+}
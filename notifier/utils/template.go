@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+// EventTemplateData is the event rendered by notification templates
+type EventTemplateData struct {
+	ID                 string              `json:"id"`
+	ClusterID          string              `json:"clusterId"`
+	MasterNodeHostname string              `json:"masterNodeHostname"`
+	MasterNodeIP       string              `json:"masterNodeIP"`
+	Time               string              `json:"time"`
+	Level              string              `json:"level"`
+	Type               string              `json:"type"`
+	Message            string              `json:"message"`
+	Subject            EventTemplateObject `json:"subject"`
+	Source             EventTemplateSource `json:"source"`
+	// Data is data of the event decoded from JSON such as current and recommended values of
+	// the recommendation executed, it is the raw string if data is not JSON and nil if it is empty
+	Data interface{} `json:"data,omitempty"`
+}
+
+// Template is a parsed text/template or html/template
+type Template interface {
+	Name() string
+	Execute(wr io.Writer, data interface{}) error
+}
+
+// EventTemplateObject is the subject of event rendered by notification templates
+type EventTemplateObject struct {
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+	APIVersion string `json:"apiVersion"`
+}
+
+// EventTemplateSource is the source of event rendered by notification templates
+type EventTemplateSource struct {
+	Host      string `json:"host"`
+	Component string `json:"component"`
+}
+
+// templateFuncs are functions of notification templates besides the builtin ones
+var templateFuncs = template.FuncMap{
+	// json encodes the value in JSON, strings are quoted and escaped
+	"json": func(v interface{}) (string, error) {
+		bin, err := json.Marshal(v)
+		return string(bin), err
+	},
+	"title": strings.Title,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// NewEventTemplateData Build template data of the event, master node of the cluster is
+// filled only if the event comes from the cluster
+func NewEventTemplateData(evt *datahub_v1alpha1.Event, clusterInfo *ClusterInfo) *EventTemplateData {
+	data := &EventTemplateData{
+		ID:        evt.GetId(),
+		ClusterID: evt.GetClusterId(),
+		Time:      time.Unix(evt.GetTime().GetSeconds(), 0).Format(time.RFC3339),
+		Level:     viper.GetString(fmt.Sprintf("eventLevel.%v", int32(evt.GetLevel()))),
+		Type:      viper.GetString(fmt.Sprintf("eventType.%v", int32(evt.GetType()))),
+		Message:   evt.GetMessage(),
+		Subject: EventTemplateObject{
+			Kind:       evt.GetSubject().GetKind(),
+			Namespace:  evt.GetSubject().GetNamespace(),
+			Name:       evt.GetSubject().GetName(),
+			APIVersion: evt.GetSubject().GetApiVersion(),
+		},
+		Source: EventTemplateSource{
+			Host:      evt.GetSource().GetHost(),
+			Component: evt.GetSource().GetComponent(),
+		},
+	}
+	if evt.GetData() != "" {
+		var eventData interface{}
+		if err := json.Unmarshal([]byte(evt.GetData()), &eventData); err == nil {
+			data.Data = eventData
+		} else {
+			data.Data = evt.GetData()
+		}
+	}
+	if clusterInfo != nil && evt.GetClusterId() == clusterInfo.UID {
+		data.MasterNodeHostname = clusterInfo.MasterNodeHostname
+		data.MasterNodeIP = clusterInfo.MasterNodeIP
+	}
+	return data
+}
+
+// ParseTemplate Parse the notification template, the function json is available besides builtin ones
+func ParseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse template %s failed", name)
+	}
+	return tmpl, nil
+}
+
+// ParseHTMLTemplate Parse the notification template by html/template so that values are escaped in HTML,
+// functions are the same as ParseTemplate
+func ParseHTMLTemplate(name, text string) (*htmltemplate.Template, error) {
+	tmpl, err := htmltemplate.New(name).Funcs(htmltemplate.FuncMap(templateFuncs)).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse template %s failed", name)
+	}
+	return tmpl, nil
+}
+
+// ValidateTemplate Parse the notification template and render it with the sample data, keys missing in
+// maps are allowed since data of events varies by types while unknown fields of events are rejected
+func ValidateTemplate(name, text string, html bool, sample *EventTemplateData) error {
+	var tmpl Template
+	if html {
+		htmlTmpl, err := ParseHTMLTemplate(name, text)
+		if err != nil {
+			return err
+		}
+		tmpl = htmlTmpl.Option("missingkey=zero")
+	} else {
+		textTmpl, err := ParseTemplate(name, text)
+		if err != nil {
+			return err
+		}
+		tmpl = textTmpl.Option("missingkey=zero")
+	}
+	_, err := ExecuteTemplate(tmpl, sample)
+	return err
+}
+
+// ExecuteTemplate Render the template with the data
+func ExecuteTemplate(tmpl Template, data interface{}) ([]byte, error) {
+	buf := bytes.Buffer{}
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, errors.Wrapf(err, "render template %s failed", tmpl.Name())
+	}
+	return buf.Bytes(), nil
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/containers-ai/alameda/operator/pkg/controller"
 	"github.com/containers-ai/alameda/operator/pkg/probe"
 	"github.com/containers-ai/alameda/operator/pkg/utils"
+	datahubutils "github.com/containers-ai/alameda/operator/pkg/utils/datahub"
 	"github.com/containers-ai/alameda/operator/pkg/webhook"
 	logUtil "github.com/containers-ai/alameda/pkg/utils/log"
 	"github.com/spf13/viper"
@@ -142,9 +143,14 @@ func main() {
 		return
 	} else if readinessProbeFlag {
 		initServerConfig(nil)
+		if err := datahubutils.SetCredentials(&operatorConf.Datahub.Config); err != nil {
+			scope.Errorf("Set datahub credentials failed: %s", err.Error())
+			os.Exit(1)
+		}
 		readinessProbe(&probe.ReadinessProbeConfig{
-			ValidationSrvPort: operatorConf.K8SWebhookServer.Port,
-			DatahubAddr:       operatorConf.Datahub.Address,
+			ValidationSrvPort:  operatorConf.K8SWebhookServer.Port,
+			DatahubAddr:        operatorConf.Datahub.Address,
+			DatahubDialOptions: datahubutils.GetDialOptions(),
 		})
 		return
 	} else if livenessProbeFlag {
@@ -177,6 +183,7 @@ func main() {
 	initServerConfig(&mgr)
 	initLogger()
 	printSoftwareInfo()
+	initDatahubClient()
 
 	scope.Info("Registering Components.")
 	registerThirdPartyCRD()
@@ -209,6 +216,7 @@ func main() {
 		if !ok {
 			scope.Error("Wait for cache synchronization failed")
 		} else {
+			go registerCluster(operatorConf.Datahub.ClusterName, operatorConf.Datahub.RetryInterval.Default)
 			go registerNodes(mgr.GetClient(), operatorConf.Datahub.RetryInterval.Default)
 			go syncAlamedaPodsWithDatahub(mgr.GetClient(), operatorConf.Datahub.RetryInterval.Default)
 			go syncAlamedaResourcesWithDatahub(mgr.GetClient(), operatorConf.Datahub.RetryInterval.Default)
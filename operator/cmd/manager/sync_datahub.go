@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/pkg/errors"
@@ -9,14 +10,84 @@ import (
 	datahub_client "github.com/containers-ai/alameda/operator/datahub/client"
 	datahub_node "github.com/containers-ai/alameda/operator/datahub/client/node"
 	datahub_pod "github.com/containers-ai/alameda/operator/datahub/client/pod"
+	datahubutils "github.com/containers-ai/alameda/operator/pkg/utils/datahub"
 	"github.com/containers-ai/alameda/operator/pkg/utils/resources"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
 	alamutils "github.com/containers-ai/alameda/pkg/utils"
+	K8SUtils "github.com/containers-ai/alameda/pkg/utils/kubernetes"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc"
 	corev1 "k8s.io/api/core/v1"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// initDatahubClient Set the credentials presented to datahub and the cluster id
+// calls to datahub are scoped to, the uid of the cluster is used if it is not configured
+func initDatahubClient() {
+	if err := datahubutils.SetCredentials(&operatorConf.Datahub.Config); err != nil {
+		scope.Errorf("Set datahub credentials failed: %s", err.Error())
+		os.Exit(1)
+	}
+
+	k8sClient, err := K8SUtils.NewK8SClient()
+	if err != nil {
+		scope.Warnf("Create kubernetes client failed: %s", err.Error())
+	}
+	clusterID, err := DatahubCluster.ResolveClusterID(operatorConf.Datahub.ClusterID, k8sClient)
+	if err != nil {
+		scope.Warnf("Get cluster uid failed, calls to datahub are scoped to the default cluster of datahub: %s", err.Error())
+	}
+	scope.Infof("Cluster id is %s.", clusterID)
+	datahubutils.SetClusterID(clusterID)
+}
+
+func registerCluster(clusterName string, retryInterval int64) {
+	clusterID := datahubutils.GetClusterID()
+	if clusterID == "" {
+		scope.Warn("Skip registering cluster to datahub since cluster id is unknown.")
+		return
+	}
+	if clusterName == "" {
+		clusterName = clusterID
+	}
+	scope.Info("Start registering cluster to datahub.")
+	for {
+		if err := startRegisteringCluster(clusterID, clusterName); err == nil {
+			scope.Info("Register cluster to datahub successfully.")
+			break
+		} else {
+			scope.Errorf("Register cluster to datahub failed due to %s.", err.Error())
+		}
+		time.Sleep(time.Duration(retryInterval) * time.Second)
+	}
+}
+
+func startRegisteringCluster(clusterID, clusterName string) error {
+	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), datahubutils.GetDialOptions()...)
+	if err != nil {
+		return errors.Wrap(err, "dial datahub failed")
+	}
+	defer conn.Close()
+
+	resp, err := DatahubCluster.NewDatahubClusterServiceClient(conn).RegisterCluster(context.Background(), &DatahubCluster.RegisterClusterRequest{
+		Cluster: &DatahubCluster.Cluster{
+			Id:   clusterID,
+			Name: clusterName,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "register cluster failed")
+	}
+	if resp.GetStatus().GetCode() != int32(code.Code_OK) {
+		return errors.Errorf("register cluster failed: %s", resp.GetStatus().GetMessage())
+	}
+	return nil
+}
+
 func registerNodes(client client.Client, retryInterval int64) {
 	scope.Info("Start registering nodes to datahub.")
 	for {
@@ -197,8 +268,40 @@ func startSyncingAlamedaResourcesWithDatahubSuccess(client client.Client) error
 			} else if err != nil {
 				return errors.Wrapf(err, "get StatefulSet (%s/%s) failed", resourceNamespace, resourceName)
 			}
+		case DatahubKind.Kind_DAEMONSET:
+			_, err := getResource.GetDaemonSet(resourceNamespace, resourceName)
+			if err != nil && k8sErrors.IsNotFound(err) {
+				controllersNeedToRm = append(controllersNeedToRm, alamedaResource)
+				continue
+			} else if err != nil {
+				return errors.Wrapf(err, "get DaemonSet (%s/%s) failed", resourceNamespace, resourceName)
+			}
+		case DatahubKind.Kind_REPLICASET:
+			_, err := getResource.GetReplicaSet(resourceNamespace, resourceName)
+			if err != nil && k8sErrors.IsNotFound(err) {
+				controllersNeedToRm = append(controllersNeedToRm, alamedaResource)
+				continue
+			} else if err != nil {
+				return errors.Wrapf(err, "get ReplicaSet (%s/%s) failed", resourceNamespace, resourceName)
+			}
+		case DatahubKind.Kind_JOB:
+			_, err := getResource.GetJob(resourceNamespace, resourceName)
+			if err != nil && k8sErrors.IsNotFound(err) {
+				controllersNeedToRm = append(controllersNeedToRm, alamedaResource)
+				continue
+			} else if err != nil {
+				return errors.Wrapf(err, "get Job (%s/%s) failed", resourceNamespace, resourceName)
+			}
+		case DatahubKind.Kind_CRONJOB:
+			_, err := getResource.GetCronJob(resourceNamespace, resourceName)
+			if err != nil && k8sErrors.IsNotFound(err) {
+				controllersNeedToRm = append(controllersNeedToRm, alamedaResource)
+				continue
+			} else if err != nil {
+				return errors.Wrapf(err, "get CronJob (%s/%s) failed", resourceNamespace, resourceName)
+			}
 		default:
-			return errors.Errorf("unknown controller datahub kind \"%s\"", kind.String())
+			return errors.Errorf("unknown controller datahub kind \"%s\"", DatahubKind.Name(kind))
 		}
 
 		// Get AlamedaScaler that owning this controller from k8s,
@@ -25,7 +25,7 @@ func NewK8SResource() *K8SResource {
 }
 
 func (repo *K8SResource) ListAlamedaWatchedResource(namespacedName *datahub_v1alpha1.NamespacedName) ([]*datahub_v1alpha1.Controller, error) {
-	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), grpc.WithInsecure())
+	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), datahubutils.GetDialOptions()...)
 	if err != nil {
 		return nil, errors.Errorf("list controllers to datahub failed: %s", err.Error())
 	}
@@ -51,7 +51,7 @@ func (repo *K8SResource) ListAlamedaWatchedResource(namespacedName *datahub_v1al
 }
 
 func (repo *K8SResource) CreateAlamedaWatchedResource(resources []*datahub_v1alpha1.Controller) error {
-	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), grpc.WithInsecure())
+	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), datahubutils.GetDialOptions()...)
 	if err != nil {
 		return errors.Errorf("create controllers to datahub failed: %s", err.Error())
 	}
@@ -73,7 +73,7 @@ func (repo *K8SResource) CreateAlamedaWatchedResource(resources []*datahub_v1alp
 }
 
 func (repo *K8SResource) DeleteAlamedaWatchedResource(resources []*datahub_v1alpha1.Controller) error {
-	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), grpc.WithInsecure())
+	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), datahubutils.GetDialOptions()...)
 	if err != nil {
 		return errors.Errorf("delete controllers to datahub failed: %s", err.Error())
 	}
@@ -244,7 +244,7 @@ func (repo *AlamedaNodeRepository) createAlamedaNode(nodes []*corev1.Node) error
 	req := datahub_v1alpha1.CreateAlamedaNodesRequest{
 		AlamedaNodes: alamedaNodes,
 	}
-	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), grpc.WithInsecure())
+	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), datahubutils.GetDialOptions()...)
 	defer conn.Close()
 
 	if err != nil {
@@ -267,7 +267,7 @@ func (repo *AlamedaNodeRepository) createAlamedaNode(nodes []*corev1.Node) error
 // DeleteAlamedaNodes delete predicted node from datahub
 func (repo *AlamedaNodeRepository) DeleteAlamedaNodes(nodes []*corev1.Node) error {
 
-	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), grpc.WithInsecure())
+	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), datahubutils.GetDialOptions()...)
 	defer conn.Close()
 	if err != nil {
 		return errors.Wrapf(err, "delete node from Datahub failed: %s", err.Error())
@@ -313,7 +313,7 @@ func (repo *AlamedaNodeRepository) ListAlamedaNodes() ([]*datahub_v1alpha1.Node,
 func (repo *AlamedaNodeRepository) listAlamedaNodes() ([]*datahub_v1alpha1.Node, error) {
 	alamNodes := []*datahub_v1alpha1.Node{}
 	req := datahub_v1alpha1.ListAlamedaNodesRequest{}
-	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), grpc.WithInsecure())
+	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), datahubutils.GetDialOptions()...)
 	defer conn.Close()
 
 	if err != nil {
@@ -25,7 +25,7 @@ func NewPodRepository() *PodRepository {
 
 func (repo *PodRepository) ListAlamedaPods() ([]*datahub_v1alpha1.Pod, error) {
 	alamedaPods := []*datahub_v1alpha1.Pod{}
-	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), grpc.WithInsecure())
+	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), datahubutils.GetDialOptions()...)
 	defer conn.Close()
 	if err != nil {
 		return nil, errors.Wrapf(err, "list Alameda pods from Datahub failed: %s", err.Error())
@@ -48,7 +48,7 @@ func (repo *PodRepository) ListAlamedaPods() ([]*datahub_v1alpha1.Pod, error) {
 // DeletePods delete pods from datahub
 func (repo *PodRepository) DeletePods(pods []*datahub_v1alpha1.Pod) error {
 
-	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), grpc.WithInsecure())
+	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), datahubutils.GetDialOptions()...)
 	defer conn.Close()
 	if err != nil {
 		return errors.Wrapf(err, "delete pods from Datahub failed: %s", err.Error())
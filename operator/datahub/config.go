@@ -5,6 +5,7 @@ import (
 	"net/url"
 
 	datahubutils "github.com/containers-ai/alameda/operator/pkg/utils/datahub"
+	DatahubCredentials "github.com/containers-ai/alameda/pkg/framework/datahub/credentials"
 )
 
 type retryInterval struct {
@@ -13,7 +14,11 @@ type retryInterval struct {
 
 type Config struct {
 	Address       string        `mapstructure:"address"`
+	ClusterID     string        `mapstructure:"clusterID"`
+	ClusterName   string        `mapstructure:"clusterName"`
 	RetryInterval retryInterval `mapstructure:"retryInterval"`
+
+	DatahubCredentials.Config `mapstructure:",squash"`
 }
 
 func NewConfig() *Config {
@@ -28,6 +33,7 @@ func (c *Config) init() {
 	c.RetryInterval = retryInterval{
 		Default: 3,
 	}
+	c.Config = *DatahubCredentials.NewDefaultConfig()
 }
 
 func (c *Config) Validate() error {
@@ -39,5 +45,10 @@ func (c *Config) Validate() error {
 		return errors.New("datahub config validate failed: " + err.Error())
 	}
 
+	err = c.Config.Validate()
+	if err != nil {
+		return errors.New("datahub config validate failed: " + err.Error())
+	}
+
 	return nil
 }
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"github.com/containers-ai/alameda/operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -29,10 +30,54 @@ type AlamedaRecommendationSpec struct {
 	Containers []AlamedaContainer `json:"containers" protobuf:"bytes,1,opt,name=containers"`
 }
 
+// RecommendationApplier is the component applying recommendations to pods
+type RecommendationApplier = string
+
+const (
+	RecommendationApplierEvictioner          RecommendationApplier = "evictioner"
+	RecommendationApplierAdmissionController RecommendationApplier = "admission-controller"
+)
+
+const (
+	// AlamedaRecommendationHistoryLimit is the max number of histories kept per controller in AlamedaScaler
+	// and per pod in AlamedaRecommendation
+	AlamedaRecommendationHistoryLimit = 10
+	// AnnotationKeyAppliedRecommendation is the pod annotation recording the history of recommendation
+	// applied by admission controller, it is moved into AlamedaScaler monitoring the pod
+	AnnotationKeyAppliedRecommendation = "autoscaling.containers.ai/applied-recommendation"
+)
+
+// AlamedaRecommendationHistory is an attempt to apply recommendation to the pod
+type AlamedaRecommendationHistory struct {
+	Time    metav1.Time           `json:"time" protobuf:"bytes,1,opt,name=time"`
+	PodName string                `json:"podName" protobuf:"bytes,2,opt,name=pod_name"`
+	Applier RecommendationApplier `json:"applier" protobuf:"bytes,3,opt,name=applier"`
+	Applied bool                  `json:"applied" protobuf:"varint,4,opt,name=applied"`
+	// Reason why the recommendation is not applied
+	Reason string             `json:"reason,omitempty" protobuf:"bytes,5,opt,name=reason"`
+	Before []AlamedaContainer `json:"before,omitempty" protobuf:"bytes,6,rep,name=before"`
+	After  []AlamedaContainer `json:"after,omitempty" protobuf:"bytes,7,rep,name=after"`
+}
+
+// NewAlamedaContainers returns resources of init containers and containers in the pod spec
+func NewAlamedaContainers(podSpec corev1.PodSpec) []AlamedaContainer {
+	alamedaContainers := make([]AlamedaContainer, 0, len(podSpec.InitContainers)+len(podSpec.Containers))
+	for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for _, container := range containers {
+			alamedaContainers = append(alamedaContainers, AlamedaContainer{
+				Name:      container.Name,
+				Resources: *container.Resources.DeepCopy(),
+			})
+		}
+	}
+	return alamedaContainers
+}
+
 // AlamedaRecommendationStatus defines the observed state of AlamedaRecommendation
 type AlamedaRecommendationStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// Histories of applying recommendations to the pod, the latest first. They are copied from histories
+	// of the controller of the pod in AlamedaScaler, which are kept after the pod is replaced.
+	History []AlamedaRecommendationHistory `json:"history,omitempty" protobuf:"bytes,1,rep,name=history"`
 }
 
 // +genclient
@@ -40,6 +85,9 @@ type AlamedaRecommendationStatus struct {
 
 // AlamedaRecommendation is the Schema for the alamedarecommendations API
 // +k8s:openapi-gen=true
+// +kubebuilder:printcolumn:name="Last Applier",type="string",JSONPath=".status.history[0].applier"
+// +kubebuilder:printcolumn:name="Last Applied",type="boolean",JSONPath=".status.history[0].applied"
+// +kubebuilder:printcolumn:name="Last Time",type="date",JSONPath=".status.history[0].time"
 type AlamedaRecommendation struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
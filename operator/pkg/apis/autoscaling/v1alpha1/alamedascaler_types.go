@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/containers-ai/alameda/operator/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
@@ -69,6 +71,12 @@ type AlamedaController struct {
 	Deployments       map[NamespacedName]AlamedaResource `json:"deployments,omitempty" protobuf:"bytes,1,opt,name=deployments"`
 	DeploymentConfigs map[NamespacedName]AlamedaResource `json:"deploymentConfigs,omitempty" protobuf:"bytes,2,opt,name=deployment_configs"`
 	StatefulSets      map[NamespacedName]AlamedaResource `json:"statefulSets,omitempty" protobuf:"bytes,3,opt,name=stateful_sets"`
+	DaemonSets        map[NamespacedName]AlamedaResource `json:"daemonSets,omitempty" protobuf:"bytes,4,opt,name=daemon_sets"`
+	// ReplicaSets are ReplicaSets which are not controlled by Deployments
+	ReplicaSets map[NamespacedName]AlamedaResource `json:"replicaSets,omitempty" protobuf:"bytes,5,opt,name=replica_sets"`
+	// Jobs are Jobs which are not controlled by CronJobs
+	Jobs     map[NamespacedName]AlamedaResource `json:"jobs,omitempty" protobuf:"bytes,6,opt,name=jobs"`
+	CronJobs map[NamespacedName]AlamedaResource `json:"cronJobs,omitempty" protobuf:"bytes,7,opt,name=cron_jobs"`
 }
 
 type AlamedaControllerType int
@@ -77,6 +85,10 @@ const (
 	DeploymentController       AlamedaControllerType = 1
 	DeploymentConfigController AlamedaControllerType = 2
 	StatefulSetController      AlamedaControllerType = 3
+	DaemonSetController        AlamedaControllerType = 4
+	ReplicaSetController       AlamedaControllerType = 5
+	JobController              AlamedaControllerType = 6
+	CronJobController          AlamedaControllerType = 7
 )
 
 var (
@@ -84,12 +96,20 @@ var (
 		DeploymentController:       "deployment",
 		DeploymentConfigController: "deploymentconfig",
 		StatefulSetController:      "statefulset",
+		DaemonSetController:        "daemonset",
+		ReplicaSetController:       "replicaset",
+		JobController:              "job",
+		CronJobController:          "cronjob",
 	}
 
 	K8SKindToAlamedaControllerType = map[string]AlamedaControllerType{
 		"Deployment":       DeploymentController,
 		"DeploymentConfig": DeploymentConfigController,
 		"StatefulSet":      StatefulSetController,
+		"DaemonSet":        DaemonSetController,
+		"ReplicaSet":       ReplicaSetController,
+		"Job":              JobController,
+		"CronJob":          CronJobController,
 	}
 )
 
@@ -118,8 +138,26 @@ type ExecutionStrategy struct {
 	MaxUnavailable   string                       `json:"maxUnavailable,omitempty" protobuf:"bytes,1,name=max_unavailable"`
 	TriggerThreshold *TriggerThreshold            `json:"triggerThreshold,omitempty" protobuf:"bytes,2,name=trigger_threshold"`
 	Resources        *corev1.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,3,name=resources"`
+	// DryRun makes the evictioner only report the pods it would evict instead of evicting them
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,4,name=dry_run"`
+	// Schedule limits when the evictioner executes recommendations
+	Schedule *ExecutionSchedule `json:"schedule,omitempty" protobuf:"bytes,5,name=schedule"`
+	// LimitsPolicy is how the admission controller patches resource limits of containers, default is recommended
+	// +kubebuilder:validation:Enum=recommended,preserve,preserveRatio
+	LimitsPolicy LimitsPolicy `json:"limitsPolicy,omitempty" protobuf:"bytes,6,name=limits_policy"`
 }
 
+type LimitsPolicy = string
+
+const (
+	// LimitsPolicyRecommended patches both requests and limits with recommendations
+	LimitsPolicyRecommended LimitsPolicy = "recommended"
+	// LimitsPolicyPreserve patches requests only and keeps the original limits
+	LimitsPolicyPreserve LimitsPolicy = "preserve"
+	// LimitsPolicyPreserveRatio patches requests only and scales limits to keep the original limit/request ratio
+	LimitsPolicyPreserveRatio LimitsPolicy = "preserveRatio"
+)
+
 const (
 	DefaultMaxUnavailablePercentage = "25%"
 )
@@ -163,6 +201,21 @@ type AlamedaScalerSpec struct {
 // AlamedaScalerStatus defines the observed state of AlamedaScaler
 type AlamedaScalerStatus struct {
 	AlamedaController AlamedaController `json:"alamedaController,omitempty" protobuf:"bytes,4,opt,name=alameda_controller"`
+	// NextExecutionWindow is the current or next window recommendations are executed in if execution schedule is set
+	NextExecutionWindow *ExecutionWindowStatus `json:"nextExecutionWindow,omitempty" protobuf:"bytes,5,opt,name=next_execution_window"`
+	// RecommendationHistories are histories of applying recommendations to pods of the monitored controllers,
+	// they are kept per controller so histories survive pods and their AlamedaRecommendations being replaced
+	RecommendationHistories []ControllerRecommendationHistory `json:"recommendationHistories,omitempty" protobuf:"bytes,6,rep,name=recommendation_histories"`
+}
+
+// ControllerRecommendationHistory is the history of applying recommendations to pods of a controller
+type ControllerRecommendationHistory struct {
+	// Kind is the kubernetes kind of the controller, such as Deployment
+	Kind      string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	Namespace string `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
+	Name      string `json:"name" protobuf:"bytes,3,opt,name=name"`
+	// History of applying recommendations to pods of the controller, the latest first
+	History []AlamedaRecommendationHistory `json:"history,omitempty" protobuf:"bytes,4,rep,name=history"`
 }
 
 // +genclient
@@ -221,6 +274,34 @@ func (as *AlamedaScaler) GetMonitoredPods() []*AlamedaPod {
 		}
 	}
 
+	for _, alamedaResource := range as.Status.AlamedaController.DaemonSets {
+		for _, pod := range alamedaResource.Pods {
+			cpPod := pod
+			pods = append(pods, &cpPod)
+		}
+	}
+
+	for _, alamedaResource := range as.Status.AlamedaController.ReplicaSets {
+		for _, pod := range alamedaResource.Pods {
+			cpPod := pod
+			pods = append(pods, &cpPod)
+		}
+	}
+
+	for _, alamedaResource := range as.Status.AlamedaController.Jobs {
+		for _, pod := range alamedaResource.Pods {
+			cpPod := pod
+			pods = append(pods, &cpPod)
+		}
+	}
+
+	for _, alamedaResource := range as.Status.AlamedaController.CronJobs {
+		for _, pod := range alamedaResource.Pods {
+			cpPod := pod
+			pods = append(pods, &cpPod)
+		}
+	}
+
 	return pods
 }
 
@@ -309,6 +390,63 @@ func (as *AlamedaScaler) IsEnableExecution() bool {
 	return true
 }
 
+// IsDryRun returns true if recommendations of the AlamedaScaler are executed in dry-run mode
+func (as *AlamedaScaler) IsDryRun() bool {
+	executionStrategy := as.Spec.ScalingTool.ExecutionStrategy
+	return executionStrategy != nil && executionStrategy.DryRun
+}
+
+// GetLimitsPolicy returns how the admission controller patches resource limits of pods watched by the AlamedaScaler
+func (as *AlamedaScaler) GetLimitsPolicy() LimitsPolicy {
+	executionStrategy := as.Spec.ScalingTool.ExecutionStrategy
+	if executionStrategy == nil || executionStrategy.LimitsPolicy == "" {
+		return LimitsPolicyRecommended
+	}
+	return executionStrategy.LimitsPolicy
+}
+
+// GetExecutionSchedule returns the execution schedule of the AlamedaScaler, nil if not set
+func (as *AlamedaScaler) GetExecutionSchedule() *ExecutionSchedule {
+	executionStrategy := as.Spec.ScalingTool.ExecutionStrategy
+	if executionStrategy == nil {
+		return nil
+	}
+	return executionStrategy.Schedule
+}
+
+// IsExecutableAt returns true if recommendations of the AlamedaScaler are allowed to be executed at time t
+func (as *AlamedaScaler) IsExecutableAt(t time.Time) (bool, error) {
+	schedule := as.GetExecutionSchedule()
+	if schedule == nil {
+		return true, nil
+	}
+	return schedule.IsExecutableAt(t)
+}
+
+// SetStatusNextExecutionWindow sets the current or next execution window after time t into status
+func (as *AlamedaScaler) SetStatusNextExecutionWindow(t time.Time) error {
+	schedule := as.GetExecutionSchedule()
+	if schedule == nil {
+		as.Status.NextExecutionWindow = nil
+		return nil
+	}
+
+	start, end, err := schedule.NextWindow(t)
+	if err != nil {
+		as.Status.NextExecutionWindow = nil
+		return err
+	}
+	window := &ExecutionWindowStatus{}
+	if start != nil {
+		window.Start = &metav1.Time{Time: *start}
+	}
+	if end != nil {
+		window.End = &metav1.Time{Time: *end}
+	}
+	as.Status.NextExecutionWindow = window
+	return nil
+}
+
 func (as *AlamedaScaler) IsScalingToolTypeHPA() bool {
 	return as.Spec.ScalingTool.Type == ScalingToolTypeHPA
 }
@@ -348,6 +486,125 @@ func (as *AlamedaScaler) HasAlamedaPod(namespace, name string) bool {
 	return false
 }
 
+// HasAlamedaController returns true if the controller of the kubernetes kind is monitored by AlamedaScaler
+func (as *AlamedaScaler) HasAlamedaController(namespace, name, kind string) bool {
+	_, exist := as.GetAlamedaResourcesOfKind(kind)[utils.GetNamespacedNameKey(namespace, name)]
+	return exist
+}
+
+// AddRecommendationHistory adds history of applying recommendation to pod of the controller of the kubernetes kind,
+// histories of a controller are kept the latest first and at most AlamedaRecommendationHistoryLimit.
+// It returns false if the history is recorded already.
+func (as *AlamedaScaler) AddRecommendationHistory(kind, namespace, name string, history AlamedaRecommendationHistory) bool {
+
+	var controllerHistory *ControllerRecommendationHistory
+	for i := range as.Status.RecommendationHistories {
+		h := &as.Status.RecommendationHistories[i]
+		if h.Kind == kind && h.Namespace == namespace && h.Name == name {
+			controllerHistory = h
+			break
+		}
+	}
+	if controllerHistory == nil {
+		as.Status.RecommendationHistories = append(as.Status.RecommendationHistories, ControllerRecommendationHistory{
+			Kind:      kind,
+			Namespace: namespace,
+			Name:      name,
+		})
+		controllerHistory = &as.Status.RecommendationHistories[len(as.Status.RecommendationHistories)-1]
+	}
+
+	idx := len(controllerHistory.History)
+	for i, h := range controllerHistory.History {
+		if h.PodName == history.PodName && h.Applier == history.Applier && h.Time.Equal(&history.Time) {
+			return false
+		}
+		if h.Time.Before(&history.Time) && idx == len(controllerHistory.History) {
+			idx = i
+		}
+	}
+	if idx >= AlamedaRecommendationHistoryLimit {
+		return false
+	}
+	histories := make([]AlamedaRecommendationHistory, 0, len(controllerHistory.History)+1)
+	histories = append(histories, controllerHistory.History[:idx]...)
+	histories = append(histories, history)
+	histories = append(histories, controllerHistory.History[idx:]...)
+	if len(histories) > AlamedaRecommendationHistoryLimit {
+		histories = histories[:AlamedaRecommendationHistoryLimit]
+	}
+	controllerHistory.History = histories
+	return true
+}
+
+// GetRecommendationHistory returns histories of applying recommendations to pods of the controller of the kubernetes kind, the latest first
+func (as *AlamedaScaler) GetRecommendationHistory(kind, namespace, name string) []AlamedaRecommendationHistory {
+	for _, h := range as.Status.RecommendationHistories {
+		if h.Kind == kind && h.Namespace == namespace && h.Name == name {
+			return h.History
+		}
+	}
+	return nil
+}
+
+// GetPodRecommendationHistory returns histories of applying recommendations to the pod, the latest first
+// and at most AlamedaRecommendationHistoryLimit
+func (as *AlamedaScaler) GetPodRecommendationHistory(namespace, podName string) []AlamedaRecommendationHistory {
+	var histories []AlamedaRecommendationHistory
+	for _, h := range as.Status.RecommendationHistories {
+		if h.Namespace != namespace {
+			continue
+		}
+		for _, history := range h.History {
+			if history.PodName == podName {
+				histories = append(histories, history)
+			}
+		}
+	}
+	sort.SliceStable(histories, func(i, j int) bool {
+		return histories[j].Time.Before(&histories[i].Time)
+	})
+	if len(histories) > AlamedaRecommendationHistoryLimit {
+		histories = histories[:AlamedaRecommendationHistoryLimit]
+	}
+	return histories
+}
+
+// PruneRecommendationHistories drops histories of controllers no longer monitored by AlamedaScaler
+func (as *AlamedaScaler) PruneRecommendationHistories() {
+	histories := make([]ControllerRecommendationHistory, 0, len(as.Status.RecommendationHistories))
+	for _, h := range as.Status.RecommendationHistories {
+		if as.HasAlamedaController(h.Namespace, h.Name, h.Kind) {
+			histories = append(histories, h)
+		}
+	}
+	if len(histories) == 0 {
+		histories = nil
+	}
+	as.Status.RecommendationHistories = histories
+}
+
+// GetAlamedaResourcesOfKind returns controllers of the kubernetes kind monitored by AlamedaScaler
+func (as *AlamedaScaler) GetAlamedaResourcesOfKind(kind string) map[NamespacedName]AlamedaResource {
+	switch K8SKindToAlamedaControllerType[kind] {
+	case DeploymentController:
+		return as.Status.AlamedaController.Deployments
+	case DeploymentConfigController:
+		return as.Status.AlamedaController.DeploymentConfigs
+	case StatefulSetController:
+		return as.Status.AlamedaController.StatefulSets
+	case DaemonSetController:
+		return as.Status.AlamedaController.DaemonSets
+	case ReplicaSetController:
+		return as.Status.AlamedaController.ReplicaSets
+	case JobController:
+		return as.Status.AlamedaController.Jobs
+	case CronJobController:
+		return as.Status.AlamedaController.CronJobs
+	}
+	return nil
+}
+
 func (as *AlamedaScaler) setDefaultEnableExecution() {
 	if as.Spec.EnableExecution == nil {
 		copyDefaultEnableExecution := defaultEnableExecution
@@ -0,0 +1,144 @@
+package v1alpha1
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// BlackoutDateLayout is the layout of blackout dates in ExecutionSchedule
+	BlackoutDateLayout = "2006-01-02"
+
+	maxScheduleLookupCount = 1000
+)
+
+// ExecutionWindow is a period recommendations are allowed to be executed in
+type ExecutionWindow struct {
+	// Start is a standard cron expression with five fields matching the start time of the window
+	Start string `json:"start" protobuf:"bytes,1,name=start"`
+	// Duration is the length of the window, e.g. "2h" or "90m"
+	Duration string `json:"duration" protobuf:"bytes,2,name=duration"`
+}
+
+// ExecutionSchedule limits when recommendations are executed by the evictioner. Pods are always patched
+// by the admission controller, regardless of the schedule.
+type ExecutionSchedule struct {
+	// Windows recommendations are allowed to be executed in, recommendations are allowed to be executed
+	// at any time if no window is specified
+	Windows []ExecutionWindow `json:"windows,omitempty" protobuf:"bytes,1,rep,name=windows"`
+	// BlackoutDates are dates in format of "2006-01-02" recommendations are never executed on
+	BlackoutDates []string `json:"blackoutDates,omitempty" protobuf:"bytes,2,rep,name=blackout_dates"`
+	// TimeZone is the IANA time zone name windows and blackout dates are in, default is UTC
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,3,opt,name=time_zone"`
+}
+
+// ExecutionWindowStatus is a period recommendations are eligible to be executed in
+type ExecutionWindowStatus struct {
+	Start *metav1.Time `json:"start,omitempty" protobuf:"bytes,1,opt,name=start"`
+	// End is empty if the window does not end
+	End *metav1.Time `json:"end,omitempty" protobuf:"bytes,2,opt,name=end"`
+}
+
+type executionWindowSchedule struct {
+	schedule cron.Schedule
+	duration time.Duration
+}
+
+func (s ExecutionSchedule) location() (*time.Location, error) {
+	location, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return nil, errors.Wrapf(err, "load time zone %s failed", s.TimeZone)
+	}
+	return location, nil
+}
+
+func (s ExecutionSchedule) windowSchedules() ([]executionWindowSchedule, error) {
+	schedules := make([]executionWindowSchedule, 0, len(s.Windows))
+	for _, window := range s.Windows {
+		schedule, err := cron.ParseStandard(window.Start)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse start %s of execution window failed", window.Start)
+		}
+		duration, err := time.ParseDuration(window.Duration)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse duration %s of execution window failed", window.Duration)
+		}
+		if duration <= 0 {
+			return nil, errors.Errorf("duration %s of execution window must be positive", window.Duration)
+		}
+		schedules = append(schedules, executionWindowSchedule{schedule: schedule, duration: duration})
+	}
+	return schedules, nil
+}
+
+func (s ExecutionSchedule) isBlackoutDate(t time.Time) bool {
+	date := t.Format(BlackoutDateLayout)
+	for _, blackoutDate := range s.BlackoutDates {
+		if blackoutDate == date {
+			return true
+		}
+	}
+	return false
+}
+
+// IsExecutableAt returns true if recommendations are allowed to be executed at time t
+func (s ExecutionSchedule) IsExecutableAt(t time.Time) (bool, error) {
+	start, _, err := s.NextWindow(t)
+	if err != nil {
+		return false, err
+	}
+	return start != nil && !start.After(t), nil
+}
+
+// NextWindow returns the window containing time t, or the first window after t if t is in none of them.
+// The returned start is nil if no window is found and the returned end is nil if the window does not end.
+func (s ExecutionSchedule) NextWindow(t time.Time) (*time.Time, *time.Time, error) {
+	location, err := s.location()
+	if err != nil {
+		return nil, nil, err
+	}
+	schedules, err := s.windowSchedules()
+	if err != nil {
+		return nil, nil, err
+	}
+	t = t.In(location)
+
+	// Without windows, recommendations are executable anytime except on blackout dates
+	if len(schedules) == 0 {
+		var start *time.Time
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, location)
+		for i := 0; i < maxScheduleLookupCount; i++ {
+			if start == nil && !s.isBlackoutDate(day) {
+				// Starts from the beginning of the day, so the window stays the same across the day
+				windowStart := day
+				start = &windowStart
+			} else if start != nil && s.isBlackoutDate(day) {
+				end := day
+				return start, &end, nil
+			}
+			day = day.AddDate(0, 0, 1)
+		}
+		return start, nil, nil
+	}
+
+	var nextStart, nextEnd *time.Time
+	for _, windowSchedule := range schedules {
+		// Windows starting in (t-duration, t] contain t
+		start := windowSchedule.schedule.Next(t.Add(-windowSchedule.duration))
+		for i := 0; i < maxScheduleLookupCount && !start.IsZero(); i++ {
+			end := start.Add(windowSchedule.duration)
+			if !s.isBlackoutDate(start) && (start.After(t) || !s.isBlackoutDate(t)) {
+				if nextStart == nil || start.Before(*nextStart) {
+					windowStart, windowEnd := start, end
+					nextStart, nextEnd = &windowStart, &windowEnd
+				}
+				break
+			}
+			start = windowSchedule.schedule.Next(start)
+		}
+	}
+	return nextStart, nextEnd, nil
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2019 The Alameda Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExecutionScheduleNextWindow(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(location *time.Location, value string) *time.Time {
+		parsed, err := time.ParseInLocation("2006-01-02 15:04", value, location)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &parsed
+	}
+
+	tests := []struct {
+		name       string
+		schedule   ExecutionSchedule
+		t          *time.Time
+		wantStart  *time.Time
+		wantEnd    *time.Time
+		executable bool
+		wantErr    bool
+	}{
+		{
+			name:       "no window executable anytime",
+			schedule:   ExecutionSchedule{},
+			t:          at(time.UTC, "2019-06-01 10:00"),
+			wantStart:  at(time.UTC, "2019-06-01 00:00"),
+			executable: true,
+		},
+		{
+			name:       "no window held on blackout date",
+			schedule:   ExecutionSchedule{BlackoutDates: []string{"2019-06-01", "2019-06-03"}},
+			t:          at(time.UTC, "2019-06-01 10:00"),
+			wantStart:  at(time.UTC, "2019-06-02 00:00"),
+			wantEnd:    at(time.UTC, "2019-06-03 00:00"),
+			executable: false,
+		},
+		{
+			name: "inside window",
+			schedule: ExecutionSchedule{
+				Windows: []ExecutionWindow{{Start: "0 2 * * *", Duration: "2h"}},
+			},
+			t:          at(time.UTC, "2019-06-01 03:00"),
+			wantStart:  at(time.UTC, "2019-06-01 02:00"),
+			wantEnd:    at(time.UTC, "2019-06-01 04:00"),
+			executable: true,
+		},
+		{
+			name: "before window",
+			schedule: ExecutionSchedule{
+				Windows: []ExecutionWindow{{Start: "0 2 * * *", Duration: "2h"}},
+			},
+			t:          at(time.UTC, "2019-06-01 01:00"),
+			wantStart:  at(time.UTC, "2019-06-01 02:00"),
+			wantEnd:    at(time.UTC, "2019-06-01 04:00"),
+			executable: false,
+		},
+		{
+			name: "window crossing midnight contains time after midnight",
+			schedule: ExecutionSchedule{
+				Windows: []ExecutionWindow{{Start: "0 23 * * *", Duration: "3h"}},
+			},
+			t:          at(time.UTC, "2019-06-02 01:30"),
+			wantStart:  at(time.UTC, "2019-06-01 23:00"),
+			wantEnd:    at(time.UTC, "2019-06-02 02:00"),
+			executable: true,
+		},
+		{
+			name: "window crossing midnight into blackout date",
+			schedule: ExecutionSchedule{
+				Windows:       []ExecutionWindow{{Start: "0 23 * * *", Duration: "3h"}},
+				BlackoutDates: []string{"2019-06-02"},
+			},
+			t:          at(time.UTC, "2019-06-02 01:30"),
+			wantStart:  at(time.UTC, "2019-06-03 23:00"),
+			wantEnd:    at(time.UTC, "2019-06-04 02:00"),
+			executable: false,
+		},
+		{
+			name: "window starting on blackout date skipped",
+			schedule: ExecutionSchedule{
+				Windows:       []ExecutionWindow{{Start: "0 2 * * *", Duration: "2h"}},
+				BlackoutDates: []string{"2019-06-01"},
+			},
+			t:          at(time.UTC, "2019-06-01 03:00"),
+			wantStart:  at(time.UTC, "2019-06-02 02:00"),
+			wantEnd:    at(time.UTC, "2019-06-02 04:00"),
+			executable: false,
+		},
+		{
+			name: "earliest of windows",
+			schedule: ExecutionSchedule{
+				Windows: []ExecutionWindow{
+					{Start: "0 22 * * *", Duration: "1h"},
+					{Start: "0 12 * * 6", Duration: "1h"},
+				},
+			},
+			t:          at(time.UTC, "2019-06-01 08:00"),
+			wantStart:  at(time.UTC, "2019-06-01 12:00"),
+			wantEnd:    at(time.UTC, "2019-06-01 13:00"),
+			executable: false,
+		},
+		{
+			name: "window in time zone",
+			schedule: ExecutionSchedule{
+				Windows:  []ExecutionWindow{{Start: "0 2 * * *", Duration: "2h"}},
+				TimeZone: "America/New_York",
+			},
+			t:          at(time.UTC, "2019-06-01 07:00"),
+			wantStart:  at(newYork, "2019-06-01 02:00"),
+			wantEnd:    at(newYork, "2019-06-01 04:00"),
+			executable: true,
+		},
+		{
+			name: "window after daylight saving time starts",
+			schedule: ExecutionSchedule{
+				Windows:  []ExecutionWindow{{Start: "0 4 * * *", Duration: "2h"}},
+				TimeZone: "America/New_York",
+			},
+			t:          at(time.UTC, "2019-03-10 08:30"),
+			wantStart:  at(newYork, "2019-03-10 04:00"),
+			wantEnd:    at(newYork, "2019-03-10 06:00"),
+			executable: true,
+		},
+		{
+			name: "window lasting over daylight saving time end",
+			schedule: ExecutionSchedule{
+				Windows:  []ExecutionWindow{{Start: "0 0 * * *", Duration: "3h"}},
+				TimeZone: "America/New_York",
+			},
+			// 02:30 EST is 3.5 hours after 00:00 EDT
+			t:          at(newYork, "2019-11-03 02:30"),
+			wantStart:  at(newYork, "2019-11-04 00:00"),
+			wantEnd:    at(newYork, "2019-11-04 03:00"),
+			executable: false,
+		},
+		{
+			name: "blackout date in time zone",
+			schedule: ExecutionSchedule{
+				Windows:       []ExecutionWindow{{Start: "0 22 * * *", Duration: "1h"}},
+				BlackoutDates: []string{"2019-06-01"},
+				TimeZone:      "America/New_York",
+			},
+			// 2019-06-02 02:30 UTC is 2019-06-01 22:30 in New York
+			t:          at(time.UTC, "2019-06-02 02:30"),
+			wantStart:  at(newYork, "2019-06-02 22:00"),
+			wantEnd:    at(newYork, "2019-06-02 23:00"),
+			executable: false,
+		},
+		{
+			name: "invalid time zone",
+			schedule: ExecutionSchedule{
+				Windows:  []ExecutionWindow{{Start: "0 2 * * *", Duration: "2h"}},
+				TimeZone: "Mars/Olympus_Mons",
+			},
+			t:       at(time.UTC, "2019-06-01 03:00"),
+			wantErr: true,
+		},
+		{
+			name: "invalid cron expression",
+			schedule: ExecutionSchedule{
+				Windows: []ExecutionWindow{{Start: "0 25 * * *", Duration: "2h"}},
+			},
+			t:       at(time.UTC, "2019-06-01 03:00"),
+			wantErr: true,
+		},
+		{
+			name: "invalid duration",
+			schedule: ExecutionSchedule{
+				Windows: []ExecutionWindow{{Start: "0 2 * * *", Duration: "-2h"}},
+			},
+			t:       at(time.UTC, "2019-06-01 03:00"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := tt.schedule.NextWindow(*tt.t)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NextWindow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			executable, executableErr := tt.schedule.IsExecutableAt(*tt.t)
+			if (executableErr != nil) != tt.wantErr {
+				t.Fatalf("IsExecutableAt() error = %v, wantErr %v", executableErr, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !sameTime(start, tt.wantStart) || !sameTime(end, tt.wantEnd) {
+				t.Errorf("NextWindow() = (%v, %v), want (%v, %v)", start, end, tt.wantStart, tt.wantEnd)
+			}
+			if executable != tt.executable {
+				t.Errorf("IsExecutableAt() = %v, want %v", executable, tt.executable)
+			}
+		})
+	}
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
@@ -66,6 +66,34 @@ func (in *AlamedaController) DeepCopyInto(out *AlamedaController) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.DaemonSets != nil {
+		in, out := &in.DaemonSets, &out.DaemonSets
+		*out = make(map[string]AlamedaResource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.ReplicaSets != nil {
+		in, out := &in.ReplicaSets, &out.ReplicaSets
+		*out = make(map[string]AlamedaResource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make(map[string]AlamedaResource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.CronJobs != nil {
+		in, out := &in.CronJobs, &out.CronJobs
+		*out = make(map[string]AlamedaResource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -108,7 +136,7 @@ func (in *AlamedaRecommendation) DeepCopyInto(out *AlamedaRecommendation) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -130,6 +158,37 @@ func (in *AlamedaRecommendation) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlamedaRecommendationHistory) DeepCopyInto(out *AlamedaRecommendationHistory) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Before != nil {
+		in, out := &in.Before, &out.Before
+		*out = make([]AlamedaContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.After != nil {
+		in, out := &in.After, &out.After
+		*out = make([]AlamedaContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlamedaRecommendationHistory.
+func (in *AlamedaRecommendationHistory) DeepCopy() *AlamedaRecommendationHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(AlamedaRecommendationHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlamedaRecommendationList) DeepCopyInto(out *AlamedaRecommendationList) {
 	*out = *in
@@ -189,6 +248,13 @@ func (in *AlamedaRecommendationSpec) DeepCopy() *AlamedaRecommendationSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlamedaRecommendationStatus) DeepCopyInto(out *AlamedaRecommendationStatus) {
 	*out = *in
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]AlamedaRecommendationHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -322,6 +388,18 @@ func (in *AlamedaScalerSpec) DeepCopy() *AlamedaScalerSpec {
 func (in *AlamedaScalerStatus) DeepCopyInto(out *AlamedaScalerStatus) {
 	*out = *in
 	in.AlamedaController.DeepCopyInto(&out.AlamedaController)
+	if in.NextExecutionWindow != nil {
+		in, out := &in.NextExecutionWindow, &out.NextExecutionWindow
+		*out = new(ExecutionWindowStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RecommendationHistories != nil {
+		in, out := &in.RecommendationHistories, &out.RecommendationHistories
+		*out = make([]ControllerRecommendationHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -335,6 +413,29 @@ func (in *AlamedaScalerStatus) DeepCopy() *AlamedaScalerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerRecommendationHistory) DeepCopyInto(out *ControllerRecommendationHistory) {
+	*out = *in
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]AlamedaRecommendationHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerRecommendationHistory.
+func (in *ControllerRecommendationHistory) DeepCopy() *ControllerRecommendationHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerRecommendationHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutionStrategy) DeepCopyInto(out *ExecutionStrategy) {
 	*out = *in
@@ -343,9 +444,40 @@ func (in *ExecutionStrategy) DeepCopyInto(out *ExecutionStrategy) {
 		*out = new(TriggerThreshold)
 		**out = **in
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(ExecutionSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionSchedule) DeepCopyInto(out *ExecutionSchedule) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]ExecutionWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlackoutDates != nil {
+		in, out := &in.BlackoutDates, &out.BlackoutDates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionSchedule.
+func (in *ExecutionSchedule) DeepCopy() *ExecutionSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionStrategy.
 func (in *ExecutionStrategy) DeepCopy() *ExecutionStrategy {
 	if in == nil {
@@ -356,6 +488,46 @@ func (in *ExecutionStrategy) DeepCopy() *ExecutionStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionWindow) DeepCopyInto(out *ExecutionWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionWindow.
+func (in *ExecutionWindow) DeepCopy() *ExecutionWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionWindowStatus) DeepCopyInto(out *ExecutionWindowStatus) {
+	*out = *in
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionWindowStatus.
+func (in *ExecutionWindowStatus) DeepCopy() *ExecutionWindowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionWindowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalingToolSpec) DeepCopyInto(out *ScalingToolSpec) {
 	*out = *in
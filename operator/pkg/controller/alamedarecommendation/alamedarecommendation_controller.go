@@ -122,7 +122,7 @@ func (r *ReconcileAlamedaRecommendation) Reconcile(request reconcile.Request) (r
 
 		// Update Recommendation from datahub
 		alamedarecommendationReconciler := alamedarecommendation_reconciler.NewReconciler(r, alamedaRecommendation)
-		if conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), grpc.WithInsecure()); err == nil {
+		if conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), datahubutils.GetDialOptions()...); err == nil {
 			defer conn.Close()
 			aiServiceClnt := datahub_v1alpha1.NewDatahubServiceClient(conn)
 			req := datahub_v1alpha1.ListPodRecommendationsRequest{
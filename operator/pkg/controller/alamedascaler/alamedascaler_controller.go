@@ -30,6 +30,7 @@ import (
 	"github.com/containers-ai/alameda/operator/pkg/utils"
 	datahubutils "github.com/containers-ai/alameda/operator/pkg/utils/datahub"
 	utilsresource "github.com/containers-ai/alameda/operator/pkg/utils/resources"
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
 	alamutils "github.com/containers-ai/alameda/pkg/utils"
 	datahubutilscontainer "github.com/containers-ai/alameda/pkg/utils/datahub/container"
 	datahubutilspod "github.com/containers-ai/alameda/pkg/utils/datahub/pod"
@@ -42,6 +43,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	logUtil "github.com/containers-ai/alameda/pkg/utils/log"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -194,6 +196,75 @@ func (r *ReconcileAlamedaScaler) Reconcile(request reconcile.Request) (reconcile
 			return reconcile.Result{Requeue: true, RequeueAfter: 1 * time.Second}, nil
 		}
 
+		// select matched daemonSets
+		if daemonSets, err := listResources.ListDaemonSetsByNamespaceLabels(request.Namespace, alamedaScaler.Spec.Selector.MatchLabels); err == nil {
+			for _, daemonSet := range daemonSets {
+				alamedaScaler, err = alamedascalerReconciler.UpdateStatusByDaemonSet(&daemonSet)
+				if err != nil {
+					scope.Errorf("update AlamedaScaler's (%s/%s) status by DaemonSets failed, retry reconciling: %s", request.Namespace, request.Name, err.Error())
+					return reconcile.Result{Requeue: true, RequeueAfter: 1 * time.Second}, nil
+				}
+			}
+		} else {
+			scope.Error(err.Error())
+			return reconcile.Result{Requeue: true, RequeueAfter: 1 * time.Second}, nil
+		}
+
+		// select matched replicaSets which are not controlled by Deployments
+		if replicaSets, err := listResources.ListReplicaSetsByNamespaceLabels(request.Namespace, alamedaScaler.Spec.Selector.MatchLabels); err == nil {
+			for _, replicaSet := range replicaSets {
+				if utilsresource.IsControlledByController(&replicaSet) {
+					continue
+				}
+				alamedaScaler, err = alamedascalerReconciler.UpdateStatusByReplicaSet(&replicaSet)
+				if err != nil {
+					scope.Errorf("update AlamedaScaler's (%s/%s) status by ReplicaSets failed, retry reconciling: %s", request.Namespace, request.Name, err.Error())
+					return reconcile.Result{Requeue: true, RequeueAfter: 1 * time.Second}, nil
+				}
+			}
+		} else {
+			scope.Error(err.Error())
+			return reconcile.Result{Requeue: true, RequeueAfter: 1 * time.Second}, nil
+		}
+
+		// select matched jobs which are not controlled by CronJobs
+		if jobs, err := listResources.ListJobsByNamespaceLabels(request.Namespace, alamedaScaler.Spec.Selector.MatchLabels); err == nil {
+			for _, job := range jobs {
+				if utilsresource.IsControlledByController(&job) {
+					continue
+				}
+				alamedaScaler, err = alamedascalerReconciler.UpdateStatusByJob(&job)
+				if err != nil {
+					scope.Errorf("update AlamedaScaler's (%s/%s) status by Jobs failed, retry reconciling: %s", request.Namespace, request.Name, err.Error())
+					return reconcile.Result{Requeue: true, RequeueAfter: 1 * time.Second}, nil
+				}
+			}
+		} else {
+			scope.Error(err.Error())
+			return reconcile.Result{Requeue: true, RequeueAfter: 1 * time.Second}, nil
+		}
+
+		// select matched cronJobs
+		if cronJobs, err := listResources.ListCronJobsByNamespaceLabels(request.Namespace, alamedaScaler.Spec.Selector.MatchLabels); err == nil {
+			for _, cronJob := range cronJobs {
+				alamedaScaler, err = alamedascalerReconciler.UpdateStatusByCronJob(&cronJob)
+				if err != nil {
+					scope.Errorf("update AlamedaScaler's (%s/%s) status by CronJobs failed, retry reconciling: %s", request.Namespace, request.Name, err.Error())
+					return reconcile.Result{Requeue: true, RequeueAfter: 1 * time.Second}, nil
+				}
+			}
+		} else {
+			scope.Error(err.Error())
+			return reconcile.Result{Requeue: true, RequeueAfter: 1 * time.Second}, nil
+		}
+
+		alamedaScaler = alamedascalerReconciler.UpdateRecommendationHistories()
+
+		now := time.Now()
+		if err := alamedaScaler.SetStatusNextExecutionWindow(now); err != nil {
+			scope.Errorf("Set next execution window of AlamedaScaler (%s/%s) failed: %s", alamedaScalerNS, alamedaScalerName, err.Error())
+		}
+
 		if err := updateResource.UpdateAlamedaScaler(alamedaScaler); err != nil {
 			scope.Errorf("Update AlamedaScaler (%s/%s) failed: %s", alamedaScalerNS, alamedaScalerName, err.Error())
 			return reconcile.Result{Requeue: true, RequeueAfter: 1 * time.Second}, nil
@@ -224,6 +295,11 @@ func (r *ReconcileAlamedaScaler) Reconcile(request reconcile.Request) (reconcile
 			return reconcile.Result{Requeue: true, RequeueAfter: 1 * time.Second}, nil
 		}
 
+		// Refresh the next execution window in status when the current one starts or ends
+		if requeueAfter := nextExecutionWindowRequeueDuration(alamedaScaler, now); requeueAfter > 0 {
+			return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		}
+
 	} else {
 		scope.Errorf("get AlamedaScaler %s/%s failed: %s", request.Namespace, request.Name, err.Error())
 		return reconcile.Result{Requeue: true, RequeueAfter: 1 * time.Second}, nil
@@ -232,6 +308,20 @@ func (r *ReconcileAlamedaScaler) Reconcile(request reconcile.Request) (reconcile
 	return reconcile.Result{}, nil
 }
 
+func nextExecutionWindowRequeueDuration(alamedaScaler *autoscalingv1alpha1.AlamedaScaler, now time.Time) time.Duration {
+	window := alamedaScaler.Status.NextExecutionWindow
+	if window == nil {
+		return 0
+	}
+	if window.Start != nil && window.Start.After(now) {
+		return window.Start.Sub(now)
+	}
+	if window.End != nil && window.End.After(now) {
+		return window.End.Sub(now)
+	}
+	return 0
+}
+
 func (r *ReconcileAlamedaScaler) syncAlamedaScalerWithDepResources(alamedaScaler *autoscalingv1alpha1.AlamedaScaler) error {
 
 	existingPodsMap := make(map[autoscalingv1alpha1.NamespacedName]bool)
@@ -387,10 +477,59 @@ func (r *ReconcileAlamedaScaler) createAlamedaWatchedResourcesToDatahub(scaler *
 			SpecReplicas:                  *statefulSet.SpecReplicas,
 		})
 	}
+	for _, controllers := range extendedAlamedaControllers(scaler) {
+		for _, controller := range controllers.resources {
+			policy := datahub_v1alpha1.RecommendationPolicy_RECOMMENDATIONPOLICY_UNDEFINED
+			if scaler.Spec.Policy == autoscalingv1alpha1.RecommendationPolicySTABLE {
+				policy = datahub_v1alpha1.RecommendationPolicy_STABLE
+			} else if scaler.Spec.Policy == autoscalingv1alpha1.RecommendationPolicyCOMPACT {
+				policy = datahub_v1alpha1.RecommendationPolicy_COMPACT
+			}
+			specReplicas := int32(0)
+			if controller.SpecReplicas != nil {
+				specReplicas = *controller.SpecReplicas
+			}
+			watchedReses = append(watchedReses, &datahub_v1alpha1.Controller{
+				ControllerInfo: &datahub_v1alpha1.ResourceInfo{
+					NamespacedName: &datahub_v1alpha1.NamespacedName{
+						Namespace: controller.Namespace,
+						Name:      controller.Name,
+					},
+					Kind: controllers.kind,
+				},
+				OwnerInfo: []*datahub_v1alpha1.ResourceInfo{&datahub_v1alpha1.ResourceInfo{
+					NamespacedName: &datahub_v1alpha1.NamespacedName{
+						Namespace: scaler.GetNamespace(),
+						Name:      scaler.GetName(),
+					},
+					Kind: datahub_v1alpha1.Kind_ALAMEDASCALER,
+				}},
+				Policy:                        policy,
+				EnableRecommendationExecution: scaler.IsEnableExecution(),
+				Replicas:                      int32(len(controller.Pods)),
+				SpecReplicas:                  specReplicas,
+			})
+		}
+	}
 	err := k8sRes.CreateAlamedaWatchedResource(watchedReses)
 	return err
 }
 
+type alamedaControllersOfKind struct {
+	kind      datahub_v1alpha1.Kind
+	resources map[autoscalingv1alpha1.NamespacedName]autoscalingv1alpha1.AlamedaResource
+}
+
+// extendedAlamedaControllers returns controllers of kinds which are not defined in containers-ai/api yet
+func extendedAlamedaControllers(scaler *autoscalingv1alpha1.AlamedaScaler) []alamedaControllersOfKind {
+	return []alamedaControllersOfKind{
+		{kind: DatahubKind.Kind_DAEMONSET, resources: scaler.Status.AlamedaController.DaemonSets},
+		{kind: DatahubKind.Kind_REPLICASET, resources: scaler.Status.AlamedaController.ReplicaSets},
+		{kind: DatahubKind.Kind_JOB, resources: scaler.Status.AlamedaController.Jobs},
+		{kind: DatahubKind.Kind_CRONJOB, resources: scaler.Status.AlamedaController.CronJobs},
+	}
+}
+
 func (r *ReconcileAlamedaScaler) deleteAlamedaWatchedResourcesToDatahub(scaler *autoscalingv1alpha1.AlamedaScaler, ctlrsFromDH []*datahub_v1alpha1.Controller) error {
 	delCtlrs := []*datahub_v1alpha1.Controller{}
 
@@ -484,6 +623,36 @@ func (r *ReconcileAlamedaScaler) deleteAlamedaWatchedResourcesToDatahub(scaler *
 					}},
 				})
 			}
+		} else {
+			for _, controllers := range extendedAlamedaControllers(scaler) {
+				if ctlrKind != controllers.kind {
+					continue
+				}
+				for _, controller := range controllers.resources {
+					if ctlrName == controller.Name {
+						inScaler = true
+						break
+					}
+				}
+				if !inScaler {
+					delCtlrs = append(delCtlrs, &datahub_v1alpha1.Controller{
+						ControllerInfo: &datahub_v1alpha1.ResourceInfo{
+							NamespacedName: &datahub_v1alpha1.NamespacedName{
+								Namespace: ctlrNS,
+								Name:      ctlrName,
+							},
+							Kind: ctlrKind,
+						},
+						OwnerInfo: []*datahub_v1alpha1.ResourceInfo{&datahub_v1alpha1.ResourceInfo{
+							NamespacedName: &datahub_v1alpha1.NamespacedName{
+								Namespace: scaler.GetNamespace(),
+								Name:      scaler.GetName(),
+							},
+							Kind: datahub_v1alpha1.Kind_ALAMEDASCALER,
+						}},
+					})
+				}
+			}
 		}
 	}
 
@@ -499,7 +668,7 @@ func (r *ReconcileAlamedaScaler) createPodsToDatahub(scaler *autoscalingv1alpha1
 
 	getResource := utilsresource.NewGetResource(r)
 
-	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), grpc.WithInsecure())
+	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), datahubutils.GetDialOptions()...)
 	if err != nil {
 		return errors.Errorf("create pods to datahub failed: %s", err.Error())
 	}
@@ -713,7 +882,7 @@ func deletePodsFromDatahub(scalerNamespacedName *types.NamespacedName, existingP
 		return errors.Wrapf(err, "delete pods from datahub failed: %s", err.Error())
 	}
 
-	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), grpc.WithInsecure())
+	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), datahubutils.GetDialOptions()...)
 	if err != nil {
 		return errors.Errorf("delete pods from datahub failed: %s", err.Error())
 	}
@@ -775,7 +944,7 @@ func getPodsObservedByAlamedaScalerFromDatahub(scalerNamespacedName *types.Names
 
 	podsInDatahub := make([]*autoscalingv1alpha1.AlamedaPod, 0)
 
-	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), grpc.WithInsecure())
+	conn, err := grpc.Dial(datahubutils.GetDatahubAddress(), datahubutils.GetDialOptions()...)
 	if err != nil {
 		return podsInDatahub, errors.Errorf("get pods from datahub failed: %s", err.Error())
 	}
@@ -850,6 +1019,9 @@ func (r *ReconcileAlamedaScaler) createAssociateRecommendation(alamedaScaler *au
 			Spec: autoscalingv1alpha1.AlamedaRecommendationSpec{
 				Containers: pod.Containers,
 			},
+			Status: autoscalingv1alpha1.AlamedaRecommendationStatus{
+				History: alamedaScaler.GetPodRecommendationHistory(recommendationNS, recommendationName),
+			},
 		}
 
 		err := controllerutil.SetControllerReference(alamedaScaler, recommendation, r.scheme)
@@ -857,12 +1029,18 @@ func (r *ReconcileAlamedaScaler) createAssociateRecommendation(alamedaScaler *au
 			scope.Errorf("set Recommendation %s/%s ownerReference failed, skip create Recommendation to kubernetes, error message: %s", alamedaScaler.Namespace, alamedaScaler.Name, err.Error())
 			continue
 		}
-		_, err = getResource.GetAlamedaRecommendation(recommendationNS, recommendationName)
+		existingRecommendation, err := getResource.GetAlamedaRecommendation(recommendationNS, recommendationName)
 		if err != nil && k8sErrors.IsNotFound(err) {
 			err = r.Create(context.TODO(), recommendation)
 			if err != nil {
 				return errors.Wrapf(err, "create recommendation %s/%s to kuernetes failed: %s", alamedaScaler.Namespace, alamedaScaler.Name, err.Error())
 			}
+		} else if err == nil && !apiequality.Semantic.DeepEqual(existingRecommendation.Status, recommendation.Status) {
+			// keep histories of the pod in AlamedaRecommendation the same as in AlamedaScaler
+			existingRecommendation.Status = recommendation.Status
+			if err := r.Update(context.TODO(), existingRecommendation); err != nil {
+				return errors.Wrapf(err, "update recommendation history of %s/%s failed: %s", recommendationNS, recommendationName, err.Error())
+			}
 		}
 	}
 	return nil
@@ -0,0 +1,178 @@
+/*
+Copyright 2019 The Alameda Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemonset
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	autoscaling_v1alpha1 "github.com/containers-ai/alameda/operator/pkg/apis/autoscaling/v1alpha1"
+	controllerutil "github.com/containers-ai/alameda/operator/pkg/controller/util"
+	utilsresource "github.com/containers-ai/alameda/operator/pkg/utils/resources"
+	"github.com/containers-ai/alameda/pkg/utils/log"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+var (
+	scope           = log.RegisterScope("daemonset_controller", "daemonset controller log", 0)
+	requeueDuration = 1 * time.Second
+)
+
+// Add creates a new DaemonSet Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) reconcile.Reconciler {
+	return &ReconcileDaemonSet{Client: mgr.GetClient(), scheme: mgr.GetScheme()}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("daemonset-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to DaemonSet
+	err = c.Watch(&source.Kind{Type: &appsv1.DaemonSet{}}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ reconcile.Reconciler = &ReconcileDaemonSet{}
+
+// ReconcileDaemonSet reconciles a DaemonSet object
+type ReconcileDaemonSet struct {
+	client.Client
+	scheme *runtime.Scheme
+}
+
+// Reconcile reads that state of the cluster for a DaemonSet object and makes changes based on the state read
+// and what is in the DaemonSet.Spec
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=daemonsets/status,verbs=get;update;patch
+func (r *ReconcileDaemonSet) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+
+	getResource := utilsresource.NewGetResource(r)
+	updateResource := utilsresource.NewUpdateResource(r)
+
+	daemonSet := appsv1.DaemonSet{}
+	err := r.Get(context.Background(), request.NamespacedName, &daemonSet)
+	if err != nil && k8s_errors.IsNotFound(err) {
+		// If daemonSet is deleted, it cannnot find the monitoring AlamedaScaler by calling method GetObservingAlamedaScalerOfController
+		// in type GetResource.
+		alamedaScaler, err := r.getMonitoringAlamedaScaler(request.Namespace, request.Name)
+		if err != nil {
+			scope.Errorf("Get observing AlamedaScaler of DaemonSet failed: %s", err.Error())
+			return reconcile.Result{}, nil
+		} else if alamedaScaler == nil {
+			scope.Warnf("Observing AlamedaScaler of DaemonSet %s/%s not found", request.Namespace, request.Name)
+			return reconcile.Result{}, nil
+		}
+
+		alamedaScaler.SetCustomResourceVersion(alamedaScaler.GenCustomResourceVersion())
+		err = updateResource.UpdateAlamedaScaler(alamedaScaler)
+		if err != nil {
+			scope.Errorf("Update AlamedaScaler falied: %s", err.Error())
+			return reconcile.Result{Requeue: true, RequeueAfter: requeueDuration}, nil
+		}
+	} else if err != nil {
+		scope.Errorf("Get DaemonSet %s/%s failed: %s", request.Namespace, request.Name, err.Error())
+		return reconcile.Result{}, nil
+	} else {
+		alamedaScaler, err := getResource.GetObservingAlamedaScalerOfController(autoscaling_v1alpha1.DaemonSetController, request.Namespace, request.Name)
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			scope.Errorf("Get observing AlamedaScaler of DaemonSet failed: %s", err.Error())
+			return reconcile.Result{Requeue: true, RequeueAfter: requeueDuration}, nil
+		} else if alamedaScaler == nil {
+			scope.Warnf("Get observing AlamedaScaler of DaemonSet %s/%s not found", request.Namespace, request.Name)
+		}
+
+		var currentMonitorAlamedaScalerName = ""
+		if alamedaScaler != nil {
+			if err := controllerutil.TriggerAlamedaScaler(updateResource, alamedaScaler); err != nil {
+				scope.Errorf("Trigger current monitoring AlamedaScaler to update falied: %s", err.Error())
+				return reconcile.Result{Requeue: true, RequeueAfter: requeueDuration}, nil
+			}
+			currentMonitorAlamedaScalerName = alamedaScaler.Name
+		}
+
+		lastMonitorAlamedaScalerName := controllerutil.GetLastMonitorAlamedaScaler(&daemonSet)
+		// Do not trigger the update process twice if last and current AlamedaScaler are the same
+		if lastMonitorAlamedaScalerName != "" && currentMonitorAlamedaScalerName != lastMonitorAlamedaScalerName {
+			lastMonitorAlamedaScaler, err := getResource.GetAlamedaScaler(request.Namespace, lastMonitorAlamedaScalerName)
+			if err != nil && !k8s_errors.IsNotFound(err) {
+				scope.Errorf("Get last monitoring AlamedaScaler falied: %s", err.Error())
+				return reconcile.Result{Requeue: true, RequeueAfter: requeueDuration}, nil
+			}
+			if lastMonitorAlamedaScaler != nil {
+				err := controllerutil.TriggerAlamedaScaler(updateResource, lastMonitorAlamedaScaler)
+				if err != nil {
+					scope.Errorf("Trigger last monitoring AlamedaScaler to update falied: %s", err.Error())
+					return reconcile.Result{Requeue: true, RequeueAfter: requeueDuration}, nil
+				}
+			}
+		}
+
+		controllerutil.SetLastMonitorAlamedaScaler(&daemonSet, currentMonitorAlamedaScalerName)
+		err = updateResource.UpdateResource(&daemonSet)
+		if err != nil {
+			scope.Errorf("Update DaemonSet falied: %s", err.Error())
+			return reconcile.Result{Requeue: true, RequeueAfter: requeueDuration}, nil
+		}
+	}
+
+	return reconcile.Result{}, nil
+
+}
+
+func (r *ReconcileDaemonSet) getMonitoringAlamedaScaler(namespace, name string) (*autoscaling_v1alpha1.AlamedaScaler, error) {
+
+	listResource := utilsresource.NewListResources(r.Client)
+	alamedaScalers, err := listResource.ListNamespaceAlamedaScaler(namespace)
+	if err != nil {
+		return nil, errors.Wrap(err, "list AlamedaScalers failed")
+	}
+
+	for _, alamedaScaler := range alamedaScalers {
+		for _, daemonSet := range alamedaScaler.Status.AlamedaController.DaemonSets {
+			if daemonSet.Namespace == namespace && daemonSet.Name == name {
+				return &alamedaScaler, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -1,6 +1,7 @@
 package alamedascaler
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -11,7 +12,11 @@ import (
 	logUtil "github.com/containers-ai/alameda/pkg/utils/log"
 	appsapi_v1 "github.com/openshift/api/apps/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	core_v1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -75,6 +80,10 @@ func (reconciler *Reconciler) ResetAlamedaController() {
 		Deployments:       make(map[autoscaling_v1alpha1.NamespacedName]autoscaling_v1alpha1.AlamedaResource),
 		DeploymentConfigs: make(map[autoscaling_v1alpha1.NamespacedName]autoscaling_v1alpha1.AlamedaResource),
 		StatefulSets:      make(map[autoscaling_v1alpha1.NamespacedName]autoscaling_v1alpha1.AlamedaResource),
+		DaemonSets:        make(map[autoscaling_v1alpha1.NamespacedName]autoscaling_v1alpha1.AlamedaResource),
+		ReplicaSets:       make(map[autoscaling_v1alpha1.NamespacedName]autoscaling_v1alpha1.AlamedaResource),
+		Jobs:              make(map[autoscaling_v1alpha1.NamespacedName]autoscaling_v1alpha1.AlamedaResource),
+		CronJobs:          make(map[autoscaling_v1alpha1.NamespacedName]autoscaling_v1alpha1.AlamedaResource),
 	}
 
 	reconciler.alamedascaler.SetStatusAlamedaController(ac)
@@ -236,6 +245,160 @@ func (reconciler *Reconciler) UpdateStatusByStatefulSet(statefulSet *appsv1.Stat
 	return reconciler.alamedascaler, nil
 }
 
+// UpdateStatusByDaemonSet updates status by DaemonSet
+func (reconciler *Reconciler) UpdateStatusByDaemonSet(daemonSet *appsv1.DaemonSet) (*autoscaling_v1alpha1.AlamedaScaler, error) {
+
+	listResources := utilsresource.NewListResources(reconciler.client)
+	pods, err := listResources.ListPodsByDaemonSet(daemonSet.GetNamespace(), daemonSet.GetName())
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods by DaemonSet failed")
+	}
+
+	specReplicas := daemonSet.Status.DesiredNumberScheduled
+	if reconciler.alamedascaler.Status.AlamedaController.DaemonSets == nil {
+		reconciler.alamedascaler.Status.AlamedaController.DaemonSets = map[autoscaling_v1alpha1.NamespacedName]autoscaling_v1alpha1.AlamedaResource{}
+	}
+	reconciler.alamedascaler.Status.AlamedaController.DaemonSets[autoscaling_v1alpha1.NamespacedName(utils.GetNamespacedNameKey(daemonSet.GetNamespace(), daemonSet.GetName()))] =
+		reconciler.newAlamedaResource(daemonSet, pods, &specReplicas)
+	return reconciler.alamedascaler, nil
+}
+
+// UpdateStatusByReplicaSet updates status by ReplicaSet which is not controlled by Deployment
+func (reconciler *Reconciler) UpdateStatusByReplicaSet(replicaSet *appsv1.ReplicaSet) (*autoscaling_v1alpha1.AlamedaScaler, error) {
+
+	listResources := utilsresource.NewListResources(reconciler.client)
+	pods, err := listResources.ListPodsByReplicaSet(replicaSet.GetNamespace(), replicaSet.GetName())
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods by ReplicaSet failed")
+	}
+
+	specReplicas := int32(1)
+	if replicaSet.Spec.Replicas != nil {
+		specReplicas = *replicaSet.Spec.Replicas
+	}
+	if reconciler.alamedascaler.Status.AlamedaController.ReplicaSets == nil {
+		reconciler.alamedascaler.Status.AlamedaController.ReplicaSets = map[autoscaling_v1alpha1.NamespacedName]autoscaling_v1alpha1.AlamedaResource{}
+	}
+	reconciler.alamedascaler.Status.AlamedaController.ReplicaSets[autoscaling_v1alpha1.NamespacedName(utils.GetNamespacedNameKey(replicaSet.GetNamespace(), replicaSet.GetName()))] =
+		reconciler.newAlamedaResource(replicaSet, pods, &specReplicas)
+	return reconciler.alamedascaler, nil
+}
+
+// UpdateStatusByJob updates status by Job which is not controlled by CronJob
+func (reconciler *Reconciler) UpdateStatusByJob(job *batchv1.Job) (*autoscaling_v1alpha1.AlamedaScaler, error) {
+
+	listResources := utilsresource.NewListResources(reconciler.client)
+	pods, err := listResources.ListPodsByJob(job.GetNamespace(), job.GetName())
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods by Job failed")
+	}
+
+	specReplicas := utilsresource.GetJobParallelism(job.Spec)
+	if reconciler.alamedascaler.Status.AlamedaController.Jobs == nil {
+		reconciler.alamedascaler.Status.AlamedaController.Jobs = map[autoscaling_v1alpha1.NamespacedName]autoscaling_v1alpha1.AlamedaResource{}
+	}
+	reconciler.alamedascaler.Status.AlamedaController.Jobs[autoscaling_v1alpha1.NamespacedName(utils.GetNamespacedNameKey(job.GetNamespace(), job.GetName()))] =
+		reconciler.newAlamedaResource(job, pods, &specReplicas)
+	return reconciler.alamedascaler, nil
+}
+
+// UpdateStatusByCronJob updates status by CronJob
+func (reconciler *Reconciler) UpdateStatusByCronJob(cronJob *batchv1beta1.CronJob) (*autoscaling_v1alpha1.AlamedaScaler, error) {
+
+	listResources := utilsresource.NewListResources(reconciler.client)
+	pods, err := listResources.ListPodsByCronJob(cronJob.GetNamespace(), cronJob.GetName())
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods by CronJob failed")
+	}
+
+	specReplicas := utilsresource.GetJobParallelism(cronJob.Spec.JobTemplate.Spec)
+	if reconciler.alamedascaler.Status.AlamedaController.CronJobs == nil {
+		reconciler.alamedascaler.Status.AlamedaController.CronJobs = map[autoscaling_v1alpha1.NamespacedName]autoscaling_v1alpha1.AlamedaResource{}
+	}
+	reconciler.alamedascaler.Status.AlamedaController.CronJobs[autoscaling_v1alpha1.NamespacedName(utils.GetNamespacedNameKey(cronJob.GetNamespace(), cronJob.GetName()))] =
+		reconciler.newAlamedaResource(cronJob, pods, &specReplicas)
+	return reconciler.alamedascaler, nil
+}
+
+// UpdateRecommendationHistories moves histories of recommendations applied by admission controller from annotations of
+// monitored pods into AlamedaScaler, and drops histories of controllers no longer monitored by AlamedaScaler
+func (reconciler *Reconciler) UpdateRecommendationHistories() *autoscaling_v1alpha1.AlamedaScaler {
+
+	reconciler.alamedascaler.PruneRecommendationHistories()
+
+	getResource := utilsresource.NewGetResource(reconciler.client)
+	for kind := range autoscaling_v1alpha1.K8SKindToAlamedaControllerType {
+		for _, alamedaResource := range reconciler.alamedascaler.GetAlamedaResourcesOfKind(kind) {
+			for _, alamedaPod := range alamedaResource.Pods {
+				pod, err := getResource.GetPod(alamedaPod.Namespace, alamedaPod.Name)
+				if err != nil {
+					if !k8sErrors.IsNotFound(err) {
+						alamedascalerReconcilerScope.Warnf("Get applied recommendation history of pod (%s/%s) failed: %s", alamedaPod.Namespace, alamedaPod.Name, err.Error())
+					}
+					continue
+				}
+				history, exist, err := getAppliedRecommendationHistory(pod)
+				if err != nil {
+					alamedascalerReconcilerScope.Warnf("Parse applied recommendation history of pod (%s/%s) failed: %s", pod.GetNamespace(), pod.GetName(), err.Error())
+					continue
+				} else if !exist {
+					continue
+				}
+				reconciler.alamedascaler.AddRecommendationHistory(kind, alamedaResource.Namespace, alamedaResource.Name, history)
+			}
+		}
+	}
+	return reconciler.alamedascaler
+}
+
+// getAppliedRecommendationHistory returns history of recommendation applied by admission controller from annotation of the pod
+func getAppliedRecommendationHistory(pod *core_v1.Pod) (autoscaling_v1alpha1.AlamedaRecommendationHistory, bool, error) {
+
+	history := autoscaling_v1alpha1.AlamedaRecommendationHistory{}
+	annotation, exist := pod.GetAnnotations()[autoscaling_v1alpha1.AnnotationKeyAppliedRecommendation]
+	if !exist {
+		return history, false, nil
+	}
+	if err := json.Unmarshal([]byte(annotation), &history); err != nil {
+		return history, false, err
+	}
+	history.PodName = pod.GetName()
+	return history, true, nil
+}
+
+// newAlamedaResource builds AlamedaResource of the controller with its pods monitored by Alameda
+func (reconciler *Reconciler) newAlamedaResource(controller metav1.Object, pods []core_v1.Pod, specReplicas *int32) autoscaling_v1alpha1.AlamedaResource {
+
+	alamedaPodsMap := map[autoscaling_v1alpha1.NamespacedName]autoscaling_v1alpha1.AlamedaPod{}
+	for _, pod := range pods {
+		if !PodIsMonitoredByAlameda(&pod) {
+			continue
+		}
+		alamedascalerReconcilerScope.Debug(fmt.Sprintf("Pod (%s/%s) belongs to AlamedaScaler (%s/%s).", pod.GetNamespace(), pod.GetName(),
+			reconciler.alamedascaler.GetNamespace(), reconciler.alamedascaler.GetName()))
+		alamedaContainers := []autoscaling_v1alpha1.AlamedaContainer{}
+		for _, container := range pod.Spec.Containers {
+			alamedaContainers = append(alamedaContainers, autoscaling_v1alpha1.AlamedaContainer{
+				Name: container.Name,
+			})
+		}
+		alamedaPodsMap[autoscaling_v1alpha1.NamespacedName(utils.GetNamespacedNameKey(pod.GetNamespace(), pod.GetName()))] = autoscaling_v1alpha1.AlamedaPod{
+			Namespace:  pod.GetNamespace(),
+			Name:       pod.GetName(),
+			UID:        string(pod.GetUID()),
+			Containers: alamedaContainers,
+		}
+	}
+
+	return autoscaling_v1alpha1.AlamedaResource{
+		Namespace:    controller.GetNamespace(),
+		Name:         controller.GetName(),
+		UID:          string(controller.GetUID()),
+		Pods:         alamedaPodsMap,
+		SpecReplicas: specReplicas,
+	}
+}
+
 func PodIsMonitoredByAlameda(pod *core_v1.Pod) bool {
 	if !podPhaseIsMonitoredByAlameda(pod.Status.Phase) || pod.ObjectMeta.DeletionTimestamp != nil {
 		return false
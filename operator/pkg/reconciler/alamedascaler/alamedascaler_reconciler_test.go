@@ -0,0 +1,262 @@
+package alamedascaler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	autoscaling_v1alpha1 "github.com/containers-ai/alameda/operator/pkg/apis/autoscaling/v1alpha1"
+	utilsresource "github.com/containers-ai/alameda/operator/pkg/utils/resources"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	core_v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestOwnedObjectMeta(name, ownerKind, ownerName string) metav1.ObjectMeta {
+	isController := true
+	return metav1.ObjectMeta{
+		Namespace: "default",
+		Name:      name,
+		OwnerReferences: []metav1.OwnerReference{
+			metav1.OwnerReference{Kind: ownerKind, Name: ownerName, Controller: &isController},
+		},
+	}
+}
+
+func newTestPod(name, ownerKind, ownerName string, phase core_v1.PodPhase) *core_v1.Pod {
+	return &core_v1.Pod{
+		ObjectMeta: newTestOwnedObjectMeta(name, ownerKind, ownerName),
+		Spec:       core_v1.PodSpec{Containers: []core_v1.Container{core_v1.Container{Name: "app"}}},
+		Status:     core_v1.PodStatus{Phase: phase},
+	}
+}
+
+func TestUpdateStatusByController(t *testing.T) {
+
+	objectMeta := metav1.ObjectMeta{Namespace: "default", Name: "app"}
+	parallelism := int32(2)
+	replicas := int32(3)
+
+	type testCase struct {
+		kind             string
+		objects          []runtime.Object
+		update           func(reconciler *Reconciler) (*autoscaling_v1alpha1.AlamedaScaler, error)
+		wantSpecReplicas int32
+	}
+
+	testCases := []testCase{
+		testCase{
+			kind: "DaemonSet",
+			objects: []runtime.Object{
+				newTestPod("app-a", "DaemonSet", "app", core_v1.PodRunning),
+				newTestPod("app-b", "DaemonSet", "app", core_v1.PodFailed),
+				newTestPod("other-a", "DaemonSet", "other", core_v1.PodRunning),
+			},
+			update: func(reconciler *Reconciler) (*autoscaling_v1alpha1.AlamedaScaler, error) {
+				return reconciler.UpdateStatusByDaemonSet(&appsv1.DaemonSet{
+					ObjectMeta: objectMeta,
+					Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 4},
+				})
+			},
+			wantSpecReplicas: 4,
+		},
+		testCase{
+			kind: "ReplicaSet",
+			objects: []runtime.Object{
+				newTestPod("app-a", "ReplicaSet", "app", core_v1.PodRunning),
+				newTestPod("app-b", "ReplicaSet", "app", core_v1.PodSucceeded),
+				newTestPod("deployment-a", "ReplicaSet", "deployment-1234", core_v1.PodRunning),
+			},
+			update: func(reconciler *Reconciler) (*autoscaling_v1alpha1.AlamedaScaler, error) {
+				return reconciler.UpdateStatusByReplicaSet(&appsv1.ReplicaSet{
+					ObjectMeta: objectMeta,
+					Spec:       appsv1.ReplicaSetSpec{Replicas: &replicas},
+				})
+			},
+			wantSpecReplicas: 3,
+		},
+		testCase{
+			kind: "Job",
+			objects: []runtime.Object{
+				newTestPod("app-a", "Job", "app", core_v1.PodPending),
+				newTestPod("app-b", "Job", "app", core_v1.PodSucceeded),
+				newTestPod("other-a", "ReplicaSet", "app", core_v1.PodRunning),
+			},
+			update: func(reconciler *Reconciler) (*autoscaling_v1alpha1.AlamedaScaler, error) {
+				return reconciler.UpdateStatusByJob(&batchv1.Job{
+					ObjectMeta: objectMeta,
+					Spec:       batchv1.JobSpec{Parallelism: &parallelism},
+				})
+			},
+			wantSpecReplicas: 2,
+		},
+		testCase{
+			kind: "CronJob",
+			objects: []runtime.Object{
+				&batchv1.Job{ObjectMeta: newTestOwnedObjectMeta("app-1560000000", "CronJob", "app")},
+				&batchv1.Job{ObjectMeta: newTestOwnedObjectMeta("other-1560000000", "CronJob", "other")},
+				newTestPod("app-1560000000-a", "Job", "app-1560000000", core_v1.PodRunning),
+				newTestPod("other-1560000000-a", "Job", "other-1560000000", core_v1.PodRunning),
+			},
+			update: func(reconciler *Reconciler) (*autoscaling_v1alpha1.AlamedaScaler, error) {
+				return reconciler.UpdateStatusByCronJob(&batchv1beta1.CronJob{
+					ObjectMeta: objectMeta,
+					Spec: batchv1beta1.CronJobSpec{
+						JobTemplate: batchv1beta1.JobTemplateSpec{Spec: batchv1.JobSpec{Parallelism: &parallelism}},
+					},
+				})
+			},
+			wantSpecReplicas: 2,
+		},
+	}
+
+	assert := assert.New(t)
+	for _, testCase := range testCases {
+		reconciler := NewReconciler(fake.NewFakeClient(testCase.objects...), &autoscaling_v1alpha1.AlamedaScaler{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "scaler"}})
+
+		scaler, err := testCase.update(reconciler)
+		assert.Nil(err, testCase.kind)
+		assert.True(scaler.HasAlamedaController("default", "app", testCase.kind), testCase.kind)
+		assert.False(scaler.HasAlamedaController("default", "other", testCase.kind), testCase.kind)
+
+		pods := scaler.GetMonitoredPods()
+		if assert.Len(pods, 1, testCase.kind) {
+			assert.Contains(pods[0].Name, "app", testCase.kind)
+			assert.Len(pods[0].Containers, 1, testCase.kind)
+		}
+
+		for _, resources := range []map[autoscaling_v1alpha1.NamespacedName]autoscaling_v1alpha1.AlamedaResource{
+			scaler.Status.AlamedaController.DaemonSets, scaler.Status.AlamedaController.ReplicaSets,
+			scaler.Status.AlamedaController.Jobs, scaler.Status.AlamedaController.CronJobs,
+		} {
+			if resource, exist := resources["default/app"]; exist {
+				assert.Equal(testCase.wantSpecReplicas, *resource.SpecReplicas, testCase.kind)
+			}
+		}
+	}
+}
+
+func TestRecommendationHistorySurvivesPodReplacement(t *testing.T) {
+
+	assert := assert.New(t)
+	replicas := int32(1)
+	replicaSet := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app"},
+		Spec:       appsv1.ReplicaSetSpec{Replicas: &replicas},
+	}
+	scalerKey := types.NamespacedName{Namespace: "default", Name: "scaler"}
+
+	clientScheme := runtime.NewScheme()
+	assert.Nil(scheme.AddToScheme(clientScheme))
+	assert.Nil(autoscaling_v1alpha1.AddToScheme(clientScheme))
+	k8sClient := fake.NewFakeClientWithScheme(clientScheme,
+		&autoscaling_v1alpha1.AlamedaScaler{ObjectMeta: metav1.ObjectMeta{Namespace: scalerKey.Namespace, Name: scalerKey.Name}},
+		newTestPod("app-a", "ReplicaSet", "app", core_v1.PodRunning),
+	)
+
+	// reconcile reproduces how the operator updates status of the AlamedaScaler
+	reconcile := func(controllers ...*appsv1.ReplicaSet) *autoscaling_v1alpha1.AlamedaScaler {
+		scaler := &autoscaling_v1alpha1.AlamedaScaler{}
+		assert.Nil(k8sClient.Get(context.TODO(), scalerKey, scaler))
+		reconciler := NewReconciler(k8sClient, scaler)
+		reconciler.ResetAlamedaController()
+		for _, controller := range controllers {
+			_, err := reconciler.UpdateStatusByReplicaSet(controller)
+			assert.Nil(err)
+		}
+		scaler = reconciler.UpdateRecommendationHistories()
+		assert.Nil(k8sClient.Update(context.TODO(), scaler))
+
+		updated := &autoscaling_v1alpha1.AlamedaScaler{}
+		assert.Nil(k8sClient.Get(context.TODO(), scalerKey, updated))
+		return updated
+	}
+
+	reconcile(replicaSet)
+
+	// evictioner evicts app-a
+	evictedAt := metav1.NewTime(time.Unix(1560000000, 0))
+	err := utilsresource.NewUpdateResource(k8sClient).AddAlamedaScalerRecommendationHistory(scalerKey.Namespace, scalerKey.Name,
+		"ReplicaSet", "default", "app", autoscaling_v1alpha1.AlamedaRecommendationHistory{
+			Time:    evictedAt,
+			PodName: "app-a",
+			Applier: autoscaling_v1alpha1.RecommendationApplierEvictioner,
+			Applied: true,
+		})
+	assert.Nil(err)
+
+	// app-a is replaced by app-b patched by admission controller
+	podA := &core_v1.Pod{}
+	assert.Nil(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "app-a"}, podA))
+	assert.Nil(k8sClient.Delete(context.TODO(), podA))
+	annotation, err := json.Marshal(autoscaling_v1alpha1.AlamedaRecommendationHistory{
+		Time:    metav1.NewTime(evictedAt.Add(time.Minute)),
+		Applier: autoscaling_v1alpha1.RecommendationApplierAdmissionController,
+		Applied: true,
+	})
+	assert.Nil(err)
+	podB := newTestPod("app-b", "ReplicaSet", "app", core_v1.PodRunning)
+	podB.Annotations = map[string]string{autoscaling_v1alpha1.AnnotationKeyAppliedRecommendation: string(annotation)}
+	assert.Nil(k8sClient.Create(context.TODO(), podB))
+
+	// histories are kept after the pod is replaced and are not duplicated by further reconciling
+	for i := 0; i < 2; i++ {
+		scaler := reconcile(replicaSet)
+		histories := scaler.GetRecommendationHistory("ReplicaSet", "default", "app")
+		if assert.Len(histories, 2) {
+			assert.Equal("app-b", histories[0].PodName)
+			assert.Equal(autoscaling_v1alpha1.RecommendationApplierAdmissionController, histories[0].Applier)
+			assert.Equal("app-a", histories[1].PodName)
+			assert.Equal(autoscaling_v1alpha1.RecommendationApplierEvictioner, histories[1].Applier)
+		}
+		// AlamedaRecommendation of the pod shows histories of the pod only
+		podHistories := scaler.GetPodRecommendationHistory("default", "app-b")
+		if assert.Len(podHistories, 1) {
+			assert.Equal(autoscaling_v1alpha1.RecommendationApplierAdmissionController, podHistories[0].Applier)
+		}
+	}
+
+	// histories are dropped with the controller no longer monitored
+	scaler := reconcile()
+	assert.Empty(scaler.Status.RecommendationHistories)
+}
+
+func TestGetPodRecommendationHistory(t *testing.T) {
+
+	assert := assert.New(t)
+	scaler := &autoscaling_v1alpha1.AlamedaScaler{}
+	for i := 0; i < 2*autoscaling_v1alpha1.AlamedaRecommendationHistoryLimit; i++ {
+		podName := "app-a"
+		if i%2 == 1 {
+			podName = "app-b"
+		}
+		scaler.Status.RecommendationHistories = append(scaler.Status.RecommendationHistories, autoscaling_v1alpha1.ControllerRecommendationHistory{
+			Kind:      "ReplicaSet",
+			Namespace: "default",
+			Name:      fmt.Sprintf("app-%d", i),
+			History: []autoscaling_v1alpha1.AlamedaRecommendationHistory{{
+				Time:    metav1.NewTime(time.Unix(1560000000+int64(i), 0)),
+				PodName: podName,
+				Applier: autoscaling_v1alpha1.RecommendationApplierEvictioner,
+			}},
+		})
+	}
+
+	histories := scaler.GetPodRecommendationHistory("default", "app-a")
+	if assert.Len(histories, autoscaling_v1alpha1.AlamedaRecommendationHistoryLimit) {
+		assert.True(histories[1].Time.Before(&histories[0].Time), "expect the latest history first")
+		for _, history := range histories {
+			assert.Equal("app-a", history.PodName)
+		}
+	}
+	assert.Empty(scaler.GetPodRecommendationHistory("other", "app-a"))
+}
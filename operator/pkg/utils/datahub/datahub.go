@@ -2,6 +2,15 @@ package datahub
 
 import (
 	"os"
+
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+	DatahubCredentials "github.com/containers-ai/alameda/pkg/framework/datahub/credentials"
+	"google.golang.org/grpc"
+)
+
+var (
+	clusterID       string
+	credentialsOpts = []grpc.DialOption{grpc.WithInsecure()}
 )
 
 func GetDatahubAddress() string {
@@ -11,3 +20,33 @@ func GetDatahubAddress() string {
 	}
 	return datahubServer
 }
+
+// SetClusterID Set id of the cluster operator runs in, calls to datahub are scoped to the cluster
+func SetClusterID(id string) {
+	clusterID = id
+}
+
+// GetClusterID Return id of the cluster operator runs in
+func GetClusterID() string {
+	return clusterID
+}
+
+// SetCredentials Set credentials presented to datahub
+func SetCredentials(config *DatahubCredentials.Config) error {
+	opts, err := config.DialOptions()
+	if err != nil {
+		return err
+	}
+	credentialsOpts = opts
+	return nil
+}
+
+// GetDialOptions Return options dialing datahub, every call presents the credentials
+// set by SetCredentials and sends the cluster id set by SetClusterID
+func GetDialOptions() []grpc.DialOption {
+	opts := append([]grpc.DialOption{}, credentialsOpts...)
+	return append(opts,
+		grpc.WithUnaryInterceptor(DatahubCluster.UnaryClientInterceptor(clusterID)),
+		grpc.WithStreamInterceptor(DatahubCluster.StreamClientInterceptor(clusterID)),
+	)
+}
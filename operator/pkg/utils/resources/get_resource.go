@@ -8,6 +8,8 @@ import (
 	appsapi_v1 "github.com/openshift/api/apps/v1"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -72,6 +74,27 @@ func (getResource *GetResource) GetStatefulSet(namespace, name string) (*appsv1.
 	return statefulSet, err
 }
 
+// GetDaemonSet returns daemonSet
+func (getResource *GetResource) GetDaemonSet(namespace, name string) (*appsv1.DaemonSet, error) {
+	daemonSet := &appsv1.DaemonSet{}
+	err := getResource.getResource(daemonSet, namespace, name)
+	return daemonSet, err
+}
+
+// GetJob returns job
+func (getResource *GetResource) GetJob(namespace, name string) (*batchv1.Job, error) {
+	job := &batchv1.Job{}
+	err := getResource.getResource(job, namespace, name)
+	return job, err
+}
+
+// GetCronJob returns cronJob
+func (getResource *GetResource) GetCronJob(namespace, name string) (*batchv1beta1.CronJob, error) {
+	cronJob := &batchv1beta1.CronJob{}
+	err := getResource.getResource(cronJob, namespace, name)
+	return cronJob, err
+}
+
 // GetAlamedaScaler return alamedascaler
 func (getResource *GetResource) GetAlamedaScaler(namespace, name string) (*autuscaling.AlamedaScaler, error) {
 	alamedaScaler := &autuscaling.AlamedaScaler{}
@@ -130,6 +153,54 @@ func (getResource *GetResource) GetObservingAlamedaScalerOfController(controller
 					return &alamedaScaler, nil
 				}
 			}
+		case autuscaling.DaemonSetController:
+
+			matchedLblDaemonSets, err := listResources.ListDaemonSetsByNamespaceLabels(controllerNamespace, alamedaScaler.Spec.Selector.MatchLabels)
+			if err != nil {
+				return nil, errors.Errorf("get observing AlamedaScaler of DaemonSet %s/%s failed: %s", controllerNamespace, controllerName, err.Error())
+			}
+			for _, matchedLblDaemonSet := range matchedLblDaemonSets {
+				// daemonSet can only join one AlamedaScaler
+				if matchedLblDaemonSet.GetName() == controllerName {
+					return &alamedaScaler, nil
+				}
+			}
+		case autuscaling.ReplicaSetController:
+
+			matchedLblReplicaSets, err := listResources.ListReplicaSetsByNamespaceLabels(controllerNamespace, alamedaScaler.Spec.Selector.MatchLabels)
+			if err != nil {
+				return nil, errors.Errorf("get observing AlamedaScaler of ReplicaSet %s/%s failed: %s", controllerNamespace, controllerName, err.Error())
+			}
+			for _, matchedLblReplicaSet := range matchedLblReplicaSets {
+				// replicaSet can only join one AlamedaScaler
+				if matchedLblReplicaSet.GetName() == controllerName {
+					return &alamedaScaler, nil
+				}
+			}
+		case autuscaling.JobController:
+
+			matchedLblJobs, err := listResources.ListJobsByNamespaceLabels(controllerNamespace, alamedaScaler.Spec.Selector.MatchLabels)
+			if err != nil {
+				return nil, errors.Errorf("get observing AlamedaScaler of Job %s/%s failed: %s", controllerNamespace, controllerName, err.Error())
+			}
+			for _, matchedLblJob := range matchedLblJobs {
+				// job can only join one AlamedaScaler
+				if matchedLblJob.GetName() == controllerName {
+					return &alamedaScaler, nil
+				}
+			}
+		case autuscaling.CronJobController:
+
+			matchedLblCronJobs, err := listResources.ListCronJobsByNamespaceLabels(controllerNamespace, alamedaScaler.Spec.Selector.MatchLabels)
+			if err != nil {
+				return nil, errors.Errorf("get observing AlamedaScaler of CronJob %s/%s failed: %s", controllerNamespace, controllerName, err.Error())
+			}
+			for _, matchedLblCronJob := range matchedLblCronJobs {
+				// cronJob can only join one AlamedaScaler
+				if matchedLblCronJob.GetName() == controllerName {
+					return &alamedaScaler, nil
+				}
+			}
 		default:
 			return nil, errors.Errorf("controllerType: %d not support", controllerType)
 		}
@@ -166,11 +237,45 @@ func (getResource *GetResource) GetReplicasCountByController(namespace, name, ki
 			return 0, errors.Errorf("statefulSet's spec.replicas is nil")
 		}
 		return *statefulSet.Spec.Replicas, nil
+	case "daemonset":
+		daemonSet, err := getResource.GetDaemonSet(namespace, name)
+		if err != nil {
+			return 0, err
+		}
+		return daemonSet.Status.DesiredNumberScheduled, nil
+	case "replicaset":
+		replicaSet, err := getResource.GetReplicaSet(namespace, name)
+		if err != nil {
+			return 0, err
+		} else if replicaSet.Spec.Replicas == nil {
+			return 0, errors.Errorf("replicaSet's spec.replicas is nil")
+		}
+		return *replicaSet.Spec.Replicas, nil
+	case "job":
+		job, err := getResource.GetJob(namespace, name)
+		if err != nil {
+			return 0, err
+		}
+		return GetJobParallelism(job.Spec), nil
+	case "cronjob":
+		cronJob, err := getResource.GetCronJob(namespace, name)
+		if err != nil {
+			return 0, err
+		}
+		return GetJobParallelism(cronJob.Spec.JobTemplate.Spec), nil
 	default:
 		return 0, errors.Errorf("not supported kind \"%s\"", kind)
 	}
 }
 
+// GetJobParallelism returns the number of pods a job runs at the same time, spec.parallelism defaults to 1
+func GetJobParallelism(spec batchv1.JobSpec) int32 {
+	if spec.Parallelism == nil {
+		return 1
+	}
+	return *spec.Parallelism
+}
+
 func (getResource *GetResource) getResource(resource runtime.Object, namespace, name string) error {
 	if namespace == "" || name == "" {
 		return errors.Errorf("Namespace: %s or name: %s is empty", namespace, name)
@@ -10,6 +10,8 @@ import (
 	appsapi_v1 "github.com/openshift/api/apps/v1"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -99,6 +101,50 @@ func (listResources *ListResources) ListStatefulSetsByNamespaceLabels(namespace
 	return statefulSetList.Items, nil
 }
 
+// ListDaemonSetsByNamespaceLabels return daemonsets by namespace and labels
+func (listResources *ListResources) ListDaemonSetsByNamespaceLabels(namespace string, labels map[string]string) ([]appsv1.DaemonSet, error) {
+	daemonSetList := &appsv1.DaemonSetList{}
+
+	if err := listResources.listResourcesByNamespaceLabels(daemonSetList, namespace, labels); err != nil {
+		return []appsv1.DaemonSet{}, err
+	}
+
+	return daemonSetList.Items, nil
+}
+
+// ListReplicaSetsByNamespaceLabels return replicasets by namespace and labels
+func (listResources *ListResources) ListReplicaSetsByNamespaceLabels(namespace string, labels map[string]string) ([]appsv1.ReplicaSet, error) {
+	replicaSetList := &appsv1.ReplicaSetList{}
+
+	if err := listResources.listResourcesByNamespaceLabels(replicaSetList, namespace, labels); err != nil {
+		return []appsv1.ReplicaSet{}, err
+	}
+
+	return replicaSetList.Items, nil
+}
+
+// ListJobsByNamespaceLabels return jobs by namespace and labels
+func (listResources *ListResources) ListJobsByNamespaceLabels(namespace string, labels map[string]string) ([]batchv1.Job, error) {
+	jobList := &batchv1.JobList{}
+
+	if err := listResources.listResourcesByNamespaceLabels(jobList, namespace, labels); err != nil {
+		return []batchv1.Job{}, err
+	}
+
+	return jobList.Items, nil
+}
+
+// ListCronJobsByNamespaceLabels return cronjobs by namespace and labels
+func (listResources *ListResources) ListCronJobsByNamespaceLabels(namespace string, labels map[string]string) ([]batchv1beta1.CronJob, error) {
+	cronJobList := &batchv1beta1.CronJobList{}
+
+	if err := listResources.listResourcesByNamespaceLabels(cronJobList, namespace, labels); err != nil {
+		return []batchv1beta1.CronJob{}, err
+	}
+
+	return cronJobList.Items, nil
+}
+
 // ListDeploymentConfigsByLabels return DeploymentConfigs by labels
 func (listResources *ListResources) ListDeploymentConfigsByLabels(labels map[string]string) ([]appsapi_v1.DeploymentConfig, error) {
 	deploymentConfigList := &appsapi_v1.DeploymentConfigList{}
@@ -121,6 +167,14 @@ func (listResources *ListResources) ListPodsByController(namespace, name, kind s
 		pods, err = listResources.ListPodsByDeploymentConfig(namespace, name)
 	case "statefulset":
 		pods, err = listResources.ListPodsByStatefulSet(namespace, name)
+	case "daemonset":
+		pods, err = listResources.ListPodsByDaemonSet(namespace, name)
+	case "replicaset":
+		pods, err = listResources.ListPodsByReplicaSet(namespace, name)
+	case "job":
+		pods, err = listResources.ListPodsByJob(namespace, name)
+	case "cronjob":
+		pods, err = listResources.ListPodsByCronJob(namespace, name)
 	default:
 		err = errors.Errorf("not supported kind \"%s\"", kind)
 	}
@@ -255,6 +309,62 @@ func (listResources *ListResources) ListPodsByStatefulSet(namespace, name string
 	return pods, nil
 }
 
+// ListPodsByDaemonSet return pods by daemonSet namespace and name
+func (listResources *ListResources) ListPodsByDaemonSet(namespace, name string) ([]corev1.Pod, error) {
+	return listResources.listPodsControlledBy(namespace, "daemonset", map[string]bool{name: true})
+}
+
+// ListPodsByReplicaSet return pods by replicaSet namespace and name
+func (listResources *ListResources) ListPodsByReplicaSet(namespace, name string) ([]corev1.Pod, error) {
+	return listResources.listPodsControlledBy(namespace, "replicaset", map[string]bool{name: true})
+}
+
+// ListPodsByJob return pods by job namespace and name
+func (listResources *ListResources) ListPodsByJob(namespace, name string) ([]corev1.Pod, error) {
+	return listResources.listPodsControlledBy(namespace, "job", map[string]bool{name: true})
+}
+
+// ListPodsByCronJob return pods of jobs created by cronJob namespace and name
+func (listResources *ListResources) ListPodsByCronJob(namespace, name string) ([]corev1.Pod, error) {
+
+	jobList := &batchv1.JobList{}
+	if err := listResources.client.List(context.TODO(), client.InNamespace(namespace), jobList); err != nil {
+		return []corev1.Pod{}, errors.Errorf("list jobs in namespace %s failed: %s", namespace, err.Error())
+	}
+	jobNames := make(map[string]bool)
+	for _, job := range jobList.Items {
+		for _, or := range job.GetOwnerReferences() {
+			if or.Controller != nil && *or.Controller && strings.ToLower(or.Kind) == "cronjob" && or.Name == name {
+				jobNames[job.GetName()] = true
+			}
+		}
+	}
+	if len(jobNames) == 0 {
+		return []corev1.Pod{}, nil
+	}
+
+	return listResources.listPodsControlledBy(namespace, "job", jobNames)
+}
+
+// listPodsControlledBy return pods in namespace whose controller is of the lowercase kind and one of names
+func (listResources *ListResources) listPodsControlledBy(namespace, kind string, names map[string]bool) ([]corev1.Pod, error) {
+
+	pods := []corev1.Pod{}
+	podList := &corev1.PodList{}
+	if err := listResources.client.List(context.TODO(), client.InNamespace(namespace), podList); err != nil {
+		return pods, errors.Errorf("list pods in namespace %s failed: %s", namespace, err.Error())
+	}
+	for _, pod := range podList.Items {
+		for _, or := range pod.GetOwnerReferences() {
+			if or.Controller != nil && *or.Controller && strings.ToLower(or.Kind) == kind && names[or.Name] {
+				pods = append(pods, pod)
+			}
+		}
+	}
+
+	return pods, nil
+}
+
 // ListAllAlamedaScaler return all AlamedaScaler in cluster
 func (listResources *ListResources) ListAllAlamedaScaler() ([]autuscaling.AlamedaScaler, error) {
 	alamedaScalerList := &autuscaling.AlamedaScalerList{}
@@ -7,6 +7,8 @@ import (
 
 	openshift_appsapi_v1 "github.com/openshift/api/apps/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -113,10 +115,64 @@ func getControlleHierarchy(client client.Client, curOwnerRef metav1.OwnerReferen
 			}
 			return getControlleHierarchy(client, *controllerOwnerRef, namespace, resultStr) + resultStr
 		}
+	} else if strings.ToLower(orKind) == "daemonset" {
+		daemonSet := &appsv1.DaemonSet{}
+		err := client.Get(context.TODO(), types.NamespacedName{
+			Namespace: namespace,
+			Name:      orName,
+		}, daemonSet)
+		if err != nil {
+			scope.Error(err.Error())
+		} else {
+			controllerOwnerRef := getControllerOwnerRef(daemonSet.OwnerReferences)
+			resultStr = fmt.Sprintf("/daemonsets/%s", daemonSet.GetName())
+			if controllerOwnerRef == nil {
+				return resultStr
+			}
+			return getControlleHierarchy(client, *controllerOwnerRef, namespace, resultStr) + resultStr
+		}
+	} else if strings.ToLower(orKind) == "job" {
+		job := &batchv1.Job{}
+		err := client.Get(context.TODO(), types.NamespacedName{
+			Namespace: namespace,
+			Name:      orName,
+		}, job)
+		if err != nil {
+			scope.Error(err.Error())
+		} else {
+			controllerOwnerRef := getControllerOwnerRef(job.OwnerReferences)
+			resultStr = fmt.Sprintf("/jobs/%s", job.GetName())
+			if controllerOwnerRef == nil {
+				return resultStr
+			}
+			return getControlleHierarchy(client, *controllerOwnerRef, namespace, resultStr) + resultStr
+		}
+	} else if strings.ToLower(orKind) == "cronjob" {
+		cronJob := &batchv1beta1.CronJob{}
+		err := client.Get(context.TODO(), types.NamespacedName{
+			Namespace: namespace,
+			Name:      orName,
+		}, cronJob)
+		if err != nil {
+			scope.Error(err.Error())
+		} else {
+			controllerOwnerRef := getControllerOwnerRef(cronJob.OwnerReferences)
+			resultStr = fmt.Sprintf("/cronjobs/%s", cronJob.GetName())
+			if controllerOwnerRef == nil {
+				return resultStr
+			}
+			return getControlleHierarchy(client, *controllerOwnerRef, namespace, resultStr) + resultStr
+		}
 	}
 	return resultStr
 }
 
+// IsControlledByController returns true if the object has an ownerReference which is controller,
+// e.g. ReplicaSet created by Deployment or Job created by CronJob
+func IsControlledByController(obj metav1.Object) bool {
+	return getControllerOwnerRef(obj.GetOwnerReferences()) != nil
+}
+
 func getControllerOwnerRef(ownerRefs []metav1.OwnerReference) *metav1.OwnerReference {
 	for _, or := range ownerRefs {
 		if or.Controller != nil && *or.Controller {
@@ -5,6 +5,8 @@ import (
 
 	autuscaling "github.com/containers-ai/alameda/operator/pkg/apis/autoscaling/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -26,6 +28,23 @@ func (updateResource *UpdateResource) UpdateAlamedaScaler(alamedaScaler *autusca
 	return err
 }
 
+// AddAlamedaScalerRecommendationHistory adds history of the controller of the kubernetes kind into status of AlamedaScaler, retries on conflict
+func (updateResource *UpdateResource) AddAlamedaScalerRecommendationHistory(scalerNamespace, scalerName, kind, namespace, name string, history autuscaling.AlamedaRecommendationHistory) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		alamedaScaler := &autuscaling.AlamedaScaler{}
+		if err := updateResource.Get(context.TODO(), types.NamespacedName{
+			Namespace: scalerNamespace,
+			Name:      scalerName,
+		}, alamedaScaler); err != nil {
+			return err
+		}
+		if !alamedaScaler.AddRecommendationHistory(kind, namespace, name, history) {
+			return nil
+		}
+		return updateResource.updateResource(alamedaScaler)
+	})
+}
+
 // UpdateResource updates resource
 func (updateResource *UpdateResource) UpdateResource(resource runtime.Object) error {
 	err := updateResource.updateResource(resource)
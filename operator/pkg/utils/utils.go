@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
 	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
 
 	openshift_api_apps_v1 "github.com/openshift/api/apps"
@@ -29,6 +30,14 @@ func ParseResourceLinkForTopController(resourceLink string) (*datahub_v1alpha1.T
 			kind = datahub_v1alpha1.Kind_DEPLOYMENTCONFIG
 		case "statefulsets":
 			kind = datahub_v1alpha1.Kind_STATEFULSET
+		case "daemonsets":
+			kind = DatahubKind.Kind_DAEMONSET
+		case "replicasets":
+			kind = DatahubKind.Kind_REPLICASET
+		case "jobs":
+			kind = DatahubKind.Kind_JOB
+		case "cronjobs":
+			kind = DatahubKind.Kind_CRONJOB
 		default:
 			kind = datahub_v1alpha1.Kind_POD
 		}
@@ -0,0 +1,40 @@
+package accuracy
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+)
+
+// DatahubPredictionAccuracyServiceClient is the client API for DatahubPredictionAccuracyService service.
+type DatahubPredictionAccuracyServiceClient interface {
+	CreatePredictionAccuracies(ctx context.Context, in *CreatePredictionAccuraciesRequest, opts ...grpc.CallOption) (*status.Status, error)
+	ListPredictionAccuracies(ctx context.Context, in *ListPredictionAccuraciesRequest, opts ...grpc.CallOption) (*ListPredictionAccuraciesResponse, error)
+}
+
+type datahubPredictionAccuracyServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewDatahubPredictionAccuracyServiceClient Constructor of DatahubPredictionAccuracyService client
+func NewDatahubPredictionAccuracyServiceClient(cc *grpc.ClientConn) DatahubPredictionAccuracyServiceClient {
+	return &datahubPredictionAccuracyServiceClient{cc}
+}
+
+func (c *datahubPredictionAccuracyServiceClient) CreatePredictionAccuracies(ctx context.Context, in *CreatePredictionAccuraciesRequest, opts ...grpc.CallOption) (*status.Status, error) {
+	out := new(status.Status)
+	err := c.cc.Invoke(ctx, "/"+ServiceName+"/CreatePredictionAccuracies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datahubPredictionAccuracyServiceClient) ListPredictionAccuracies(ctx context.Context, in *ListPredictionAccuraciesRequest, opts ...grpc.CallOption) (*ListPredictionAccuraciesResponse, error) {
+	out := new(ListPredictionAccuraciesResponse)
+	err := c.cc.Invoke(ctx, "/"+ServiceName+"/ListPredictionAccuracies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,77 @@
+package accuracy
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+)
+
+const (
+	// ServiceName Full name of the gRPC service serving accuracy of predictions
+	ServiceName = "containers_ai.alameda.v1alpha1.datahub.DatahubPredictionAccuracyService"
+)
+
+// DatahubPredictionAccuracyServiceServer is the server API for DatahubPredictionAccuracyService service.
+type DatahubPredictionAccuracyServiceServer interface {
+	// CreatePredictionAccuracies saves accuracy scores of predictions
+	CreatePredictionAccuracies(context.Context, *CreatePredictionAccuraciesRequest) (*status.Status, error)
+	// ListPredictionAccuracies lists accuracy scores of predictions
+	ListPredictionAccuracies(context.Context, *ListPredictionAccuraciesRequest) (*ListPredictionAccuraciesResponse, error)
+}
+
+// RegisterDatahubPredictionAccuracyServiceServer Register DatahubPredictionAccuracyService implementation to the gRPC server
+func RegisterDatahubPredictionAccuracyServiceServer(s *grpc.Server, srv DatahubPredictionAccuracyServiceServer) {
+	s.RegisterService(&_DatahubPredictionAccuracyService_serviceDesc, srv)
+}
+
+func _DatahubPredictionAccuracyService_CreatePredictionAccuracies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePredictionAccuraciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatahubPredictionAccuracyServiceServer).CreatePredictionAccuracies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/CreatePredictionAccuracies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatahubPredictionAccuracyServiceServer).CreatePredictionAccuracies(ctx, req.(*CreatePredictionAccuraciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatahubPredictionAccuracyService_ListPredictionAccuracies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPredictionAccuraciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatahubPredictionAccuracyServiceServer).ListPredictionAccuracies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/ListPredictionAccuracies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatahubPredictionAccuracyServiceServer).ListPredictionAccuracies(ctx, req.(*ListPredictionAccuraciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DatahubPredictionAccuracyService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*DatahubPredictionAccuracyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePredictionAccuracies",
+			Handler:    _DatahubPredictionAccuracyService_CreatePredictionAccuracies_Handler,
+		},
+		{
+			MethodName: "ListPredictionAccuracies",
+			Handler:    _DatahubPredictionAccuracyService_ListPredictionAccuracies_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
@@ -0,0 +1,225 @@
+package accuracy
+
+import (
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/genproto/googleapis/rpc/status"
+)
+
+// Messages of DatahubPredictionAccuracyService. They are maintained by hand
+// in the same shape protoc-gen-go produces, so the default gRPC codec marshals
+// them with golang/protobuf's reflection based marshaler.
+
+// Unit types of prediction accuracy
+const (
+	UnitTypePod  = "POD"
+	UnitTypeNode = "NODE"
+)
+
+// PredictionAccuracy Accuracy scores of predictions of a metric of a container
+// or a node with a granularity, measured against the actual metric values.
+type PredictionAccuracy struct {
+	UnitType             string                           `protobuf:"bytes,1,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
+	NamespacedName       *datahub_v1alpha1.NamespacedName `protobuf:"bytes,2,opt,name=namespaced_name,json=namespacedName,proto3" json:"namespaced_name,omitempty"`
+	ContainerName        string                           `protobuf:"bytes,3,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	NodeName             string                           `protobuf:"bytes,4,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	MetricType           datahub_v1alpha1.MetricType      `protobuf:"varint,5,opt,name=metric_type,json=metricType,proto3,enum=containers_ai.alameda.v1alpha1.datahub.MetricType" json:"metric_type,omitempty"`
+	Granularity          int64                            `protobuf:"varint,6,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	Time                 *timestamp.Timestamp             `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`
+	Mape                 float64                          `protobuf:"fixed64,8,opt,name=mape,proto3" json:"mape,omitempty"`
+	Rmse                 float64                          `protobuf:"fixed64,9,opt,name=rmse,proto3" json:"rmse,omitempty"`
+	Smape                float64                          `protobuf:"fixed64,10,opt,name=smape,proto3" json:"smape,omitempty"`
+	SampleCount          int32                            `protobuf:"varint,11,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *PredictionAccuracy) Reset()         { *m = PredictionAccuracy{} }
+func (m *PredictionAccuracy) String() string { return proto.CompactTextString(m) }
+func (*PredictionAccuracy) ProtoMessage()    {}
+
+func (m *PredictionAccuracy) GetUnitType() string {
+	if m != nil {
+		return m.UnitType
+	}
+	return ""
+}
+
+func (m *PredictionAccuracy) GetNamespacedName() *datahub_v1alpha1.NamespacedName {
+	if m != nil {
+		return m.NamespacedName
+	}
+	return nil
+}
+
+func (m *PredictionAccuracy) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *PredictionAccuracy) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *PredictionAccuracy) GetMetricType() datahub_v1alpha1.MetricType {
+	if m != nil {
+		return m.MetricType
+	}
+	return datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE
+}
+
+func (m *PredictionAccuracy) GetGranularity() int64 {
+	if m != nil {
+		return m.Granularity
+	}
+	return 0
+}
+
+func (m *PredictionAccuracy) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *PredictionAccuracy) GetMape() float64 {
+	if m != nil {
+		return m.Mape
+	}
+	return 0
+}
+
+func (m *PredictionAccuracy) GetRmse() float64 {
+	if m != nil {
+		return m.Rmse
+	}
+	return 0
+}
+
+func (m *PredictionAccuracy) GetSmape() float64 {
+	if m != nil {
+		return m.Smape
+	}
+	return 0
+}
+
+func (m *PredictionAccuracy) GetSampleCount() int32 {
+	if m != nil {
+		return m.SampleCount
+	}
+	return 0
+}
+
+// CreatePredictionAccuraciesRequest Request of CreatePredictionAccuracies
+type CreatePredictionAccuraciesRequest struct {
+	PredictionAccuracies []*PredictionAccuracy `protobuf:"bytes,1,rep,name=prediction_accuracies,json=predictionAccuracies,proto3" json:"prediction_accuracies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CreatePredictionAccuraciesRequest) Reset()         { *m = CreatePredictionAccuraciesRequest{} }
+func (m *CreatePredictionAccuraciesRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePredictionAccuraciesRequest) ProtoMessage()    {}
+
+func (m *CreatePredictionAccuraciesRequest) GetPredictionAccuracies() []*PredictionAccuracy {
+	if m != nil {
+		return m.PredictionAccuracies
+	}
+	return nil
+}
+
+// ListPredictionAccuraciesRequest Request of ListPredictionAccuracies. Empty
+// filters match all, the namespace and name of pods only apply to unit type POD
+// and node names only apply to unit type NODE.
+type ListPredictionAccuraciesRequest struct {
+	UnitType             string                           `protobuf:"bytes,1,opt,name=unit_type,json=unitType,proto3" json:"unit_type,omitempty"`
+	NamespacedName       *datahub_v1alpha1.NamespacedName `protobuf:"bytes,2,opt,name=namespaced_name,json=namespacedName,proto3" json:"namespaced_name,omitempty"`
+	NodeNames            []string                         `protobuf:"bytes,3,rep,name=node_names,json=nodeNames,proto3" json:"node_names,omitempty"`
+	MetricTypes          []datahub_v1alpha1.MetricType    `protobuf:"varint,4,rep,packed,name=metric_types,json=metricTypes,proto3,enum=containers_ai.alameda.v1alpha1.datahub.MetricType" json:"metric_types,omitempty"`
+	Granularity          int64                            `protobuf:"varint,5,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	QueryCondition       *datahub_v1alpha1.QueryCondition `protobuf:"bytes,6,opt,name=query_condition,json=queryCondition,proto3" json:"query_condition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *ListPredictionAccuraciesRequest) Reset()         { *m = ListPredictionAccuraciesRequest{} }
+func (m *ListPredictionAccuraciesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPredictionAccuraciesRequest) ProtoMessage()    {}
+
+func (m *ListPredictionAccuraciesRequest) GetUnitType() string {
+	if m != nil {
+		return m.UnitType
+	}
+	return ""
+}
+
+func (m *ListPredictionAccuraciesRequest) GetNamespacedName() *datahub_v1alpha1.NamespacedName {
+	if m != nil {
+		return m.NamespacedName
+	}
+	return nil
+}
+
+func (m *ListPredictionAccuraciesRequest) GetNodeNames() []string {
+	if m != nil {
+		return m.NodeNames
+	}
+	return nil
+}
+
+func (m *ListPredictionAccuraciesRequest) GetMetricTypes() []datahub_v1alpha1.MetricType {
+	if m != nil {
+		return m.MetricTypes
+	}
+	return nil
+}
+
+func (m *ListPredictionAccuraciesRequest) GetGranularity() int64 {
+	if m != nil {
+		return m.Granularity
+	}
+	return 0
+}
+
+func (m *ListPredictionAccuraciesRequest) GetQueryCondition() *datahub_v1alpha1.QueryCondition {
+	if m != nil {
+		return m.QueryCondition
+	}
+	return nil
+}
+
+// ListPredictionAccuraciesResponse Response of ListPredictionAccuracies
+type ListPredictionAccuraciesResponse struct {
+	Status               *status.Status        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PredictionAccuracies []*PredictionAccuracy `protobuf:"bytes,2,rep,name=prediction_accuracies,json=predictionAccuracies,proto3" json:"prediction_accuracies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListPredictionAccuraciesResponse) Reset()         { *m = ListPredictionAccuraciesResponse{} }
+func (m *ListPredictionAccuraciesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPredictionAccuraciesResponse) ProtoMessage()    {}
+
+func (m *ListPredictionAccuraciesResponse) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListPredictionAccuraciesResponse) GetPredictionAccuracies() []*PredictionAccuracy {
+	if m != nil {
+		return m.PredictionAccuracies
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package cluster
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+)
+
+// DatahubClusterServiceClient is the client API for DatahubClusterService service.
+type DatahubClusterServiceClient interface {
+	RegisterCluster(ctx context.Context, in *RegisterClusterRequest, opts ...grpc.CallOption) (*RegisterClusterResponse, error)
+	ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error)
+	DeregisterCluster(ctx context.Context, in *DeregisterClusterRequest, opts ...grpc.CallOption) (*status.Status, error)
+}
+
+type datahubClusterServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewDatahubClusterServiceClient Constructor of DatahubClusterService client
+func NewDatahubClusterServiceClient(cc *grpc.ClientConn) DatahubClusterServiceClient {
+	return &datahubClusterServiceClient{cc}
+}
+
+func (c *datahubClusterServiceClient) RegisterCluster(ctx context.Context, in *RegisterClusterRequest, opts ...grpc.CallOption) (*RegisterClusterResponse, error) {
+	out := new(RegisterClusterResponse)
+	err := c.cc.Invoke(ctx, "/"+ServiceName+"/RegisterCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datahubClusterServiceClient) ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error) {
+	out := new(ListClustersResponse)
+	err := c.cc.Invoke(ctx, "/"+ServiceName+"/ListClusters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datahubClusterServiceClient) DeregisterCluster(ctx context.Context, in *DeregisterClusterRequest, opts ...grpc.CallOption) (*status.Status, error) {
+	out := new(status.Status)
+	err := c.cc.Invoke(ctx, "/"+ServiceName+"/DeregisterCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
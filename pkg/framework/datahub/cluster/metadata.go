@@ -0,0 +1,102 @@
+package cluster
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Calls to datahub are scoped to the cluster of the caller. The caller sends
+// its cluster id in gRPC metadata since messages of DatahubService have no
+// cluster field, datahub resolves it into the context of the call after
+// checking the authenticated caller is bound to the cluster.
+
+const (
+	// MetadataKeyClusterID Key of gRPC metadata carrying the cluster id of the caller
+	MetadataKeyClusterID = "x-alameda-cluster-id"
+)
+
+type clusterIDContextKey struct{}
+
+// NewOutgoingContext Return context sending the cluster id to datahub
+func NewOutgoingContext(ctx context.Context, clusterID string) context.Context {
+	if clusterID == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, MetadataKeyClusterID, clusterID)
+}
+
+// FromIncomingContext Return cluster id sent by the caller, empty if it is not sent
+func FromIncomingContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(MetadataKeyClusterID); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// NewContext Return context carrying the cluster id the call is scoped to
+func NewContext(ctx context.Context, clusterID string) context.Context {
+	return context.WithValue(ctx, clusterIDContextKey{}, clusterID)
+}
+
+// FromContext Return cluster id the call is scoped to, empty if the call is not scoped
+func FromContext(ctx context.Context) string {
+	if clusterID, ok := ctx.Value(clusterIDContextKey{}).(string); ok {
+		return clusterID
+	}
+	return ""
+}
+
+// UnaryClientInterceptor Return interceptor sending the cluster id on every unary call
+func UnaryClientInterceptor(clusterID string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(NewOutgoingContext(ctx, clusterID), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor Return interceptor sending the cluster id on every streaming call
+func StreamClientInterceptor(clusterID string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(NewOutgoingContext(ctx, clusterID), desc, cc, method, opts...)
+	}
+}
+
+// UnaryServerInterceptor Return interceptor scoping every unary call to the
+// cluster sent by the caller, calls without cluster id are scoped to defaultClusterID
+func UnaryServerInterceptor(defaultClusterID string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(newScopedContext(ctx, defaultClusterID), req)
+	}
+}
+
+// StreamServerInterceptor Return interceptor scoping every streaming call to the
+// cluster sent by the caller, calls without cluster id are scoped to defaultClusterID
+func StreamServerInterceptor(defaultClusterID string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &scopedServerStream{
+			ServerStream: ss,
+			ctx:          newScopedContext(ss.Context(), defaultClusterID),
+		})
+	}
+}
+
+func newScopedContext(ctx context.Context, defaultClusterID string) context.Context {
+	clusterID := FromIncomingContext(ctx)
+	if clusterID == "" {
+		clusterID = defaultClusterID
+	}
+	return NewContext(ctx, clusterID)
+}
+
+type scopedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *scopedServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,73 @@
+package cluster
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeServer lists the cluster the call is scoped to
+type fakeServer struct{}
+
+func (f *fakeServer) RegisterCluster(ctx context.Context, in *RegisterClusterRequest) (*RegisterClusterResponse, error) {
+	return &RegisterClusterResponse{}, nil
+}
+
+func (f *fakeServer) ListClusters(ctx context.Context, in *ListClustersRequest) (*ListClustersResponse, error) {
+	return &ListClustersResponse{Clusters: []*Cluster{{Id: FromContext(ctx)}}}, nil
+}
+
+func (f *fakeServer) DeregisterCluster(ctx context.Context, in *DeregisterClusterRequest) (*status.Status, error) {
+	return &status.Status{}, nil
+}
+
+func dialFakeServer(t *testing.T, defaultClusterID string, opts ...grpc.DialOption) (*grpc.ClientConn, func()) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.UnaryInterceptor(UnaryServerInterceptor(defaultClusterID)))
+	RegisterDatahubClusterServiceServer(server, &fakeServer{})
+	go server.Serve(listener)
+
+	opts = append(opts, grpc.WithInsecure(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	conn, err := grpc.Dial("bufnet", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn, func() {
+		conn.Close()
+		server.Stop()
+	}
+}
+
+func TestClusterIDOfCall(t *testing.T) {
+	testCases := []struct {
+		name             string
+		clientClusterID  string
+		defaultClusterID string
+		expected         string
+	}{
+		{name: "cluster id sent by client", clientClusterID: "cluster-a", defaultClusterID: "local", expected: "cluster-a"},
+		{name: "default cluster id", defaultClusterID: "local", expected: "local"},
+		{name: "unscoped"},
+	}
+
+	for _, testCase := range testCases {
+		conn, closeFn := dialFakeServer(t, testCase.defaultClusterID, grpc.WithUnaryInterceptor(UnaryClientInterceptor(testCase.clientClusterID)))
+
+		resp, err := NewDatahubClusterServiceClient(conn).ListClusters(context.Background(), &ListClustersRequest{})
+		closeFn()
+
+		if err != nil {
+			t.Fatalf("%s: %v", testCase.name, err)
+		}
+		if actual := resp.GetClusters()[0].GetId(); actual != testCase.expected {
+			t.Errorf("%s: expect cluster id %q, got %q", testCase.name, testCase.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package cluster
+
+import (
+	K8SUtils "github.com/containers-ai/alameda/pkg/utils/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ConfigKeyClusterID Key of the cluster id in section datahub of configuration of every component calling datahub
+	ConfigKeyClusterID = "clusterID"
+)
+
+// ResolveClusterID Return the cluster id calls to datahub are scoped to. The configured id is returned
+// if it is set, otherwise the uid of configmap default/cluster-info read with k8sClient is returned,
+// which is also the cluster datahub scopes calls without cluster id to. Empty id is returned
+// if it is not configured and k8sClient is nil, calls are then scoped to the cluster datahub runs in.
+func ResolveClusterID(configured string, k8sClient client.Client) (string, error) {
+	if configured != "" {
+		return configured, nil
+	}
+	if k8sClient == nil {
+		return "", nil
+	}
+	return K8SUtils.GetClusterUID(k8sClient)
+}
@@ -0,0 +1,101 @@
+package cluster
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+)
+
+const (
+	// ServiceName Full name of the gRPC service serving the registry of clusters of datahub
+	ServiceName = "containers_ai.alameda.v1alpha1.datahub.DatahubClusterService"
+)
+
+// DatahubClusterServiceServer is the server API for DatahubClusterService service.
+type DatahubClusterServiceServer interface {
+	// RegisterCluster creates or updates a cluster in the registry
+	RegisterCluster(context.Context, *RegisterClusterRequest) (*RegisterClusterResponse, error)
+	// ListClusters lists registered clusters
+	ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error)
+	// DeregisterCluster removes a cluster from the registry
+	DeregisterCluster(context.Context, *DeregisterClusterRequest) (*status.Status, error)
+}
+
+// RegisterDatahubClusterServiceServer Register DatahubClusterService implementation to the gRPC server
+func RegisterDatahubClusterServiceServer(s *grpc.Server, srv DatahubClusterServiceServer) {
+	s.RegisterService(&_DatahubClusterService_serviceDesc, srv)
+}
+
+func _DatahubClusterService_RegisterCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatahubClusterServiceServer).RegisterCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/RegisterCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatahubClusterServiceServer).RegisterCluster(ctx, req.(*RegisterClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatahubClusterService_ListClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatahubClusterServiceServer).ListClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/ListClusters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatahubClusterServiceServer).ListClusters(ctx, req.(*ListClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatahubClusterService_DeregisterCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeregisterClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatahubClusterServiceServer).DeregisterCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/DeregisterCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatahubClusterServiceServer).DeregisterCluster(ctx, req.(*DeregisterClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DatahubClusterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*DatahubClusterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterCluster",
+			Handler:    _DatahubClusterService_RegisterCluster_Handler,
+		},
+		{
+			MethodName: "ListClusters",
+			Handler:    _DatahubClusterService_ListClusters_Handler,
+		},
+		{
+			MethodName: "DeregisterCluster",
+			Handler:    _DatahubClusterService_DeregisterCluster_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
@@ -1,12 +1,21 @@
 package datahub
 
+import (
+	DatahubCredentials "github.com/containers-ai/alameda/pkg/framework/datahub/credentials"
+)
+
 // Config datahub service configuration
 type Config struct {
 	Address string `mapstructure:"address"`
+	// ClusterID scopes calls to datahub, the uid of configmap default/cluster-info is used if it is empty
+	ClusterID string `mapstructure:"clusterID"`
+
+	DatahubCredentials.Config `mapstructure:",squash"`
 }
 
 func NewDefaultConfig() Config {
 	return Config{
 		Address: "datahub.alameda.svc:50050",
+		Config:  *DatahubCredentials.NewDefaultConfig(),
 	}
 }
@@ -0,0 +1,100 @@
+package credentials
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	defaultTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+)
+
+// Config Credentials presented to datahub
+type Config struct {
+	// TokenFile is the service account token sent on every call, no token is sent if it is empty or the file does not exist
+	TokenFile string     `mapstructure:"tokenFile"`
+	TLS       *TLSConfig `mapstructure:"tls"`
+}
+
+// TLSConfig Configuration of tls connection to datahub
+type TLSConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// CAFile verifies the certificate of datahub, system roots are used if it is empty
+	CAFile string `mapstructure:"caFile"`
+	// CertFile and KeyFile are the client certificate presented to datahub requiring mTLS
+	CertFile           string `mapstructure:"certFile"`
+	KeyFile            string `mapstructure:"keyFile"`
+	ServerName         string `mapstructure:"serverName"`
+	InsecureSkipVerify bool   `mapstructure:"insecureSkipVerify"`
+}
+
+// NewDefaultConfig Provide default credentials sending the service account token over plaintext connection
+func NewDefaultConfig() *Config {
+	return &Config{
+		TokenFile: defaultTokenFile,
+		TLS:       &TLSConfig{},
+	}
+}
+
+// Validate Confirm the credentials configuration is validated
+func (c *Config) Validate() error {
+	if c.TLS != nil && (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		return errors.New("certFile and keyFile of datahub tls must be set together")
+	}
+	return nil
+}
+
+// DialOptions Return options dialing datahub with the credentials
+func (c *Config) DialOptions() ([]grpc.DialOption, error) {
+	opts := []grpc.DialOption{}
+	if c == nil {
+		return append(opts, grpc.WithInsecure()), nil
+	}
+
+	tlsEnabled := c.TLS != nil && c.TLS.Enabled
+	if tlsEnabled {
+		tlsConfig, err := c.TLS.build()
+		if err != nil {
+			return nil, errors.Wrap(err, "build datahub tls configuration failed")
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	if c.TokenFile != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(NewTokenFileCredentials(c.TokenFile, tlsEnabled)))
+	}
+	return opts, nil
+}
+
+func (c *TLSConfig) build() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         c.ServerName,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if c.CAFile != "" {
+		ca, err := ioutil.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "read ca file failed")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errors.Errorf("no certificate found in ca file %s", c.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if c.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "load client certificate failed")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
@@ -0,0 +1,74 @@
+package credentials
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// MetadataKeyAuthorization Key of gRPC metadata carrying the bearer token of the caller
+	MetadataKeyAuthorization = "authorization"
+
+	tokenReloadInterval = time.Minute
+)
+
+// tokenFileCredentials Sends the token in the file as bearer token, the file is
+// reloaded periodically since kubelet rotates projected service account tokens
+type tokenFileCredentials struct {
+	path       string
+	requireTLS bool
+
+	lock     sync.Mutex
+	token    string
+	loadTime time.Time
+}
+
+// NewTokenFileCredentials Return per-RPC credentials sending token in the file
+func NewTokenFileCredentials(path string, requireTLS bool) credentials.PerRPCCredentials {
+	return &tokenFileCredentials{
+		path:       path,
+		requireTLS: requireTLS,
+	}
+}
+
+func (c *tokenFileCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token, err := c.getToken()
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		return map[string]string{}, nil
+	}
+	return map[string]string{
+		MetadataKeyAuthorization: "Bearer " + token,
+	}, nil
+}
+
+func (c *tokenFileCredentials) RequireTransportSecurity() bool {
+	return c.requireTLS
+}
+
+func (c *tokenFileCredentials) getToken() (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.token != "" && time.Since(c.loadTime) < tokenReloadInterval {
+		return c.token, nil
+	}
+	content, err := ioutil.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return "", nil
+	} else if err != nil {
+		return "", errors.Wrapf(err, "read token file %s failed", c.path)
+	}
+	c.token = strings.TrimSpace(string(content))
+	c.loadTime = time.Now()
+	return c.token, nil
+}
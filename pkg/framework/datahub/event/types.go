@@ -0,0 +1,54 @@
+package event
+
+import (
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+)
+
+// Event types which are not defined in containers-ai/api yet. The values are reserved for them in the
+// EventType enum of containers-ai/api, use Name and Value instead of the generated enum maps and
+// EventType.String() to convert event types from and to the names they are stored by.
+const (
+	// EventType_EVENT_TYPE_VPA_RECOMMENDATION_EVICTION_REFUSED Eviction of a pod to apply its recommendation is refused by the cluster
+	EventType_EVENT_TYPE_VPA_RECOMMENDATION_EVICTION_REFUSED datahub_v1alpha1.EventType = 101
+	// EventType_EVENT_TYPE_VPA_RECOMMENDATION_QUOTA_EXCEEDED Eviction of a pod is skipped since applying its recommendation would exceed ResourceQuotas
+	EventType_EVENT_TYPE_VPA_RECOMMENDATION_QUOTA_EXCEEDED datahub_v1alpha1.EventType = 102
+	// EventType_EVENT_TYPE_VPA_RECOMMENDATION_DRY_RUN Pods would be evicted to apply their recommendations but eviction is a dry run
+	EventType_EVENT_TYPE_VPA_RECOMMENDATION_DRY_RUN datahub_v1alpha1.EventType = 103
+	// EventType_EVENT_TYPE_HPA_RECOMMENDATION_DRY_RUN Replicas of a controller would be scaled to apply its recommendation but scaling is a dry run
+	EventType_EVENT_TYPE_HPA_RECOMMENDATION_DRY_RUN datahub_v1alpha1.EventType = 104
+)
+
+var (
+	extendedEventTypeNames = map[datahub_v1alpha1.EventType]string{
+		EventType_EVENT_TYPE_VPA_RECOMMENDATION_EVICTION_REFUSED: "EVENT_TYPE_VPA_RECOMMENDATION_EVICTION_REFUSED",
+		EventType_EVENT_TYPE_VPA_RECOMMENDATION_QUOTA_EXCEEDED:   "EVENT_TYPE_VPA_RECOMMENDATION_QUOTA_EXCEEDED",
+		EventType_EVENT_TYPE_VPA_RECOMMENDATION_DRY_RUN:          "EVENT_TYPE_VPA_RECOMMENDATION_DRY_RUN",
+		EventType_EVENT_TYPE_HPA_RECOMMENDATION_DRY_RUN:          "EVENT_TYPE_HPA_RECOMMENDATION_DRY_RUN",
+	}
+	extendedEventTypeValues = map[string]datahub_v1alpha1.EventType{
+		"EVENT_TYPE_VPA_RECOMMENDATION_EVICTION_REFUSED": EventType_EVENT_TYPE_VPA_RECOMMENDATION_EVICTION_REFUSED,
+		"EVENT_TYPE_VPA_RECOMMENDATION_QUOTA_EXCEEDED":   EventType_EVENT_TYPE_VPA_RECOMMENDATION_QUOTA_EXCEEDED,
+		"EVENT_TYPE_VPA_RECOMMENDATION_DRY_RUN":          EventType_EVENT_TYPE_VPA_RECOMMENDATION_DRY_RUN,
+		"EVENT_TYPE_HPA_RECOMMENDATION_DRY_RUN":          EventType_EVENT_TYPE_HPA_RECOMMENDATION_DRY_RUN,
+	}
+)
+
+// Name Return name of the event type
+func Name(eventType datahub_v1alpha1.EventType) string {
+	if name, ok := datahub_v1alpha1.EventType_name[int32(eventType)]; ok {
+		return name
+	}
+	if name, ok := extendedEventTypeNames[eventType]; ok {
+		return name
+	}
+	return eventType.String()
+}
+
+// Value Return the event type of the name and whether the name is known
+func Value(name string) (datahub_v1alpha1.EventType, bool) {
+	if value, ok := datahub_v1alpha1.EventType_value[name]; ok {
+		return datahub_v1alpha1.EventType(value), true
+	}
+	eventType, ok := extendedEventTypeValues[name]
+	return eventType, ok
+}
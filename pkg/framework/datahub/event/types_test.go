@@ -0,0 +1,37 @@
+package event
+
+import (
+	"testing"
+
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+)
+
+func TestNameAndValue(t *testing.T) {
+	tests := []struct {
+		eventType datahub_v1alpha1.EventType
+		name      string
+	}{
+		{eventType: datahub_v1alpha1.EventType_EVENT_TYPE_VPA_RECOMMENDATION_EXECUTE, name: "EVENT_TYPE_VPA_RECOMMENDATION_EXECUTE"},
+		{eventType: EventType_EVENT_TYPE_VPA_RECOMMENDATION_EVICTION_REFUSED, name: "EVENT_TYPE_VPA_RECOMMENDATION_EVICTION_REFUSED"},
+		{eventType: EventType_EVENT_TYPE_VPA_RECOMMENDATION_QUOTA_EXCEEDED, name: "EVENT_TYPE_VPA_RECOMMENDATION_QUOTA_EXCEEDED"},
+		{eventType: EventType_EVENT_TYPE_VPA_RECOMMENDATION_DRY_RUN, name: "EVENT_TYPE_VPA_RECOMMENDATION_DRY_RUN"},
+		{eventType: EventType_EVENT_TYPE_HPA_RECOMMENDATION_DRY_RUN, name: "EVENT_TYPE_HPA_RECOMMENDATION_DRY_RUN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Name(tt.eventType); got != tt.name {
+				t.Errorf("Name() = %s, want %s", got, tt.name)
+			}
+			if got, ok := Value(tt.name); !ok || got != tt.eventType {
+				t.Errorf("Value() = (%v, %t), want (%v, true)", got, ok, tt.eventType)
+			}
+		})
+	}
+
+	if _, ok := Value("UNKNOWN"); ok {
+		t.Error("expect unknown name not found")
+	}
+	if _, ok := datahub_v1alpha1.EventType_value["EVENT_TYPE_VPA_RECOMMENDATION_EVICTION_REFUSED"]; ok {
+		t.Error("expect generated enum maps not modified")
+	}
+}
@@ -0,0 +1,69 @@
+package history
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// DatahubRecommendationHistoryServiceClient is the client API for DatahubRecommendationHistoryService service.
+type DatahubRecommendationHistoryServiceClient interface {
+	DiffPodRecommendations(ctx context.Context, in *DiffPodRecommendationsRequest, opts ...grpc.CallOption) (*DiffPodRecommendationsResponse, error)
+	DiffControllerRecommendations(ctx context.Context, in *DiffControllerRecommendationsRequest, opts ...grpc.CallOption) (*DiffControllerRecommendationsResponse, error)
+	RollbackPodRecommendations(ctx context.Context, in *RollbackPodRecommendationsRequest, opts ...grpc.CallOption) (*RollbackPodRecommendationsResponse, error)
+	RollbackControllerRecommendations(ctx context.Context, in *RollbackControllerRecommendationsRequest, opts ...grpc.CallOption) (*RollbackControllerRecommendationsResponse, error)
+	GetLatestControllerRecommendation(ctx context.Context, in *GetLatestControllerRecommendationRequest, opts ...grpc.CallOption) (*GetLatestControllerRecommendationResponse, error)
+}
+
+type datahubRecommendationHistoryServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewDatahubRecommendationHistoryServiceClient Constructor of DatahubRecommendationHistoryService client
+func NewDatahubRecommendationHistoryServiceClient(cc *grpc.ClientConn) DatahubRecommendationHistoryServiceClient {
+	return &datahubRecommendationHistoryServiceClient{cc}
+}
+
+func (c *datahubRecommendationHistoryServiceClient) DiffPodRecommendations(ctx context.Context, in *DiffPodRecommendationsRequest, opts ...grpc.CallOption) (*DiffPodRecommendationsResponse, error) {
+	out := new(DiffPodRecommendationsResponse)
+	err := c.cc.Invoke(ctx, "/"+ServiceName+"/DiffPodRecommendations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datahubRecommendationHistoryServiceClient) DiffControllerRecommendations(ctx context.Context, in *DiffControllerRecommendationsRequest, opts ...grpc.CallOption) (*DiffControllerRecommendationsResponse, error) {
+	out := new(DiffControllerRecommendationsResponse)
+	err := c.cc.Invoke(ctx, "/"+ServiceName+"/DiffControllerRecommendations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datahubRecommendationHistoryServiceClient) RollbackPodRecommendations(ctx context.Context, in *RollbackPodRecommendationsRequest, opts ...grpc.CallOption) (*RollbackPodRecommendationsResponse, error) {
+	out := new(RollbackPodRecommendationsResponse)
+	err := c.cc.Invoke(ctx, "/"+ServiceName+"/RollbackPodRecommendations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datahubRecommendationHistoryServiceClient) RollbackControllerRecommendations(ctx context.Context, in *RollbackControllerRecommendationsRequest, opts ...grpc.CallOption) (*RollbackControllerRecommendationsResponse, error) {
+	out := new(RollbackControllerRecommendationsResponse)
+	err := c.cc.Invoke(ctx, "/"+ServiceName+"/RollbackControllerRecommendations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datahubRecommendationHistoryServiceClient) GetLatestControllerRecommendation(ctx context.Context, in *GetLatestControllerRecommendationRequest, opts ...grpc.CallOption) (*GetLatestControllerRecommendationResponse, error) {
+	out := new(GetLatestControllerRecommendationResponse)
+	err := c.cc.Invoke(ctx, "/"+ServiceName+"/GetLatestControllerRecommendation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,259 @@
+package history
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	DatahubKind "github.com/containers-ai/alameda/pkg/framework/datahub/kind"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// Kinds of container recommendation compared by DiffPodRecommendations
+const (
+	KindLimit          = "limit"
+	KindRequest        = "request"
+	KindInitialLimit   = "initLimit"
+	KindInitialRequest = "initRequest"
+)
+
+// Fields of controller recommendation compared by DiffControllerRecommendations
+const (
+	FieldKind               = "kind"
+	FieldCurrentReplicas    = "current_replicas"
+	FieldDesiredReplicas    = "desired_replicas"
+	FieldCurrentCPURequests = "current_cpu_requests"
+	FieldCurrentMemRequests = "current_mem_requests"
+	FieldCurrentCPULimits   = "current_cpu_limits"
+	FieldCurrentMemLimits   = "current_mem_limits"
+	FieldDesiredCPULimits   = "desired_cpu_limits"
+	FieldDesiredMemLimits   = "desired_mem_limits"
+	FieldTotalCost          = "total_cost"
+)
+
+type field struct {
+	name  string
+	value string
+}
+
+// DiffPodRecommendations Return the changed recommendations of containers from
+// one generation of pod recommendation to another, containers are sorted by name.
+func DiffPodRecommendations(from, to *datahub_v1alpha1.PodRecommendation) []*ContainerRecommendationDiff {
+	fromContainers := containerRecommendationMap(from)
+	toContainers := containerRecommendationMap(to)
+
+	names := make([]string, 0, len(fromContainers)+len(toContainers))
+	for name := range fromContainers {
+		names = append(names, name)
+	}
+	for name := range toContainers {
+		if _, exist := fromContainers[name]; !exist {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	diffs := make([]*ContainerRecommendationDiff, 0)
+	for _, name := range names {
+		resourceDiffs := diffContainerRecommendation(fromContainers[name], toContainers[name])
+		if len(resourceDiffs) == 0 {
+			continue
+		}
+		diffs = append(diffs, &ContainerRecommendationDiff{
+			Name:          name,
+			ResourceDiffs: resourceDiffs,
+		})
+	}
+	return diffs
+}
+
+// DiffControllerRecommendations Return the changed fields from one generation of controller recommendation to another
+func DiffControllerRecommendations(from, to *datahub_v1alpha1.ControllerRecommendation) []*FieldDiff {
+	fromFields := controllerRecommendationFields(from)
+	toFields := controllerRecommendationFields(to)
+
+	toValues := make(map[string]string, len(toFields))
+	for _, f := range toFields {
+		toValues[f.name] = f.value
+	}
+
+	diffs := make([]*FieldDiff, 0)
+	for _, f := range fromFields {
+		toValue := toValues[f.name]
+		delete(toValues, f.name)
+		if f.value == toValue {
+			continue
+		}
+		diffs = append(diffs, &FieldDiff{Field: f.name, FromValue: f.value, ToValue: toValue, Delta: delta(f.value, toValue)})
+	}
+	for _, f := range toFields {
+		if _, exist := toValues[f.name]; !exist || f.value == "" {
+			continue
+		}
+		diffs = append(diffs, &FieldDiff{Field: f.name, ToValue: f.value, Delta: delta("", f.value)})
+	}
+	return diffs
+}
+
+// ControllerRecommendationTime Return the time of the generation of controller recommendation
+func ControllerRecommendationTime(recommendation *datahub_v1alpha1.ControllerRecommendation) *timestamp.Timestamp {
+	switch recommendation.GetRecommendedType() {
+	case datahub_v1alpha1.ControllerRecommendedType_CRT_Primitive:
+		return recommendation.GetRecommendedSpec().GetTime()
+	case datahub_v1alpha1.ControllerRecommendedType_CRT_K8s:
+		return recommendation.GetRecommendedSpecK8S().GetTime()
+	}
+	return nil
+}
+
+// RepublishPodRecommendation Return a copy of the pod recommendation which becomes
+// applicable at the time and stays applicable as long as the original one did.
+func RepublishPodRecommendation(recommendation *datahub_v1alpha1.PodRecommendation, now time.Time) *datahub_v1alpha1.PodRecommendation {
+	republished := proto.Clone(recommendation).(*datahub_v1alpha1.PodRecommendation)
+	shift := now.Unix() - republished.GetStartTime().GetSeconds()
+
+	shiftTimestamp := func(t *timestamp.Timestamp) *timestamp.Timestamp {
+		if t == nil || t.GetSeconds() == 0 {
+			return t
+		}
+		return &timestamp.Timestamp{Seconds: t.GetSeconds() + shift}
+	}
+
+	republished.StartTime = &timestamp.Timestamp{Seconds: now.Unix()}
+	republished.EndTime = shiftTimestamp(republished.GetEndTime())
+	for _, containerRecommendation := range republished.GetContainerRecommendations() {
+		for _, metricDataList := range containerMetricDataLists(containerRecommendation) {
+			for _, metricData := range metricDataList {
+				for _, sample := range metricData.GetData() {
+					sample.Time = shiftTimestamp(sample.GetTime())
+					sample.EndTime = shiftTimestamp(sample.GetEndTime())
+				}
+			}
+		}
+	}
+	return republished
+}
+
+// RepublishControllerRecommendation Return a copy of the controller recommendation created at the time
+func RepublishControllerRecommendation(recommendation *datahub_v1alpha1.ControllerRecommendation, now time.Time) *datahub_v1alpha1.ControllerRecommendation {
+	republished := proto.Clone(recommendation).(*datahub_v1alpha1.ControllerRecommendation)
+	nowTimestamp := &timestamp.Timestamp{Seconds: now.Unix()}
+
+	if spec := republished.GetRecommendedSpec(); spec != nil {
+		spec.Time = nowTimestamp
+		spec.CreateTime = nowTimestamp
+	}
+	if spec := republished.GetRecommendedSpecK8S(); spec != nil {
+		spec.Time = nowTimestamp
+		spec.CreateTime = nowTimestamp
+	}
+	return republished
+}
+
+func containerRecommendationMap(recommendation *datahub_v1alpha1.PodRecommendation) map[string]*datahub_v1alpha1.ContainerRecommendation {
+	containers := make(map[string]*datahub_v1alpha1.ContainerRecommendation)
+	for _, containerRecommendation := range recommendation.GetContainerRecommendations() {
+		containers[containerRecommendation.GetName()] = containerRecommendation
+	}
+	return containers
+}
+
+func containerMetricDataLists(containerRecommendation *datahub_v1alpha1.ContainerRecommendation) map[string][]*datahub_v1alpha1.MetricData {
+	return map[string][]*datahub_v1alpha1.MetricData{
+		KindLimit:          containerRecommendation.GetLimitRecommendations(),
+		KindRequest:        containerRecommendation.GetRequestRecommendations(),
+		KindInitialLimit:   containerRecommendation.GetInitialLimitRecommendations(),
+		KindInitialRequest: containerRecommendation.GetInitialRequestRecommendations(),
+	}
+}
+
+func diffContainerRecommendation(from, to *datahub_v1alpha1.ContainerRecommendation) []*ResourceDiff {
+	fromLists := containerMetricDataLists(from)
+	toLists := containerMetricDataLists(to)
+	metricTypes := []datahub_v1alpha1.MetricType{
+		datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE,
+		datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES,
+	}
+
+	diffs := make([]*ResourceDiff, 0)
+	for _, kind := range []string{KindLimit, KindRequest, KindInitialLimit, KindInitialRequest} {
+		for _, metricType := range metricTypes {
+			fromValue := metricValue(fromLists[kind], metricType)
+			toValue := metricValue(toLists[kind], metricType)
+			if fromValue == toValue {
+				continue
+			}
+			diffs = append(diffs, &ResourceDiff{
+				Kind:       kind,
+				MetricType: metricType,
+				FromValue:  fromValue,
+				ToValue:    toValue,
+				Delta:      delta(fromValue, toValue),
+			})
+		}
+	}
+	return diffs
+}
+
+// metricValue Return value of the first sample of the metric type, a generation
+// holds one sample per metric.
+func metricValue(metricDataList []*datahub_v1alpha1.MetricData, metricType datahub_v1alpha1.MetricType) string {
+	for _, metricData := range metricDataList {
+		if metricData.GetMetricType() != metricType {
+			continue
+		}
+		for _, sample := range metricData.GetData() {
+			return sample.GetNumValue()
+		}
+	}
+	return ""
+}
+
+func controllerRecommendationFields(recommendation *datahub_v1alpha1.ControllerRecommendation) []field {
+	if spec := recommendation.GetRecommendedSpec(); spec != nil {
+		return []field{
+			{FieldKind, DatahubKind.Name(spec.GetKind())},
+			{FieldCurrentReplicas, strconv.FormatInt(int64(spec.GetCurrentReplicas()), 10)},
+			{FieldDesiredReplicas, strconv.FormatInt(int64(spec.GetDesiredReplicas()), 10)},
+			{FieldCurrentCPURequests, formatFloat(spec.GetCurrentCpuRequests())},
+			{FieldCurrentMemRequests, formatFloat(spec.GetCurrentMemRequests())},
+			{FieldCurrentCPULimits, formatFloat(spec.GetCurrentCpuLimits())},
+			{FieldCurrentMemLimits, formatFloat(spec.GetCurrentMemLimits())},
+			{FieldDesiredCPULimits, formatFloat(spec.GetDesiredCpuLimits())},
+			{FieldDesiredMemLimits, formatFloat(spec.GetDesiredMemLimits())},
+			{FieldTotalCost, formatFloat(spec.GetTotalCost())},
+		}
+	}
+	if spec := recommendation.GetRecommendedSpecK8S(); spec != nil {
+		return []field{
+			{FieldKind, DatahubKind.Name(spec.GetKind())},
+			{FieldCurrentReplicas, strconv.FormatInt(int64(spec.GetCurrentReplicas()), 10)},
+			{FieldDesiredReplicas, strconv.FormatInt(int64(spec.GetDesiredReplicas()), 10)},
+		}
+	}
+	return nil
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func delta(fromValue, toValue string) float64 {
+	if fromValue == "" {
+		fromValue = "0"
+	}
+	if toValue == "" {
+		toValue = "0"
+	}
+	from, err := strconv.ParseFloat(fromValue, 64)
+	if err != nil {
+		return 0
+	}
+	to, err := strconv.ParseFloat(toValue, 64)
+	if err != nil {
+		return 0
+	}
+	return to - from
+}
@@ -0,0 +1,135 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+func newContainerRecommendation(name, cpuLimit, memoryRequest string, start, end int64) *datahub_v1alpha1.ContainerRecommendation {
+	sample := func(value string) []*datahub_v1alpha1.Sample {
+		return []*datahub_v1alpha1.Sample{{
+			Time:     &timestamp.Timestamp{Seconds: start},
+			EndTime:  &timestamp.Timestamp{Seconds: end},
+			NumValue: value,
+		}}
+	}
+	return &datahub_v1alpha1.ContainerRecommendation{
+		Name: name,
+		LimitRecommendations: []*datahub_v1alpha1.MetricData{
+			{MetricType: datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE, Data: sample(cpuLimit)},
+		},
+		RequestRecommendations: []*datahub_v1alpha1.MetricData{
+			{MetricType: datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES, Data: sample(memoryRequest)},
+		},
+	}
+}
+
+func TestDiffPodRecommendations(t *testing.T) {
+	from := &datahub_v1alpha1.PodRecommendation{
+		ContainerRecommendations: []*datahub_v1alpha1.ContainerRecommendation{
+			newContainerRecommendation("app", "100", "1024", 0, 0),
+			newContainerRecommendation("removed", "50", "512", 0, 0),
+			newContainerRecommendation("sidecar", "10", "64", 0, 0),
+		},
+	}
+	to := &datahub_v1alpha1.PodRecommendation{
+		ContainerRecommendations: []*datahub_v1alpha1.ContainerRecommendation{
+			newContainerRecommendation("sidecar", "10", "64", 0, 0),
+			newContainerRecommendation("app", "150", "1024", 0, 0),
+			newContainerRecommendation("added", "20", "", 0, 0),
+		},
+	}
+
+	diffs := DiffPodRecommendations(from, to)
+
+	expected := []struct {
+		name      string
+		kind      string
+		fromValue string
+		toValue   string
+		delta     float64
+	}{
+		{"added", KindLimit, "", "20", 20},
+		{"app", KindLimit, "100", "150", 50},
+		{"removed", KindLimit, "50", "", -50},
+		{"removed", KindRequest, "512", "", -512},
+	}
+	actual := make([]*ResourceDiff, 0)
+	names := make([]string, 0)
+	for _, diff := range diffs {
+		for _, resourceDiff := range diff.GetResourceDiffs() {
+			actual = append(actual, resourceDiff)
+			names = append(names, diff.GetName())
+		}
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("expect %d resource diffs, got %v", len(expected), diffs)
+	}
+	for i, e := range expected {
+		a := actual[i]
+		if names[i] != e.name || a.GetKind() != e.kind || a.GetFromValue() != e.fromValue || a.GetToValue() != e.toValue || a.GetDelta() != e.delta {
+			t.Errorf("diff %d: expect %+v, got %s %v", i, e, names[i], a)
+		}
+	}
+}
+
+func TestDiffControllerRecommendations(t *testing.T) {
+	from := &datahub_v1alpha1.ControllerRecommendation{
+		RecommendedType: datahub_v1alpha1.ControllerRecommendedType_CRT_Primitive,
+		RecommendedSpec: &datahub_v1alpha1.ControllerRecommendedSpec{
+			CurrentReplicas:  2,
+			DesiredReplicas:  3,
+			DesiredCpuLimits: 0.5,
+		},
+	}
+	to := &datahub_v1alpha1.ControllerRecommendation{
+		RecommendedType: datahub_v1alpha1.ControllerRecommendedType_CRT_Primitive,
+		RecommendedSpec: &datahub_v1alpha1.ControllerRecommendedSpec{
+			CurrentReplicas:  3,
+			DesiredReplicas:  3,
+			DesiredCpuLimits: 0.25,
+		},
+	}
+
+	diffs := DiffControllerRecommendations(from, to)
+	if len(diffs) != 2 {
+		t.Fatalf("expect 2 field diffs, got %v", diffs)
+	}
+	if diffs[0].GetField() != FieldCurrentReplicas || diffs[0].GetDelta() != 1 {
+		t.Errorf("unexpected diff %v", diffs[0])
+	}
+	if diffs[1].GetField() != FieldDesiredCPULimits || diffs[1].GetFromValue() != "0.5" || diffs[1].GetDelta() != -0.25 {
+		t.Errorf("unexpected diff %v", diffs[1])
+	}
+
+	if diffs := DiffControllerRecommendations(nil, to); len(diffs) != 10 {
+		t.Errorf("expect every field reported against no generation, got %v", diffs)
+	}
+}
+
+func TestRepublishPodRecommendation(t *testing.T) {
+	recommendation := &datahub_v1alpha1.PodRecommendation{
+		StartTime: &timestamp.Timestamp{Seconds: 1000},
+		EndTime:   &timestamp.Timestamp{Seconds: 4600},
+		ContainerRecommendations: []*datahub_v1alpha1.ContainerRecommendation{
+			newContainerRecommendation("app", "100", "1024", 1000, 4600),
+		},
+	}
+	now := time.Unix(90000, 0)
+
+	republished := RepublishPodRecommendation(recommendation, now)
+
+	if republished.GetStartTime().GetSeconds() != 90000 || republished.GetEndTime().GetSeconds() != 93600 {
+		t.Errorf("unexpected applicable period %v - %v", republished.GetStartTime(), republished.GetEndTime())
+	}
+	sample := republished.GetContainerRecommendations()[0].GetLimitRecommendations()[0].GetData()[0]
+	if sample.GetTime().GetSeconds() != 90000 || sample.GetEndTime().GetSeconds() != 93600 || sample.GetNumValue() != "100" {
+		t.Errorf("unexpected sample %v", sample)
+	}
+	if recommendation.GetStartTime().GetSeconds() != 1000 {
+		t.Errorf("expect original recommendation unchanged")
+	}
+}
@@ -0,0 +1,148 @@
+package history
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+const (
+	// ServiceName Full name of the gRPC service serving history of datahub recommendations
+	ServiceName = "containers_ai.alameda.v1alpha1.datahub.DatahubRecommendationHistoryService"
+)
+
+// DatahubRecommendationHistoryServiceServer is the server API for DatahubRecommendationHistoryService service.
+type DatahubRecommendationHistoryServiceServer interface {
+	// DiffPodRecommendations compares two generations of recommendations of a pod
+	DiffPodRecommendations(context.Context, *DiffPodRecommendationsRequest) (*DiffPodRecommendationsResponse, error)
+	// DiffControllerRecommendations compares two generations of recommendations of a controller
+	DiffControllerRecommendations(context.Context, *DiffControllerRecommendationsRequest) (*DiffControllerRecommendationsResponse, error)
+	// RollbackPodRecommendations re-publishes an earlier generation of pod recommendations as the applicable one
+	RollbackPodRecommendations(context.Context, *RollbackPodRecommendationsRequest) (*RollbackPodRecommendationsResponse, error)
+	// RollbackControllerRecommendations re-publishes an earlier generation of controller recommendations as the latest one
+	RollbackControllerRecommendations(context.Context, *RollbackControllerRecommendationsRequest) (*RollbackControllerRecommendationsResponse, error)
+	// GetLatestControllerRecommendation returns the latest generation of recommendations of a controller of the kind
+	GetLatestControllerRecommendation(context.Context, *GetLatestControllerRecommendationRequest) (*GetLatestControllerRecommendationResponse, error)
+}
+
+// RegisterDatahubRecommendationHistoryServiceServer Register DatahubRecommendationHistoryService implementation to the gRPC server
+func RegisterDatahubRecommendationHistoryServiceServer(s *grpc.Server, srv DatahubRecommendationHistoryServiceServer) {
+	s.RegisterService(&_DatahubRecommendationHistoryService_serviceDesc, srv)
+}
+
+func _DatahubRecommendationHistoryService_DiffPodRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffPodRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatahubRecommendationHistoryServiceServer).DiffPodRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/DiffPodRecommendations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatahubRecommendationHistoryServiceServer).DiffPodRecommendations(ctx, req.(*DiffPodRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatahubRecommendationHistoryService_DiffControllerRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffControllerRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatahubRecommendationHistoryServiceServer).DiffControllerRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/DiffControllerRecommendations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatahubRecommendationHistoryServiceServer).DiffControllerRecommendations(ctx, req.(*DiffControllerRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatahubRecommendationHistoryService_RollbackPodRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackPodRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatahubRecommendationHistoryServiceServer).RollbackPodRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/RollbackPodRecommendations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatahubRecommendationHistoryServiceServer).RollbackPodRecommendations(ctx, req.(*RollbackPodRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatahubRecommendationHistoryService_RollbackControllerRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackControllerRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatahubRecommendationHistoryServiceServer).RollbackControllerRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/RollbackControllerRecommendations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatahubRecommendationHistoryServiceServer).RollbackControllerRecommendations(ctx, req.(*RollbackControllerRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatahubRecommendationHistoryService_GetLatestControllerRecommendation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestControllerRecommendationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatahubRecommendationHistoryServiceServer).GetLatestControllerRecommendation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/GetLatestControllerRecommendation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatahubRecommendationHistoryServiceServer).GetLatestControllerRecommendation(ctx, req.(*GetLatestControllerRecommendationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DatahubRecommendationHistoryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*DatahubRecommendationHistoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DiffPodRecommendations",
+			Handler:    _DatahubRecommendationHistoryService_DiffPodRecommendations_Handler,
+		},
+		{
+			MethodName: "DiffControllerRecommendations",
+			Handler:    _DatahubRecommendationHistoryService_DiffControllerRecommendations_Handler,
+		},
+		{
+			MethodName: "RollbackPodRecommendations",
+			Handler:    _DatahubRecommendationHistoryService_RollbackPodRecommendations_Handler,
+		},
+		{
+			MethodName: "RollbackControllerRecommendations",
+			Handler:    _DatahubRecommendationHistoryService_RollbackControllerRecommendations_Handler,
+		},
+		{
+			MethodName: "GetLatestControllerRecommendation",
+			Handler:    _DatahubRecommendationHistoryService_GetLatestControllerRecommendation_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
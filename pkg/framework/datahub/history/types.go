@@ -0,0 +1,523 @@
+package history
+
+import (
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/genproto/googleapis/rpc/status"
+)
+
+// Messages of DatahubRecommendationHistoryService. They are maintained by hand
+// in the same shape protoc-gen-go produces, so the default gRPC codec marshals
+// them with golang/protobuf's reflection based marshaler.
+
+// DiffPodRecommendationsRequest Request of DiffPodRecommendations. If ToTime is
+// not set, the latest generation is compared. If FromTime is not set, the
+// generation right before the compared one is used.
+type DiffPodRecommendationsRequest struct {
+	NamespacedName       *datahub_v1alpha1.NamespacedName `protobuf:"bytes,1,opt,name=namespaced_name,json=namespacedName,proto3" json:"namespaced_name,omitempty"`
+	Granularity          int64                            `protobuf:"varint,2,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	FromTime             *timestamp.Timestamp             `protobuf:"bytes,3,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime               *timestamp.Timestamp             `protobuf:"bytes,4,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *DiffPodRecommendationsRequest) Reset()         { *m = DiffPodRecommendationsRequest{} }
+func (m *DiffPodRecommendationsRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffPodRecommendationsRequest) ProtoMessage()    {}
+
+func (m *DiffPodRecommendationsRequest) GetNamespacedName() *datahub_v1alpha1.NamespacedName {
+	if m != nil {
+		return m.NamespacedName
+	}
+	return nil
+}
+
+func (m *DiffPodRecommendationsRequest) GetGranularity() int64 {
+	if m != nil {
+		return m.Granularity
+	}
+	return 0
+}
+
+func (m *DiffPodRecommendationsRequest) GetFromTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.FromTime
+	}
+	return nil
+}
+
+func (m *DiffPodRecommendationsRequest) GetToTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ToTime
+	}
+	return nil
+}
+
+// DiffPodRecommendationsResponse Response of DiffPodRecommendations
+type DiffPodRecommendationsResponse struct {
+	Status               *status.Status                      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	From                 *datahub_v1alpha1.PodRecommendation `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *datahub_v1alpha1.PodRecommendation `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	ContainerDiffs       []*ContainerRecommendationDiff      `protobuf:"bytes,4,rep,name=container_diffs,json=containerDiffs,proto3" json:"container_diffs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *DiffPodRecommendationsResponse) Reset()         { *m = DiffPodRecommendationsResponse{} }
+func (m *DiffPodRecommendationsResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffPodRecommendationsResponse) ProtoMessage()    {}
+
+func (m *DiffPodRecommendationsResponse) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DiffPodRecommendationsResponse) GetFrom() *datahub_v1alpha1.PodRecommendation {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *DiffPodRecommendationsResponse) GetTo() *datahub_v1alpha1.PodRecommendation {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *DiffPodRecommendationsResponse) GetContainerDiffs() []*ContainerRecommendationDiff {
+	if m != nil {
+		return m.ContainerDiffs
+	}
+	return nil
+}
+
+// ContainerRecommendationDiff Changed resources of a container between two generations
+type ContainerRecommendationDiff struct {
+	Name                 string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ResourceDiffs        []*ResourceDiff `protobuf:"bytes,2,rep,name=resource_diffs,json=resourceDiffs,proto3" json:"resource_diffs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ContainerRecommendationDiff) Reset()         { *m = ContainerRecommendationDiff{} }
+func (m *ContainerRecommendationDiff) String() string { return proto.CompactTextString(m) }
+func (*ContainerRecommendationDiff) ProtoMessage()    {}
+
+func (m *ContainerRecommendationDiff) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContainerRecommendationDiff) GetResourceDiffs() []*ResourceDiff {
+	if m != nil {
+		return m.ResourceDiffs
+	}
+	return nil
+}
+
+// ResourceDiff Changed value of one kind of recommendation of a metric. Value
+// is empty if the generation has no such recommendation, Delta is ToValue
+// minus FromValue if both are numbers.
+type ResourceDiff struct {
+	Kind                 string                      `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	MetricType           datahub_v1alpha1.MetricType `protobuf:"varint,2,opt,name=metric_type,json=metricType,proto3,enum=containers_ai.alameda.v1alpha1.datahub.MetricType" json:"metric_type,omitempty"`
+	FromValue            string                      `protobuf:"bytes,3,opt,name=from_value,json=fromValue,proto3" json:"from_value,omitempty"`
+	ToValue              string                      `protobuf:"bytes,4,opt,name=to_value,json=toValue,proto3" json:"to_value,omitempty"`
+	Delta                float64                     `protobuf:"fixed64,5,opt,name=delta,proto3" json:"delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ResourceDiff) Reset()         { *m = ResourceDiff{} }
+func (m *ResourceDiff) String() string { return proto.CompactTextString(m) }
+func (*ResourceDiff) ProtoMessage()    {}
+
+func (m *ResourceDiff) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ResourceDiff) GetMetricType() datahub_v1alpha1.MetricType {
+	if m != nil {
+		return m.MetricType
+	}
+	return datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE
+}
+
+func (m *ResourceDiff) GetFromValue() string {
+	if m != nil {
+		return m.FromValue
+	}
+	return ""
+}
+
+func (m *ResourceDiff) GetToValue() string {
+	if m != nil {
+		return m.ToValue
+	}
+	return ""
+}
+
+func (m *ResourceDiff) GetDelta() float64 {
+	if m != nil {
+		return m.Delta
+	}
+	return 0
+}
+
+// DiffControllerRecommendationsRequest Request of DiffControllerRecommendations.
+// If ToTime is not set, the latest generation is compared. If FromTime is not
+// set, the generation right before the compared one is used.
+type DiffControllerRecommendationsRequest struct {
+	NamespacedName       *datahub_v1alpha1.NamespacedName           `protobuf:"bytes,1,opt,name=namespaced_name,json=namespacedName,proto3" json:"namespaced_name,omitempty"`
+	RecommendedType      datahub_v1alpha1.ControllerRecommendedType `protobuf:"varint,2,opt,name=recommended_type,json=recommendedType,proto3,enum=containers_ai.alameda.v1alpha1.datahub.ControllerRecommendedType" json:"recommended_type,omitempty"`
+	FromTime             *timestamp.Timestamp                       `protobuf:"bytes,3,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime               *timestamp.Timestamp                       `protobuf:"bytes,4,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *DiffControllerRecommendationsRequest) Reset()         { *m = DiffControllerRecommendationsRequest{} }
+func (m *DiffControllerRecommendationsRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffControllerRecommendationsRequest) ProtoMessage()    {}
+
+func (m *DiffControllerRecommendationsRequest) GetNamespacedName() *datahub_v1alpha1.NamespacedName {
+	if m != nil {
+		return m.NamespacedName
+	}
+	return nil
+}
+
+func (m *DiffControllerRecommendationsRequest) GetRecommendedType() datahub_v1alpha1.ControllerRecommendedType {
+	if m != nil {
+		return m.RecommendedType
+	}
+	return datahub_v1alpha1.ControllerRecommendedType_CRT_Undefined
+}
+
+func (m *DiffControllerRecommendationsRequest) GetFromTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.FromTime
+	}
+	return nil
+}
+
+func (m *DiffControllerRecommendationsRequest) GetToTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ToTime
+	}
+	return nil
+}
+
+// DiffControllerRecommendationsResponse Response of DiffControllerRecommendations
+type DiffControllerRecommendationsResponse struct {
+	Status               *status.Status                             `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	From                 *datahub_v1alpha1.ControllerRecommendation `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *datahub_v1alpha1.ControllerRecommendation `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	FieldDiffs           []*FieldDiff                               `protobuf:"bytes,4,rep,name=field_diffs,json=fieldDiffs,proto3" json:"field_diffs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *DiffControllerRecommendationsResponse) Reset()         { *m = DiffControllerRecommendationsResponse{} }
+func (m *DiffControllerRecommendationsResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffControllerRecommendationsResponse) ProtoMessage()    {}
+
+func (m *DiffControllerRecommendationsResponse) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DiffControllerRecommendationsResponse) GetFrom() *datahub_v1alpha1.ControllerRecommendation {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *DiffControllerRecommendationsResponse) GetTo() *datahub_v1alpha1.ControllerRecommendation {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *DiffControllerRecommendationsResponse) GetFieldDiffs() []*FieldDiff {
+	if m != nil {
+		return m.FieldDiffs
+	}
+	return nil
+}
+
+// FieldDiff Changed field of controller recommendation between two generations
+type FieldDiff struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	FromValue            string   `protobuf:"bytes,2,opt,name=from_value,json=fromValue,proto3" json:"from_value,omitempty"`
+	ToValue              string   `protobuf:"bytes,3,opt,name=to_value,json=toValue,proto3" json:"to_value,omitempty"`
+	Delta                float64  `protobuf:"fixed64,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldDiff) Reset()         { *m = FieldDiff{} }
+func (m *FieldDiff) String() string { return proto.CompactTextString(m) }
+func (*FieldDiff) ProtoMessage()    {}
+
+func (m *FieldDiff) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *FieldDiff) GetFromValue() string {
+	if m != nil {
+		return m.FromValue
+	}
+	return ""
+}
+
+func (m *FieldDiff) GetToValue() string {
+	if m != nil {
+		return m.ToValue
+	}
+	return ""
+}
+
+func (m *FieldDiff) GetDelta() float64 {
+	if m != nil {
+		return m.Delta
+	}
+	return 0
+}
+
+// RollbackPodRecommendationsRequest Request of RollbackPodRecommendations,
+// GenerationTime is the start time of the generation to restore.
+type RollbackPodRecommendationsRequest struct {
+	NamespacedName       *datahub_v1alpha1.NamespacedName `protobuf:"bytes,1,opt,name=namespaced_name,json=namespacedName,proto3" json:"namespaced_name,omitempty"`
+	Granularity          int64                            `protobuf:"varint,2,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	GenerationTime       *timestamp.Timestamp             `protobuf:"bytes,3,opt,name=generation_time,json=generationTime,proto3" json:"generation_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *RollbackPodRecommendationsRequest) Reset()         { *m = RollbackPodRecommendationsRequest{} }
+func (m *RollbackPodRecommendationsRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackPodRecommendationsRequest) ProtoMessage()    {}
+
+func (m *RollbackPodRecommendationsRequest) GetNamespacedName() *datahub_v1alpha1.NamespacedName {
+	if m != nil {
+		return m.NamespacedName
+	}
+	return nil
+}
+
+func (m *RollbackPodRecommendationsRequest) GetGranularity() int64 {
+	if m != nil {
+		return m.Granularity
+	}
+	return 0
+}
+
+func (m *RollbackPodRecommendationsRequest) GetGenerationTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.GenerationTime
+	}
+	return nil
+}
+
+// RollbackPodRecommendationsResponse Response of RollbackPodRecommendations,
+// PodRecommendation is the re-published generation.
+type RollbackPodRecommendationsResponse struct {
+	Status               *status.Status                      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PodRecommendation    *datahub_v1alpha1.PodRecommendation `protobuf:"bytes,2,opt,name=pod_recommendation,json=podRecommendation,proto3" json:"pod_recommendation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *RollbackPodRecommendationsResponse) Reset()         { *m = RollbackPodRecommendationsResponse{} }
+func (m *RollbackPodRecommendationsResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackPodRecommendationsResponse) ProtoMessage()    {}
+
+func (m *RollbackPodRecommendationsResponse) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *RollbackPodRecommendationsResponse) GetPodRecommendation() *datahub_v1alpha1.PodRecommendation {
+	if m != nil {
+		return m.PodRecommendation
+	}
+	return nil
+}
+
+// RollbackControllerRecommendationsRequest Request of RollbackControllerRecommendations,
+// GenerationTime is the time of the generation to restore.
+type RollbackControllerRecommendationsRequest struct {
+	NamespacedName       *datahub_v1alpha1.NamespacedName           `protobuf:"bytes,1,opt,name=namespaced_name,json=namespacedName,proto3" json:"namespaced_name,omitempty"`
+	RecommendedType      datahub_v1alpha1.ControllerRecommendedType `protobuf:"varint,2,opt,name=recommended_type,json=recommendedType,proto3,enum=containers_ai.alameda.v1alpha1.datahub.ControllerRecommendedType" json:"recommended_type,omitempty"`
+	GenerationTime       *timestamp.Timestamp                       `protobuf:"bytes,3,opt,name=generation_time,json=generationTime,proto3" json:"generation_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *RollbackControllerRecommendationsRequest) Reset() {
+	*m = RollbackControllerRecommendationsRequest{}
+}
+func (m *RollbackControllerRecommendationsRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackControllerRecommendationsRequest) ProtoMessage()    {}
+
+func (m *RollbackControllerRecommendationsRequest) GetNamespacedName() *datahub_v1alpha1.NamespacedName {
+	if m != nil {
+		return m.NamespacedName
+	}
+	return nil
+}
+
+func (m *RollbackControllerRecommendationsRequest) GetRecommendedType() datahub_v1alpha1.ControllerRecommendedType {
+	if m != nil {
+		return m.RecommendedType
+	}
+	return datahub_v1alpha1.ControllerRecommendedType_CRT_Undefined
+}
+
+func (m *RollbackControllerRecommendationsRequest) GetGenerationTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.GenerationTime
+	}
+	return nil
+}
+
+// RollbackControllerRecommendationsResponse Response of RollbackControllerRecommendations,
+// ControllerRecommendation is the re-published generation.
+type RollbackControllerRecommendationsResponse struct {
+	Status                   *status.Status                             `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ControllerRecommendation *datahub_v1alpha1.ControllerRecommendation `protobuf:"bytes,2,opt,name=controller_recommendation,json=controllerRecommendation,proto3" json:"controller_recommendation,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}                                   `json:"-"`
+	XXX_unrecognized         []byte                                     `json:"-"`
+	XXX_sizecache            int32                                      `json:"-"`
+}
+
+func (m *RollbackControllerRecommendationsResponse) Reset() {
+	*m = RollbackControllerRecommendationsResponse{}
+}
+func (m *RollbackControllerRecommendationsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RollbackControllerRecommendationsResponse) ProtoMessage() {}
+
+func (m *RollbackControllerRecommendationsResponse) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *RollbackControllerRecommendationsResponse) GetControllerRecommendation() *datahub_v1alpha1.ControllerRecommendation {
+	if m != nil {
+		return m.ControllerRecommendation
+	}
+	return nil
+}
+
+// GetLatestControllerRecommendationRequest Request of GetLatestControllerRecommendation.
+// If Time is not set, the latest generation until now is returned.
+type GetLatestControllerRecommendationRequest struct {
+	NamespacedName       *datahub_v1alpha1.NamespacedName           `protobuf:"bytes,1,opt,name=namespaced_name,json=namespacedName,proto3" json:"namespaced_name,omitempty"`
+	Kind                 datahub_v1alpha1.Kind                      `protobuf:"varint,2,opt,name=kind,proto3,enum=containers_ai.alameda.v1alpha1.datahub.Kind" json:"kind,omitempty"`
+	RecommendedType      datahub_v1alpha1.ControllerRecommendedType `protobuf:"varint,3,opt,name=recommended_type,json=recommendedType,proto3,enum=containers_ai.alameda.v1alpha1.datahub.ControllerRecommendedType" json:"recommended_type,omitempty"`
+	Time                 *timestamp.Timestamp                       `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *GetLatestControllerRecommendationRequest) Reset() {
+	*m = GetLatestControllerRecommendationRequest{}
+}
+func (m *GetLatestControllerRecommendationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLatestControllerRecommendationRequest) ProtoMessage()    {}
+
+func (m *GetLatestControllerRecommendationRequest) GetNamespacedName() *datahub_v1alpha1.NamespacedName {
+	if m != nil {
+		return m.NamespacedName
+	}
+	return nil
+}
+
+func (m *GetLatestControllerRecommendationRequest) GetKind() datahub_v1alpha1.Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return datahub_v1alpha1.Kind_POD
+}
+
+func (m *GetLatestControllerRecommendationRequest) GetRecommendedType() datahub_v1alpha1.ControllerRecommendedType {
+	if m != nil {
+		return m.RecommendedType
+	}
+	return datahub_v1alpha1.ControllerRecommendedType_CRT_Undefined
+}
+
+func (m *GetLatestControllerRecommendationRequest) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// GetLatestControllerRecommendationResponse Response of GetLatestControllerRecommendation,
+// ControllerRecommendation is nil if the controller has no recommendation of the kind.
+type GetLatestControllerRecommendationResponse struct {
+	Status                   *status.Status                             `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ControllerRecommendation *datahub_v1alpha1.ControllerRecommendation `protobuf:"bytes,2,opt,name=controller_recommendation,json=controllerRecommendation,proto3" json:"controller_recommendation,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}                                   `json:"-"`
+	XXX_unrecognized         []byte                                     `json:"-"`
+	XXX_sizecache            int32                                      `json:"-"`
+}
+
+func (m *GetLatestControllerRecommendationResponse) Reset() {
+	*m = GetLatestControllerRecommendationResponse{}
+}
+func (m *GetLatestControllerRecommendationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GetLatestControllerRecommendationResponse) ProtoMessage() {}
+
+func (m *GetLatestControllerRecommendationResponse) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetLatestControllerRecommendationResponse) GetControllerRecommendation() *datahub_v1alpha1.ControllerRecommendation {
+	if m != nil {
+		return m.ControllerRecommendation
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package kind
+
+import (
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+)
+
+// Kinds of controllers which are not defined in containers-ai/api yet. The values are reserved for
+// them in the Kind enum of containers-ai/api, use Name and Value instead of the generated enum maps
+// and Kind.String() to convert kinds from and to the names they are stored by.
+const (
+	// Kind_DAEMONSET DaemonSet of apps/v1
+	Kind_DAEMONSET datahub_v1alpha1.Kind = 101
+	// Kind_REPLICASET ReplicaSet of apps/v1 which is not controlled by a Deployment
+	Kind_REPLICASET datahub_v1alpha1.Kind = 102
+	// Kind_JOB Job of batch/v1 which is not controlled by a CronJob
+	Kind_JOB datahub_v1alpha1.Kind = 103
+	// Kind_CRONJOB CronJob of batch/v1beta1
+	Kind_CRONJOB datahub_v1alpha1.Kind = 104
+)
+
+var (
+	extendedKindNames = map[datahub_v1alpha1.Kind]string{
+		Kind_DAEMONSET:  "DAEMONSET",
+		Kind_REPLICASET: "REPLICASET",
+		Kind_JOB:        "JOB",
+		Kind_CRONJOB:    "CRONJOB",
+	}
+	extendedKindValues = map[string]datahub_v1alpha1.Kind{
+		"DAEMONSET":  Kind_DAEMONSET,
+		"REPLICASET": Kind_REPLICASET,
+		"JOB":        Kind_JOB,
+		"CRONJOB":    Kind_CRONJOB,
+	}
+)
+
+// Name Return name of the kind
+func Name(kind datahub_v1alpha1.Kind) string {
+	if name, ok := datahub_v1alpha1.Kind_name[int32(kind)]; ok {
+		return name
+	}
+	if name, ok := extendedKindNames[kind]; ok {
+		return name
+	}
+	return kind.String()
+}
+
+// Value Return the kind of the name and whether the name is known
+func Value(name string) (datahub_v1alpha1.Kind, bool) {
+	if value, ok := datahub_v1alpha1.Kind_value[name]; ok {
+		return datahub_v1alpha1.Kind(value), true
+	}
+	kind, ok := extendedKindValues[name]
+	return kind, ok
+}
@@ -0,0 +1,38 @@
+package kind
+
+import (
+	"testing"
+
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+)
+
+func TestNameAndValue(t *testing.T) {
+	tests := []struct {
+		kind datahub_v1alpha1.Kind
+		name string
+	}{
+		{kind: datahub_v1alpha1.Kind_DEPLOYMENT, name: "DEPLOYMENT"},
+		{kind: datahub_v1alpha1.Kind_STATEFULSET, name: "STATEFULSET"},
+		{kind: Kind_DAEMONSET, name: "DAEMONSET"},
+		{kind: Kind_REPLICASET, name: "REPLICASET"},
+		{kind: Kind_JOB, name: "JOB"},
+		{kind: Kind_CRONJOB, name: "CRONJOB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Name(tt.kind); got != tt.name {
+				t.Errorf("Name() = %s, want %s", got, tt.name)
+			}
+			if got, ok := Value(tt.name); !ok || got != tt.kind {
+				t.Errorf("Value() = (%v, %t), want (%v, true)", got, ok, tt.kind)
+			}
+		})
+	}
+
+	if _, ok := Value("UNKNOWN"); ok {
+		t.Error("expect unknown name not found")
+	}
+	if _, ok := datahub_v1alpha1.Kind_value["DAEMONSET"]; ok {
+		t.Error("expect generated enum maps not modified")
+	}
+}
@@ -0,0 +1,29 @@
+package rawdata
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// DatahubRawdataServiceClient is the client API for DatahubRawdataService service.
+type DatahubRawdataServiceClient interface {
+	QueryRawdata(ctx context.Context, in *QueryRawdataRequest, opts ...grpc.CallOption) (*QueryRawdataResponse, error)
+}
+
+type datahubRawdataServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewDatahubRawdataServiceClient Constructor of DatahubRawdataService client
+func NewDatahubRawdataServiceClient(cc *grpc.ClientConn) DatahubRawdataServiceClient {
+	return &datahubRawdataServiceClient{cc}
+}
+
+func (c *datahubRawdataServiceClient) QueryRawdata(ctx context.Context, in *QueryRawdataRequest, opts ...grpc.CallOption) (*QueryRawdataResponse, error) {
+	out := new(QueryRawdataResponse)
+	err := c.cc.Invoke(ctx, "/"+ServiceName+"/QueryRawdata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
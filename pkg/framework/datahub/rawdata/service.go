@@ -0,0 +1,52 @@
+package rawdata
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+const (
+	// ServiceName Full name of the gRPC service serving typed queries of raw data of datahub
+	ServiceName = "containers_ai.alameda.v1alpha1.datahub.DatahubRawdataService"
+)
+
+// DatahubRawdataServiceServer is the server API for DatahubRawdataService service.
+type DatahubRawdataServiceServer interface {
+	// QueryRawdata queries a whitelisted measurement with conditions bound as parameters
+	QueryRawdata(context.Context, *QueryRawdataRequest) (*QueryRawdataResponse, error)
+}
+
+// RegisterDatahubRawdataServiceServer Register DatahubRawdataService implementation to the gRPC server
+func RegisterDatahubRawdataServiceServer(s *grpc.Server, srv DatahubRawdataServiceServer) {
+	s.RegisterService(&_DatahubRawdataService_serviceDesc, srv)
+}
+
+func _DatahubRawdataService_QueryRawdata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRawdataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatahubRawdataServiceServer).QueryRawdata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/QueryRawdata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatahubRawdataServiceServer).QueryRawdata(ctx, req.(*QueryRawdataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DatahubRawdataService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*DatahubRawdataServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryRawdata",
+			Handler:    _DatahubRawdataService_QueryRawdata_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
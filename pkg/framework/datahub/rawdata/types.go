@@ -0,0 +1,170 @@
+package rawdata
+
+import (
+	Common "github.com/containers-ai/api/common"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/genproto/googleapis/rpc/status"
+)
+
+// Messages of DatahubRawdataService. They are maintained by hand
+// in the same shape protoc-gen-go produces, so the default gRPC codec marshals
+// them with golang/protobuf's reflection based marshaler.
+
+// Operators supported by conditions of QueryRawdataRequest
+var Operators = []string{"=", "!=", "<", "<=", ">", ">="}
+
+// Condition Compare the tag or field Key with Value. Value is converted
+// to DataType and bound as parameter, it is never spliced into the query.
+type Condition struct {
+	Key                  string          `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator             string          `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Value                string          `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	DataType             Common.DataType `protobuf:"varint,4,opt,name=data_type,json=dataType,proto3,enum=containersai.common.DataType" json:"data_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Condition) Reset()         { *m = Condition{} }
+func (m *Condition) String() string { return proto.CompactTextString(m) }
+func (*Condition) ProtoMessage()    {}
+
+func (m *Condition) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Condition) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *Condition) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Condition) GetDataType() Common.DataType {
+	if m != nil {
+		return m.DataType
+	}
+	return Common.DataType_DATATYPE_UNDEFINED
+}
+
+// QueryRawdataRequest Request of QueryRawdata. Conditions are joined by AND,
+// all fields of the measurement are selected if Selects is empty.
+type QueryRawdataRequest struct {
+	Database             string               `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Measurement          string               `protobuf:"bytes,2,opt,name=measurement,proto3" json:"measurement,omitempty"`
+	Selects              []string             `protobuf:"bytes,3,rep,name=selects,proto3" json:"selects,omitempty"`
+	Conditions           []*Condition         `protobuf:"bytes,4,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Groups               []string             `protobuf:"bytes,5,rep,name=groups,proto3" json:"groups,omitempty"`
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Descending           bool                 `protobuf:"varint,8,opt,name=descending,proto3" json:"descending,omitempty"`
+	Limit                uint64               `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *QueryRawdataRequest) Reset()         { *m = QueryRawdataRequest{} }
+func (m *QueryRawdataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRawdataRequest) ProtoMessage()    {}
+
+func (m *QueryRawdataRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *QueryRawdataRequest) GetMeasurement() string {
+	if m != nil {
+		return m.Measurement
+	}
+	return ""
+}
+
+func (m *QueryRawdataRequest) GetSelects() []string {
+	if m != nil {
+		return m.Selects
+	}
+	return nil
+}
+
+func (m *QueryRawdataRequest) GetConditions() []*Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *QueryRawdataRequest) GetGroups() []string {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *QueryRawdataRequest) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *QueryRawdataRequest) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *QueryRawdataRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+func (m *QueryRawdataRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QueryRawdataResponse Response of QueryRawdata
+type QueryRawdataResponse struct {
+	Status               *status.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Rawdata              *Common.ReadRawdata `protobuf:"bytes,2,opt,name=rawdata,proto3" json:"rawdata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *QueryRawdataResponse) Reset()         { *m = QueryRawdataResponse{} }
+func (m *QueryRawdataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRawdataResponse) ProtoMessage()    {}
+
+func (m *QueryRawdataResponse) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *QueryRawdataResponse) GetRawdata() *Common.ReadRawdata {
+	if m != nil {
+		return m.Rawdata
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package stream
+
+import (
+	"io"
+
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// DatahubStreamServiceClient is the client API for DatahubStreamService service.
+type DatahubStreamServiceClient interface {
+	StreamPodMetrics(ctx context.Context, in *datahub_v1alpha1.ListPodMetricsRequest, opts ...grpc.CallOption) (DatahubStreamService_StreamPodMetricsClient, error)
+	StreamPodPredictions(ctx context.Context, in *datahub_v1alpha1.ListPodPredictionsRequest, opts ...grpc.CallOption) (DatahubStreamService_StreamPodPredictionsClient, error)
+	StreamNodePredictions(ctx context.Context, in *datahub_v1alpha1.ListNodePredictionsRequest, opts ...grpc.CallOption) (DatahubStreamService_StreamNodePredictionsClient, error)
+	StreamAvailablePodRecommendations(ctx context.Context, in *datahub_v1alpha1.ListPodRecommendationsRequest, opts ...grpc.CallOption) (DatahubStreamService_StreamAvailablePodRecommendationsClient, error)
+	ListPodMetricsPage(ctx context.Context, in *ListPodMetricsPageRequest, opts ...grpc.CallOption) (*ListPodMetricsPageResponse, error)
+	ListPodPredictionsPage(ctx context.Context, in *ListPodPredictionsPageRequest, opts ...grpc.CallOption) (*ListPodPredictionsPageResponse, error)
+	ListNodePredictionsPage(ctx context.Context, in *ListNodePredictionsPageRequest, opts ...grpc.CallOption) (*ListNodePredictionsPageResponse, error)
+}
+
+type datahubStreamServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewDatahubStreamServiceClient Constructor of DatahubStreamService client
+func NewDatahubStreamServiceClient(cc *grpc.ClientConn) DatahubStreamServiceClient {
+	return &datahubStreamServiceClient{cc}
+}
+
+func (c *datahubStreamServiceClient) newServerStream(ctx context.Context, index int, in proto.Message, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	desc := &_DatahubStreamService_serviceDesc.Streams[index]
+	stream, err := c.cc.NewStream(ctx, desc, "/"+ServiceName+"/"+desc.StreamName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (c *datahubStreamServiceClient) StreamPodMetrics(ctx context.Context, in *datahub_v1alpha1.ListPodMetricsRequest, opts ...grpc.CallOption) (DatahubStreamService_StreamPodMetricsClient, error) {
+	stream, err := c.newServerStream(ctx, 0, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &datahubStreamServiceStreamPodMetricsClient{stream}, nil
+}
+
+type DatahubStreamService_StreamPodMetricsClient interface {
+	Recv() (*datahub_v1alpha1.PodMetric, error)
+	grpc.ClientStream
+}
+
+type datahubStreamServiceStreamPodMetricsClient struct {
+	grpc.ClientStream
+}
+
+func (x *datahubStreamServiceStreamPodMetricsClient) Recv() (*datahub_v1alpha1.PodMetric, error) {
+	m := new(datahub_v1alpha1.PodMetric)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *datahubStreamServiceClient) StreamPodPredictions(ctx context.Context, in *datahub_v1alpha1.ListPodPredictionsRequest, opts ...grpc.CallOption) (DatahubStreamService_StreamPodPredictionsClient, error) {
+	stream, err := c.newServerStream(ctx, 1, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &datahubStreamServiceStreamPodPredictionsClient{stream}, nil
+}
+
+type DatahubStreamService_StreamPodPredictionsClient interface {
+	Recv() (*datahub_v1alpha1.PodPrediction, error)
+	grpc.ClientStream
+}
+
+type datahubStreamServiceStreamPodPredictionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *datahubStreamServiceStreamPodPredictionsClient) Recv() (*datahub_v1alpha1.PodPrediction, error) {
+	m := new(datahub_v1alpha1.PodPrediction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *datahubStreamServiceClient) StreamNodePredictions(ctx context.Context, in *datahub_v1alpha1.ListNodePredictionsRequest, opts ...grpc.CallOption) (DatahubStreamService_StreamNodePredictionsClient, error) {
+	stream, err := c.newServerStream(ctx, 2, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &datahubStreamServiceStreamNodePredictionsClient{stream}, nil
+}
+
+type DatahubStreamService_StreamNodePredictionsClient interface {
+	Recv() (*datahub_v1alpha1.NodePrediction, error)
+	grpc.ClientStream
+}
+
+type datahubStreamServiceStreamNodePredictionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *datahubStreamServiceStreamNodePredictionsClient) Recv() (*datahub_v1alpha1.NodePrediction, error) {
+	m := new(datahub_v1alpha1.NodePrediction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *datahubStreamServiceClient) StreamAvailablePodRecommendations(ctx context.Context, in *datahub_v1alpha1.ListPodRecommendationsRequest, opts ...grpc.CallOption) (DatahubStreamService_StreamAvailablePodRecommendationsClient, error) {
+	stream, err := c.newServerStream(ctx, 3, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &datahubStreamServiceStreamAvailablePodRecommendationsClient{stream}, nil
+}
+
+type DatahubStreamService_StreamAvailablePodRecommendationsClient interface {
+	Recv() (*datahub_v1alpha1.PodRecommendation, error)
+	grpc.ClientStream
+}
+
+type datahubStreamServiceStreamAvailablePodRecommendationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *datahubStreamServiceStreamAvailablePodRecommendationsClient) Recv() (*datahub_v1alpha1.PodRecommendation, error) {
+	m := new(datahub_v1alpha1.PodRecommendation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *datahubStreamServiceClient) ListPodMetricsPage(ctx context.Context, in *ListPodMetricsPageRequest, opts ...grpc.CallOption) (*ListPodMetricsPageResponse, error) {
+	out := new(ListPodMetricsPageResponse)
+	err := c.cc.Invoke(ctx, "/"+ServiceName+"/ListPodMetricsPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datahubStreamServiceClient) ListPodPredictionsPage(ctx context.Context, in *ListPodPredictionsPageRequest, opts ...grpc.CallOption) (*ListPodPredictionsPageResponse, error) {
+	out := new(ListPodPredictionsPageResponse)
+	err := c.cc.Invoke(ctx, "/"+ServiceName+"/ListPodPredictionsPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datahubStreamServiceClient) ListNodePredictionsPage(ctx context.Context, in *ListNodePredictionsPageRequest, opts ...grpc.CallOption) (*ListNodePredictionsPageResponse, error) {
+	out := new(ListNodePredictionsPageResponse)
+	err := c.cc.Invoke(ctx, "/"+ServiceName+"/ListNodePredictionsPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// isEOF Return true if the error marks the normal end of a server stream
+func isEOF(err error) bool {
+	return err == io.EOF
+}
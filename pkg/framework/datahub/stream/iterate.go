@@ -0,0 +1,244 @@
+package stream
+
+import (
+	"github.com/containers-ai/alameda/pkg/framework/datahub"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Helpers iterating items of the streaming RPCs. When datahub does not serve
+// DatahubStreamService yet, they fall back to the paged or plain unary RPCs so
+// clients can be upgraded before datahub.
+
+const (
+	// DefaultPageSize Number of items requested per page when falling back to paged RPCs
+	DefaultPageSize = 100
+)
+
+func isUnimplemented(err error) bool {
+	return status.Code(err) == codes.Unimplemented
+}
+
+// ForEachPodMetric Call fn with metrics of each pod matching the request
+func ForEachPodMetric(ctx context.Context, conn *grpc.ClientConn, in *datahub_v1alpha1.ListPodMetricsRequest, fn func(*datahub_v1alpha1.PodMetric) error) error {
+
+	client := NewDatahubStreamServiceClient(conn)
+	stream, err := client.StreamPodMetrics(ctx, in)
+	if err == nil {
+		for {
+			podMetric, err := stream.Recv()
+			if isEOF(err) {
+				return nil
+			} else if isUnimplemented(err) {
+				break
+			} else if err != nil {
+				return errors.Wrap(err, "receive pod metric failed")
+			}
+			if err := fn(podMetric); err != nil {
+				return err
+			}
+		}
+	} else if !isUnimplemented(err) {
+		return errors.Wrap(err, "stream pod metrics failed")
+	}
+
+	pageReq := &ListPodMetricsPageRequest{Request: in, PageSize: DefaultPageSize}
+	for {
+		resp, err := client.ListPodMetricsPage(ctx, pageReq)
+		if isUnimplemented(err) {
+			return forEachPodMetricUnary(ctx, conn, in, fn)
+		} else if err != nil {
+			return errors.Wrap(err, "list pod metrics page failed")
+		} else if _, err := datahub.IsResponseStatusOK(resp.GetStatus()); err != nil {
+			return errors.Wrap(err, "list pod metrics page failed")
+		}
+		for _, podMetric := range resp.GetPodMetrics() {
+			if err := fn(podMetric); err != nil {
+				return err
+			}
+		}
+		if resp.GetNextPageToken() == "" {
+			return nil
+		}
+		pageReq.PageToken = resp.GetNextPageToken()
+	}
+}
+
+func forEachPodMetricUnary(ctx context.Context, conn *grpc.ClientConn, in *datahub_v1alpha1.ListPodMetricsRequest, fn func(*datahub_v1alpha1.PodMetric) error) error {
+
+	resp, err := datahub_v1alpha1.NewDatahubServiceClient(conn).ListPodMetrics(ctx, in)
+	if err != nil {
+		return errors.Wrap(err, "list pod metrics failed")
+	} else if _, err := datahub.IsResponseStatusOK(resp.GetStatus()); err != nil {
+		return errors.Wrap(err, "list pod metrics failed")
+	}
+	for _, podMetric := range resp.GetPodMetrics() {
+		if err := fn(podMetric); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ForEachPodPrediction Call fn with predictions of each pod matching the request
+func ForEachPodPrediction(ctx context.Context, conn *grpc.ClientConn, in *datahub_v1alpha1.ListPodPredictionsRequest, fn func(*datahub_v1alpha1.PodPrediction) error) error {
+
+	client := NewDatahubStreamServiceClient(conn)
+	stream, err := client.StreamPodPredictions(ctx, in)
+	if err == nil {
+		for {
+			podPrediction, err := stream.Recv()
+			if isEOF(err) {
+				return nil
+			} else if isUnimplemented(err) {
+				break
+			} else if err != nil {
+				return errors.Wrap(err, "receive pod prediction failed")
+			}
+			if err := fn(podPrediction); err != nil {
+				return err
+			}
+		}
+	} else if !isUnimplemented(err) {
+		return errors.Wrap(err, "stream pod predictions failed")
+	}
+
+	pageReq := &ListPodPredictionsPageRequest{Request: in, PageSize: DefaultPageSize}
+	for {
+		resp, err := client.ListPodPredictionsPage(ctx, pageReq)
+		if isUnimplemented(err) {
+			return forEachPodPredictionUnary(ctx, conn, in, fn)
+		} else if err != nil {
+			return errors.Wrap(err, "list pod predictions page failed")
+		} else if _, err := datahub.IsResponseStatusOK(resp.GetStatus()); err != nil {
+			return errors.Wrap(err, "list pod predictions page failed")
+		}
+		for _, podPrediction := range resp.GetPodPredictions() {
+			if err := fn(podPrediction); err != nil {
+				return err
+			}
+		}
+		if resp.GetNextPageToken() == "" {
+			return nil
+		}
+		pageReq.PageToken = resp.GetNextPageToken()
+	}
+}
+
+func forEachPodPredictionUnary(ctx context.Context, conn *grpc.ClientConn, in *datahub_v1alpha1.ListPodPredictionsRequest, fn func(*datahub_v1alpha1.PodPrediction) error) error {
+
+	resp, err := datahub_v1alpha1.NewDatahubServiceClient(conn).ListPodPredictions(ctx, in)
+	if err != nil {
+		return errors.Wrap(err, "list pod predictions failed")
+	} else if _, err := datahub.IsResponseStatusOK(resp.GetStatus()); err != nil {
+		return errors.Wrap(err, "list pod predictions failed")
+	}
+	for _, podPrediction := range resp.GetPodPredictions() {
+		if err := fn(podPrediction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ForEachNodePrediction Call fn with predictions of each node matching the request
+func ForEachNodePrediction(ctx context.Context, conn *grpc.ClientConn, in *datahub_v1alpha1.ListNodePredictionsRequest, fn func(*datahub_v1alpha1.NodePrediction) error) error {
+
+	client := NewDatahubStreamServiceClient(conn)
+	stream, err := client.StreamNodePredictions(ctx, in)
+	if err == nil {
+		for {
+			nodePrediction, err := stream.Recv()
+			if isEOF(err) {
+				return nil
+			} else if isUnimplemented(err) {
+				break
+			} else if err != nil {
+				return errors.Wrap(err, "receive node prediction failed")
+			}
+			if err := fn(nodePrediction); err != nil {
+				return err
+			}
+		}
+	} else if !isUnimplemented(err) {
+		return errors.Wrap(err, "stream node predictions failed")
+	}
+
+	pageReq := &ListNodePredictionsPageRequest{Request: in, PageSize: DefaultPageSize}
+	for {
+		resp, err := client.ListNodePredictionsPage(ctx, pageReq)
+		if isUnimplemented(err) {
+			return forEachNodePredictionUnary(ctx, conn, in, fn)
+		} else if err != nil {
+			return errors.Wrap(err, "list node predictions page failed")
+		} else if _, err := datahub.IsResponseStatusOK(resp.GetStatus()); err != nil {
+			return errors.Wrap(err, "list node predictions page failed")
+		}
+		for _, nodePrediction := range resp.GetNodePredictions() {
+			if err := fn(nodePrediction); err != nil {
+				return err
+			}
+		}
+		if resp.GetNextPageToken() == "" {
+			return nil
+		}
+		pageReq.PageToken = resp.GetNextPageToken()
+	}
+}
+
+func forEachNodePredictionUnary(ctx context.Context, conn *grpc.ClientConn, in *datahub_v1alpha1.ListNodePredictionsRequest, fn func(*datahub_v1alpha1.NodePrediction) error) error {
+
+	resp, err := datahub_v1alpha1.NewDatahubServiceClient(conn).ListNodePredictions(ctx, in)
+	if err != nil {
+		return errors.Wrap(err, "list node predictions failed")
+	} else if _, err := datahub.IsResponseStatusOK(resp.GetStatus()); err != nil {
+		return errors.Wrap(err, "list node predictions failed")
+	}
+	for _, nodePrediction := range resp.GetNodePredictions() {
+		if err := fn(nodePrediction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ForEachAvailablePodRecommendation Call fn with each applicable pod recommendation matching the request
+func ForEachAvailablePodRecommendation(ctx context.Context, conn *grpc.ClientConn, in *datahub_v1alpha1.ListPodRecommendationsRequest, fn func(*datahub_v1alpha1.PodRecommendation) error) error {
+
+	client := NewDatahubStreamServiceClient(conn)
+	stream, err := client.StreamAvailablePodRecommendations(ctx, in)
+	if err == nil {
+		for {
+			podRecommendation, err := stream.Recv()
+			if isEOF(err) {
+				return nil
+			} else if isUnimplemented(err) {
+				break
+			} else if err != nil {
+				return errors.Wrap(err, "receive pod recommendation failed")
+			}
+			if err := fn(podRecommendation); err != nil {
+				return err
+			}
+		}
+	} else if !isUnimplemented(err) {
+		return errors.Wrap(err, "stream available pod recommendations failed")
+	}
+
+	resp, err := datahub_v1alpha1.NewDatahubServiceClient(conn).ListAvailablePodRecommendations(ctx, in)
+	if err != nil {
+		return errors.Wrap(err, "list available pod recommendations failed")
+	} else if _, err := datahub.IsResponseStatusOK(resp.GetStatus()); err != nil {
+		return errors.Wrap(err, "list available pod recommendations failed")
+	}
+	for _, podRecommendation := range resp.GetPodRecommendations() {
+		if err := fn(podRecommendation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package stream
+
+import (
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	GrpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type fakeServer struct {
+	nodeNames         []string
+	streamUnsupported bool
+}
+
+func (f *fakeServer) StreamPodMetrics(in *datahub_v1alpha1.ListPodMetricsRequest, stream DatahubStreamService_StreamPodMetricsServer) error {
+	return GrpcStatus.Error(codes.Unimplemented, "")
+}
+
+func (f *fakeServer) StreamPodPredictions(in *datahub_v1alpha1.ListPodPredictionsRequest, stream DatahubStreamService_StreamPodPredictionsServer) error {
+	return GrpcStatus.Error(codes.Unimplemented, "")
+}
+
+func (f *fakeServer) StreamNodePredictions(in *datahub_v1alpha1.ListNodePredictionsRequest, stream DatahubStreamService_StreamNodePredictionsServer) error {
+	if f.streamUnsupported {
+		return GrpcStatus.Error(codes.Unimplemented, "")
+	}
+	for _, nodeName := range f.nodeNames {
+		if err := stream.Send(&datahub_v1alpha1.NodePrediction{Name: nodeName}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeServer) StreamAvailablePodRecommendations(in *datahub_v1alpha1.ListPodRecommendationsRequest, stream DatahubStreamService_StreamAvailablePodRecommendationsServer) error {
+	return GrpcStatus.Error(codes.Unimplemented, "")
+}
+
+func (f *fakeServer) ListPodMetricsPage(ctx context.Context, in *ListPodMetricsPageRequest) (*ListPodMetricsPageResponse, error) {
+	return nil, GrpcStatus.Error(codes.Unimplemented, "")
+}
+
+func (f *fakeServer) ListPodPredictionsPage(ctx context.Context, in *ListPodPredictionsPageRequest) (*ListPodPredictionsPageResponse, error) {
+	return nil, GrpcStatus.Error(codes.Unimplemented, "")
+}
+
+func (f *fakeServer) ListNodePredictionsPage(ctx context.Context, in *ListNodePredictionsPageRequest) (*ListNodePredictionsPageResponse, error) {
+	start := 0
+	if in.GetPageToken() != "" {
+		start, _ = strconv.Atoi(in.GetPageToken())
+	}
+	end := start + int(in.GetPageSize())
+	nextPageToken := strconv.Itoa(end)
+	if end >= len(f.nodeNames) {
+		end = len(f.nodeNames)
+		nextPageToken = ""
+	}
+
+	resp := &ListNodePredictionsPageResponse{
+		Status:        &status.Status{Code: int32(code.Code_OK)},
+		NextPageToken: nextPageToken,
+	}
+	for _, nodeName := range f.nodeNames[start:end] {
+		resp.NodePredictions = append(resp.NodePredictions, &datahub_v1alpha1.NodePrediction{Name: nodeName})
+	}
+	return resp, nil
+}
+
+func dialFakeServer(t *testing.T, srv DatahubStreamServiceServer) (*grpc.ClientConn, func()) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	RegisterDatahubStreamServiceServer(server, srv)
+	go server.Serve(listener)
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn, func() {
+		conn.Close()
+		server.Stop()
+	}
+}
+
+func TestForEachNodePrediction(t *testing.T) {
+	nodeNames := make([]string, DefaultPageSize+5)
+	for i := range nodeNames {
+		nodeNames[i] = "node" + strconv.Itoa(i)
+	}
+
+	for _, streamUnsupported := range []bool{false, true} {
+		conn, closeFn := dialFakeServer(t, &fakeServer{nodeNames: nodeNames, streamUnsupported: streamUnsupported})
+
+		received := make([]string, 0)
+		err := ForEachNodePrediction(context.Background(), conn, &datahub_v1alpha1.ListNodePredictionsRequest{},
+			func(nodePrediction *datahub_v1alpha1.NodePrediction) error {
+				received = append(received, nodePrediction.GetName())
+				return nil
+			})
+		closeFn()
+
+		if err != nil {
+			t.Fatalf("streamUnsupported %t: %v", streamUnsupported, err)
+		}
+		if len(received) != len(nodeNames) {
+			t.Fatalf("streamUnsupported %t: expect %d node predictions, got %d", streamUnsupported, len(nodeNames), len(received))
+		}
+		for i := range nodeNames {
+			if received[i] != nodeNames[i] {
+				t.Errorf("streamUnsupported %t: expect %s at %d, got %s", streamUnsupported, nodeNames[i], i, received[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package stream
+
+import (
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+const (
+	// ServiceName Full name of the gRPC service serving streaming and paged variants of datahub List APIs
+	ServiceName = "containers_ai.alameda.v1alpha1.datahub.DatahubStreamService"
+)
+
+// DatahubStreamServiceServer is the server API for DatahubStreamService service.
+type DatahubStreamServiceServer interface {
+	// StreamPodMetrics sends metrics of one pod per message
+	StreamPodMetrics(*datahub_v1alpha1.ListPodMetricsRequest, DatahubStreamService_StreamPodMetricsServer) error
+	// StreamPodPredictions sends predictions of one pod per message
+	StreamPodPredictions(*datahub_v1alpha1.ListPodPredictionsRequest, DatahubStreamService_StreamPodPredictionsServer) error
+	// StreamNodePredictions sends predictions of one node per message
+	StreamNodePredictions(*datahub_v1alpha1.ListNodePredictionsRequest, DatahubStreamService_StreamNodePredictionsServer) error
+	// StreamAvailablePodRecommendations sends one applicable pod recommendation per message
+	StreamAvailablePodRecommendations(*datahub_v1alpha1.ListPodRecommendationsRequest, DatahubStreamService_StreamAvailablePodRecommendationsServer) error
+	// ListPodMetricsPage lists pods' metrics one page at a time for unary clients
+	ListPodMetricsPage(context.Context, *ListPodMetricsPageRequest) (*ListPodMetricsPageResponse, error)
+	// ListPodPredictionsPage lists pods' predictions one page at a time for unary clients
+	ListPodPredictionsPage(context.Context, *ListPodPredictionsPageRequest) (*ListPodPredictionsPageResponse, error)
+	// ListNodePredictionsPage lists nodes' predictions one page at a time for unary clients
+	ListNodePredictionsPage(context.Context, *ListNodePredictionsPageRequest) (*ListNodePredictionsPageResponse, error)
+}
+
+// RegisterDatahubStreamServiceServer Register DatahubStreamService implementation to the gRPC server
+func RegisterDatahubStreamServiceServer(s *grpc.Server, srv DatahubStreamServiceServer) {
+	s.RegisterService(&_DatahubStreamService_serviceDesc, srv)
+}
+
+type DatahubStreamService_StreamPodMetricsServer interface {
+	Send(*datahub_v1alpha1.PodMetric) error
+	grpc.ServerStream
+}
+
+type datahubStreamServiceStreamPodMetricsServer struct {
+	grpc.ServerStream
+}
+
+func (x *datahubStreamServiceStreamPodMetricsServer) Send(m *datahub_v1alpha1.PodMetric) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DatahubStreamService_StreamPodMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(datahub_v1alpha1.ListPodMetricsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DatahubStreamServiceServer).StreamPodMetrics(m, &datahubStreamServiceStreamPodMetricsServer{stream})
+}
+
+type DatahubStreamService_StreamPodPredictionsServer interface {
+	Send(*datahub_v1alpha1.PodPrediction) error
+	grpc.ServerStream
+}
+
+type datahubStreamServiceStreamPodPredictionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *datahubStreamServiceStreamPodPredictionsServer) Send(m *datahub_v1alpha1.PodPrediction) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DatahubStreamService_StreamPodPredictions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(datahub_v1alpha1.ListPodPredictionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DatahubStreamServiceServer).StreamPodPredictions(m, &datahubStreamServiceStreamPodPredictionsServer{stream})
+}
+
+type DatahubStreamService_StreamNodePredictionsServer interface {
+	Send(*datahub_v1alpha1.NodePrediction) error
+	grpc.ServerStream
+}
+
+type datahubStreamServiceStreamNodePredictionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *datahubStreamServiceStreamNodePredictionsServer) Send(m *datahub_v1alpha1.NodePrediction) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DatahubStreamService_StreamNodePredictions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(datahub_v1alpha1.ListNodePredictionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DatahubStreamServiceServer).StreamNodePredictions(m, &datahubStreamServiceStreamNodePredictionsServer{stream})
+}
+
+type DatahubStreamService_StreamAvailablePodRecommendationsServer interface {
+	Send(*datahub_v1alpha1.PodRecommendation) error
+	grpc.ServerStream
+}
+
+type datahubStreamServiceStreamAvailablePodRecommendationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *datahubStreamServiceStreamAvailablePodRecommendationsServer) Send(m *datahub_v1alpha1.PodRecommendation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DatahubStreamService_StreamAvailablePodRecommendations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(datahub_v1alpha1.ListPodRecommendationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DatahubStreamServiceServer).StreamAvailablePodRecommendations(m, &datahubStreamServiceStreamAvailablePodRecommendationsServer{stream})
+}
+
+func _DatahubStreamService_ListPodMetricsPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPodMetricsPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatahubStreamServiceServer).ListPodMetricsPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/ListPodMetricsPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatahubStreamServiceServer).ListPodMetricsPage(ctx, req.(*ListPodMetricsPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatahubStreamService_ListPodPredictionsPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPodPredictionsPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatahubStreamServiceServer).ListPodPredictionsPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/ListPodPredictionsPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatahubStreamServiceServer).ListPodPredictionsPage(ctx, req.(*ListPodPredictionsPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatahubStreamService_ListNodePredictionsPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodePredictionsPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatahubStreamServiceServer).ListNodePredictionsPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + ServiceName + "/ListNodePredictionsPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatahubStreamServiceServer).ListNodePredictionsPage(ctx, req.(*ListNodePredictionsPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DatahubStreamService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*DatahubStreamServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPodMetricsPage",
+			Handler:    _DatahubStreamService_ListPodMetricsPage_Handler,
+		},
+		{
+			MethodName: "ListPodPredictionsPage",
+			Handler:    _DatahubStreamService_ListPodPredictionsPage_Handler,
+		},
+		{
+			MethodName: "ListNodePredictionsPage",
+			Handler:    _DatahubStreamService_ListNodePredictionsPage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPodMetrics",
+			Handler:       _DatahubStreamService_StreamPodMetrics_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamPodPredictions",
+			Handler:       _DatahubStreamService_StreamPodPredictions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamNodePredictions",
+			Handler:       _DatahubStreamService_StreamNodePredictions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAvailablePodRecommendations",
+			Handler:       _DatahubStreamService_StreamAvailablePodRecommendations_Handler,
+			ServerStreams: true,
+		},
+	},
+}
@@ -0,0 +1,221 @@
+package stream
+
+import (
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/status"
+)
+
+// Messages of DatahubStreamService. They are maintained by hand in the same
+// shape protoc-gen-go produces, so the default gRPC codec marshals them with
+// golang/protobuf's reflection based marshaler.
+
+// ListPodMetricsPageRequest Request of ListPodMetricsPage
+type ListPodMetricsPageRequest struct {
+	Request              *datahub_v1alpha1.ListPodMetricsRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	PageSize             int32                                   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                                  `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *ListPodMetricsPageRequest) Reset()         { *m = ListPodMetricsPageRequest{} }
+func (m *ListPodMetricsPageRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPodMetricsPageRequest) ProtoMessage()    {}
+
+func (m *ListPodMetricsPageRequest) GetRequest() *datahub_v1alpha1.ListPodMetricsRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *ListPodMetricsPageRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListPodMetricsPageRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// ListPodMetricsPageResponse Response of ListPodMetricsPage
+type ListPodMetricsPageResponse struct {
+	Status               *status.Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PodMetrics           []*datahub_v1alpha1.PodMetric `protobuf:"bytes,2,rep,name=pod_metrics,json=podMetrics,proto3" json:"pod_metrics,omitempty"`
+	NextPageToken        string                        `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ListPodMetricsPageResponse) Reset()         { *m = ListPodMetricsPageResponse{} }
+func (m *ListPodMetricsPageResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPodMetricsPageResponse) ProtoMessage()    {}
+
+func (m *ListPodMetricsPageResponse) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListPodMetricsPageResponse) GetPodMetrics() []*datahub_v1alpha1.PodMetric {
+	if m != nil {
+		return m.PodMetrics
+	}
+	return nil
+}
+
+func (m *ListPodMetricsPageResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// ListPodPredictionsPageRequest Request of ListPodPredictionsPage
+type ListPodPredictionsPageRequest struct {
+	Request              *datahub_v1alpha1.ListPodPredictionsRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	PageSize             int32                                       `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                                      `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *ListPodPredictionsPageRequest) Reset()         { *m = ListPodPredictionsPageRequest{} }
+func (m *ListPodPredictionsPageRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPodPredictionsPageRequest) ProtoMessage()    {}
+
+func (m *ListPodPredictionsPageRequest) GetRequest() *datahub_v1alpha1.ListPodPredictionsRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *ListPodPredictionsPageRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListPodPredictionsPageRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// ListPodPredictionsPageResponse Response of ListPodPredictionsPage
+type ListPodPredictionsPageResponse struct {
+	Status               *status.Status                    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PodPredictions       []*datahub_v1alpha1.PodPrediction `protobuf:"bytes,2,rep,name=pod_predictions,json=podPredictions,proto3" json:"pod_predictions,omitempty"`
+	NextPageToken        string                            `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *ListPodPredictionsPageResponse) Reset()         { *m = ListPodPredictionsPageResponse{} }
+func (m *ListPodPredictionsPageResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPodPredictionsPageResponse) ProtoMessage()    {}
+
+func (m *ListPodPredictionsPageResponse) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListPodPredictionsPageResponse) GetPodPredictions() []*datahub_v1alpha1.PodPrediction {
+	if m != nil {
+		return m.PodPredictions
+	}
+	return nil
+}
+
+func (m *ListPodPredictionsPageResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// ListNodePredictionsPageRequest Request of ListNodePredictionsPage
+type ListNodePredictionsPageRequest struct {
+	Request              *datahub_v1alpha1.ListNodePredictionsRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	PageSize             int32                                        `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                                       `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *ListNodePredictionsPageRequest) Reset()         { *m = ListNodePredictionsPageRequest{} }
+func (m *ListNodePredictionsPageRequest) String() string { return proto.CompactTextString(m) }
+func (*ListNodePredictionsPageRequest) ProtoMessage()    {}
+
+func (m *ListNodePredictionsPageRequest) GetRequest() *datahub_v1alpha1.ListNodePredictionsRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *ListNodePredictionsPageRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListNodePredictionsPageRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// ListNodePredictionsPageResponse Response of ListNodePredictionsPage
+type ListNodePredictionsPageResponse struct {
+	Status               *status.Status                     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	NodePredictions      []*datahub_v1alpha1.NodePrediction `protobuf:"bytes,2,rep,name=node_predictions,json=nodePredictions,proto3" json:"node_predictions,omitempty"`
+	NextPageToken        string                             `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *ListNodePredictionsPageResponse) Reset()         { *m = ListNodePredictionsPageResponse{} }
+func (m *ListNodePredictionsPageResponse) String() string { return proto.CompactTextString(m) }
+func (*ListNodePredictionsPageResponse) ProtoMessage()    {}
+
+func (m *ListNodePredictionsPageResponse) GetStatus() *status.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListNodePredictionsPageResponse) GetNodePredictions() []*datahub_v1alpha1.NodePrediction {
+	if m != nil {
+		return m.NodePredictions
+	}
+	return nil
+}
+
+func (m *ListNodePredictionsPageResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
@@ -0,0 +1,31 @@
+package instrumentation
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultAddress = ":9091"
+)
+
+// Config Configuration of the HTTP endpoint serving metrics
+type Config struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Address string `mapstructure:"address"`
+}
+
+// NewDefaultConfig Provide default configuration serving metrics on port 9091
+func NewDefaultConfig() *Config {
+	return &Config{
+		Enabled: true,
+		Address: defaultAddress,
+	}
+}
+
+// Validate Confirm the metrics configuration is validated
+func (c *Config) Validate() error {
+	if c.Enabled && c.Address == "" {
+		return errors.New("address is required when metrics are enabled")
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package instrumentation
+
+import (
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	RPCStatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor Return interceptor recording metrics of unary calls
+// handled by the server and continuing traces of callers
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		service, method := splitMethodName(info.FullMethod)
+		ctx, span, parent := startServerSpan(ctx)
+
+		serverInFlight.WithLabelValues(service, method).Inc()
+		defer serverInFlight.WithLabelValues(service, method).Dec()
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		code := responseCode(resp, err)
+		serverHandled.WithLabelValues(service, method, code.String()).Inc()
+		serverHandlingSeconds.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+		logSpan(info.FullMethod, span, parent, code, start)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor Return interceptor recording metrics of streaming
+// calls handled by the server and continuing traces of callers
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		service, method := splitMethodName(info.FullMethod)
+		ctx, span, parent := startServerSpan(ss.Context())
+
+		serverInFlight.WithLabelValues(service, method).Inc()
+		defer serverInFlight.WithLabelValues(service, method).Dec()
+
+		start := time.Now()
+		err := handler(srv, &tracedServerStream{ServerStream: ss, ctx: ctx})
+		code := status.Code(err)
+		serverHandled.WithLabelValues(service, method, code.String()).Inc()
+		serverHandlingSeconds.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+		logSpan(info.FullMethod, span, parent, code, start)
+		return err
+	}
+}
+
+// UnaryClientInterceptor Return interceptor recording metrics of unary calls
+// sent by the client and propagating traces to the server
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, fullMethod string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		service, method := splitMethodName(fullMethod)
+		ctx, _ = startClientSpan(ctx)
+
+		clientInFlight.WithLabelValues(service, method).Inc()
+		defer clientInFlight.WithLabelValues(service, method).Dec()
+
+		start := time.Now()
+		err := invoker(ctx, fullMethod, req, reply, cc, opts...)
+		clientHandled.WithLabelValues(service, method, responseCode(reply, err).String()).Inc()
+		clientHandlingSeconds.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
+
+// StreamClientInterceptor Return interceptor recording metrics of establishing
+// streaming calls by the client and propagating traces to the server
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, fullMethod string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		service, method := splitMethodName(fullMethod)
+		ctx, _ = startClientSpan(ctx)
+
+		start := time.Now()
+		cs, err := streamer(ctx, desc, cc, fullMethod, opts...)
+		clientHandled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+		clientHandlingSeconds.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+		return cs, err
+	}
+}
+
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tracedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// responseCode Return code of the call. Most APIs of datahub return errors in
+// the status of the response with a nil error, the code of the status is returned then.
+func responseCode(resp interface{}, err error) codes.Code {
+	if err != nil {
+		return status.Code(err)
+	}
+	switch r := resp.(type) {
+	case *RPCStatus.Status:
+		return codes.Code(r.GetCode())
+	case interface{ GetStatus() *RPCStatus.Status }:
+		return codes.Code(r.GetStatus().GetCode())
+	}
+	return codes.OK
+}
+
+// splitMethodName Split full method name "/package.Service/Method" into service and method
+func splitMethodName(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+func logSpan(fullMethod string, span, parent SpanContext, code codes.Code, start time.Time) {
+	parentSpanID := ""
+	if parent.IsValid() {
+		parentSpanID = parent.SpanIDString()
+	}
+	scope.Debugf("span %s code=%s duration=%s trace_id=%s span_id=%s parent_span_id=%s",
+		fullMethod, code.String(), time.Since(start).String(), span.TraceIDString(), span.SpanIDString(), parentSpanID)
+}
@@ -0,0 +1,32 @@
+package instrumentation
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// ServeMetrics Serve metrics of the default registry on path /metrics of the
+// address, it blocks until the server fails
+func ServeMetrics(address string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	scope.Infof("Serve metrics on %s", address)
+	if err := http.ListenAndServe(address, mux); err != nil {
+		return errors.Wrapf(err, "serve metrics on %s failed", address)
+	}
+	return nil
+}
+
+// Start Serve metrics in background if metrics are enabled
+func (c *Config) Start() {
+	if !c.Enabled {
+		return
+	}
+	go func() {
+		if err := ServeMetrics(c.Address); err != nil {
+			scope.Error(err.Error())
+		}
+	}()
+}
@@ -0,0 +1,86 @@
+package instrumentation
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"golang.org/x/net/context"
+	RPCStatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestParseTraceparent(t *testing.T) {
+	traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	span, err := ParseTraceparent(traceparent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !span.Sampled || span.TraceIDString() != "4bf92f3577b34da6a3ce929d0e0e4736" || span.SpanIDString() != "00f067aa0ba902b7" {
+		t.Errorf("unexpected span %+v", span)
+	}
+	if span.Traceparent() != traceparent {
+		t.Errorf("expect traceparent %s, got %s", traceparent, span.Traceparent())
+	}
+
+	for _, invalid := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	} {
+		if _, err := ParseTraceparent(invalid); err == nil {
+			t.Errorf("expect traceparent %q invalid", invalid)
+		}
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	parent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKeyTraceparent, parent))
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/DeletePods"}
+
+	var handled SpanContext
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handled, _ = SpanFromContext(ctx)
+		return &RPCStatus.Status{Code: int32(codes.PermissionDenied)}, nil
+	}
+	if _, err := UnaryServerInterceptor()(ctx, nil, info, handler); err != nil {
+		t.Fatal(err)
+	}
+
+	if handled.TraceIDString() != "4bf92f3577b34da6a3ce929d0e0e4736" || handled.SpanIDString() == "00f067aa0ba902b7" {
+		t.Errorf("expect new span of the trace of caller, got %+v", handled)
+	}
+	if count := testutil.ToFloat64(serverHandled.WithLabelValues("test.Service", "DeletePods", codes.PermissionDenied.String())); count != 1 {
+		t.Errorf("expect 1 call handled with code of status in response, got %v", count)
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	parent := SpanContext{TraceID: [16]byte{1}, SpanID: [8]byte{2}, Sampled: true}
+	ctx := ContextWithSpan(context.Background(), parent)
+
+	var sent []string
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		sent = md.Get(MetadataKeyTraceparent)
+		return nil
+	}
+	if err := UnaryClientInterceptor()(ctx, "/test.Service/ListPods", nil, nil, nil, invoker); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sent) != 1 {
+		t.Fatalf("expect traceparent sent to the server, got %v", sent)
+	}
+	span, err := ParseTraceparent(sent[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if span.TraceID != parent.TraceID || span.SpanID == parent.SpanID {
+		t.Errorf("expect new span of the trace of context sent, got %+v", span)
+	}
+}
@@ -0,0 +1,85 @@
+package instrumentation
+
+import (
+	"time"
+
+	"github.com/containers-ai/alameda/pkg/utils/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "alameda"
+)
+
+var (
+	scope = log.RegisterScope("instrumentation", "metrics and tracing", 0)
+
+	rpcLabels        = []string{"grpc_service", "grpc_method"}
+	rpcHandledLabels = []string{"grpc_service", "grpc_method", "grpc_code"}
+
+	serverHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_server",
+		Name:      "handled_total",
+		Help:      "Total number of RPCs completed on the server by code",
+	}, rpcHandledLabels)
+	serverHandlingSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_server",
+		Name:      "handling_seconds",
+		Help:      "Latency of RPCs handled by the server",
+		Buckets:   prometheus.DefBuckets,
+	}, rpcLabels)
+	serverInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_server",
+		Name:      "in_flight",
+		Help:      "Number of RPCs being handled by the server",
+	}, rpcLabels)
+
+	clientHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_client",
+		Name:      "handled_total",
+		Help:      "Total number of RPCs completed by the client by code",
+	}, rpcHandledLabels)
+	clientHandlingSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_client",
+		Name:      "handling_seconds",
+		Help:      "Latency of RPCs until the client receives the response",
+		Buckets:   prometheus.DefBuckets,
+	}, rpcLabels)
+	clientInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "grpc_client",
+		Name:      "in_flight",
+		Help:      "Number of RPCs sent by the client and waiting for the response",
+	}, rpcLabels)
+
+	backendRequestSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "backend",
+		Name:      "request_seconds",
+		Help:      "Latency of requests to backends like InfluxDB and Prometheus",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"backend", "operation", "result"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		serverHandled, serverHandlingSeconds, serverInFlight,
+		clientHandled, clientHandlingSeconds, clientInFlight,
+		backendRequestSeconds,
+	)
+}
+
+// ObserveBackendRequest Record latency of the request started at start to the
+// backend, e.g. "influxdb" or "prometheus", err is the result of the request
+func ObserveBackendRequest(backend, operation string, start time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	backendRequestSeconds.WithLabelValues(backend, operation, result).Observe(time.Since(start).Seconds())
+}
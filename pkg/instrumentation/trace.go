@@ -0,0 +1,149 @@
+package instrumentation
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+// Traces are propagated across gRPC calls in the W3C Trace Context format,
+// which is the default propagation of OpenTelemetry, so spans of callers and
+// callees instrumented with OpenTelemetry are joined into the same trace.
+
+const (
+	// MetadataKeyTraceparent Key of gRPC metadata carrying the W3C traceparent of the caller
+	MetadataKeyTraceparent = "traceparent"
+
+	traceparentVersion = "00"
+	flagSampled        = 0x01
+)
+
+// SpanContext Identity of a span propagated across gRPC calls
+type SpanContext struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+	Sampled bool
+}
+
+// IsValid Return true if both trace id and span id are not zero
+func (s SpanContext) IsValid() bool {
+	return s.TraceID != [16]byte{} && s.SpanID != [8]byte{}
+}
+
+// TraceIDString Return hex encoded trace id
+func (s SpanContext) TraceIDString() string {
+	return hex.EncodeToString(s.TraceID[:])
+}
+
+// SpanIDString Return hex encoded span id
+func (s SpanContext) SpanIDString() string {
+	return hex.EncodeToString(s.SpanID[:])
+}
+
+// Traceparent Return the span in the format of W3C traceparent header
+func (s SpanContext) Traceparent() string {
+	flags := 0
+	if s.Sampled {
+		flags |= flagSampled
+	}
+	return fmt.Sprintf("%s-%s-%s-%02x", traceparentVersion, s.TraceIDString(), s.SpanIDString(), flags)
+}
+
+// ParseTraceparent Parse span from W3C traceparent header
+func ParseTraceparent(traceparent string) (SpanContext, error) {
+	var span SpanContext
+	parts := strings.Split(strings.TrimSpace(traceparent), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" {
+		return span, errors.Errorf("invalid traceparent %q", traceparent)
+	}
+	if parts[0] == traceparentVersion && len(parts) != 4 {
+		return span, errors.Errorf("invalid traceparent %q", traceparent)
+	}
+	if err := decodeHex(parts[1], span.TraceID[:]); err != nil {
+		return span, errors.Wrapf(err, "invalid trace id of traceparent %q", traceparent)
+	}
+	if err := decodeHex(parts[2], span.SpanID[:]); err != nil {
+		return span, errors.Wrapf(err, "invalid span id of traceparent %q", traceparent)
+	}
+	var flags [1]byte
+	if err := decodeHex(parts[3], flags[:]); err != nil {
+		return span, errors.Wrapf(err, "invalid flags of traceparent %q", traceparent)
+	}
+	span.Sampled = flags[0]&flagSampled != 0
+	if !span.IsValid() {
+		return span, errors.Errorf("invalid traceparent %q", traceparent)
+	}
+	return span, nil
+}
+
+func decodeHex(s string, dst []byte) error {
+	if len(s) != hex.EncodedLen(len(dst)) || strings.ToLower(s) != s {
+		return errors.Errorf("expect %d lowercase hex digits", hex.EncodedLen(len(dst)))
+	}
+	_, err := hex.Decode(dst, []byte(s))
+	return err
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpan Return context carrying the span
+func ContextWithSpan(ctx context.Context, span SpanContext) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, span)
+}
+
+// SpanFromContext Return span carried by the context, false if there is none
+func SpanFromContext(ctx context.Context) (SpanContext, bool) {
+	span, ok := ctx.Value(spanContextKey{}).(SpanContext)
+	return span, ok
+}
+
+// StartSpan Return context carrying a new span, the span is a child of the
+// span carried by ctx or the root of a new trace if ctx carries none
+func StartSpan(ctx context.Context) (context.Context, SpanContext) {
+	span, ok := SpanFromContext(ctx)
+	if !ok || !span.IsValid() {
+		span = SpanContext{Sampled: true}
+		rand.Read(span.TraceID[:])
+	}
+	rand.Read(span.SpanID[:])
+	return ContextWithSpan(ctx, span), span
+}
+
+// spanFromIncomingContext Return span of the caller sent in gRPC metadata
+func spanFromIncomingContext(ctx context.Context) (SpanContext, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return SpanContext{}, false
+	}
+	values := md.Get(MetadataKeyTraceparent)
+	if len(values) == 0 {
+		return SpanContext{}, false
+	}
+	span, err := ParseTraceparent(values[0])
+	if err != nil {
+		scope.Debugf("ignore traceparent of caller: %s", err.Error())
+		return SpanContext{}, false
+	}
+	return span, true
+}
+
+// startServerSpan Return context carrying a new span continuing the trace of the caller
+func startServerSpan(ctx context.Context) (context.Context, SpanContext, SpanContext) {
+	parent, _ := spanFromIncomingContext(ctx)
+	if parent.IsValid() {
+		ctx = ContextWithSpan(ctx, parent)
+	}
+	ctx, span := StartSpan(ctx)
+	return ctx, span, parent
+}
+
+// startClientSpan Return outgoing context sending a new span to the callee
+func startClientSpan(ctx context.Context) (context.Context, SpanContext) {
+	ctx, span := StartSpan(ctx)
+	return metadata.AppendToOutgoingContext(ctx, MetadataKeyTraceparent, span.Traceparent()), span
+}
@@ -255,6 +255,27 @@ func (ort *OwnerReferenceTracer) GetControllerOwnerReferenceLink(objectMeta meta
 	return link, nil
 }
 
+// GetTopOwnerReferenceOfKinds returns the topmost controller ownerReference in the link whose kind is one of kinds,
+// e.g. Deployment rather than ReplicaSet and CronJob rather than Job. Returns empty ownerReference if none matched.
+func (ort *OwnerReferenceTracer) GetTopOwnerReferenceOfKinds(objectMeta meta_v1.Object, kinds map[string]bool) (meta_v1.OwnerReference, error) {
+
+	var ownerRef = meta_v1.OwnerReference{}
+
+	link, err := ort.GetControllerOwnerReferenceLink(objectMeta)
+	if err != nil {
+		return ownerRef, err
+	}
+
+	for i := len(link) - 1; i >= 0; i-- {
+		if kinds[link[i].Kind] {
+			ownerRef = link[i]
+			break
+		}
+	}
+
+	return ownerRef, nil
+}
+
 func (ort *OwnerReferenceTracer) getOwnerRefsOfResource(namespace, name string, gvk schema.GroupVersionKind) ([]meta_v1.OwnerReference, error) {
 
 	ownerRefs := make([]meta_v1.OwnerReference, 0)
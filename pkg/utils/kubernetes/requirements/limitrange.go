@@ -0,0 +1,61 @@
+package requirements
+
+import (
+	core_v1 "k8s.io/api/core/v1"
+)
+
+// ClampByLimitRanges clamps requests and limits of container resources into min, max and maxLimitRequestRatio
+// of container type items in LimitRanges, so the API server will not reject the pod
+func ClampByLimitRanges(resources *core_v1.ResourceRequirements, limitRanges []core_v1.LimitRange) {
+
+	for _, limitRange := range limitRanges {
+		for _, item := range limitRange.Spec.Limits {
+			if item.Type != core_v1.LimitTypeContainer {
+				continue
+			}
+			clampByLimitRangeItem(resources, item)
+		}
+	}
+}
+
+func clampByLimitRangeItem(resources *core_v1.ResourceRequirements, item core_v1.LimitRangeItem) {
+
+	for resourceName, max := range item.Max {
+		for _, resourceList := range []core_v1.ResourceList{resources.Requests, resources.Limits} {
+			if quantity, exist := resourceList[resourceName]; exist && quantity.Cmp(max) > 0 {
+				resourceList[resourceName] = max
+			}
+		}
+	}
+	for resourceName, min := range item.Min {
+		for _, resourceList := range []core_v1.ResourceList{resources.Requests, resources.Limits} {
+			if quantity, exist := resourceList[resourceName]; exist && quantity.Cmp(min) < 0 {
+				resourceList[resourceName] = min
+			}
+		}
+	}
+
+	// Raise request to keep limit/request ratio not greater than maxLimitRequestRatio
+	for resourceName, maxRatio := range item.MaxLimitRequestRatio {
+		limit, exist := resources.Limits[resourceName]
+		if !exist {
+			continue
+		}
+		request, exist := resources.Requests[resourceName]
+		if !exist || request.IsZero() || maxRatio.IsZero() {
+			continue
+		}
+		ratio := float64(limit.MilliValue()) / float64(request.MilliValue())
+		maxRatioValue := float64(maxRatio.MilliValue()) / 1000
+		if ratio > maxRatioValue {
+			resources.Requests[resourceName] = scaleQuantity(resourceName, limit, 1/maxRatioValue)
+		}
+	}
+
+	// Request must not exceed limit
+	for resourceName, request := range resources.Requests {
+		if limit, exist := resources.Limits[resourceName]; exist && request.Cmp(limit) > 0 {
+			resources.Requests[resourceName] = limit
+		}
+	}
+}
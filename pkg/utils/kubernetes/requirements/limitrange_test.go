@@ -0,0 +1,57 @@
+package requirements
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	k8s_resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestClampByLimitRanges(t *testing.T) {
+
+	limitRanges := []core_v1.LimitRange{
+		core_v1.LimitRange{
+			Spec: core_v1.LimitRangeSpec{
+				Limits: []core_v1.LimitRangeItem{
+					core_v1.LimitRangeItem{
+						Type: core_v1.LimitTypeContainer,
+						Max:  core_v1.ResourceList{core_v1.ResourceMemory: k8s_resource.MustParse("1Gi")},
+						Min:  core_v1.ResourceList{core_v1.ResourceCPU: k8s_resource.MustParse("100m")},
+						MaxLimitRequestRatio: core_v1.ResourceList{
+							core_v1.ResourceCPU: k8s_resource.MustParse("2"),
+						},
+					},
+					core_v1.LimitRangeItem{
+						Type: core_v1.LimitTypePod,
+						Max:  core_v1.ResourceList{core_v1.ResourceCPU: k8s_resource.MustParse("10m")},
+					},
+				},
+			},
+		},
+	}
+	resources := &core_v1.ResourceRequirements{
+		Requests: core_v1.ResourceList{
+			core_v1.ResourceCPU:    k8s_resource.MustParse("50m"),
+			core_v1.ResourceMemory: k8s_resource.MustParse("2Gi"),
+		},
+		Limits: core_v1.ResourceList{
+			core_v1.ResourceCPU:    k8s_resource.MustParse("500m"),
+			core_v1.ResourceMemory: k8s_resource.MustParse("4Gi"),
+		},
+	}
+
+	ClampByLimitRanges(resources, limitRanges)
+
+	assert := assert.New(t)
+	for resourceName, expected := range map[core_v1.ResourceName]string{core_v1.ResourceCPU: "250m", core_v1.ResourceMemory: "1Gi"} {
+		expectedQuantity := k8s_resource.MustParse(expected)
+		actual := resources.Requests[resourceName]
+		assert.Zero(expectedQuantity.Cmp(actual), "request %s: %s", resourceName, actual.String())
+	}
+	for resourceName, expected := range map[core_v1.ResourceName]string{core_v1.ResourceCPU: "500m", core_v1.ResourceMemory: "1Gi"} {
+		expectedQuantity := k8s_resource.MustParse(expected)
+		actual := resources.Limits[resourceName]
+		assert.Zero(expectedQuantity.Cmp(actual), "limit %s: %s", resourceName, actual.String())
+	}
+}
@@ -0,0 +1,66 @@
+package requirements
+
+import (
+	"math"
+
+	autoscalingv1alpha1 "github.com/containers-ai/alameda/operator/pkg/apis/autoscaling/v1alpha1"
+	core_v1 "k8s.io/api/core/v1"
+	k8s_resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// MergeRecommendation sets recommended requests and limits into resources per resource name, limits
+// are set by the limits policy of AlamedaScaler and resources without recommendation are kept
+func MergeRecommendation(resources *core_v1.ResourceRequirements, requests, limits core_v1.ResourceList, limitsPolicy autoscalingv1alpha1.LimitsPolicy) {
+
+	origin := resources.DeepCopy()
+
+	for resourceName, request := range requests {
+		if resources.Requests == nil {
+			resources.Requests = make(core_v1.ResourceList)
+		}
+		resources.Requests[resourceName] = request
+	}
+
+	switch limitsPolicy {
+	case autoscalingv1alpha1.LimitsPolicyPreserve:
+		// Request must not exceed the preserved limit
+		for resourceName, request := range requests {
+			if limit, exist := origin.Limits[resourceName]; exist && request.Cmp(limit) > 0 {
+				resources.Requests[resourceName] = limit
+			}
+		}
+	case autoscalingv1alpha1.LimitsPolicyPreserveRatio:
+		for resourceName, request := range requests {
+			limit, exist := origin.Limits[resourceName]
+			if !exist {
+				continue
+			}
+			// Request defaults to limit if it is not specified
+			ratio := 1.0
+			if originRequest, exist := origin.Requests[resourceName]; exist && !originRequest.IsZero() {
+				ratio = float64(limit.MilliValue()) / float64(originRequest.MilliValue())
+			}
+			resources.Limits[resourceName] = scaleQuantity(resourceName, request, ratio)
+		}
+	default:
+		for resourceName, limit := range limits {
+			if resources.Limits == nil {
+				resources.Limits = make(core_v1.ResourceList)
+			}
+			resources.Limits[resourceName] = limit
+		}
+		// Limit kept from the origin must not be less than the recommended request
+		for resourceName, request := range requests {
+			if limit, exist := resources.Limits[resourceName]; exist && request.Cmp(limit) > 0 {
+				resources.Limits[resourceName] = request
+			}
+		}
+	}
+}
+
+func scaleQuantity(resourceName core_v1.ResourceName, quantity k8s_resource.Quantity, ratio float64) k8s_resource.Quantity {
+	if resourceName == core_v1.ResourceCPU {
+		return *k8s_resource.NewMilliQuantity(int64(math.Ceil(float64(quantity.MilliValue())*ratio)), quantity.Format)
+	}
+	return *k8s_resource.NewQuantity(int64(math.Ceil(float64(quantity.Value())*ratio)), quantity.Format)
+}
@@ -0,0 +1,78 @@
+package requirements
+
+import (
+	"testing"
+
+	autoscalingv1alpha1 "github.com/containers-ai/alameda/operator/pkg/apis/autoscaling/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	k8s_resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestMergeRecommendation(t *testing.T) {
+
+	origin := core_v1.ResourceRequirements{
+		Requests: core_v1.ResourceList{
+			core_v1.ResourceCPU:              k8s_resource.MustParse("100m"),
+			core_v1.ResourceEphemeralStorage: k8s_resource.MustParse("1Gi"),
+			"nvidia.com/gpu":                 k8s_resource.MustParse("1"),
+		},
+		Limits: core_v1.ResourceList{
+			core_v1.ResourceCPU:    k8s_resource.MustParse("200m"),
+			core_v1.ResourceMemory: k8s_resource.MustParse("1Gi"),
+			"nvidia.com/gpu":       k8s_resource.MustParse("1"),
+		},
+	}
+	requests := core_v1.ResourceList{
+		core_v1.ResourceCPU:    k8s_resource.MustParse("300m"),
+		core_v1.ResourceMemory: k8s_resource.MustParse("512Mi"),
+	}
+	limits := core_v1.ResourceList{
+		core_v1.ResourceCPU:    k8s_resource.MustParse("400m"),
+		core_v1.ResourceMemory: k8s_resource.MustParse("768Mi"),
+	}
+
+	type testCase struct {
+		limitsPolicy     autoscalingv1alpha1.LimitsPolicy
+		expectedRequests map[core_v1.ResourceName]string
+		expectedLimits   map[core_v1.ResourceName]string
+	}
+	testCases := []testCase{
+		{
+			limitsPolicy:     autoscalingv1alpha1.LimitsPolicyRecommended,
+			expectedRequests: map[core_v1.ResourceName]string{core_v1.ResourceCPU: "300m", core_v1.ResourceMemory: "512Mi"},
+			expectedLimits:   map[core_v1.ResourceName]string{core_v1.ResourceCPU: "400m", core_v1.ResourceMemory: "768Mi"},
+		},
+		{
+			limitsPolicy:     autoscalingv1alpha1.LimitsPolicyPreserve,
+			expectedRequests: map[core_v1.ResourceName]string{core_v1.ResourceCPU: "200m", core_v1.ResourceMemory: "512Mi"},
+			expectedLimits:   map[core_v1.ResourceName]string{core_v1.ResourceCPU: "200m", core_v1.ResourceMemory: "1Gi"},
+		},
+		{
+			limitsPolicy:     autoscalingv1alpha1.LimitsPolicyPreserveRatio,
+			expectedRequests: map[core_v1.ResourceName]string{core_v1.ResourceCPU: "300m", core_v1.ResourceMemory: "512Mi"},
+			expectedLimits:   map[core_v1.ResourceName]string{core_v1.ResourceCPU: "600m", core_v1.ResourceMemory: "512Mi"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		resources := origin.DeepCopy()
+		MergeRecommendation(resources, requests, limits, testCase.limitsPolicy)
+
+		for resourceName, expected := range testCase.expectedRequests {
+			actual := resources.Requests[resourceName]
+			expectedQuantity := k8s_resource.MustParse(expected)
+			assert.Zero(t, expectedQuantity.Cmp(actual), "%s request %s: %s", testCase.limitsPolicy, resourceName, actual.String())
+		}
+		for resourceName, expected := range testCase.expectedLimits {
+			actual := resources.Limits[resourceName]
+			expectedQuantity := k8s_resource.MustParse(expected)
+			assert.Zero(t, expectedQuantity.Cmp(actual), "%s limit %s: %s", testCase.limitsPolicy, resourceName, actual.String())
+		}
+
+		// Resources without recommendation are kept
+		assert.Equal(t, origin.Requests[core_v1.ResourceEphemeralStorage], resources.Requests[core_v1.ResourceEphemeralStorage])
+		assert.Equal(t, origin.Requests["nvidia.com/gpu"], resources.Requests["nvidia.com/gpu"])
+		assert.Equal(t, origin.Limits["nvidia.com/gpu"], resources.Limits["nvidia.com/gpu"])
+	}
+}
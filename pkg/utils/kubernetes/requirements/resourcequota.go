@@ -0,0 +1,108 @@
+package requirements
+
+import (
+	"fmt"
+
+	core_v1 "k8s.io/api/core/v1"
+)
+
+var (
+	// standardRequestResourceNames are resources counted by ResourceQuota with the name without "requests." prefix
+	standardRequestResourceNames = map[core_v1.ResourceName]bool{
+		core_v1.ResourceCPU:              true,
+		core_v1.ResourceMemory:           true,
+		core_v1.ResourceEphemeralStorage: true,
+	}
+)
+
+// PodQuotaUsage returns usage of the pod counted by ResourceQuota, keyed by resource names of ResourceQuota
+func PodQuotaUsage(podSpec core_v1.PodSpec) core_v1.ResourceList {
+
+	usage := make(core_v1.ResourceList)
+	for resourceName, quantity := range podEffectiveResources(podSpec, func(r core_v1.ResourceRequirements) core_v1.ResourceList { return r.Requests }) {
+		usage[core_v1.ResourceName(core_v1.DefaultResourceRequestsPrefix+string(resourceName))] = quantity
+		if standardRequestResourceNames[resourceName] {
+			usage[resourceName] = quantity
+		}
+	}
+	for resourceName, quantity := range podEffectiveResources(podSpec, func(r core_v1.ResourceRequirements) core_v1.ResourceList { return r.Limits }) {
+		if !standardRequestResourceNames[resourceName] {
+			continue
+		}
+		usage[core_v1.ResourceName("limits."+string(resourceName))] = quantity
+	}
+	return usage
+}
+
+// podEffectiveResources returns the sum of resources of containers, or the max of resources of
+// init containers if it is greater, since init containers run one by one before containers
+func podEffectiveResources(podSpec core_v1.PodSpec, getResourceList func(core_v1.ResourceRequirements) core_v1.ResourceList) core_v1.ResourceList {
+
+	resourceList := make(core_v1.ResourceList)
+	for _, container := range podSpec.Containers {
+		for resourceName, quantity := range getResourceList(container.Resources) {
+			sum := resourceList[resourceName].DeepCopy()
+			sum.Add(quantity)
+			resourceList[resourceName] = sum
+		}
+	}
+	for _, container := range podSpec.InitContainers {
+		for resourceName, quantity := range getResourceList(container.Resources) {
+			if current, exist := resourceList[resourceName]; !exist || quantity.Cmp(current) > 0 {
+				resourceList[resourceName] = quantity.DeepCopy()
+			}
+		}
+	}
+	return resourceList
+}
+
+// ExceededResourceQuotas returns descriptions of ResourceQuotas whose hard limits would be exceeded if usage current
+// is replaced by usage desired, ResourceQuotas with scopes are not checked
+func ExceededResourceQuotas(resourceQuotas []core_v1.ResourceQuota, current, desired core_v1.ResourceList) []string {
+
+	exceeded := make([]string, 0)
+	for _, resourceQuota := range resourceQuotas {
+		if len(resourceQuota.Spec.Scopes) > 0 || resourceQuota.Spec.ScopeSelector != nil {
+			continue
+		}
+		for resourceName, hard := range resourceQuota.Status.Hard {
+			used, exist := resourceQuota.Status.Used[resourceName]
+			if !exist {
+				continue
+			}
+			desiredQuantity, exist := desired[resourceName]
+			if !exist {
+				continue
+			}
+			currentQuantity := current[resourceName]
+			if desiredQuantity.Cmp(currentQuantity) <= 0 {
+				continue
+			}
+			used = used.DeepCopy()
+			used.Sub(currentQuantity)
+			used.Add(desiredQuantity)
+			if used.Cmp(hard) > 0 {
+				exceeded = append(exceeded, fmt.Sprintf("%s/%s %s (hard: %s, used: %s)",
+					resourceQuota.Namespace, resourceQuota.Name, resourceName, hard.String(), used.String()))
+			}
+		}
+	}
+	return exceeded
+}
+
+// ReserveResourceQuotas records usage current being replaced by usage desired into used of ResourceQuotas
+func ReserveResourceQuotas(resourceQuotas []core_v1.ResourceQuota, current, desired core_v1.ResourceList) {
+
+	for _, resourceQuota := range resourceQuotas {
+		for resourceName, used := range resourceQuota.Status.Used {
+			desiredQuantity, exist := desired[resourceName]
+			if !exist {
+				continue
+			}
+			used = used.DeepCopy()
+			used.Sub(current[resourceName])
+			used.Add(desiredQuantity)
+			resourceQuota.Status.Used[resourceName] = used
+		}
+	}
+}
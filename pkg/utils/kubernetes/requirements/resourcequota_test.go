@@ -0,0 +1,68 @@
+package requirements
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	k8s_resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestPodQuotaUsage(t *testing.T) {
+
+	podSpec := core_v1.PodSpec{
+		InitContainers: []core_v1.Container{
+			core_v1.Container{Resources: core_v1.ResourceRequirements{
+				Requests: core_v1.ResourceList{core_v1.ResourceCPU: k8s_resource.MustParse("1")},
+			}},
+		},
+		Containers: []core_v1.Container{
+			core_v1.Container{Resources: core_v1.ResourceRequirements{
+				Requests: core_v1.ResourceList{core_v1.ResourceCPU: k8s_resource.MustParse("200m"), "nvidia.com/gpu": k8s_resource.MustParse("1")},
+				Limits:   core_v1.ResourceList{core_v1.ResourceMemory: k8s_resource.MustParse("1Gi")},
+			}},
+			core_v1.Container{Resources: core_v1.ResourceRequirements{
+				Requests: core_v1.ResourceList{core_v1.ResourceCPU: k8s_resource.MustParse("300m")},
+				Limits:   core_v1.ResourceList{core_v1.ResourceMemory: k8s_resource.MustParse("1Gi")},
+			}},
+		},
+	}
+
+	usage := PodQuotaUsage(podSpec)
+
+	assert := assert.New(t)
+	for resourceName, expected := range map[core_v1.ResourceName]string{
+		core_v1.ResourceCPU:          "1",
+		core_v1.ResourceRequestsCPU:  "1",
+		"requests.nvidia.com/gpu":    "1",
+		core_v1.ResourceLimitsMemory: "2Gi",
+	} {
+		expectedQuantity := k8s_resource.MustParse(expected)
+		actual := usage[resourceName]
+		assert.Zero(expectedQuantity.Cmp(actual), "usage %s: %s", resourceName, actual.String())
+	}
+	_, exist := usage["nvidia.com/gpu"]
+	assert.False(exist)
+}
+
+func TestExceededResourceQuotas(t *testing.T) {
+
+	resourceQuotas := []core_v1.ResourceQuota{
+		core_v1.ResourceQuota{
+			Status: core_v1.ResourceQuotaStatus{
+				Hard: core_v1.ResourceList{core_v1.ResourceRequestsCPU: k8s_resource.MustParse("1")},
+				Used: core_v1.ResourceList{core_v1.ResourceRequestsCPU: k8s_resource.MustParse("800m")},
+			},
+		},
+	}
+	current := core_v1.ResourceList{core_v1.ResourceRequestsCPU: k8s_resource.MustParse("200m")}
+
+	assert := assert.New(t)
+	desired := core_v1.ResourceList{core_v1.ResourceRequestsCPU: k8s_resource.MustParse("300m")}
+	assert.Empty(ExceededResourceQuotas(resourceQuotas, current, desired))
+
+	// Another pod fits only if the reserved usage of the first pod is counted
+	ReserveResourceQuotas(resourceQuotas, current, desired)
+	desired = core_v1.ResourceList{core_v1.ResourceRequestsCPU: k8s_resource.MustParse("350m")}
+	assert.Len(ExceededResourceQuotas(resourceQuotas, current, desired), 1)
+}
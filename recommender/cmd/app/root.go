@@ -0,0 +1,98 @@
+package app
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/containers-ai/alameda/cmd/app"
+	"github.com/containers-ai/alameda/pkg/utils/log"
+	"github.com/containers-ai/alameda/recommender"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	envVarPrefix = "ALAMEDA_RECOMMENDER"
+
+	defaultRotationMaxSizeMegabytes = 100
+	defaultRotationMaxBackups       = 7
+	defaultLogRotateOutputFile      = "/var/log/alameda/alameda-recommender.log"
+)
+
+var (
+	scope  *log.Scope
+	config recommender.Config
+
+	configurationFilePath string
+	RootCmd               = &cobra.Command{
+		Use:   "recommender",
+		Short: "alameda recommender",
+		Long:  "",
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(RunCmd)
+	RootCmd.AddCommand(app.VersionCmd)
+
+	RootCmd.PersistentFlags().StringVar(&configurationFilePath, "config", "/etc/alameda/recommender/recommender.yml", "The path to recommender configuration file.")
+}
+
+func initConfig() {
+
+	config = recommender.NewDefaultConfig()
+
+	initViperSetting()
+	mergeConfigFileValueWithDefaultConfigValue()
+}
+
+func initViperSetting() {
+
+	viper.SetEnvPrefix(envVarPrefix)
+	viper.AutomaticEnv()
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
+}
+
+func mergeConfigFileValueWithDefaultConfigValue() {
+
+	if configurationFilePath == "" {
+
+	} else {
+
+		viper.SetConfigFile(configurationFilePath)
+		err := viper.ReadInConfig()
+		if err != nil {
+			panic(errors.New("Read configuration file failed: " + err.Error()))
+		}
+		err = viper.Unmarshal(&config)
+		if err != nil {
+			panic(errors.New("Unmarshal configuration failed: " + err.Error()))
+		}
+	}
+}
+
+func initLogger() {
+
+	opt := log.DefaultOptions()
+	opt.RotationMaxSize = defaultRotationMaxSizeMegabytes
+	opt.RotationMaxBackups = defaultRotationMaxBackups
+	opt.RotateOutputPath = defaultLogRotateOutputFile
+	err := log.Configure(opt)
+	if err != nil {
+		panic(err)
+	}
+
+	scope = log.RegisterScope("recommender", "recommender server log", 0)
+}
+
+func setLoggerScopesWithConfig(config log.Config) {
+	for _, scope := range log.Scopes() {
+		scope.SetLogCallers(config.SetLogCallers == true)
+		if outputLvl, ok := log.StringToLevel(config.OutputLevel); ok {
+			scope.SetOutputLevel(outputLvl)
+		}
+		if stacktraceLevel, ok := log.StringToLevel(config.StackTraceLevel); ok {
+			scope.SetStackTraceLevel(stacktraceLevel)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/containers-ai/alameda/cmd/app"
+	DatahubCluster "github.com/containers-ai/alameda/pkg/framework/datahub/cluster"
+	"github.com/containers-ai/alameda/recommender/pkg/recommendation"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+var (
+	RunCmd = &cobra.Command{
+		Use:   "run",
+		Short: "start alameda recommender",
+		Long:  "",
+		Run: func(cmd *cobra.Command, args []string) {
+			app.PrintSoftwareVer()
+			initConfig()
+			initLogger()
+			setLoggerScopesWithConfig(*config.Log)
+			displayConfig()
+			startRecommender()
+		},
+	}
+)
+
+func displayConfig() {
+	if configBin, err := json.MarshalIndent(config, "", "  "); err != nil {
+		scope.Error(err.Error())
+	} else {
+		scope.Infof(fmt.Sprintf("Recommender configuration: %s", string(configBin)))
+	}
+}
+
+func startRecommender() {
+	if err := config.Validate(); err != nil {
+		scope.Errorf("Validate configuration failed: %s", err.Error())
+		return
+	}
+
+	dialOpts, err := config.Datahub.DialOptions()
+	if err != nil {
+		scope.Errorf("Build datahub credentials failed: %s", err.Error())
+		return
+	}
+	// recommender has no access to kubernetes, calls are scoped to the cluster datahub runs in if cluster id is not configured
+	clusterID, _ := DatahubCluster.ResolveClusterID(config.Datahub.ClusterID, nil)
+	dialOpts = append(dialOpts,
+		grpc.WithUnaryInterceptor(DatahubCluster.UnaryClientInterceptor(clusterID)),
+		grpc.WithStreamInterceptor(DatahubCluster.StreamClientInterceptor(clusterID)))
+	conn, err := grpc.Dial(config.Datahub.Address, dialOpts...)
+	if err != nil {
+		scope.Errorf("Dial to datahub failed: %s", err.Error())
+		return
+	}
+
+	defer conn.Close()
+
+	recommender := recommendation.NewRecommender(conn, *config.Recommendation)
+	recommender.Start()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	wg.Wait()
+}
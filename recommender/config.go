@@ -0,0 +1,42 @@
+package recommender
+
+import (
+	"github.com/containers-ai/alameda/pkg/utils/log"
+	"github.com/containers-ai/alameda/recommender/pkg/datahub"
+	"github.com/containers-ai/alameda/recommender/pkg/recommendation"
+)
+
+// Config is recommender configuration
+type Config struct {
+	Log            *log.Config            `mapstructure:"log"`
+	Datahub        *datahub.Config        `mapstructure:"datahub"`
+	Recommendation *recommendation.Config `mapstructure:"recommendation"`
+}
+
+// NewDefaultConfig returns Config instance
+func NewDefaultConfig() Config {
+
+	var (
+		defaultlogConfig            = log.NewDefaultConfig()
+		defaultDatahubConfig        = datahub.NewConfig()
+		defaultRecommendationConfig = recommendation.NewDefaultConfig()
+		config                      = Config{
+			Log:            &defaultlogConfig,
+			Datahub:        defaultDatahubConfig,
+			Recommendation: &defaultRecommendationConfig,
+		}
+	)
+
+	return config
+}
+
+func (c *Config) Validate() error {
+
+	if err := c.Datahub.Validate(); err != nil {
+		return err
+	}
+	if err := c.Recommendation.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package recommendation
+
+import (
+	"github.com/pkg/errors"
+)
+
+// StableConfig is configuration of recommendations for AlamedaScalers with policy stable
+type StableConfig struct {
+	// Percentile of the predicted upper bound taken as resource requests, in range (0, 100]
+	Percentile float64 `mapstructure:"percentile"`
+	// Headroom is the ratio added on top of the predicted upper bound, e.g. 0.2 for 20%
+	Headroom float64 `mapstructure:"headroom"`
+}
+
+// CompactConfig is configuration of recommendations for AlamedaScalers with policy compact
+type CompactConfig struct {
+	// Percentile of the raw prediction taken as resource requests, in range (0, 100]
+	Percentile float64 `mapstructure:"percentile"`
+}
+
+// Config is configuration of turning predictions into recommendations
+type Config struct {
+	Enable     bool  `mapstructure:"enable"`
+	CheckCycle int64 `mapstructure:"checkCycle"`
+	// Granularity is the seconds between predictions read and of recommendations written
+	Granularity int64 `mapstructure:"granularity"`
+	// ValidDuration is the seconds recommendations are valid for, predictions in this period are considered
+	ValidDuration int64         `mapstructure:"validDuration"`
+	Stable        StableConfig  `mapstructure:"stable"`
+	Compact       CompactConfig `mapstructure:"compact"`
+}
+
+// NewDefaultConfig returns Config instance
+func NewDefaultConfig() Config {
+	return Config{
+		Enable:        true,
+		CheckCycle:    300,
+		Granularity:   30,
+		ValidDuration: 900,
+		Stable: StableConfig{
+			Percentile: 95,
+			Headroom:   0.2,
+		},
+		Compact: CompactConfig{
+			Percentile: 50,
+		},
+	}
+}
+
+func (c *Config) Validate() error {
+
+	if c.CheckCycle <= 0 {
+		return errors.Errorf("checkCycle %d must be positive", c.CheckCycle)
+	}
+	if c.Granularity <= 0 {
+		return errors.Errorf("granularity %d must be positive", c.Granularity)
+	}
+	if c.ValidDuration < c.CheckCycle {
+		return errors.Errorf("validDuration %d must not be less than checkCycle %d", c.ValidDuration, c.CheckCycle)
+	}
+	if c.Stable.Percentile <= 0 || c.Stable.Percentile > 100 {
+		return errors.Errorf("stable percentile %f must be in range (0, 100]", c.Stable.Percentile)
+	}
+	if c.Stable.Headroom < 0 {
+		return errors.Errorf("stable headroom %f must not be negative", c.Stable.Headroom)
+	}
+	if c.Compact.Percentile <= 0 || c.Compact.Percentile > 100 {
+		return errors.Errorf("compact percentile %f must be in range (0, 100]", c.Compact.Percentile)
+	}
+	return nil
+}
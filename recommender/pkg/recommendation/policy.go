@@ -0,0 +1,132 @@
+package recommendation
+
+import (
+	"math"
+	"sort"
+	"strconv"
+
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+)
+
+var (
+	metricTypeResourceName = map[datahub_v1alpha1.MetricType]datahub_v1alpha1.ResourceName{
+		datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE: datahub_v1alpha1.ResourceName_CPU,
+		datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES:           datahub_v1alpha1.ResourceName_MEMORY,
+	}
+)
+
+// resourceBounds are the min/max of recommendations of a resource, zero means unbounded
+type resourceBounds struct {
+	min float64
+	max float64
+}
+
+// resourceRecommendation is the recommended requests and limits of a resource
+type resourceRecommendation struct {
+	request float64
+	limit   float64
+}
+
+// recommendResource turns predictions of a resource into recommendation with the policy,
+// returns false if there is no prediction to recommend with
+func recommendResource(cfg Config, policy datahub_v1alpha1.RecommendationPolicy, raw, upperbound []float64, bounds resourceBounds) (resourceRecommendation, bool) {
+
+	var (
+		samples    []float64
+		percentile float64
+		headroom   float64
+	)
+	switch policy {
+	case datahub_v1alpha1.RecommendationPolicy_COMPACT:
+		samples = raw
+		percentile = cfg.Compact.Percentile
+	default:
+		// AlamedaScaler without policy is stable
+		samples = upperbound
+		if len(samples) == 0 {
+			samples = raw
+		}
+		percentile = cfg.Stable.Percentile
+		headroom = cfg.Stable.Headroom
+	}
+	if len(samples) == 0 {
+		return resourceRecommendation{}, false
+	}
+
+	recommendation := resourceRecommendation{
+		request: bounds.clamp(getPercentile(samples, percentile) * (1 + headroom)),
+		limit:   bounds.clamp(getPercentile(samples, 100) * (1 + headroom)),
+	}
+	if recommendation.limit < recommendation.request {
+		recommendation.limit = recommendation.request
+	}
+	return recommendation, true
+}
+
+func (b resourceBounds) clamp(value float64) float64 {
+	if b.max > 0 && value > b.max {
+		value = b.max
+	}
+	if b.min > 0 && value < b.min {
+		value = b.min
+	}
+	return value
+}
+
+// buildResourceBounds returns bounds of the resource from AlamedaScaler's resources,
+// requests are the minimum and limits are the maximum of recommendations
+func buildResourceBounds(resources *datahub_v1alpha1.ResourceRequirements, metricType datahub_v1alpha1.MetricType) resourceBounds {
+
+	bounds := resourceBounds{}
+	resourceName, exist := metricTypeResourceName[metricType]
+	if !exist || resources == nil {
+		return bounds
+	}
+	if value, err := strconv.ParseFloat(resources.GetRequests()[int32(resourceName)], 64); err == nil {
+		bounds.min = value
+	}
+	if value, err := strconv.ParseFloat(resources.GetLimits()[int32(resourceName)], 64); err == nil {
+		bounds.max = value
+	}
+	return bounds
+}
+
+// getSampleValues returns values of samples in metric data with the metric type
+func getSampleValues(metricData []*datahub_v1alpha1.MetricData, metricType datahub_v1alpha1.MetricType) []float64 {
+
+	values := make([]float64, 0)
+	for _, data := range metricData {
+		if data.GetMetricType() != metricType {
+			continue
+		}
+		for _, sample := range data.GetData() {
+			value, err := strconv.ParseFloat(sample.GetNumValue(), 64)
+			if err != nil || math.IsNaN(value) {
+				continue
+			}
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// getPercentile returns the nearest-rank percentile p of values, values must not be empty
+func getPercentile(values []float64, p float64) float64 {
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	} else if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
+
+// formatResourceValue formats value into integer millicores or bytes
+func formatResourceValue(value float64) string {
+	return strconv.FormatFloat(math.Ceil(value), 'f', 0, 64)
+}
@@ -0,0 +1,98 @@
+package recommendation
+
+import (
+	"testing"
+
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecommendResource(t *testing.T) {
+
+	cfg := NewDefaultConfig()
+	raw := []float64{100, 120, 80, 110}
+	upperbound := []float64{150, 200, 120, 180}
+
+	type testCase struct {
+		name       string
+		policy     datahub_v1alpha1.RecommendationPolicy
+		raw        []float64
+		upperbound []float64
+		bounds     resourceBounds
+		expected   resourceRecommendation
+		ok         bool
+	}
+	testCases := []testCase{
+		{
+			name:       "stable uses upper bound with headroom",
+			policy:     datahub_v1alpha1.RecommendationPolicy_STABLE,
+			raw:        raw,
+			upperbound: upperbound,
+			expected:   resourceRecommendation{request: 240, limit: 240},
+			ok:         true,
+		},
+		{
+			name:       "undefined policy is stable",
+			policy:     datahub_v1alpha1.RecommendationPolicy_RECOMMENDATIONPOLICY_UNDEFINED,
+			raw:        raw,
+			upperbound: upperbound,
+			expected:   resourceRecommendation{request: 240, limit: 240},
+			ok:         true,
+		},
+		{
+			name:     "stable falls back to raw prediction without upper bound",
+			policy:   datahub_v1alpha1.RecommendationPolicy_STABLE,
+			raw:      raw,
+			expected: resourceRecommendation{request: 144, limit: 144},
+			ok:       true,
+		},
+		{
+			name:       "compact uses raw prediction",
+			policy:     datahub_v1alpha1.RecommendationPolicy_COMPACT,
+			raw:        raw,
+			upperbound: upperbound,
+			expected:   resourceRecommendation{request: 100, limit: 120},
+			ok:         true,
+		},
+		{
+			name:       "recommendations are clamped into bounds",
+			policy:     datahub_v1alpha1.RecommendationPolicy_COMPACT,
+			raw:        raw,
+			upperbound: upperbound,
+			bounds:     resourceBounds{min: 105, max: 115},
+			expected:   resourceRecommendation{request: 105, limit: 115},
+			ok:         true,
+		},
+		{
+			name:       "compact without raw prediction",
+			policy:     datahub_v1alpha1.RecommendationPolicy_COMPACT,
+			upperbound: upperbound,
+			ok:         false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual, ok := recommendResource(cfg, testCase.policy, testCase.raw, testCase.upperbound, testCase.bounds)
+		assert.Equal(t, testCase.ok, ok, testCase.name)
+		assert.InDelta(t, testCase.expected.request, actual.request, 0.001, testCase.name)
+		assert.InDelta(t, testCase.expected.limit, actual.limit, 0.001, testCase.name)
+	}
+}
+
+func TestBuildResourceBounds(t *testing.T) {
+
+	resources := &datahub_v1alpha1.ResourceRequirements{
+		Requests: map[int32]string{
+			int32(datahub_v1alpha1.ResourceName_CPU):    "100",
+			int32(datahub_v1alpha1.ResourceName_MEMORY): "",
+		},
+		Limits: map[int32]string{
+			int32(datahub_v1alpha1.ResourceName_CPU):    "500",
+			int32(datahub_v1alpha1.ResourceName_MEMORY): "1073741824",
+		},
+	}
+
+	assert.Equal(t, resourceBounds{min: 100, max: 500}, buildResourceBounds(resources, datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE))
+	assert.Equal(t, resourceBounds{max: 1073741824}, buildResourceBounds(resources, datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES))
+	assert.Equal(t, resourceBounds{}, buildResourceBounds(nil, datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE))
+}
@@ -0,0 +1,322 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	logUtil "github.com/containers-ai/alameda/pkg/utils/log"
+	datahub_v1alpha1 "github.com/containers-ai/api/alameda_api/v1alpha1/datahub"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc"
+)
+
+var (
+	scope = logUtil.RegisterScope("recommendation", "alameda recommender", 0)
+
+	recommendedMetricTypes = []datahub_v1alpha1.MetricType{
+		datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE,
+		datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES,
+	}
+)
+
+// Recommender turns predictions of pods into recommendations with policies of AlamedaScalers
+type Recommender struct {
+	datahubClnt datahub_v1alpha1.DatahubServiceClient
+	cfg         Config
+}
+
+// NewRecommender return Recommender instance
+func NewRecommender(datahubConn *grpc.ClientConn, cfg Config) *Recommender {
+	return &Recommender{
+		datahubClnt: datahub_v1alpha1.NewDatahubServiceClient(datahubConn),
+		cfg:         cfg,
+	}
+}
+
+// Start recommending pods watched by AlamedaScalers periodically
+func (recommender *Recommender) Start() {
+	go recommender.recommendProcess()
+}
+
+func (recommender *Recommender) recommendProcess() {
+	for {
+		if !recommender.cfg.Enable {
+			scope.Warn("recommender is not enabled")
+			return
+		}
+		if err := recommender.recommend(time.Now()); err != nil {
+			scope.Error(err.Error())
+		}
+		time.Sleep(time.Duration(recommender.cfg.CheckCycle) * time.Second)
+	}
+}
+
+func (recommender *Recommender) recommend(now time.Time) error {
+
+	pods, err := recommender.listAlamedaPods()
+	if err != nil {
+		return errors.Wrap(err, "list pods watched by AlamedaScalers failed")
+	}
+
+	podRecommendations := make([]*datahub_v1alpha1.PodRecommendation, 0, len(pods))
+	for _, pod := range pods {
+		podPrediction, err := recommender.getPodPrediction(pod.GetNamespacedName(), now)
+		if err != nil {
+			scope.Errorf("Get predictions of pod (%s/%s) failed, skip recommending pod: %s",
+				pod.GetNamespacedName().GetNamespace(), pod.GetNamespacedName().GetName(), err.Error())
+			continue
+		} else if podPrediction == nil {
+			scope.Debugf("No predictions of pod (%s/%s), skip recommending pod",
+				pod.GetNamespacedName().GetNamespace(), pod.GetNamespacedName().GetName())
+			continue
+		}
+		if podRecommendation := buildPodRecommendation(recommender.cfg, pod, podPrediction, now); podRecommendation != nil {
+			podRecommendations = append(podRecommendations, podRecommendation)
+		}
+	}
+
+	if err := recommender.createPodRecommendations(podRecommendations); err != nil {
+		return err
+	}
+	return recommender.createControllerRecommendations(buildControllerRecommendations(pods, podRecommendations, now))
+}
+
+func (recommender *Recommender) listAlamedaPods() ([]*datahub_v1alpha1.Pod, error) {
+
+	resp, err := recommender.datahubClnt.ListAlamedaPods(context.Background(), &datahub_v1alpha1.ListAlamedaPodsRequest{
+		Kind: datahub_v1alpha1.Kind_POD,
+	})
+	if err != nil {
+		return nil, errors.Errorf("list alameda pods from datahub failed: %s", err.Error())
+	} else if resp.Status == nil {
+		return nil, errors.Errorf("list alameda pods from datahub failed: receive nil status")
+	} else if resp.Status.Code != int32(code.Code_OK) {
+		return nil, errors.Errorf("list alameda pods from datahub failed: statusCode: %d, message: %s", resp.Status.Code, resp.Status.Message)
+	}
+	return resp.GetPods(), nil
+}
+
+// getPodPrediction returns predictions of the pod in the period recommendations are valid for, nil if not found
+func (recommender *Recommender) getPodPrediction(namespacedName *datahub_v1alpha1.NamespacedName, now time.Time) (*datahub_v1alpha1.PodPrediction, error) {
+
+	resp, err := recommender.datahubClnt.ListPodPredictions(context.Background(), &datahub_v1alpha1.ListPodPredictionsRequest{
+		NamespacedName: namespacedName,
+		Granularity:    recommender.cfg.Granularity,
+		QueryCondition: &datahub_v1alpha1.QueryCondition{
+			TimeRange: &datahub_v1alpha1.TimeRange{
+				StartTime: &timestamp.Timestamp{
+					Seconds: now.Unix(),
+				},
+				EndTime: &timestamp.Timestamp{
+					Seconds: now.Unix() + recommender.cfg.ValidDuration,
+				},
+			},
+			Order: datahub_v1alpha1.QueryCondition_ASC,
+		},
+	})
+	if err != nil {
+		return nil, errors.Errorf("list pod predictions from datahub failed: %s", err.Error())
+	} else if resp.Status == nil {
+		return nil, errors.Errorf("list pod predictions from datahub failed: receive nil status")
+	} else if resp.Status.Code != int32(code.Code_OK) {
+		return nil, errors.Errorf("list pod predictions from datahub failed: statusCode: %d, message: %s", resp.Status.Code, resp.Status.Message)
+	}
+
+	for _, podPrediction := range resp.GetPodPredictions() {
+		if podPrediction.GetNamespacedName().GetNamespace() == namespacedName.GetNamespace() &&
+			podPrediction.GetNamespacedName().GetName() == namespacedName.GetName() {
+			return podPrediction, nil
+		}
+	}
+	return nil, nil
+}
+
+func (recommender *Recommender) createPodRecommendations(podRecommendations []*datahub_v1alpha1.PodRecommendation) error {
+
+	if len(podRecommendations) == 0 {
+		return nil
+	}
+
+	status, err := recommender.datahubClnt.CreatePodRecommendations(context.Background(), &datahub_v1alpha1.CreatePodRecommendationsRequest{
+		PodRecommendations: podRecommendations,
+		Granularity:        recommender.cfg.Granularity,
+	})
+	if err != nil {
+		return errors.Errorf("create pod recommendations to datahub failed: %s", err.Error())
+	} else if status == nil {
+		return errors.Errorf("create pod recommendations to datahub failed: receive nil status")
+	} else if status.Code != int32(code.Code_OK) {
+		return errors.Errorf("create pod recommendations to datahub failed: statusCode: %d, message: %s", status.Code, status.Message)
+	}
+	scope.Infof("Create %d pod recommendations to datahub", len(podRecommendations))
+	return nil
+}
+
+func (recommender *Recommender) createControllerRecommendations(controllerRecommendations []*datahub_v1alpha1.ControllerRecommendation) error {
+
+	if len(controllerRecommendations) == 0 {
+		return nil
+	}
+
+	status, err := recommender.datahubClnt.CreateControllerRecommendations(context.Background(), &datahub_v1alpha1.CreateControllerRecommendationsRequest{
+		ControllerRecommendations: controllerRecommendations,
+	})
+	if err != nil {
+		return errors.Errorf("create controller recommendations to datahub failed: %s", err.Error())
+	} else if status == nil {
+		return errors.Errorf("create controller recommendations to datahub failed: receive nil status")
+	} else if status.Code != int32(code.Code_OK) {
+		return errors.Errorf("create controller recommendations to datahub failed: statusCode: %d, message: %s", status.Code, status.Message)
+	}
+	scope.Infof("Create %d controller recommendations to datahub", len(controllerRecommendations))
+	return nil
+}
+
+// buildPodRecommendation returns recommendation of the pod valid from now, nil if no container is recommended
+func buildPodRecommendation(cfg Config, pod *datahub_v1alpha1.Pod, podPrediction *datahub_v1alpha1.PodPrediction, now time.Time) *datahub_v1alpha1.PodRecommendation {
+
+	startTime := &timestamp.Timestamp{Seconds: now.Unix()}
+	endTime := &timestamp.Timestamp{Seconds: now.Unix() + cfg.ValidDuration}
+
+	containerRecommendations := make([]*datahub_v1alpha1.ContainerRecommendation, 0)
+	for _, containerPrediction := range podPrediction.GetContainerPredictions() {
+		limitRecommendations := make([]*datahub_v1alpha1.MetricData, 0)
+		requestRecommendations := make([]*datahub_v1alpha1.MetricData, 0)
+		for _, metricType := range recommendedMetricTypes {
+			raw := getSampleValues(containerPrediction.GetPredictedRawData(), metricType)
+			upperbound := getSampleValues(containerPrediction.GetPredictedUpperboundData(), metricType)
+			bounds := buildResourceBounds(pod.GetAlamedaScalerResources(), metricType)
+			recommendation, ok := recommendResource(cfg, pod.GetPolicy(), raw, upperbound, bounds)
+			if !ok {
+				continue
+			}
+			limitRecommendations = append(limitRecommendations, newMetricData(metricType, recommendation.limit, startTime, endTime, cfg.Granularity))
+			requestRecommendations = append(requestRecommendations, newMetricData(metricType, recommendation.request, startTime, endTime, cfg.Granularity))
+		}
+		if len(limitRecommendations) == 0 {
+			continue
+		}
+		containerRecommendations = append(containerRecommendations, &datahub_v1alpha1.ContainerRecommendation{
+			Name:                   containerPrediction.GetName(),
+			LimitRecommendations:   limitRecommendations,
+			RequestRecommendations: requestRecommendations,
+		})
+	}
+	if len(containerRecommendations) == 0 {
+		return nil
+	}
+
+	return &datahub_v1alpha1.PodRecommendation{
+		NamespacedName:           pod.GetNamespacedName(),
+		TopController:            pod.GetTopController(),
+		ContainerRecommendations: containerRecommendations,
+		StartTime:                startTime,
+		EndTime:                  endTime,
+	}
+}
+
+// buildControllerRecommendations returns recommendations of the largest pod resources of each controller.
+// Replicas are kept unchanged, controllers of AlamedaScalers with scaling tool type hpa are skipped.
+func buildControllerRecommendations(pods []*datahub_v1alpha1.Pod, podRecommendations []*datahub_v1alpha1.PodRecommendation, now time.Time) []*datahub_v1alpha1.ControllerRecommendation {
+
+	podMap := make(map[string]*datahub_v1alpha1.Pod, len(pods))
+	for _, pod := range pods {
+		podMap[getNamespacedNameKey(pod.GetNamespacedName())] = pod
+	}
+
+	specMap := make(map[string]*datahub_v1alpha1.ControllerRecommendedSpec)
+	controllerKeys := make([]string, 0)
+	for _, podRecommendation := range podRecommendations {
+		pod, exist := podMap[getNamespacedNameKey(podRecommendation.GetNamespacedName())]
+		if !exist || pod.GetEnable_HPA() {
+			continue
+		}
+		topController := pod.GetTopController()
+		if topController.GetNamespacedName() == nil {
+			continue
+		}
+
+		controllerKey := fmt.Sprintf("%s/%s", topController.GetKind(), getNamespacedNameKey(topController.GetNamespacedName()))
+		spec, exist := specMap[controllerKey]
+		if !exist {
+			spec = &datahub_v1alpha1.ControllerRecommendedSpec{
+				NamespacedName:  topController.GetNamespacedName(),
+				Kind:            topController.GetKind(),
+				CurrentReplicas: topController.GetReplicas(),
+				DesiredReplicas: topController.GetReplicas(),
+				Time:            &timestamp.Timestamp{Seconds: now.Unix()},
+				CreateTime:      &timestamp.Timestamp{Seconds: now.Unix()},
+			}
+			specMap[controllerKey] = spec
+			controllerKeys = append(controllerKeys, controllerKey)
+		}
+
+		currentCPURequests, currentMemRequests, currentCPULimits, currentMemLimits := sumPodResources(pod)
+		desiredCPULimits, desiredMemLimits := sumPodLimitRecommendations(podRecommendation)
+		spec.CurrentCpuRequests = math.Max(spec.CurrentCpuRequests, currentCPURequests)
+		spec.CurrentMemRequests = math.Max(spec.CurrentMemRequests, currentMemRequests)
+		spec.CurrentCpuLimits = math.Max(spec.CurrentCpuLimits, currentCPULimits)
+		spec.CurrentMemLimits = math.Max(spec.CurrentMemLimits, currentMemLimits)
+		spec.DesiredCpuLimits = math.Max(spec.DesiredCpuLimits, desiredCPULimits)
+		spec.DesiredMemLimits = math.Max(spec.DesiredMemLimits, desiredMemLimits)
+	}
+
+	controllerRecommendations := make([]*datahub_v1alpha1.ControllerRecommendation, 0, len(controllerKeys))
+	for _, controllerKey := range controllerKeys {
+		controllerRecommendations = append(controllerRecommendations, &datahub_v1alpha1.ControllerRecommendation{
+			RecommendedType: datahub_v1alpha1.ControllerRecommendedType_CRT_Primitive,
+			RecommendedSpec: specMap[controllerKey],
+		})
+	}
+	return controllerRecommendations
+}
+
+// sumPodResources returns current cpu/memory requests and limits of all containers in the pod
+func sumPodResources(pod *datahub_v1alpha1.Pod) (cpuRequests, memRequests, cpuLimits, memLimits float64) {
+	for _, container := range pod.GetContainers() {
+		cpuRequests += sum(getSampleValues(container.GetRequestResource(), datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE))
+		memRequests += sum(getSampleValues(container.GetRequestResource(), datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES))
+		cpuLimits += sum(getSampleValues(container.GetLimitResource(), datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE))
+		memLimits += sum(getSampleValues(container.GetLimitResource(), datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES))
+	}
+	return cpuRequests, memRequests, cpuLimits, memLimits
+}
+
+// sumPodLimitRecommendations returns recommended cpu/memory limits of all containers in the pod
+func sumPodLimitRecommendations(podRecommendation *datahub_v1alpha1.PodRecommendation) (cpuLimits, memLimits float64) {
+	for _, containerRecommendation := range podRecommendation.GetContainerRecommendations() {
+		cpuLimits += sum(getSampleValues(containerRecommendation.GetLimitRecommendations(), datahub_v1alpha1.MetricType_CPU_USAGE_SECONDS_PERCENTAGE))
+		memLimits += sum(getSampleValues(containerRecommendation.GetLimitRecommendations(), datahub_v1alpha1.MetricType_MEMORY_USAGE_BYTES))
+	}
+	return cpuLimits, memLimits
+}
+
+func newMetricData(metricType datahub_v1alpha1.MetricType, value float64, startTime, endTime *timestamp.Timestamp, granularity int64) *datahub_v1alpha1.MetricData {
+	return &datahub_v1alpha1.MetricData{
+		MetricType: metricType,
+		Data: []*datahub_v1alpha1.Sample{
+			&datahub_v1alpha1.Sample{
+				Time:     startTime,
+				EndTime:  endTime,
+				NumValue: formatResourceValue(value),
+			},
+		},
+		Granularity: granularity,
+	}
+}
+
+func getNamespacedNameKey(namespacedName *datahub_v1alpha1.NamespacedName) string {
+	return fmt.Sprintf("%s/%s", namespacedName.GetNamespace(), namespacedName.GetName())
+}
+
+func sum(values []float64) float64 {
+	total := 0.0
+	for _, value := range values {
+		total += value
+	}
+	return total
+}